@@ -14,45 +14,75 @@ import (
 	"fmt"
 	"hash"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/memsys"
 )
 
-// This source contains cluster-wide HMAC signing for HTTP redirect URLs.
+// This source contains cluster-wide HMAC signing for HTTP redirect URLs
+// and intra-cluster requests.
 //
 // Cluster Shared Key (aka "Cluster Secret Key" or `clusterKey`):
 //   A cryptographically random secret generated by the primary proxy
 //   and distributed to all cluster nodes via metasync. The key is versioned and tied to the
 //   current Smap (cluster map) version for consistency during topology changes.
 // Other stateful entities include:
-//   - cskOwner: holds {secret, ver, created} + nonce
+//   - cskOwner: holds {secret, ver, created} + nonce, and the previous key (rotation)
 //   - signer:   populates its own state and computes over HMAC payload
 // Configuration:
 //   Controlled via cluster config: auth.cluster_key.enabled
 //   See cmn.ClusterKeyConf for time windows and related settings.
 // HMAC:
 //   When enabled, proxies sign redirect URLs using HMAC-SHA256. The signature currently covers:
-//   HTTP method, URL path, proxy ID, Smap version, content-length, and a monotonic nonce.
+//   HTTP method, URL path, proxy ID, audited user (apc.QparamAuditRedUser, if any), Smap version,
+//   content-length, and a monotonic nonce.
 //   Incoming requests are validated once at the datapath entry point (h.parseReq => sign.verify()):
 //     - dpq fast-path embeds cskgrp directly;
 //     - url.Values slow-path uses cskFromQ().
 //   signer.verify() reconstructs the HMAC payload (using pid, smapVer, nonce, and URL fields)
 //   and compares it against the provided signature. Any mismatch results in a 401.
+// Intra-cluster requests:
+//   Control and data-plane requests between nodes (including metasync, keepalive,
+//   and transport streams) carry apc.HdrSenderSig:
+//   "<key version>.<unix nano>.<nonce>.<body digest>.<HMAC>",
+//   where the HMAC covers HTTP method, URL path and query, sender ID, transport session ID
+//   (stream handshake), body digest (sha256; "-" when the body is streamed), timestamp, and nonce.
+//   The signature is validated once at the server's entry (see netServer.ServeHTTP)
+//   for all requests to intra-cluster-only routes (see regNetHandlers) and all requests
+//   that identify themselves as intra-cluster (apc.HdrSenderID or apc.HdrT2TPutterID):
+//     - the timestamp must fall within auth.cluster_key.nonce_window;
+//     - (sender ID, nonce) must not repeat within the window (anti-replay);
+//     - the body, when digested, must match.
+//   Once a node has the key, unsigned intra-cluster requests and redirects get rejected
+//   (see cskOwner.requireSig).
+// Rotation:
+//   With auth.cluster_key.ttl > 0, primary generates a new key every so often and metasyncs it.
+//   For the duration of auth.cluster_key.rotation_grace, nodes keep signing with the previous key
+//   while accepting both. When the feature gets enabled (or a node receives its first key),
+//   "previous" means unsigned: the node signs with the new key while accepting unsigned requests.
+//   Each node persists the current key locally (fname.CSK) to survive restarts - sealed
+//   (AES-GCM) with the node-local owner-only key (cmn.SealKeyPath; see cos.Seal);
+//   disabling the feature clears the key cluster-wide.
 
 // TODO: sign.verify()
 // - check that csk is always initialized across (enable/disable; lifecycle events)
 //   (see related sign.warn())
-// - anti-replay logic (sliding time window, per-sender nonce tracking, etc.)
 // - validate pid: a) != "" (weak) or b) in Smap (strong)
-// - optionally, extend HMAC payload to cover assorted query parameters
+// - redirects: optionally, extend HMAC payload to cover assorted query parameters
 
 const (
 	cskTag = "csk"
@@ -63,6 +93,14 @@ const (
 	cskBase        = 36               // base for all signed int64/uint64 fields
 	cskURLOverhead = 160              // pid+utm+vpams+x+u qparams (~145 worst-case)
 	cskUptime      = 10 * time.Second // cluster uptime after which we start warning if CSK version remains zero
+
+	cskDfltWindow = time.Minute // (when not configured; see cmn.ClusterKeyConf)
+	cskRotateIval = time.Minute // primary: check whether it's time to rotate
+
+	cskStreamed  = "-"              // body digest placeholder: streamed body
+	cskSigFields = 5                // apc.HdrSenderSig: (version, timestamp, nonce, digest, HMAC)
+	cskPruneIval = 10 * time.Second // seen nonces
+	cskSeenShrds = 64               // power of two (see cskSeen)
 )
 
 type (
@@ -71,12 +109,36 @@ type (
 		h       *htrun
 		sb      *cos.SB
 		sig     []byte
+		user    string // apc.QparamAuditRedUser (see htaudit.go)
 		smapVer int64
 		nonce   uint64
 	}
 	cskOwner struct {
-		k     atomic.Value // => clusterKey
-		nonce atomic.Uint64
+		k         atomic.Value               // => clusterKey
+		prev      atomic.Pointer[clusterKey] // previous key - valid until prevUntil
+		prevUntil atomic.Int64               // mono.NanoTime
+		stored    atomic.Int64               // when the current key was stored (ditto)
+		nonce     atomic.Uint64
+		fpath     string // persistent copy of the current key
+		seal      []byte // node-local key to seal the former
+		seen      cskSeen
+		mu        sync.Mutex
+	}
+	// (sender ID, nonce) pairs seen within the time window, sharded by nonce
+	// (sequential per sender) to keep per-object intra-cluster requests from
+	// contending on a single lock
+	cskSeen struct {
+		shards [cskSeenShrds]cskSeenShard
+	}
+	cskSeenShard struct {
+		m      map[string]int64 // => expiration (unix nano)
+		pruned int64
+		mu     sync.Mutex
+	}
+	// persistent (jsp) version of the clusterKey; the secret is sealed (see cos.Seal)
+	cskDisk struct {
+		Secret []byte `json:"secret"`
+		Ver    int64  `json:"version,string"`
 	}
 	clusterKey struct {
 		secret  []byte
@@ -86,7 +148,7 @@ type (
 	// hash and buffer
 	handb struct {
 		h   hash.Hash
-		ver int64
+		k   *clusterKey // (versions may repeat after disable/enable)
 		buf [sha256.Size]byte
 	}
 )
@@ -108,35 +170,256 @@ func (csk *cskOwner) init() {
 	csk.reset()
 }
 
-func (csk *cskOwner) load() (k *clusterKey) { return csk.k.Load().(*clusterKey) }
-func (csk *cskOwner) store(k *clusterKey)   { csk.k.Store(k) }
-func (csk *cskOwner) reset()                { csk.k.Store(&clusterKey{}) }
+// load the key persisted prior to restart, if any
+func (csk *cskOwner) restore(config *cmn.Config) {
+	var (
+		err  error
+		disk = &cskDisk{}
+	)
+	if csk.seal, err = cos.LoadSealKey(cmn.SealKeyPath(config.ConfigDir)); err != nil {
+		nlog.Errorf("failed to load sealing key - won't persist %s: %v", cskTag, err)
+		return
+	}
+	csk.fpath = filepath.Join(config.ConfigDir, fname.CSK)
+	if _, err = jsp.LoadMeta(csk.fpath, disk); err != nil {
+		if !cos.IsNotExist(err) {
+			nlog.Errorf("failed to load %s from %s, err: %v", cskTag, csk.fpath, err)
+		}
+		return
+	}
+	secret, err := cos.Unseal(csk.seal, disk.Secret)
+	if err != nil || disk.Ver <= 0 || len(secret) != cskKeyLen {
+		nlog.Errorf("invalid %s in %s (v%d, len %d, err %v) - ignoring", cskTag, csk.fpath, disk.Ver, len(secret), err)
+		return
+	}
+	now := mono.NanoTime()
+	csk.store(&clusterKey{secret: secret, ver: disk.Ver, created: now})
+	csk.stored.Store(now)
+	nlog.Infoln("loaded", csk.load().String())
+}
+
+func (csk *cskOwner) store(k *clusterKey) { csk.k.Store(k) }
+
+func (csk *cskOwner) load() *clusterKey {
+	if k, ok := csk.k.Load().(*clusterKey); ok {
+		return k
+	}
+	return &clusterKey{} // (not initialized)
+}
+
+// clear the key, in memory and on disk (including when disabled)
+func (csk *cskOwner) reset() {
+	csk.mu.Lock()
+	csk.k.Store(&clusterKey{})
+	csk.prev.Store(nil)
+	if csk.fpath != "" {
+		if err := cos.RemoveFile(csk.fpath); err != nil {
+			nlog.Errorln("failed to remove", csk.fpath, err)
+		}
+	}
+	csk.mu.Unlock()
+}
+
+// install a new key; with grace > 0, the current one remains valid for the duration
+// (see also: signKey, prevKey)
+func (csk *cskOwner) update(nk *clusterKey, grace time.Duration) {
+	csk.mu.Lock()
+	now := mono.NanoTime()
+	if grace > 0 {
+		csk.prevUntil.Store(now + int64(grace))
+		csk.prev.Store(csk.load())
+	} else {
+		csk.prev.Store(nil)
+	}
+	csk.store(nk)
+	csk.stored.Store(now)
+	csk.persist(nk)
+	csk.mu.Unlock()
+}
+
+// non-primary: install the key received from primary
+func (csk *cskOwner) recv(nk *clusterKey) {
+	csk.update(nk, cskGrace(&cmn.GCO.Get().Auth))
+}
+
+// (under lock)
+func (csk *cskOwner) persist(k *clusterKey) {
+	if csk.fpath == "" {
+		return
+	}
+	sealed, err := cos.Seal(csk.seal, k.secret)
+	if err == nil {
+		disk := &cskDisk{Secret: sealed, Ver: k.ver}
+		if err = jsp.SaveMeta(csk.fpath, disk, nil); err == nil {
+			err = os.Chmod(csk.fpath, cos.PermRW)
+		}
+	}
+	if err != nil {
+		nlog.Errorf("failed to store %s at %s, err: %v", k, csk.fpath, err)
+	}
+}
 
 // primary only
 // version is monotonically increasing and is loosely tied to smap version:
 // the latter is strictly guarded by primary and  can therefore, be relied
 // upon in re: false-positive downgrades
-func (csk *cskOwner) gen(smapVer int64) (nk *clusterKey) {
+func (csk *cskOwner) gen(smapVer int64, grace time.Duration) (nk *clusterKey) {
 	ok := csk.load()
 	nk = &clusterKey{
 		secret:  cos.CryptoRandB(cskKeyLen),
 		ver:     max(smapVer, ok.ver+1),
 		created: mono.NanoTime(),
 	}
-	csk.store(nk)
+	csk.update(nk, grace)
 	return
 }
 
+// previous key while still within rotation grace; nil otherwise
+func (csk *cskOwner) prevKey() *clusterKey {
+	pk := csk.prev.Load()
+	if pk == nil || mono.NanoTime() >= csk.prevUntil.Load() {
+		return nil
+	}
+	return pk
+}
+
+// to sign with: the previous key during rotation grace (so that the nodes
+// that are yet to receive the new one can still verify), the current one otherwise;
+// zero version (prior to receiving the key) means "unsigned"
+func (csk *cskOwner) signKey() *clusterKey {
+	if pk := csk.prevKey(); pk != nil && pk.ver != 0 {
+		return pk
+	}
+	return csk.load()
+}
+
+// whether to reject unsigned intra-cluster requests and redirects
+// (not before receiving the key and not during enablement grace, when the
+// previous key is "no key" and other nodes may not have received the new one yet)
+func (csk *cskOwner) requireSig() bool {
+	if csk.load().ver == 0 {
+		return false
+	}
+	pk := csk.prevKey()
+	return pk == nil || pk.ver != 0
+}
+
+// by version: current or previous (the latter - within rotation grace)
+func (csk *cskOwner) lookup(ver int64) *clusterKey {
+	if ver == 0 {
+		return nil
+	}
+	if k := csk.load(); k.ver == ver {
+		return k
+	}
+	if pk := csk.prevKey(); pk != nil && pk.ver == ver {
+		return pk
+	}
+	return nil
+}
+
+// sign intra-cluster request (apc.HdrSenderSig); returns empty string when there's no key
+func (csk *cskOwner) signReq(ireq *core.IntraReq, sid string, now int64) string {
+	k := csk.signKey()
+	if k.ver == 0 {
+		return ""
+	}
+	var (
+		nonce  = csk.nonce.Add(1)
+		digest = cskStreamed
+		sb     = sbAlloc()
+	)
+	if !ireq.Streamed {
+		digest = cskDigest(ireq.Body)
+	}
+	sig := k.reqSig(sb, ireq, sid, digest, now, nonce)
+	out := strconv.FormatInt(k.ver, cskBase) + "." + strconv.FormatInt(now, cskBase) + "." +
+		strconv.FormatUint(nonce, cskBase) + "." + digest + "." + string(sig)
+	sbFree(sb)
+	return out
+}
+
+// validate signature and nonce; return the signed body digest for the caller to check
+// (ireq.Body is not used)
+func (csk *cskOwner) verifyReq(val string, ireq *core.IntraReq, sid string, now int64, window time.Duration) (string, error) {
+	parts := strings.Split(val, ".")
+	if len(parts) != cskSigFields || len(parts[4]) != cskSigLen {
+		return "", errors.New("malformed signature")
+	}
+	digest := parts[3]
+	if digest != cskStreamed && len(digest) != cskSigLen {
+		return "", errors.New("malformed signature (digest)")
+	}
+	ver, err := strconv.ParseInt(parts[0], cskBase, 64)
+	if err != nil {
+		return "", fmt.Errorf("malformed signature (version): %v", err)
+	}
+	ts, err := strconv.ParseInt(parts[1], cskBase, 64)
+	if err != nil {
+		return "", fmt.Errorf("malformed signature (timestamp): %v", err)
+	}
+	nonce, err := strconv.ParseUint(parts[2], cskBase, 64)
+	if err != nil {
+		return "", fmt.Errorf("malformed signature (nonce): %v", err)
+	}
+	if d := time.Duration(now - ts); d > window || d < -window {
+		return "", fmt.Errorf("signature timestamp is outside the allowed window (%v)", window)
+	}
+	k := csk.lookup(ver)
+	if k == nil {
+		return "", fmt.Errorf("unknown or expired %s v%d", cskTag, ver)
+	}
+	sb := sbAlloc()
+	ok := hmac.Equal(k.reqSig(sb, ireq, sid, digest, ts, nonce), cos.UnsafeB(parts[4]))
+	sbFree(sb)
+	if !ok {
+		return "", errors.New("HMAC signature mismatch")
+	}
+	// (after the timestamp window expires, the latter is sufficient)
+	if err := csk.seen.add(sid, parts[2], nonce, ts+int64(window), now); err != nil {
+		return "", err
+	}
+	return digest, nil
+}
+
+// sha256 of the (non-streamed) request body
+func cskDigest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// primary only: periodically rotate the key (auth.cluster_key.ttl)
+// and metasync the new one; other nodes keep accepting the previous key
+// for the duration of auth.cluster_key.rotation_grace
+func (p *proxy) rotateCSK(now int64) time.Duration {
+	config := cmn.GCO.Get()
+	if !config.Auth.CSKEnabled() || config.Auth.ClusterKey.TTL == 0 {
+		return cskRotateIval
+	}
+	smap := p.owner.smap.get()
+	if !smap.isPrimary(p.si) || !p.ClusterStarted() {
+		return cskRotateIval
+	}
+	csk := &p.owner.csk
+	if ok := csk.load(); ok.ver == 0 || time.Duration(now-csk.stored.Load()) < config.Auth.ClusterKey.TTL.D() {
+		return cskRotateIval
+	}
+	nk := csk.gen(smap.Version, cskGrace(&config.Auth))
+	_ = p.metasyncer.sync(revsPair{nk, p.newAmsgStr("rotate "+cskTag, nil)})
+	nlog.Infoln(p.String(), "rotated", nk.String())
+	return cskRotateIval
+}
+
 ////////////
 // signer //
 ////////////
 
 func (sign *signer) bufsize(pid string) int {
 	r := sign.r
-	return len(r.Method) + 1 + len(r.URL.Path) + 1 + len(pid) + 1 + 3*cos.SizeofI64 + cskSigLen
+	return len(r.Method) + 1 + len(r.URL.Path) + 1 + len(pid) + 1 + len(sign.user) + 1 + 3*cos.SizeofI64 + cskSigLen
 }
 
-func (sign *signer) compute(pid string) {
+func (sign *signer) compute(pid string, k *clusterKey) {
 	var (
 		r    = sign.r
 		sb   = sign.sb
@@ -145,13 +428,15 @@ func (sign *signer) compute(pid string) {
 	sb.Reset(size, false /*allow shrink*/)
 	debug.Assert(sb.Cap() >= size, sb.Cap(), " vs ", size)
 
-	// (method, url path, pid)
+	// (method, url path, pid, user)
 	sb.WriteString(r.Method)
 	sb.WriteUint8(cskSepa)
 	sb.WriteString(r.URL.Path)
 	sb.WriteUint8(cskSepa)
 	sb.WriteString(pid)
 	sb.WriteUint8(cskSepa)
+	sb.WriteString(sign.user)
+	sb.WriteUint8(cskSepa)
 
 	// (smap, content-length, nonce)
 	var b8 [8]byte
@@ -162,31 +447,12 @@ func (sign *signer) compute(pid string) {
 	binary.BigEndian.PutUint64(b8[:], sign.nonce)
 	sb.WriteBytes(b8[:])
 
-	// load key
-	k := sign.h.owner.csk.load()
 	if k.ver == 0 {
+		sign.sig = nil
 		sign.warn()
 		return
 	}
-	debug.Assert(len(k.secret) > 0, k.String())
-
-	// hash
-	hb := handAlloc()
-	if hb.h == nil || hb.ver != k.ver {
-		hb.h = hmac.New(sha256.New, k.secret)
-		hb.ver = k.ver
-	} else {
-		hb.h.Reset()
-	}
-	buf, l := sb.Bytes(), sb.Len()
-	hb.h.Write(buf[:l])
-	hb.h.Sum(hb.buf[:0])
-
-	// encode and append => sb
-	sign.sig = sb.ReserveAppend(cskSigLen)
-	base64.RawURLEncoding.Encode(sign.sig, hb.buf[:])
-
-	handFree(hb)
+	sign.sig = k.sign(sb)
 }
 
 func (sign *signer) warn() {
@@ -240,14 +506,25 @@ func (sign *signer) verify(pid string, cskgrp *cskgrp) (int, error) {
 	sign.nonce = cskgrp.nonce
 	sign.smapVer = cskgrp.smapVer
 
-	size := sign.bufsize(pid)
+	var (
+		csk  = &sign.h.owner.csk
+		size = sign.bufsize(pid)
+	)
 	sign.sb = sbAlloc()
 	sign.sb.Reset(size, true /*allow shrink*/)
 
-	sign.compute(pid)
+	sign.compute(pid, csk.load())
+	ok := bytes.Equal(sign.sig, cos.UnsafeB(cskgrp.hmacSig))
+	if !ok {
+		// signed by the previous key (rotation grace)
+		if pk := csk.prevKey(); pk != nil && pk.ver != 0 {
+			sign.compute(pid, pk)
+			ok = bytes.Equal(sign.sig, cos.UnsafeB(cskgrp.hmacSig))
+		}
+	}
 	sbFree(sign.sb)
 
-	if !bytes.Equal(sign.sig, cos.UnsafeB(cskgrp.hmacSig)) {
+	if !ok {
 		return http.StatusUnauthorized, errors.New("HMAC signature mismatch")
 	}
 	return 0, nil
@@ -257,6 +534,47 @@ func (sign *signer) verify(pid string, cskgrp *cskgrp) (int, error) {
 // clusterKey //
 ////////////////
 
+// hash sb contents and append the resulting (encoded) signature => sb
+func (k *clusterKey) sign(sb *cos.SB) []byte {
+	debug.Assert(len(k.secret) > 0, k.String())
+	hb := handAlloc()
+	if hb.h == nil || hb.k != k {
+		hb.h = hmac.New(sha256.New, k.secret)
+		hb.k = k
+	} else {
+		hb.h.Reset()
+	}
+	buf, l := sb.Bytes(), sb.Len()
+	hb.h.Write(buf[:l])
+	hb.h.Sum(hb.buf[:0])
+
+	sig := sb.ReserveAppend(cskSigLen)
+	base64.RawURLEncoding.Encode(sig, hb.buf[:])
+
+	handFree(hb)
+	return sig
+}
+
+// intra-cluster request: (method, url path and query, sender ID, session ID, body digest, timestamp, nonce)
+func (k *clusterKey) reqSig(sb *cos.SB, ireq *core.IntraReq, sid, digest string, ts int64, nonce uint64) []byte {
+	size := len(ireq.Method) + 1 + len(ireq.Path) + 1 + len(ireq.RawQuery) + 1 + len(sid) + 1 +
+		len(ireq.SessID) + 1 + len(digest) + 1 + 2*cos.SizeofI64 + cskSigLen
+	sb.Reset(size, true /*allow shrink*/)
+
+	for _, s := range [...]string{ireq.Method, ireq.Path, ireq.RawQuery, sid, ireq.SessID, digest} {
+		sb.WriteString(s)
+		sb.WriteUint8(cskSepa)
+	}
+
+	var b8 [8]byte
+	binary.BigEndian.PutUint64(b8[:], uint64(ts))
+	sb.WriteBytes(b8[:])
+	binary.BigEndian.PutUint64(b8[:], nonce)
+	sb.WriteBytes(b8[:])
+
+	return k.sign(sb)
+}
+
 // as byte-packer
 func (k *clusterKey) PackedSize() int {
 	return cos.SizeofI64 + cos.SizeofI64 + cos.PackedBytesLen(k.secret)
@@ -297,6 +615,59 @@ func (k *clusterKey) String() string {
 	return cskTag + " v" + strconv.FormatInt(k.ver, 10)
 }
 
+/////////////
+// cskSeen //
+/////////////
+
+func (seen *cskSeen) add(sid, nonce string, n uint64, exp, now int64) error {
+	var (
+		key   = sid + "." + nonce
+		shard = &seen.shards[n&(cskSeenShrds-1)]
+	)
+	shard.mu.Lock()
+	if shard.m == nil {
+		shard.m = make(map[string]int64, 16)
+	}
+	if now-shard.pruned > int64(cskPruneIval) {
+		for k, e := range shard.m {
+			if e < now {
+				delete(shard.m, k)
+			}
+		}
+		shard.pruned = now
+	}
+	_, ok := shard.m[key]
+	if !ok {
+		shard.m[key] = exp
+	}
+	shard.mu.Unlock()
+	if ok {
+		return fmt.Errorf("replayed request (sender %q, nonce %s)", sid, nonce)
+	}
+	return nil
+}
+
+/////////////
+// cskDisk //
+/////////////
+
+func (*cskDisk) JspOpts() jsp.Options { return jsp.CCSign(cmn.MetaverCSK) }
+
+// rotation grace and timestamp window (both are validated and defaulted by the config)
+func cskGrace(auth *cmn.AuthConf) time.Duration {
+	if ck := auth.ClusterKey; ck != nil && ck.RotationGrace > 0 {
+		return ck.RotationGrace.D()
+	}
+	return cskDfltWindow
+}
+
+func cskWindow(auth *cmn.AuthConf) time.Duration {
+	if ck := auth.ClusterKey; ck != nil && ck.NonceWindow > 0 {
+		return ck.NonceWindow.D()
+	}
+	return cskDfltWindow
+}
+
 //
 // mem-pools
 //
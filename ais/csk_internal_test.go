@@ -0,0 +1,273 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const cskTestWindow = time.Minute
+
+func newTestCSK(ver int64) *clusterKey {
+	return &clusterKey{secret: []byte("0123456789abcdef"), ver: ver, created: mono.NanoTime()}
+}
+
+func TestCSK_SignReq(t *testing.T) {
+	var (
+		csk  = &cskOwner{}
+		ireq = &core.IntraReq{Method: http.MethodPut, Path: apc.URLPathMetasync.S, RawQuery: "a=1", Body: []byte("payload")}
+		now  = time.Now().UnixNano()
+	)
+	csk.init()
+	tassert.Errorf(t, csk.signReq(ireq, "t1", now) == "", "expected no signature without key")
+
+	csk.store(newTestCSK(7))
+	sig := csk.signReq(ireq, "t1", now)
+	tassert.Fatalf(t, strings.Count(sig, ".") == cskSigFields-1, "malformed signature %q", sig)
+	digest, err := csk.verifyReq(sig, ireq, "t1", now, cskTestWindow)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, digest == cskDigest(ireq.Body), "unexpected body digest %q", digest)
+
+	// replayed
+	_, err = csk.verifyReq(sig, ireq, "t1", now, cskTestWindow)
+	tassert.Errorf(t, err != nil, "replayed signature accepted")
+
+	// tampered
+	for _, tc := range []struct {
+		name string
+		ireq core.IntraReq
+		sid  string
+	}{
+		{"method", core.IntraReq{Method: http.MethodPost, Path: ireq.Path, RawQuery: ireq.RawQuery}, "t1"},
+		{"path", core.IntraReq{Method: ireq.Method, Path: ireq.Path + "x", RawQuery: ireq.RawQuery}, "t1"},
+		{"query", core.IntraReq{Method: ireq.Method, Path: ireq.Path, RawQuery: "a=2"}, "t1"},
+		{"session", core.IntraReq{Method: ireq.Method, Path: ireq.Path, RawQuery: ireq.RawQuery, SessID: "1"}, "t1"},
+		{"sender", *ireq, "t2"},
+	} {
+		sig := csk.signReq(ireq, "t1", now)
+		_, err := csk.verifyReq(sig, &tc.ireq, tc.sid, now, cskTestWindow)
+		tassert.Errorf(t, err != nil, "%s not covered", tc.name)
+	}
+	sig = csk.signReq(ireq, "t1", now)
+	_, err = csk.verifyReq(sig[:len(sig)-1]+"A", ireq, "t1", now, cskTestWindow)
+	tassert.Errorf(t, err != nil, "modified HMAC accepted")
+	_, err = csk.verifyReq(strings.Replace(sig, cskDigest(ireq.Body), cskDigest([]byte("other")), 1), ireq, "t1", now, cskTestWindow)
+	tassert.Errorf(t, err != nil, "modified body digest accepted")
+	_, err = csk.verifyReq("garbage", ireq, "t1", now, cskTestWindow)
+	tassert.Errorf(t, err != nil, "malformed signature accepted")
+
+	// streamed
+	sreq := &core.IntraReq{Method: http.MethodPut, Path: "/v1/transport/trname", SessID: "12", Streamed: true}
+	digest, err = csk.verifyReq(csk.signReq(sreq, "t1", now), sreq, "t1", now, cskTestWindow)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, digest == cskStreamed, "expected streamed, got %q", digest)
+
+	// outside time window
+	sig = csk.signReq(ireq, "t1", now)
+	later := now + int64(cskTestWindow) + int64(time.Second)
+	_, err = csk.verifyReq(sig, ireq, "t1", later, cskTestWindow)
+	tassert.Errorf(t, err != nil, "stale signature accepted")
+
+	// signed by a different secret (same version)
+	other := &cskOwner{}
+	other.init()
+	other.store(&clusterKey{secret: []byte("fedcba9876543210"), ver: 7})
+	sig = other.signReq(ireq, "t1", now)
+	_, err = csk.verifyReq(sig, ireq, "t1", now, cskTestWindow)
+	tassert.Errorf(t, err != nil, "foreign key accepted")
+}
+
+func TestCSK_Rotation(t *testing.T) {
+	var (
+		csk  = &cskOwner{}
+		ireq = &core.IntraReq{Method: http.MethodPost, Path: apc.URLPathCluKalive.S}
+		now  = time.Now().UnixNano()
+	)
+	csk.init()
+	k1 := newTestCSK(10)
+	csk.store(k1)
+	tassert.Errorf(t, csk.requireSig(), "expected signatures to be required")
+	old := csk.signReq(ireq, "p1", now)
+
+	// rotate: keep signing with (and accepting) the previous key during grace
+	k2 := csk.gen(12, time.Minute)
+	tassert.Fatalf(t, k2.ver == 12, "expected v12, got %s", k2)
+	tassert.Errorf(t, csk.signKey() == k1, "expected to sign with previous key during grace")
+	tassert.Errorf(t, csk.lookup(k1.ver) == k1 && csk.lookup(k2.ver) == k2, "expected both keys during grace")
+	old2 := csk.signReq(ireq, "p1", now)
+	_, err := csk.verifyReq(old, ireq, "p1", now, cskTestWindow)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, csk.requireSig(), "expected signatures to be required during rotation")
+
+	// grace expired
+	csk.prevUntil.Store(mono.NanoTime() - 1)
+	tassert.Errorf(t, csk.signKey() == k2, "expected to sign with new key")
+	tassert.Errorf(t, csk.lookup(k1.ver) == nil, "previous key must expire")
+	_, err = csk.verifyReq(old2, ireq, "p1", now, cskTestWindow)
+	tassert.Errorf(t, err != nil, "signature by expired key accepted")
+	sig := csk.signReq(ireq, "p1", now)
+	_, err = csk.verifyReq(sig, ireq, "p1", now, cskTestWindow)
+	tassert.CheckFatal(t, err)
+
+	// version is monotonic
+	k3 := csk.gen(5, 0)
+	tassert.Errorf(t, k3.ver == k2.ver+1, "expected v%d, got %s", k2.ver+1, k3)
+	tassert.Errorf(t, csk.prevKey() == nil, "no grace - no previous key")
+}
+
+func TestCSK_Enable(t *testing.T) {
+	csk := &cskOwner{}
+	csk.init()
+	tassert.Errorf(t, !csk.requireSig(), "no key - nothing to require")
+
+	// enablement grace: previous means unsigned
+	k := csk.gen(3, time.Minute)
+	tassert.Errorf(t, !csk.requireSig(), "unsigned requests must be accepted during enablement grace")
+	tassert.Errorf(t, csk.signKey() == k, "expected to sign with %s during enablement grace", k)
+	tassert.Errorf(t, csk.lookup(0) == nil, "zero version must never verify")
+
+	csk.prevUntil.Store(mono.NanoTime() - 1)
+	tassert.Errorf(t, csk.requireSig(), "expected signatures to be required after grace")
+}
+
+func TestCSK_Persist(t *testing.T) {
+	config := &cmn.Config{}
+	config.ConfigDir = t.TempDir()
+
+	csk := &cskOwner{}
+	csk.init()
+	csk.restore(config)
+	tassert.Fatalf(t, csk.load().ver == 0, "unexpected %s", csk.load())
+	k := csk.gen(21, 0)
+
+	// restart
+	csk = &cskOwner{}
+	csk.init()
+	csk.restore(config)
+	tassert.Fatalf(t, csk.load().ver == k.ver && string(csk.load().secret) == string(k.secret),
+		"expected %s to survive restart, got %s", k, csk.load())
+
+	// at rest: sealed and owner-only
+	finfo, err := os.Stat(csk.fpath)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, finfo.Mode().Perm() == cos.PermRW, "expected %v, got %v", cos.PermRW, finfo.Mode().Perm())
+	disk := &cskDisk{}
+	_, err = jsp.LoadMeta(csk.fpath, disk)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !bytes.Contains(disk.Secret, k.secret), "secret persisted in plaintext")
+
+	// disabled
+	csk.reset()
+	csk = &cskOwner{}
+	csk.init()
+	csk.restore(config)
+	tassert.Errorf(t, csk.load().ver == 0, "expected no key after reset, got %s", csk.load())
+}
+
+func TestCSK_VerifyIntra(t *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	config.Auth.ClusterKey = &cmn.ClusterKeyConf{Enabled: true}
+	cmn.GCO.CommitUpdate(config)
+	cmn.Rom.Set(&config.ClusterConfig)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Auth.ClusterKey = nil
+		cmn.GCO.CommitUpdate(config)
+		cmn.Rom.Set(&config.ClusterConfig)
+	}()
+
+	h := &htrun{si: &meta.Snode{DaeID: "t1", DaeType: apc.Target}}
+	h.owner.csk.init()
+	h.owner.csk.store(newTestCSK(3))
+	streams := cos.JoinW0(apc.Version, apc.ObjStream)
+	h.intraOnly = []string{apc.URLPathMetasync.S, streams}
+
+	verify := func(req *http.Request) int {
+		w := httptest.NewRecorder()
+		if h.verifyIntra(w, req) {
+			return http.StatusOK
+		}
+		return w.Code
+	}
+	newReq := func(method, path string, body []byte, sign bool) *http.Request {
+		req := httptest.NewRequest(method, path, bytes.NewReader(body))
+		if sign {
+			args := &cmn.HreqArgs{Body: body}
+			req.Header.Set(apc.HdrSenderID, h.SID())
+			h.signReq(req, args)
+		}
+		return req
+	}
+	body := []byte("metasync payload")
+
+	// intra-cluster-only routes
+	tassert.Errorf(t, verify(newReq(http.MethodPut, apc.URLPathMetasync.S, body, false)) == http.StatusUnauthorized,
+		"unsigned request to intra-cluster route accepted")
+	tassert.Errorf(t, verify(newReq(http.MethodPut, streams+"/trname", nil, false)) == http.StatusUnauthorized,
+		"unsigned stream accepted")
+	req := newReq(http.MethodPut, apc.URLPathMetasync.S, body, true)
+	tassert.Fatalf(t, verify(req) == http.StatusOK, "signed request rejected")
+	b, err := cos.ReadAll(req.Body)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(b, body), "body not preserved")
+
+	// replay; modified body
+	req = newReq(http.MethodPut, apc.URLPathMetasync.S, body, true)
+	replay := httptest.NewRequest(http.MethodPut, apc.URLPathMetasync.S, bytes.NewReader(body))
+	replay.Header = req.Header.Clone()
+	tassert.Errorf(t, verify(req) == http.StatusOK, "signed request rejected")
+	tassert.Errorf(t, verify(replay) == http.StatusUnauthorized, "replayed request accepted")
+	req = newReq(http.MethodPut, apc.URLPathMetasync.S, body, true)
+	req.Body = io.NopCloser(bytes.NewReader([]byte("forged payload!!")))
+	tassert.Errorf(t, verify(req) == http.StatusUnauthorized, "modified body accepted")
+
+	// public API
+	tassert.Errorf(t, verify(newReq(http.MethodGet, apc.URLPathObjects.Join("b", "o"), nil, false)) == http.StatusOK,
+		"public API request rejected")
+	req = newReq(http.MethodGet, apc.URLPathObjects.Join("b", "o"), nil, false)
+	req.Header.Set(apc.HdrSenderID, "t2")
+	tassert.Errorf(t, verify(req) == http.StatusUnauthorized, "unsigned request from a 'node' accepted")
+}
+
+func TestCSK_RedirectUser(t *testing.T) {
+	h := &htrun{}
+	h.owner.csk.init()
+	h.owner.csk.store(newTestCSK(5))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/objects/b/o", http.NoBody)
+	sign := &signer{r: r, h: h, sb: sbAlloc(), smapVer: 3, nonce: 42, user: "alice"}
+	sign.compute("p1", h.owner.csk.load())
+	csk := &cskgrp{nonce: 42, smapVer: 3, hmacSig: string(sign.sig)}
+	sbFree(sign.sb)
+
+	for _, tc := range []struct {
+		user string
+		ok   bool
+	}{
+		{"alice", true},
+		{"mallory", false},
+		{"", false},
+	} {
+		v := &signer{r: r, h: h, user: tc.user}
+		_, err := v.verify("p1", csk)
+		tassert.Errorf(t, (err == nil) == tc.ok, "user %q: expected ok=%t, got %v", tc.user, tc.ok, err)
+	}
+}
@@ -394,7 +394,10 @@ func (p *proxy) primaryStartup(loadedSmap *smapX, config *cmn.Config, ntargets i
 		pairs     = []revsPair{{smap, actMsgExt}, {bmd, actMsgExt}, {cluConfig, actMsgExt}}
 	)
 	if cluConfig.Auth.CSKEnabled() {
-		k := p.owner.csk.gen(smap.Version)
+		k := p.owner.csk.load()
+		if k.ver == 0 { // otherwise, keep using the one persisted prior to restart
+			k = p.owner.csk.gen(smap.Version, cskGrace(&cluConfig.Auth))
+		}
 		pairs = append(pairs, revsPair{k, actMsgExt})
 	} // note: can do signed requests after this point
 
@@ -407,6 +410,9 @@ func (p *proxy) primaryStartup(loadedSmap *smapX, config *cmn.Config, ntargets i
 	if etlMD.Version > 0 {
 		_ = p.metasyncer.sync(revsPair{etlMD, actMsgExt})
 	}
+	if jsched := p.jsched.owner.get(); jsched.Version > 0 {
+		_ = p.metasyncer.sync(revsPair{jsched, actMsgExt})
+	}
 
 	// 12. clear regpool
 	p.reg.mu.Lock()
@@ -0,0 +1,282 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/audit"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// audit log (see package audit):
+// - proxies record client requests they terminate (i.e., control plane), excluding
+//   GET and HEAD unless the latter are bucket-scoped and the bucket has `audit.reads`
+// - targets record (redirected) data-plane requests to buckets with enabled `audit`
+// - the user (authenticated by the proxy) is passed to the target with the redirect
+//   and is recorded only when covered by the redirect's HMAC (auth.cluster_key);
+//   without the latter, proxies record redirected requests as well
+// - native and S3 API alike
+// - intra-cluster requests are never recorded
+
+// response writer that remembers status and (via readActionMsg) ActMsg
+type auditW struct {
+	http.ResponseWriter
+	action string
+	name   string
+	status int
+}
+
+// interface guard
+var _ io.ReaderFrom = (*auditW)(nil)
+
+func (aw *auditW) WriteHeader(code int) {
+	if aw.status == 0 {
+		aw.status = code
+	}
+	aw.ResponseWriter.WriteHeader(code)
+}
+
+func (aw *auditW) Write(b []byte) (int, error) {
+	if aw.status == 0 {
+		aw.status = http.StatusOK
+	}
+	return aw.ResponseWriter.Write(b)
+}
+
+// (keep sendfile)
+func (aw *auditW) ReadFrom(r io.Reader) (int64, error) {
+	if aw.status == 0 {
+		aw.status = http.StatusOK
+	}
+	if rf, ok := aw.ResponseWriter.(io.ReaderFrom); ok {
+		return rf.ReadFrom(r)
+	}
+	return io.Copy(aw.ResponseWriter, r)
+}
+
+func (aw *auditW) Unwrap() http.ResponseWriter { return aw.ResponseWriter }
+
+func (aw *auditW) setMsg(msg *apc.ActMsg) {
+	aw.action, aw.name = msg.Action, msg.Name
+}
+
+func isS3Path(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/"+apc.URLPathS3.S+"/")
+}
+
+// bucket-scoped (/v1/buckets/<name>, /v1/objects/<name>/<object>) request
+// (used by audit and proxy-side cache)
+func _urlBck(r *http.Request) (bck *meta.Bck, objName string) {
+	var items []string
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/"+apc.URLPathObjects.S+"/"):
+		items, _ = cmn.ParseURL(r.URL.Path, apc.URLPathObjects.L, 0, true)
+	case strings.HasPrefix(r.URL.Path, "/"+apc.URLPathBuckets.S+"/"):
+		items, _ = cmn.ParseURL(r.URL.Path, apc.URLPathBuckets.L, 0, true)
+	}
+	if len(items) == 0 || items[0] == "" {
+		return nil, ""
+	}
+	q := r.URL.Query()
+	provider := apc.NormalizeProvider(q.Get(apc.QparamProvider))
+	if provider == "" {
+		return nil, ""
+	}
+	bck = meta.NewBck(items[0], provider, cmn.ParseNsUname(q.Get(apc.QparamNamespace)))
+	if len(items) > 1 {
+		objName = items[1]
+	}
+	return bck, objName
+}
+
+// S3 API (/s3/<bucket>[/<object>]): bucket by name - within the namespace
+// that the proxy forwards to the target (see p.initByNameOnly), if any
+func (h *htrun) _s3Bck(r *http.Request) (bck *meta.Bck, objName string) {
+	items, err := cmn.ParseURL(r.URL.Path, apc.URLPathS3.L, 0, true)
+	if err != nil || len(items) == 0 || items[0] == "" {
+		return nil, ""
+	}
+	if uname := r.URL.Query().Get(apc.QparamNamespace); uname != "" {
+		bck, _, err = meta.InitByNameNs(items[0], cmn.ParseNsUname(uname), h.owner.bmd)
+	} else {
+		bck, _, err = meta.InitByNameOnly(items[0], h.owner.bmd)
+	}
+	if err != nil {
+		return nil, ""
+	}
+	if len(items) > 1 {
+		objName = items[1]
+	}
+	return bck, objName
+}
+
+func (h *htrun) auditBck(r *http.Request) (*meta.Bck, string) {
+	if isS3Path(r) {
+		return h._s3Bck(r)
+	}
+	return _urlBck(r)
+}
+
+func (h *htrun) bckAuditConf(bck *meta.Bck) *cmn.AuditBckConf {
+	if bck == nil {
+		return nil
+	}
+	props, ok := h.owner.bmd.get().Get(bck)
+	if !ok {
+		return nil
+	}
+	return &props.Audit
+}
+
+func (h *htrun) audit(aw *auditW, r *http.Request, started time.Time, elapsed int64, user string, bck *meta.Bck, objName string) {
+	ev := &apc.AuditEvent{
+		Time:    started.UnixNano(),
+		Node:    h.SID(),
+		User:    user,
+		Method:  r.Method,
+		Path:    r.URL.Path,
+		Action:  aw.action,
+		Name:    aw.name,
+		Object:  objName,
+		Remote:  r.RemoteAddr,
+		Status:  cos.NonZero(aw.status, http.StatusOK),
+		Latency: elapsed,
+	}
+	if bck != nil {
+		ev.Bucket = bck.Cname("")
+	}
+	audit.Record(ev, &cmn.GCO.Get().Audit)
+}
+
+//
+// proxy
+//
+
+func (p *proxy) audited(f http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !cmn.Rom.AuditEnabled() || p.checkIntraCall(r.Header, false /*from primary*/) == nil {
+			f(w, r)
+			return
+		}
+		var (
+			aw      = &auditW{ResponseWriter: w}
+			started = time.Now()
+			tstart  = mono.NanoTime()
+		)
+		if isS3Path(r) && cmn.Rom.AuthEnabled() {
+			// verify SigV4 upfront (idempotent - see p.s3verify) to record the signer
+			var err error
+			if r, err = p.s3verify(r); err != nil {
+				s3.WriteErr(aw, r, err, http.StatusForbidden)
+				p.audit(aw, r, started, mono.SinceNano(tstart), "", nil, "")
+				return
+			}
+		}
+		f(aw, r)
+		if aw.status >= http.StatusMultipleChoices && aw.status < http.StatusBadRequest && cmn.Rom.CSKEnabled() {
+			return // redirected (the target will record it)
+		}
+		bck, objName := p.auditBck(r)
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			if conf := p.bckAuditConf(bck); conf == nil || !conf.Enabled || !conf.Reads {
+				return
+			}
+		}
+		p.audit(aw, r, started, mono.SinceNano(tstart), p.auditUser(r), bck, objName)
+	}
+}
+
+// authenticated user (cached claims; compare w/ validateToken)
+func (p *proxy) auditUser(r *http.Request) string {
+	if !cmn.Rom.AuthEnabled() {
+		return ""
+	}
+	// S3 request signed with AuthN-issued access key (see p.s3verify)
+	if claims, ok := r.Context().Value(cos.CtxS3Signer).(*tok.AISClaims); ok {
+		return claimsUser(claims)
+	}
+	tokenHdr, err := tok.ExtractToken(r.Header)
+	if err != nil {
+		return ""
+	}
+	claims, ok := p.authn.tokenMap.getClaims(tokenHdr.Token)
+	if !ok || claims == nil {
+		return ""
+	}
+	return claimsUser(claims)
+}
+
+func claimsUser(claims *tok.AISClaims) string {
+	if sub, err := claims.GetSubject(); err == nil && sub != "" {
+		return sub
+	}
+	return claims.UserID
+}
+
+// redirecting: pass the user to the target (see redurl) - only when signed
+func (p *proxy) auditRedUser(r *http.Request) string {
+	if !cmn.Rom.AuditEnabled() || !cmn.Rom.CSKEnabled() {
+		return ""
+	}
+	return p.auditUser(r)
+}
+
+//
+// target
+//
+
+func (t *target) audited(f http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !cmn.Rom.AuditEnabled() || t.checkIntraCall(r.Header, false /*from primary*/) == nil {
+			f(w, r)
+			return
+		}
+		bck, objName := t.auditBck(r)
+		conf := t.bckAuditConf(bck)
+		if conf == nil || !conf.Enabled || !strings.HasPrefix(objName, conf.Prefix) {
+			f(w, r)
+			return
+		}
+		if !conf.Reads && (r.Method == http.MethodGet || r.Method == http.MethodHead) {
+			f(w, r)
+			return
+		}
+		var (
+			aw      = &auditW{ResponseWriter: w}
+			started = time.Now()
+			tstart  = mono.NanoTime()
+		)
+		f(aw, r)
+		t.audit(aw, r, started, mono.SinceNano(tstart), t.auditRedUser(r), bck, objName)
+	}
+}
+
+// the user passed by the redirecting proxy: trusted only when covered by the redirect's HMAC
+func (t *target) auditRedUser(r *http.Request) string {
+	q := r.URL.Query()
+	user := q.Get(apc.QparamAuditRedUser)
+	if user == "" || isRedirect(q) == "" || !cmn.Rom.CSKEnabled() {
+		return ""
+	}
+	csk, err := cskFromQ(q)
+	if err != nil || csk == nil {
+		return ""
+	}
+	sign := &signer{r: r, h: &t.htrun, user: user}
+	if _, err := sign.verify(q.Get(apc.QparamPID), csk); err != nil {
+		return ""
+	}
+	return user
+}
@@ -172,6 +172,7 @@ type (
 	netServer struct {
 		s             *http.Server
 		muxers        httpMuxers
+		verify        func(w http.ResponseWriter, r *http.Request) bool // intra-cluster signature (see csk.go)
 		sndRcvBufSize int
 		sync.Mutex
 		lowLatencyToS bool
@@ -504,6 +505,9 @@ func (*nlogWriter) Write(p []byte) (int, error) {
 ///////////////
 
 func (server *netServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if server.verify != nil && !server.verify(w, r) {
+		return
+	}
 	server.muxers.ServeHTTP(w, r)
 }
 
@@ -515,7 +519,7 @@ func (server *netServer) listen(addr string, logger *log.Logger, tlsConf *tls.Co
 	server.Lock()
 	server.s = &http.Server{
 		Addr:              addr,
-		Handler:           server.muxers,
+		Handler:           server,
 		ErrorLog:          logger,
 		ReadHeaderTimeout: apc.ReadHeaderTimeout,
 		IdleTimeout:       dfltIdleTimeout,
@@ -538,6 +542,9 @@ func (server *netServer) listen(addr string, logger *log.Logger, tlsConf *tls.Co
 	}
 
 	server.s.TLSConfig = tlsConf
+	if tlsConf != nil {
+		server.s.ConnContext = tlsConnContext // (client certificates - see prxmtls.go)
+	}
 	server.Unlock()
 
 	return server._listen(config)
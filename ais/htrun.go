@@ -25,6 +25,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/audit"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -86,6 +87,7 @@ type htrun struct {
 		csk    cskOwner
 	}
 	keepalive keepaliver
+	intraOnly []string // URL paths of the intra-cluster-only routes (see verifyIntra)
 	statsT    stats.Tracker
 	si        *meta.Snode
 	gmm       *memsys.MMSA // system pagesize-based memory manager and slab allocator
@@ -138,8 +140,9 @@ func (h *htrun) parseReq(w http.ResponseWriter, r *http.Request, apireq *apiRequ
 	bckName := apireq.items[apireq.bckIdx]
 
 	var (
-		csk *cskgrp
-		pid string
+		csk  *cskgrp
+		pid  string
+		user string
 	)
 	if apireq.dpq != nil {
 		if err = apireq.dpq.parse(r.URL.RawQuery); err != nil {
@@ -148,8 +151,8 @@ func (h *htrun) parseReq(w http.ResponseWriter, r *http.Request, apireq *apiRequ
 		}
 		if cmn.Rom.CSKEnabled() && apireq.dpq.csk.hmacSig != "" {
 			csk = &apireq.dpq.csk
-			pid = apireq.dpq.sys.pid
 		}
+		pid, user = apireq.dpq.sys.pid, apireq.dpq.m[apc.QparamAuditRedUser]
 	} else {
 		apireq.query = r.URL.Query()
 		if cmn.Rom.CSKEnabled() {
@@ -158,19 +161,24 @@ func (h *htrun) parseReq(w http.ResponseWriter, r *http.Request, apireq *apiRequ
 				return err
 			}
 		}
-		pid = apireq.query.Get(apc.QparamPID)
+		pid, user = apireq.query.Get(apc.QparamPID), apireq.query.Get(apc.QparamAuditRedUser)
 	}
 
 	if csk != nil {
 		sign := &signer{
-			r: r,
-			h: h,
+			r:    r,
+			h:    h,
+			user: user,
 		}
 		ecode, err := sign.verify(pid, csk)
 		if err != nil {
 			h.writeErr(w, r, err, ecode)
 			return err
 		}
+	} else if pid != "" && cmn.Rom.CSKEnabled() && h.owner.csk.requireSig() {
+		err = fmt.Errorf("%s: unsigned redirect from %s", h, meta.Pname(pid))
+		h.writeErr(w, r, err, http.StatusUnauthorized)
+		return err
 	}
 
 	if apireq.bck, err = newBckFromQ(bckName, apireq.query, apireq.dpq); err != nil {
@@ -250,7 +258,7 @@ func (h *htrun) recvCluMeta(body []byte) (*cluMeta, error) {
 			return nil, newErrDowngrade(h.si, ok.String(), nk.String())
 		}
 	} else {
-		h.owner.csk.store(&nk)
+		h.owner.csk.recv(&nk)
 		nlog.Infoln(h.String(), "received", nk.String())
 	}
 	return &cm, nil
@@ -307,6 +315,8 @@ func (h *htrun) regNetHandlers(networkHandlers []networkHandler) {
 		if nh.net.isSet(accessNetPublic) {
 			handlePub(path, nh.h)
 			reg = true
+		} else {
+			h.intraOnly = append(h.intraOnly, path)
 		}
 		if config.HostNet.UseIntraControl && nh.net.isSet(accessNetIntraControl) {
 			handleControl(path, nh.h)
@@ -473,6 +483,7 @@ func (h *htrun) initPhase1(config *cmn.Config) {
 		PubNet:     pubAddr,
 		ControlNet: ctrlAddr,
 		DataNet:    dataAddr,
+		Domain:     config.FailureDomain,
 	}
 	if l := len(pubExtra); l > 0 {
 		h.si.PubExtra = make([]meta.NetInfo, l)
@@ -489,19 +500,19 @@ func (h *htrun) initPhase1(config *cmn.Config) {
 
 	// pub-net first
 	muxers := newMuxers(tracing.IsEnabled())
-	g.netServ.pub = &netServer{muxers: muxers, sndRcvBufSize: tcpbuf, useIPv6: useIPv6}
+	g.netServ.pub = &netServer{muxers: muxers, verify: h.verifyIntra, sndRcvBufSize: tcpbuf, useIPv6: useIPv6}
 
 	// intra-control and intra-data
 	// note: separate config and isolated bandwidth - strongly recommended
 	g.netServ.control = g.netServ.pub
 	if config.HostNet.UseIntraControl {
 		muxers = newMuxers(false /*enableTracing*/)
-		g.netServ.control = &netServer{muxers: muxers, sndRcvBufSize: 0, lowLatencyToS: true, useIPv6: useIPv6}
+		g.netServ.control = &netServer{muxers: muxers, verify: h.verifyIntra, sndRcvBufSize: 0, lowLatencyToS: true, useIPv6: useIPv6}
 	}
 	g.netServ.data = g.netServ.control // if not configured, intra-data net is intra-control
 	if config.HostNet.UseIntraData {
 		muxers = newMuxers(false /*enableTracing*/)
-		g.netServ.data = &netServer{muxers: muxers, sndRcvBufSize: tcpbuf, useIPv6: useIPv6}
+		g.netServ.data = &netServer{muxers: muxers, verify: h.verifyIntra, sndRcvBufSize: tcpbuf, useIPv6: useIPv6}
 	}
 }
 
@@ -533,11 +544,13 @@ func (h *htrun) initPhase2(config *cmn.Config) {
 	initDataClient(config, g.netServ.data.useIPv6)
 
 	load.Init()
+	audit.Init(config.LogDir)
 
 	h.owner.smap = newSmapOwner(config)
 	h.owner.rmd = newRMDOwner(config)
 	h.owner.rmd.load()
 	h.owner.csk.init()
+	h.owner.csk.restore(config)
 
 	h.gmm = memsys.PageMM()
 	h.gmm.RegWithHK()
@@ -631,6 +644,10 @@ func (h *htrun) run(config *cmn.Config) error {
 		if err != nil {
 			cos.ExitLog(err)
 		}
+		// proxies: request client certificates to map them to AuthN identities (see prxmtls.go)
+		if h.si.IsProxy() && config.Auth.MTLSEnabled() && c.ClientAuth < tls.RequestClientCert {
+			c.ClientAuth = tls.RequestClientCert
+		}
 		tlsConf = c
 	}
 
@@ -662,6 +679,7 @@ func (h *htrun) _listen(pubExtra meta.NetInfo, logger *log.Logger, tlsConf *tls.
 	debug.Assert(pubExtra.Port == h.si.PubNet.Port, "expecting the same TCP port for all multi-home interfaces")
 	server := &netServer{
 		muxers:        g.netServ.pub.muxers,
+		verify:        g.netServ.pub.verify,
 		sndRcvBufSize: g.netServ.pub.sndRcvBufSize,
 		useIPv6:       useIPv6, // in fact, expecting the same TCP port _and_ the same IP family as PubNet
 	}
@@ -805,6 +823,7 @@ func (h *htrun) call(args *callArgs, smap *smapX) (res *callResult) {
 	}
 	req.Header.Set(apc.HdrSenderID, h.SID())
 	req.Header.Set(apc.HdrSenderName, h.si.Name())
+	h.signReq(req, &args.req)
 	req.Header.Set(cos.HdrUserAgent, ua)
 
 	resp, res.err = client.Do(req)
@@ -1240,6 +1259,16 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 			h.sendOneLog(w, r, query)
 		}
 		return
+	case apc.WhatAudit:
+		aq, err := audit.NewQuery(query)
+		if err != nil {
+			h.writeErr(w, r, err)
+			return
+		}
+		if body, err = audit.Read(aq); err != nil {
+			h.writeErr(w, r, err)
+			return
+		}
 	case apc.WhatNodeStats:
 		statsNode := h.statsT.GetStats()
 		statsNode.Snode = h.si
@@ -1955,7 +1984,13 @@ func (h *htrun) _recvCfg(newConfig *globalConfig, msg *actMsgExt, payload msPayl
 	if err := h.owner.config.persist(newConfig, payload); err != nil {
 		return err
 	}
-	return cmn.GCO.Update(&newConfig.ClusterConfig)
+	if err := cmn.GCO.Update(&newConfig.ClusterConfig); err != nil {
+		return err
+	}
+	if config.Auth.CSKEnabled() && !newConfig.Auth.CSKEnabled() {
+		h.owner.csk.reset() // (compare w/ primary's _syncConfFinal)
+	}
+	return nil
 }
 
 func (h *htrun) extractRevokedTokenList(payload msPayload, sender string) (*tokenList, *actMsgExt, error) {
@@ -2018,7 +2053,7 @@ func (h *htrun) receiveCSK(nk *clusterKey, msg *actMsgExt, sender string) error
 			return newErrDowngrade(h.si, ok.String(), nk.String())
 		}
 	} else {
-		h.owner.csk.store(nk)
+		h.owner.csk.recv(nk)
 	}
 	return nil
 }
@@ -2488,6 +2523,102 @@ func (h *htrun) ensureSameSmap(hdr http.Header, smap *smapX) (int, error) {
 	return 0, nil
 }
 
+// sign intra-cluster request (see csk.go); args provide the body to digest
+func (h *htrun) signReq(req *http.Request, args *cmn.HreqArgs) {
+	ireq := core.IntraReq{
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		RawQuery: req.URL.RawQuery,
+		SessID:   req.Header.Get(apc.HdrSessID),
+		Body:     args.Body,
+		Streamed: args.BodyR != nil,
+	}
+	if sig := h.IntraSig(&ireq); sig != "" {
+		req.Header.Set(apc.HdrSenderSig, sig)
+	}
+}
+
+// (for transport streams and other intra-cluster clients outside this package)
+func (h *htrun) IntraSig(ireq *core.IntraReq) string {
+	return h.owner.csk.signReq(ireq, h.SID(), time.Now().UnixNano())
+}
+
+func (h *htrun) isIntraOnly(path string) bool {
+	for _, p := range h.intraOnly {
+		if strings.HasPrefix(path, p) && (len(path) == len(p) || path[len(p)] == '/') {
+			return true
+		}
+	}
+	return false
+}
+
+// validate signature of the request to intra-cluster-only route or the one that
+// identifies itself as intra-cluster; called at the server entry (see netServer.ServeHTTP)
+func (h *htrun) verifyIntra(w http.ResponseWriter, r *http.Request) bool {
+	if !cmn.Rom.CSKEnabled() {
+		return true
+	}
+	sid := r.Header.Get(apc.HdrSenderID)
+	if sid == "" {
+		sid = r.Header.Get(apc.HdrT2TPutterID)
+	}
+	val := r.Header.Get(apc.HdrSenderSig)
+	if sid == "" && val == "" && !h.isIntraOnly(r.URL.Path) {
+		return true // public API
+	}
+	csk := &h.owner.csk
+	if csk.load().ver == 0 {
+		return true // not yet received
+	}
+	var err error
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == apc.URLPathCluAutoReg.S:
+		return true // self-join: the node may be (re)joining with an outdated key
+	case strings.HasPrefix(r.URL.Path, apc.URLPathObjects.S+"/") && r.URL.Query().Has(apc.QparamHMAC):
+		// redirected (e.g., followed by http client that retains the original headers)
+		// and signed by the proxy - validated by h.parseReq
+		return true
+	case val == "":
+		if !csk.requireSig() {
+			return true
+		}
+		err = errors.New("missing signature")
+	case sid == "":
+		err = errors.New("missing sender ID")
+	default:
+		err = h._verifyIntra(r, val, sid)
+	}
+	if err == nil {
+		return true
+	}
+	err = fmt.Errorf("%s: intra-cluster %s %s from %q (%s): %w", h, r.Method, r.URL.Path, sid, r.RemoteAddr, err)
+	h.writeErr(w, r, err, http.StatusUnauthorized)
+	return false
+}
+
+// verify HMAC and nonce, and then the body digest (unless streamed)
+func (h *htrun) _verifyIntra(r *http.Request, val, sid string) error {
+	ireq := core.IntraReq{
+		Method:   r.Method,
+		Path:     r.URL.Path,
+		RawQuery: r.URL.RawQuery,
+		SessID:   r.Header.Get(apc.HdrSessID),
+	}
+	digest, err := h.owner.csk.verifyReq(val, &ireq, sid, time.Now().UnixNano(), cskWindow(&cmn.GCO.Get().Auth))
+	if err != nil || digest == cskStreamed {
+		return err
+	}
+	body, err := cos.ReadAllN(r.Body, r.ContentLength)
+	if err != nil {
+		return err
+	}
+	if cskDigest(body) != digest {
+		return errors.New("body digest mismatch")
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return nil
+}
+
 func (h *htrun) checkIntraCall(hdr http.Header, fromPrimary bool) error {
 	var (
 		smap       = h.owner.smap.get()
@@ -2606,6 +2737,9 @@ func ptLatency(tts int64, ptime, isPrimary string) (dur int64) {
 func (*htrun) readAisMsg(w http.ResponseWriter, r *http.Request) (msg *actMsgExt, err error) {
 	msg = &actMsgExt{}
 	err = cmn.ReadJSON(w, r, msg)
+	if aw, ok := w.(*auditW); ok && err == nil {
+		aw.setMsg(&msg.ActMsg)
+	}
 	return
 }
 
@@ -2652,8 +2786,20 @@ func (h *htrun) newAmsg(amsg *apc.ActMsg, bmd *bucketMD, uuid ...string) *actMsg
 // apc.ActMsg c-tor and reader
 func (*htrun) readActionMsg(w http.ResponseWriter, r *http.Request) (msg *apc.ActMsg, err error) {
 	msg = &apc.ActMsg{}
-	err = cmn.ReadJSON(w, r, msg)
-	return
+	if err = cmn.ReadJSON(w, r, msg); err != nil {
+		return msg, err
+	}
+	if msg.Prio == "" {
+		msg.Prio = r.Header.Get(apc.HdrJobPrio)
+	}
+	if err = apc.ValidatePrio(msg.Prio); err != nil {
+		cmn.WriteErr(w, r, err)
+		return msg, err
+	}
+	if aw, ok := w.(*auditW); ok {
+		aw.setMsg(msg)
+	}
+	return msg, nil
 }
 
 // cmn.ReadJSON with the only difference: EOF is ok
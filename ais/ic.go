@@ -47,6 +47,7 @@ type (
 		smap  *smapX
 		query url.Values
 		msg   any
+		user  string // initiating user (see job history)
 	}
 
 	xactRegMsg struct {
@@ -381,6 +382,9 @@ func (ic *ic) registerEqual(a regIC) {
 	if a.query != nil {
 		a.query.Set(apc.QparamNotifyMe, equalIC)
 	}
+	if a.user != "" {
+		a.nl.SetUser(a.user)
+	}
 	if a.smap.IsIC(ic.p.si) {
 		err := ic.p.notifs.add(a.nl)
 		debug.AssertNoErr(err)
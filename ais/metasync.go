@@ -90,7 +90,11 @@ const (
 	revsEtlMDTag = "EtlMD"
 	revsCSKTag   = cskTag
 
-	revsMaxTags   = 7         // NOTE
+	revsJobSchedTag = "JobSched" // proxies only
+	revsS3KeysTag   = "S3Keys"   // ditto
+	revsCertsTag    = "Certs"    // ditto
+
+	revsMaxTags   = 10        // NOTE
 	revsActionTag = "-action" // prefix revs tag
 )
 
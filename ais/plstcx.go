@@ -27,8 +27,12 @@ import (
 	"github.com/NVIDIA/aistore/xact/xs"
 )
 
+const lsoFillPages = 8 // per-prefix ACL: max number of pages to list to fill a filtered one (see lsFill)
+
 // one page => msgpack rsp
-func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg) {
+// filter != nil: the caller is allowed to list only certain prefixes (see p.lsoAccess)
+func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg,
+	filter func(string) bool) {
 	// LsVerChanged a.k.a. '--check-versions' limitations
 	if lsmsg.IsFlagSet(apc.LsDiff) {
 		if err := _checkVerChanged(bck, lsmsg); err != nil {
@@ -56,13 +60,38 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 		lsmsg.SetFlag(apc.LsCached)
 	}
 
-	// do page
-	beg := mono.NanoTime()
-	lst, err := p.lsPage(bck, amsg, lsmsg, r.Header, p.owner.smap.get())
-	if err != nil {
-		p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
-		p.writeErr(w, r, err)
-		return
+	// do page (or serve the first page from the proxy-side cache)
+	var (
+		lst    *cmn.LsoRes
+		err    error
+		pxkey  string
+		cached bool
+		beg    = mono.NanoTime()
+		smap   = p.owner.smap.get()
+	)
+	if bck.Props.ProxyCache.Enabled {
+		if pxkey = pxcLsoKey(bck, lsmsg, r.Header); pxkey != "" {
+			lst = p.pxc.getLso(bck, pxkey, smap)
+			cached = lst != nil
+		}
+	}
+	if !cached {
+		lst, err = p.lsPage(bck, amsg, lsmsg, r.Header, smap)
+		if err != nil {
+			p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
+			p.writeErr(w, r, err)
+			return
+		}
+		if pxkey != "" {
+			p.pxc.putLso(bck, pxkey, lst, smap)
+		}
+	}
+	if filter != nil {
+		if lst, err = p.lsFill(bck, amsg, lsmsg, r.Header, smap, lst, filter); err != nil {
+			p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
+			p.writeErr(w, r, err)
+			return
+		}
 	}
 
 	vlabs := map[string]string{stats.VlabBucket: bck.Cname("")}
@@ -80,6 +109,9 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	if !ok && cmn.Rom.V(4, cos.ModAIS) {
 		nlog.Errorln("failed to transmit list-objects page (TCP RST?)")
 	}
+	if cached {
+		return // (entries are shared with the cache)
+	}
 
 	// GC
 	clear(lst.Entries)
@@ -87,6 +119,51 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	lst.Entries = nil
 }
 
+// (per-prefix ACL) filtered page may come out short or even empty while still carrying
+// a continuation token; to fill it up to the requested size, keep listing - up to
+// lsoFillPages next pages, after which the (short) page is returned as is
+func (p *proxy) lsFill(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header, smap *smapX,
+	lst *cmn.LsoRes, filter func(string) bool) (*cmn.LsoRes, error) {
+	var (
+		flst     = filterLso(lst, filter)
+		pageSize = cos.NonZero(lsmsg.PageSize, bck.MaxPageSize())
+		orig     = lsmsg.PageSize
+	)
+	for i := 0; i < lsoFillPages && flst.ContinuationToken != "" && int64(len(flst.Entries)) < pageSize; i++ {
+		lsmsg.UUID = flst.UUID
+		lsmsg.ContinuationToken = flst.ContinuationToken
+		lsmsg.PageSize = pageSize - int64(len(flst.Entries))
+		next, err := p.lsPage(bck, amsg, lsmsg, hdr, smap)
+		if err != nil {
+			lsmsg.PageSize = orig
+			return nil, err
+		}
+		for _, en := range next.Entries {
+			if filter(en.Name) {
+				flst.Entries = append(flst.Entries, en)
+			}
+		}
+		flst.ContinuationToken = next.ContinuationToken
+		flst.Flags |= next.Flags
+	}
+	lsmsg.PageSize = orig
+	return flst, nil
+}
+
+// (per-prefix ACL) only the entries that the caller can read;
+// not in place - the page may be shared with the proxy-side cache
+func filterLso(lst *cmn.LsoRes, filter func(string) bool) *cmn.LsoRes {
+	entries := make(cmn.LsoEntries, 0, len(lst.Entries))
+	for _, en := range lst.Entries {
+		if filter(en.Name) {
+			entries = append(entries, en)
+		}
+	}
+	flst := *lst
+	flst.Entries = entries
+	return &flst
+}
+
 func _checkVerChanged(bck *meta.Bck, lsmsg *apc.LsoMsg) error {
 	const a = "cannot perform remote versions check"
 	if !bck.HasVersioningMD() {
@@ -112,6 +189,12 @@ func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr h
 		listRemote     bool
 		wantOnlyRemote bool
 	)
+	if lsmsg.Snap != "" {
+		return p.lsSnap(bck, lsmsg)
+	}
+	if lsmsg.IsFlagSet(apc.LsVerHistory) {
+		return p.lsVersions(bck, lsmsg)
+	}
 	if lsmsg.UUID == "" {
 		lsmsg.UUID = cos.GenUUID()
 		newls = true
@@ -273,6 +356,9 @@ func (p *proxy) lsObjsA(bck *meta.Bck, lsmsg *apc.LsoMsg) (allEntries *cmn.LsoRe
 	}
 	freeBcastRes(results)
 
+	if lsmsg.IsFlagSet(apc.LsVerHistory) {
+		return concatLsoVer(lists, lsmsg), nil
+	}
 	page := concatLso(lists, lsmsg)
 	finLsoA(page, lsmsg)
 	return page, nil
@@ -20,6 +20,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
@@ -34,7 +35,9 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
@@ -61,8 +64,16 @@ type (
 
 		htrun // common w/ target
 
-		notifs notifs
-		reg    struct {
+		notifs  notifs
+		jhist   jobHist  // persistent job history (see prxhist.go)
+		jsched  jobSched // scheduled (recurring) jobs (see prxcron.go)
+		s3keys  s3keys   // S3 access keys issued by AuthN (see prxs3keys.go)
+		certs   certs    // client certificate identities mapped by AuthN (see prxmtls.go)
+		mtls    mtlsIDs  // client certificate identities (see prxmtls.go)
+		pxc     pxcache  // proxy-side cache (see prxcache.go)
+		tenants ptenants // tenants' rate limits and quotas (see prxtenant.go)
+		adm     padmit   // cluster-wide admission of batch jobs (see prxadmit.go)
+		reg     struct {
 			pool nodeRegPool
 			mu   sync.RWMutex
 		}
@@ -191,6 +202,9 @@ func (p *proxy) Run() error {
 
 	p.owner.bmd.init() // initialize owner and load BMD
 	p.owner.etl.init() // initialize owner and load EtlMD
+	p.jsched.owner.init(config)
+	p.s3keys.init(config)
+	p.certs.init(config)
 
 	core.Pinit()
 
@@ -202,7 +216,13 @@ func (p *proxy) Run() error {
 	p.rproxy.init()
 
 	p.notifs.init(p)
+	p.jhist.init(config)
+	p.jsched.init(p)
 	p.ic.init(p)
+	p.pxc.init(p)
+	p.tenants.init(p)
+	p.adm.init(p)
+	hk.Reg(cskTag+hk.NameSuffix, p.rotateCSK, cskRotateIval)
 
 	p.initRecvHandlers()
 
@@ -225,28 +245,29 @@ func (p *proxy) initRecvHandlers() {
 		networkHandler{r: apc.Reverse, h: p.reverseHandler, net: accessNetPublicControl},
 
 		// pubnet handlers: cluster must be started
-		networkHandler{r: apc.Buckets, h: p.bucketHandler, net: accessNetPublic},
-		networkHandler{r: apc.Objects, h: p.objectHandler, net: accessNetPublic},
-		networkHandler{r: apc.Download, h: p.dloadHandler, net: accessNetPublic},
-		networkHandler{r: apc.ETL, h: p.etlHandler, net: accessNetPublic},
+		networkHandler{r: apc.Buckets, h: p.audited(p.bucketHandler), net: accessNetPublic},
+		networkHandler{r: apc.Objects, h: p.audited(p.objectHandler), net: accessNetPublic},
+		networkHandler{r: apc.Download, h: p.audited(p.dloadHandler), net: accessNetPublic},
+		networkHandler{r: apc.ETL, h: p.audited(p.etlHandler), net: accessNetPublic},
 
 		networkHandler{r: apc.IC, h: p.ic.handler, net: accessNetIntraControl},
-		networkHandler{r: apc.Daemon, h: p.daemonHandler, net: accessNetPublicControl},
-		networkHandler{r: apc.Cluster, h: p.clusterHandler, net: accessNetPublicControl},
-		networkHandler{r: apc.Tokens, h: p.tokenHandler, net: accessNetPublic},
+		networkHandler{r: apc.Daemon, h: p.audited(p.daemonHandler), net: accessNetPublicControl},
+		networkHandler{r: apc.Cluster, h: p.audited(p.clusterHandler), net: accessNetPublicControl},
+		networkHandler{r: apc.Tokens, h: p.audited(p.tokenHandler), net: accessNetPublic},
 
 		networkHandler{r: apc.Metasync, h: p.metasyncHandler, net: accessNetIntraControl},
 		networkHandler{r: apc.Health, h: p.healthHandler, net: accessNetPublicControl},
 		networkHandler{r: apc.Vote, h: p.voteHandler, net: accessNetIntraControl},
 
 		networkHandler{r: apc.Notifs, h: p.notifs.handler, net: accessNetIntraControl},
+		networkHandler{r: apc.Xactions, h: p.adm.handler, net: accessNetIntraControl},
 		networkHandler{r: apc.EC, h: p.ecHandler, net: accessNetIntraControl},
 
 		// machine learning
 		networkHandler{r: apc.ML, h: p.mlHandler, net: accessNetPublic},
 
 		// S3 compatibility
-		networkHandler{r: "/" + apc.S3, h: p.s3Handler, net: accessNetPublic},
+		networkHandler{r: "/" + apc.S3, h: p.audited(p.s3Handler), net: accessNetPublic},
 
 		// "easy URL"
 		networkHandler{r: "/" + apc.GSScheme, h: p.easyURLHandler, net: accessNetPublic},
@@ -421,6 +442,7 @@ func (p *proxy) _parseReqTry(w http.ResponseWriter, r *http.Request, bckArgs *bc
 		return
 	}
 	bckArgs.bck, bckArgs.query = apireq.bck, apireq.query
+	bckArgs.objName = apireq.items[1]
 	bck, err = bckArgs.initAndTry()
 	objName = apireq.items[1]
 
@@ -435,6 +457,10 @@ func (p *proxy) bucketHandler(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusServiceUnavailable)
 		return
 	}
+	if r.Method == http.MethodPut || r.Method == http.MethodPost || r.Method == http.MethodDelete {
+		p.pxc.evict(r)
+		defer p.pxc.evict(r) // (bucket-level writes are synchronous)
+	}
 	switch r.Method {
 	case http.MethodGet:
 		dpq := dpqAlloc()
@@ -464,6 +490,9 @@ func (p *proxy) bucketHandler(w http.ResponseWriter, r *http.Request) {
 
 // verb /v1/objects/
 func (p *proxy) objectHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		p.pxc.evict(r)
+	}
 	switch r.Method {
 	case http.MethodGet:
 		p.httpobjget(w, r)
@@ -647,6 +676,56 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		return
 	}
 
+	// (I.b) search bucket's metadata index
+	if msg.Action == apc.ActSearchBck {
+		if !qbck.IsBucket() {
+			p.writeErrf(w, r, "%s: expecting bucket name, got %q", msg.Action, qbck.String())
+			return
+		}
+		bckArgs := allocBctx()
+		{
+			bckArgs.p = p
+			bckArgs.w = w
+			bckArgs.r = r
+			bckArgs.msg = msg
+			bckArgs.perms = apc.AceObjLIST
+			bckArgs.bck = (*meta.Bck)(qbck)
+			bckArgs.dpq = dpq
+		}
+		bck, err := bckArgs.initAndTry()
+		freeBctx(bckArgs)
+		if err != nil {
+			return
+		}
+		p.searchBck(w, r, bck, msg)
+		return
+	}
+
+	// (I.c) list deleted objects (trash)
+	if msg.Action == apc.ActListTrash {
+		if !qbck.IsBucket() {
+			p.writeErrf(w, r, "%s: expecting bucket name, got %q", msg.Action, qbck.String())
+			return
+		}
+		bckArgs := allocBctx()
+		{
+			bckArgs.p = p
+			bckArgs.w = w
+			bckArgs.r = r
+			bckArgs.msg = msg
+			bckArgs.perms = apc.AceObjLIST
+			bckArgs.bck = (*meta.Bck)(qbck)
+			bckArgs.dpq = dpq
+		}
+		bck, err := bckArgs.initAndTry()
+		freeBctx(bckArgs)
+		if err != nil {
+			return
+		}
+		p.listTrash(w, r, bck, msg)
+		return
+	}
+
 	// (II) invalid action
 	if msg.Action != apc.ActList {
 		p.writeErrAct(w, r, msg.Action)
@@ -663,7 +742,7 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		if qbck.IsRemoteAIS() {
 			qbck.Ns.UUID = p.a2u(qbck.Ns.UUID)
 		}
-		if err := p.checkAccess(w, r, nil, apc.AceListBuckets); err == nil {
+		if err := p.checkAccess(w, r, nil, apc.AceListBuckets); err == nil && p.tenantQbck(w, r, qbck) {
 			p.listBuckets(w, r, qbck, msg, dpq)
 		}
 		return
@@ -702,6 +781,7 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		bckArgs.r = r
 		bckArgs.msg = msg
 		bckArgs.perms = apc.AceObjLIST
+		bckArgs.lso = true
 		bckArgs.bck = bck
 		bckArgs.dpq = dpq
 		bckArgs.createAIS = false
@@ -714,13 +794,14 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		}
 	}
 	bck, errN := bckArgs.initAndTry()
+	filter := bckArgs.lsoFilter
 	freeBctx(bckArgs)
 	if errN != nil {
 		return
 	}
 
 	// do
-	p.listObjects(w, r, bck, msg /*amsg*/, &lsmsg)
+	p.listObjects(w, r, bck, msg /*amsg*/, &lsmsg, filter)
 }
 
 // +gen:endpoint GET /v1/objects/{bucket-name}/{object-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamOrigURL=string,apc.QparamLatestVer=bool]
@@ -742,6 +823,7 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 		bckArgs.bck = apireq.bck
 		bckArgs.dpq = apireq.dpq
 		bckArgs.perms = apc.AceGET
+		bckArgs.objName = apireq.items[1]
 		bckArgs.createAIS = false
 	}
 	if len(origURLBck) > 0 {
@@ -778,6 +860,10 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 		p.writeErr(w, r, err)
 		return
 	}
+	if bck.Props.ProxyCache.Enabled && p.pxc.get(w, r, bck, objName, tsi, smap) {
+		p.statsT.IncBck(stats.GetCount, bck.Bucket())
+		return
+	}
 	if cmn.Rom.V(5, cos.ModAIS) {
 		nlog.Infoln("GET", bck.Cname(objName), "=>", tsi.StringEx())
 	}
@@ -834,6 +920,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		bckArgs.w = w
 		bckArgs.r = r
 		bckArgs.perms = perms
+		bckArgs.objName = apireq.items[1]
 		bckArgs.createAIS = false
 	}
 	bckArgs.bck, bckArgs.dpq = apireq.bck, apireq.dpq
@@ -850,6 +937,10 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		p.writeErr(w, r, err, ecode, Silent)
 		return
 	}
+	if ecode, err := p.tenants.checkQuota(bck); err != nil {
+		p.writeErr(w, r, err, ecode, Silent)
+		return
+	}
 
 	// 4. redirect
 	var (
@@ -936,7 +1027,7 @@ func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 	p.statsT.IncBck(stats.DeleteCount, bck.Bucket())
 }
 
-// +gen:endpoint DELETE /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamKeepRemote=bool] action=[apc.ActDestroyBck=apc.ActMsg|apc.ActEvictRemoteBck=apc.ActMsg|apc.ActDeleteObjects=apc.EvdMsg|apc.ActEvictObjects=apc.EvdMsg]
+// +gen:endpoint DELETE /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamKeepRemote=bool,apc.QparamDryRun=bool] action=[apc.ActDestroyBck=apc.ActMsg|apc.ActEvictRemoteBck=apc.ActMsg|apc.ActDeleteObjects=apc.EvdMsg|apc.ActEvictObjects=apc.EvdMsg]
 // +gen:payload apc.ActDeleteObjects={"action": "delete-listrange", "value": {"objnames": ["o1", "o2"]}}
 // +gen:payload apc.ActEvictObjects={"action": "evict-listrange", "value": {"template": "prefix{001..100}"}}
 // Delete a bucket or delete/evict objects within a bucket
@@ -987,12 +1078,17 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 	}
 
 	// 3. action
+	dryRun := cos.IsParseBool(apireq.query.Get(apc.QparamDryRun))
 	switch msg.Action {
 	case apc.ActEvictRemoteBck:
 		if err := cmn.ValidateRemoteBck(apc.ActEvictRemoteBck, bck.Bucket()); err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
+		if dryRun {
+			p.dryRun(w, r, bck, msg.Action, nil)
+			return
+		}
 		keepMD := cos.IsParseBool(apireq.query.Get(apc.QparamKeepRemote))
 		if keepMD {
 			if err := p.evictRemoteKeepMD(msg, bck); err != nil {
@@ -1007,6 +1103,14 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 			p.writeErr(w, r, err)
 		}
 	case apc.ActDestroyBck:
+		if dryRun {
+			if bck.IsRemoteAIS() {
+				p.writeErr(w, r, cmn.NewErrUnsupp("dry-run destroy", bck.Cname("")))
+				return
+			}
+			p.dryRun(w, r, bck, msg.Action, nil)
+			return
+		}
 		if p.forwardCP(w, r, msg, bck.Name) {
 			return
 		}
@@ -1036,6 +1140,15 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 				return
 			}
 		}
+		evdMsg := &apc.EvdMsg{}
+		if err := cos.MorphMarshal(msg.Value, evdMsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if evdMsg.DryRun {
+			p.dryRun(w, r, bck, msg.Action, evdMsg)
+			return
+		}
 		xid, err := p.bcastMultiobj(r.Method, bck.Name, msg, apireq.query)
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -1097,6 +1210,9 @@ func (p *proxy) metasyncHandler(w http.ResponseWriter, r *http.Request) {
 		newEtlMD, msgEtlMD, errEtlMD        = p.extractEtlMD(payload, sender)
 		revokedTokens, msgTokens, errTokens = p.extractRevokedTokenList(payload, sender)
 		newCSK, msgCSK, errCSK              = p.extractCSK(payload, sender)
+		newJsched, msgJsched, errJsched     = p.extractJobSched(payload, sender)
+		newS3Keys, msgS3Keys, errS3Keys     = p.extractS3Keys(payload, sender)
+		newCerts, msgCerts, errCerts        = p.extractCerts(payload, sender)
 	)
 
 	// 2. apply
@@ -1117,19 +1233,30 @@ func (p *proxy) metasyncHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	if errTokens == nil && revokedTokens != nil {
 		// tokens don't do versioning and don't have UUID
-		nlog.Infoln("msync Rx token list from", sender, "msg:", msgTokens.String(), "num revoked:", len(revokedTokens.Tokens))
+		nlog.Infoln("msync Rx token list from", sender, "msg:", msgTokens.String(), "num revoked:", len(revokedTokens.Tokens),
+			"sessions:", len(revokedTokens.Sessions))
 		_ = p.authn.updateRevokedList(r.Context(), revokedTokens)
 	}
 	if errCSK == nil && newCSK != nil {
 		errCSK = p.receiveCSK(newCSK, msgCSK, sender)
 	}
+	if errJsched == nil && newJsched != nil {
+		errJsched = p.receiveJobSched(newJsched, msgJsched, payload, sender)
+	}
+	if errS3Keys == nil && newS3Keys != nil {
+		errS3Keys = p.receiveS3Keys(newS3Keys, msgS3Keys, sender)
+	}
+	if errCerts == nil && newCerts != nil {
+		errCerts = p.receiveCerts(newCerts, msgCerts, sender)
+	}
 
 	// 3. respond
-	if errConf == nil && errSmap == nil && errBMD == nil && errRMD == nil && errTokens == nil && errEtlMD == nil && errCSK == nil {
+	if errConf == nil && errSmap == nil && errBMD == nil && errRMD == nil && errTokens == nil && errEtlMD == nil && errCSK == nil &&
+		errJsched == nil && errS3Keys == nil && errCerts == nil {
 		return
 	}
 	p.fillNsti(nsti)
-	retErr := err.message(errConf, errSmap, errBMD, errRMD, errEtlMD, errTokens, errCSK)
+	retErr := err.message(errConf, errSmap, errBMD, errRMD, errEtlMD, errTokens, errCSK, errJsched, errS3Keys, errCerts)
 	p.writeErr(w, r, retErr, http.StatusConflict)
 }
 
@@ -1314,7 +1441,7 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// +gen:endpoint POST /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamBckTo=string,apc.QparamDontHeadRemote=bool] action=[apc.ActMoveBck=apc.ActMsg|apc.ActCopyBck=apc.TCBMsg|apc.ActETLBck=apc.TCBMsg|apc.ActCopyObjects=cmn.TCOMsg|apc.ActETLObjects=cmn.TCOMsg|apc.ActAddRemoteBck=apc.ActMsg|apc.ActPrefetchObjects=apc.ActMsg|apc.ActMakeNCopies=apc.ActMsg|apc.ActECEncode=apc.ActMsg]
+// +gen:endpoint POST /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamBckTo=string,apc.QparamDontHeadRemote=bool,apc.QparamDryRun=bool] action=[apc.ActMoveBck=apc.ActMsg|apc.ActCopyBck=apc.TCBMsg|apc.ActETLBck=apc.TCBMsg|apc.ActCopyObjects=cmn.TCOMsg|apc.ActETLObjects=cmn.TCOMsg|apc.ActAddRemoteBck=apc.ActMsg|apc.ActPrefetchObjects=apc.ActMsg|apc.ActMakeNCopies=apc.ActMsg|apc.ActECEncode=apc.ActMsg]
 // +gen:payload apc.ActCopyBck={"action": "copy-bck", "value": {"dry_run": false, "force": false}}
 // +gen:payload apc.ActETLBck={"action": "etl-bck", "value": {"id": "ETL_NAME"}}
 // +gen:payload apc.ActCopyObjects={"action": "copy-objects", "value": {"to_bck": {"name": "destination-bucket", "provider": "ais"}, "template": "prefix{001..100}"}}
@@ -1364,10 +1491,15 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 		p._bcr(w, r, query, msg, bck)
 		return
 	}
+	if msg.Action == apc.ActUndeleteBck {
+		// restore destroyed bucket (not in BMD)
+		p.undeleteBucket(w, r, msg, bck)
+		return
+	}
 
 	// only the primary can do metasync
 	dtor := xact.Table[msg.Action]
-	if dtor.Metasync {
+	if dtor.Metasync || msg.Action == apc.ActDestroySnap {
 		if p.forwardCP(w, r, msg, bucket) {
 			return
 		}
@@ -1381,6 +1513,9 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 		bckArgs.r = r
 		bckArgs.bck = bck
 		bckArgs.perms = apc.AccessNone // access checked below
+		if msg.Action == apc.ActDestroySnap {
+			bckArgs.perms = xact.Table[apc.ActCreateSnap].Access
+		}
 		bckArgs.msg = msg
 		bckArgs.query = query
 		bckArgs.createAIS = false
@@ -1413,6 +1548,11 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, "cannot rename bucket %q to itself (%q)", bckFrom.Cname(""), bckTo.Cname(""))
 			return
 		}
+		if n := len(bckFrom.Props.Snaps); n > 0 {
+			p.writeErrf(w, r, "cannot rename bucket %q that has %d snapshot%s (destroy snapshots first)",
+				bckFrom.Cname(""), n, cos.Plural(n))
+			return
+		}
 		bckFrom.Provider, bckTo.Provider = apc.AIS, apc.AIS
 		if _, present := p.owner.bmd.get().Get(bckTo); present {
 			err := cmn.NewErrBckAlreadyExists(bckTo.Bucket())
@@ -1422,6 +1562,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 		if err := p.checkAccess(w, r, nil, apc.AceMoveBucket); err != nil {
 			return
 		}
+		if cos.IsParseBool(query.Get(apc.QparamDryRun)) {
+			p.dryRun(w, r, bckFrom, msg.Action, nil)
+			return
+		}
 		nlog.Infof("%s bucket %s => %s", msg.Action, bckFrom.String(), bckTo.String())
 		if xid, err = p.renameBucket(bckFrom, bckTo, msg); err != nil {
 			p.writeErr(w, r, err)
@@ -1593,11 +1737,34 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActScrub:
+		// verify (and optionally, repair) bucket's in-cluster content
+		if xid, err = p.bcastMultiobj(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	case apc.ActInventory:
+		if xid, err = p.inventory(w, r, bck, msg, query); err != nil {
+			return
+		}
+	case apc.ActUndelete:
+		p.undelete(w, r, bck, msg)
+		return
 	case apc.ActMakeNCopies:
 		if xid, err = p.makeNCopies(msg, bck); err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActCreateSnap:
+		if xid, err = p.createSnap(msg, bck); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	case apc.ActDestroySnap:
+		if err = p.destroySnap(msg, bck); err != nil {
+			p.writeErr(w, r, err)
+		}
+		return
 	case apc.ActECEncode:
 		if cmn.Rom.EcStreams() > 0 {
 			if err = p.ec.on(p, p.ec.timeout()); err != nil {
@@ -1644,6 +1811,75 @@ func (p *proxy) initBckTo(w http.ResponseWriter, r *http.Request, query url.Valu
 	return bckTo, ecode, nil
 }
 
+// export inventory of an ais:// bucket into (existing) destination bucket
+// (each target writes its own part - see xs.XactInventory)
+func (p *proxy) inventory(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg, query url.Values) (string, error) {
+	if !bck.IsAIS() {
+		err := fmt.Errorf("%s: inventory export is supported only for ais:// buckets, got %s", msg.Action, bck.Cname(""))
+		p.writeErr(w, r, err)
+		return "", err
+	}
+	invMsg := &apc.InventoryMsg{}
+	if err := cos.MorphMarshal(msg.Value, invMsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return "", err
+	}
+	if err := invMsg.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return "", err
+	}
+	if err := p.checkAccess(w, r, bck, apc.AceObjLIST); err != nil {
+		return "", err
+	}
+	bckTo, err := newBckFromQuname(query, true /*required*/)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return "", err
+	}
+	if bck.Equal(bckTo, true, true) {
+		err := fmt.Errorf("%s: source and destination cannot be the same bucket %s", msg.Action, bck.Cname(""))
+		p.writeErr(w, r, err)
+		return "", err
+	}
+	bckTo, ecode, err := p.initBckTo(w, r, query, bckTo)
+	if err != nil {
+		return "", err
+	}
+	if ecode == http.StatusNotFound {
+		err := cmn.NewErrBckNotFound(bckTo.Bucket())
+		p.writeErr(w, r, err, ecode)
+		return "", err
+	}
+
+	// point-in-time: the named snapshot or a temporary one (destroyed upon completion)
+	var fin *_invfin
+	if invMsg.Snap == "" {
+		invMsg.Snap = apc.InvSnapPrefix + cos.GenUUID()
+		if _, err := p.createSnap(&apc.ActMsg{Action: apc.ActCreateSnap, Name: invMsg.Snap, User: msg.User}, bck); err != nil {
+			p.writeErr(w, r, err)
+			return "", err
+		}
+		fin = &_invfin{p: p, bck: bck, snap: invMsg.Snap}
+	} else if bck.Props.GetSnap(invMsg.Snap) == nil {
+		err := cos.NewErrNotFound(p, bck.Cname("")+" snapshot \""+invMsg.Snap+"\"")
+		p.writeErr(w, r, err)
+		return "", err
+	}
+	msg.Value = invMsg
+	var cb nl.Callback
+	if fin != nil {
+		cb = fin.cb
+	}
+	xid, err := p._bcastMultiobj(r.Method, bck.Name, msg, query, cb)
+	if err != nil {
+		if fin != nil {
+			fin.cb(nil)
+		}
+		p.writeErr(w, r, err)
+	}
+	return xid, err
+}
+
 // create-bucket semantics
 //
 // AIS buckets (ais://):
@@ -1691,6 +1927,9 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 	if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
 		return
 	}
+	if !p.tenantBck(w, r, bck) {
+		return
+	}
 	if err := bck.Validate(); err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -2112,7 +2351,7 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 		return
 	}
 	perms := apc.AcePATCH
-	if propsToUpdate.Access != nil {
+	if propsToUpdate.Access != nil || propsToUpdate.PrefixACL != nil {
 		perms |= apc.AceBckSetACL
 	}
 
@@ -2173,6 +2412,37 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
+	if nprops.Repl.Enabled {
+		// replication destination must exist (and gets added to BMD, if need be)
+		cbck, err := nprops.Repl.DstBck()
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if cbck.Equal(bck.Bucket()) {
+			p.writeErrf(w, r, "%s: cannot replicate bucket onto itself", bck.Cname(""))
+			return
+		}
+		dstBck := meta.CloneBck(&cbck)
+
+		bckArgs := allocBctx()
+		{
+			bckArgs.p = p
+			bckArgs.w = w
+			bckArgs.r = r
+			bckArgs.bck = dstBck
+			bckArgs.msg = msg
+			bckArgs.dpq = apireq.dpq
+			bckArgs.query = apireq.query
+			bckArgs.createAIS = false
+		}
+		_, err = bckArgs.initAndTry()
+		freeBctx(bckArgs)
+		if err != nil {
+			return
+		}
+		nprops.Repl.Dst = dstBck.Cname("")
+	}
 	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -2209,6 +2479,9 @@ func (p *proxy) httpobjhead(w http.ResponseWriter, r *http.Request, origURLBck .
 		p.writeErr(w, r, err, http.StatusInternalServerError)
 		return
 	}
+	if bck.Props.ProxyCache.Enabled && p.pxc.head(w, r, bck, objName, si, smap) {
+		return
+	}
 	if cmn.Rom.V(5, cos.ModAIS) {
 		nlog.Infoln(r.Method, bck.Cname(objName), "=>", si.StringEx())
 	}
@@ -2311,7 +2584,12 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 	}
 }
 
-func (p *proxy) bcastMultiobj(method, bucket string, msg *apc.ActMsg, query url.Values) (xid string, err error) {
+func (p *proxy) bcastMultiobj(method, bucket string, msg *apc.ActMsg, query url.Values) (string, error) {
+	return p._bcastMultiobj(method, bucket, msg, query, nil)
+}
+
+// with an optional (upon completion) callback
+func (p *proxy) _bcastMultiobj(method, bucket string, msg *apc.ActMsg, query url.Values, cb nl.Callback) (xid string, err error) {
 	var (
 		smap      = p.owner.smap.get()
 		actMsgExt = p.newAmsg(msg, nil, cos.GenUUID())
@@ -2320,7 +2598,8 @@ func (p *proxy) bcastMultiobj(method, bucket string, msg *apc.ActMsg, query url.
 	)
 	nlb := xact.NewXactNL(actMsgExt.UUID, actMsgExt.Action, &smap.Smap, nil)
 	nlb.SetOwner(equalIC)
-	p.ic.registerEqual(regIC{smap: smap, query: query, nl: nlb})
+	nlb.F = cb
+	p.ic.registerEqual(regIC{smap: smap, query: query, nl: nlb, user: msg.User})
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: method, Path: path, Query: query, Body: body}
 	args.smap = smap
@@ -2339,6 +2618,91 @@ func (p *proxy) bcastMultiobj(method, bucket string, msg *apc.ActMsg, query url.
 	return
 }
 
+// broadcast dry-run of the named action (with its original value, if any) to all targets;
+// respond with per-target results (compare with bcastMultiobj above)
+func (p *proxy) dryRun(w http.ResponseWriter, r *http.Request, bck *meta.Bck, action string, value any) {
+	var (
+		path  = apc.URLPathBuckets.S
+		query url.Values
+		amsg  = p.newAmsg(&apc.ActMsg{Action: apc.ActDryRun, Name: action, Value: value}, nil)
+	)
+	if bck != nil {
+		path = apc.URLPathBuckets.Join(bck.Name)
+		query = bck.AddToQuery(nil)
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: path, Query: query, Body: cos.MustMarshal(amsg)}
+	args.timeout = apc.LongTimeout
+	args.cresv = cresjGeneric[apc.DryRunRes]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	all := make(apc.DryRunResults, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.errorf("%s failed to dry-run %q", res.si, action))
+			freeBcastRes(results)
+			return
+		}
+		all[res.si.ID()] = res.v.(*apc.DryRunRes)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, all, apc.ActDryRun)
+}
+
+// broadcast metadata search to all targets, merge the results
+func (p *proxy) searchBck(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg) {
+	var q apc.MDQuery
+	if err := cos.MorphMarshal(msg.Value, &q); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if err := q.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if !bck.Props.MDIndex.Enabled {
+		p.writeErrf(w, r, fmtErrMDIndexDisabled, bck.Cname(""))
+		return
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.AddToQuery(nil),
+		Body:   cos.MustMarshal(p.newAmsg(&apc.ActMsg{Action: apc.ActSearchBck, Value: &q}, nil)),
+	}
+	args.timeout = apc.LongTimeout
+	args.cresv = cresjGeneric[apc.MDEntries]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var (
+		all  apc.MDEntries
+		uniq = make(map[string]int, q.Limit)
+	)
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.errorf("%s failed to search %s", res.si, bck.Cname("")))
+			freeBcastRes(results)
+			return
+		}
+		for _, e := range *res.v.(*apc.MDEntries) {
+			// (transient duplicates, e.g. during rebalance: keep the most recently accessed)
+			if i, ok := uniq[e.Name]; ok {
+				if e.Atime > all[i].Atime {
+					all[i] = e
+				}
+				continue
+			}
+			uniq[e.Name] = len(all)
+			all = append(all, e)
+		}
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, q.Finalize(all), apc.ActSearchBck)
+}
+
 //
 // /daemon handlers
 //
@@ -2406,7 +2770,7 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 			p.handlePendingRenamedLB(renamedBucket)
 		}
 		fallthrough // fallthrough
-	case apc.WhatNodeConfig, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog, apc.WhatNodeStats, apc.WhatMetricNames:
+	case apc.WhatNodeConfig, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog, apc.WhatAudit, apc.WhatNodeStats, apc.WhatMetricNames:
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
 
 	case apc.WhatNodeStatsAndStatus:
@@ -2680,6 +3044,15 @@ func (p *proxy) rootHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// (SigV4 signs the original path)
+	if cmn.Rom.AuthEnabled() {
+		var err error
+		if r, err = p.s3verify(r); err != nil {
+			s3.WriteErr(w, r, err, http.StatusForbidden)
+			return
+		}
+	}
+
 	// prepend /s3 and handle
 	switch {
 	case r.URL.Path == "" || r.URL.Path == "/":
@@ -2689,7 +3062,7 @@ func (p *proxy) rootHandler(w http.ResponseWriter, r *http.Request) {
 	default:
 		r.URL.Path = fs3 + "/" + r.URL.Path
 	}
-	p.s3Handler(w, r)
+	p.audited(p.s3Handler)(w, r)
 }
 
 // GET | HEAD vanilla http(s) location via `ht://` bucket with the corresponding `OrigURLBck`
@@ -3103,6 +3476,7 @@ func (p *proxy) Stop(err error) {
 	xreg.AbortAll(errors.New("p-stop"))
 
 	p.htrun.stop(&sync.WaitGroup{}, !isPrimary && smap.isValid() && !isEnu /*rmFromSmap*/)
+	p.jhist.close()
 	p.authn.stop()
 }
 
@@ -3117,6 +3491,7 @@ func (p *proxy) notifyCandidate(npsi *meta.Snode, smap *smapX) {
 	}
 	req.Header.Set(apc.HdrSenderID, p.SID())
 	req.Header.Set(apc.HdrSenderSmapVer, smap.vstr)
+	p.signReq(req, &cargs.req)
 	g.client.control.Do(req) //nolint:bodyclose // exiting
 	cmn.HreqFree(req)
 }
@@ -3126,6 +3501,9 @@ func (p *proxy) notifyCandidate(npsi *meta.Snode, smap *smapX) {
 //
 
 func (p *proxy) ratelimit(bck *meta.Bck, verb string, smap *smapX) (ecode int, err error) {
+	if ecode, err = p.ratelimitTenant(bck, smap); err != nil {
+		return ecode, err
+	}
 	if !bck.Props.RateLimit.Frontend.Enabled {
 		return 0, nil
 	}
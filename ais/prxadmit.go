@@ -0,0 +1,212 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Cluster-wide admission of prioritized batch jobs (see xact/sched.go)
+// - targets ask the primary before a queued job does any work;
+// - the first request for a given job (xaction ID) takes one of the `xact_sched.max_<class>`
+//   slots; all subsequent requests for the same job are granted right away, so that
+//   all targets run (or queue) the job together;
+// - the slot is freed when the last admitted target finishes the job, or else when
+//   housekeeping finds that the job is no longer running on any of the admitted targets;
+// - in-memory state: a new primary starts with no slots taken
+
+const padmHKIval = time.Minute
+
+type (
+	padmJob struct {
+		tids cos.StrSet // admitted targets
+		prio string
+		last int64 // mono time of the most recent admission
+		done int64 // mono time when released; zero while holding a slot
+	}
+	padmit struct {
+		p       *proxy
+		jobs    map[string]*padmJob // by xaction ID
+		running map[string]int      // by priority class
+		mu      sync.Mutex
+	}
+)
+
+func (pa *padmit) init(p *proxy) {
+	pa.p = p
+	pa.jobs = make(map[string]*padmJob, 8)
+	pa.running = make(map[string]int, 3)
+	hk.Reg("admission"+hk.NameSuffix, pa.housekeep, padmHKIval)
+}
+
+// +gen:endpoint POST /v1/xactions/{xid}
+// +gen:endpoint DELETE /v1/xactions/{xid}
+// (intra-cluster) admit and release batch jobs
+func (pa *padmit) handler(w http.ResponseWriter, r *http.Request) {
+	p := pa.p
+	if r.Method != http.MethodPost && r.Method != http.MethodDelete {
+		cmn.WriteErr405(w, r, http.MethodPost, http.MethodDelete)
+		return
+	}
+	if err := p.checkIntraCall(r.Header, false /*from primary*/); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	apiItems, err := p.parseURL(w, r, apc.URLPathXactions.L, 1, false)
+	if err != nil {
+		return
+	}
+	xid, tid := apiItems[0], r.Header.Get(apc.HdrSenderID)
+	if err := xact.CheckValidUUID(xid); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	smap := p.owner.smap.get()
+	if !smap.isPrimary(p.si) {
+		p.writeErr(w, r, newErrNotPrimary(p.si, smap, "cannot admit "+xid), http.StatusServiceUnavailable)
+		return
+	}
+	if r.Method == http.MethodDelete {
+		pa.release(xid, tid)
+		return
+	}
+	admitted := pa.admit(xid, tid, r.Header.Get(apc.HdrJobPrio), &cmn.GCO.Get().XactSched)
+	p.writeJSON(w, r, admitted, "admit")
+}
+
+func (pa *padmit) admit(xid, tid, prio string, conf *cmn.XactSchedConf) bool {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	now := mono.NanoTime()
+	if job, ok := pa.jobs[xid]; ok {
+		job.tids.Set(tid)
+		job.last = now
+		return true
+	}
+	if limit := conf.MaxRunning(prio); limit > 0 && pa.running[prio] >= limit {
+		return false
+	}
+	pa.jobs[xid] = &padmJob{tids: cos.NewStrSet(tid), prio: prio, last: now}
+	pa.running[prio]++
+	return true
+}
+
+func (pa *padmit) release(xid, tid string) {
+	pa.mu.Lock()
+	if job, ok := pa.jobs[xid]; ok {
+		delete(job.tids, tid)
+		pa._done(job, mono.NanoTime())
+	}
+	pa.mu.Unlock()
+}
+
+// (under lock) free the slot but keep the job for late arrivals - targets that
+// get to it after all others are done
+func (pa *padmit) _done(job *padmJob, now int64) {
+	if len(job.tids) == 0 && job.done == 0 {
+		job.done = now
+		pa.running[job.prio]--
+	}
+}
+
+// drop released jobs; free the slots of jobs that are no longer running
+// (e.g., on targets that restarted or left the cluster)
+func (pa *padmit) housekeep(int64) time.Duration {
+	if !pa.p.owner.smap.get().isPrimary(pa.p.si) {
+		pa.mu.Lock()
+		clear(pa.jobs)
+		clear(pa.running)
+		pa.mu.Unlock()
+		return padmHKIval
+	}
+	var (
+		now     = mono.NanoTime()
+		running bool
+	)
+	pa.mu.Lock()
+	for xid, job := range pa.jobs {
+		switch {
+		case job.done == 0:
+			running = true
+		case time.Duration(now-job.done) > padmHKIval:
+			delete(pa.jobs, xid)
+		}
+	}
+	pa.mu.Unlock()
+	if !running {
+		return padmHKIval
+	}
+
+	smap, xids := pa.p.runningXids()
+	pa.mu.Lock()
+	for xid, job := range pa.jobs {
+		if job.done != 0 || job.last > now {
+			continue // (admitted in the meantime)
+		}
+		for tid := range job.tids {
+			if smap.GetTarget(tid) == nil {
+				delete(job.tids, tid)
+			} else if ids, ok := xids[tid]; ok && !ids.Contains(xid) {
+				delete(job.tids, tid)
+			}
+		}
+		pa._done(job, now)
+	}
+	pa.mu.Unlock()
+	return padmHKIval
+}
+
+// IDs of the xactions currently running on each (responding) target
+func (p *proxy) runningXids() (*smapX, map[string]cos.StrSet) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathXactions.S,
+		Body:   cos.MustMarshal(&xact.QueryMsg{}),
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatAllRunningXacts}},
+	}
+	args.to = core.Targets
+	args.smap = p.owner.smap.get()
+	smap := args.smap
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	xids := make(map[string]cos.StrSet, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			continue
+		}
+		var cnames []string
+		if len(res.bytes) > 0 {
+			if err := jsoniter.Unmarshal(res.bytes, &cnames); err != nil {
+				nlog.Warningln(p.String(), "failed to parse running xactions from", res.si.StringEx(), err)
+				continue
+			}
+		}
+		ids := make(cos.StrSet, len(cnames))
+		for _, cname := range cnames {
+			if _, id, err := xact.ParseCname(cname); err == nil {
+				ids.Set(id)
+			}
+		}
+		xids[res.si.ID()] = ids
+	}
+	freeBcastRes(results)
+	return smap, xids
+}
@@ -0,0 +1,39 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestAdmitClusterWide(t *testing.T) {
+	var (
+		pa   = &padmit{jobs: make(map[string]*padmJob), running: make(map[string]int)}
+		conf = &cmn.XactSchedConf{MaxLow: 1, Enabled: true}
+	)
+	tassert.Fatalf(t, pa.admit("x1", "t1", apc.PrioLow, conf), "expected x1 admitted")
+	// same job, other targets: admitted regardless of the limit
+	tassert.Fatalf(t, pa.admit("x1", "t2", apc.PrioLow, conf), "expected x1 admitted on t2")
+	// other job: queued cluster-wide
+	tassert.Fatalf(t, !pa.admit("x2", "t1", apc.PrioLow, conf), "expected x2 queued")
+	tassert.Fatalf(t, !pa.admit("x2", "t2", apc.PrioLow, conf), "expected x2 queued on t2")
+	// other class: not affected
+	tassert.Fatalf(t, pa.admit("x3", "t1", apc.PrioNormal, conf), "expected x3 admitted")
+
+	pa.release("x1", "t1")
+	tassert.Fatalf(t, !pa.admit("x2", "t1", apc.PrioLow, conf), "expected x2 queued while x1 runs on t2")
+	pa.release("x1", "t2")
+	tassert.Errorf(t, pa.running[apc.PrioLow] == 0, "expected no running low-priority jobs, got %d", pa.running[apc.PrioLow])
+
+	// late arrival: admitted without taking a slot
+	tassert.Fatalf(t, pa.admit("x1", "t3", apc.PrioLow, conf), "expected x1 admitted on t3")
+	tassert.Fatalf(t, pa.admit("x2", "t1", apc.PrioLow, conf), "expected x2 admitted")
+	pa.release("x1", "t3")
+	tassert.Errorf(t, pa.running[apc.PrioLow] == 1, "expected one running low-priority job, got %d", pa.running[apc.PrioLow])
+}
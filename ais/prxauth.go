@@ -43,6 +43,8 @@ type (
 		// list of invalid tokens(revoked or of deleted users)
 		// Authn sends these tokens to primary for broadcasting
 		revokedTokens map[string]bool
+		// terminated AuthN sessions => (unix) time when the session's last token expires
+		sessions map[string]int64
 		// latest revoked version
 		version int64
 		// lock
@@ -156,6 +158,7 @@ func (a *authManager) updateRevokedList(ctx context.Context, newRevoked *tokenLi
 		return nil
 	}
 	// Remove revoked tokens from the token cache
+	// (tokens of terminated sessions are rejected after cache lookup - see validateToken)
 	a.tokenMap.deleteMultiple(newRevoked.Tokens)
 	// Clean up any expired tokens from the revoked list
 	return a.revokedTokens.cleanup(ctx, a.tokenParser)
@@ -167,6 +170,7 @@ func (a *authManager) revokedTokenList() (allRevoked *tokenList) {
 
 // Checks if a token is valid:
 //   - must not be revoked one
+//   - must not belong to a terminated session
 //   - must not be expired
 //   - must have valid JWT claims or equivalent: sub, iss, aud, exp
 //
@@ -186,9 +190,16 @@ func (a *authManager) validateToken(ctx context.Context, token string) (*tok.AIS
 			a.tokenMap.delete(token)
 			return nil, fmt.Errorf("%w [err: %w]: %s", tok.ErrInvalidToken, tok.ErrTokenExpired, token)
 		}
-		return claims, nil
+	} else {
+		var err error
+		if claims, err = a.cacheNewToken(ctx, token); err != nil {
+			return nil, err
+		}
+	}
+	if claims.SessionID != "" && a.revokedTokens.containsSession(claims.SessionID) {
+		return nil, fmt.Errorf("%w [err: %w]: session %s", tok.ErrInvalidToken, tok.ErrTokenRevoked, claims.SessionID)
 	}
-	return a.cacheNewToken(ctx, token)
+	return claims, nil
 }
 
 // Take a token string, validate the signature and claims, and add to the authManager cache
@@ -264,8 +275,10 @@ func (p *proxy) tokenHandler(w http.ResponseWriter, r *http.Request) {
 		p.validateKey(w, r)
 	case http.MethodDelete:
 		p.delToken(w, r)
+	case http.MethodPut:
+		p.putAuthnList(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodPost, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodPost, http.MethodDelete, http.MethodPut)
 	}
 }
 
@@ -318,14 +331,21 @@ func (p *proxy) delToken(w http.ResponseWriter, r *http.Request) {
 		msg := p.newAmsgStr(apc.ActNewPrimary, nil)
 		_ = p.metasyncer.sync(revsPair{allRevoked, msg})
 	}
+	if len(tokenList.Sessions) > 0 && p.owner.smap.get().isPrimary(p.si) {
+		p.jsched.revokeOwners(tokenList.Sessions)
+	}
 }
 
 // Validate the token found in the given header, return claims, and update metrics
 // Returned claims will always be non-nil if no error is returned
 func (p *proxy) validateToken(ctx context.Context, hdr http.Header) (*tok.AISClaims, error) {
 	claims, err := p.extractAndValidate(ctx, hdr)
-	p.statsT.Inc(stats.AuthTotalCount)
+	p.authStats(err)
+	return claims, err
+}
 
+func (p *proxy) authStats(err error) {
+	p.statsT.Inc(stats.AuthTotalCount)
 	if err != nil {
 		p.statsT.Inc(stats.AuthFailCount)
 		switch {
@@ -339,7 +359,6 @@ func (p *proxy) validateToken(ctx context.Context, hdr http.Header) (*tok.AISCla
 	} else {
 		p.statsT.Inc(stats.AuthSuccessCount)
 	}
-	return claims, err
 }
 
 // Validates a token from the request header.
@@ -393,48 +412,92 @@ func aceErrToCode(err error) (status int) {
 
 // Validate the given header contains a token allowing access to the given bucket with the requested permissions
 // All failures must be logged at this level
-func (p *proxy) access(ctx context.Context, hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
-	// Skip internal calls
-	if p.checkIntraCall(hdr, false /*from primary*/) == nil {
-		return nil
-	}
+func (p *proxy) access(ctx context.Context, hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) error {
+	return p.accessObj(ctx, hdr, bck, "", ace)
+}
 
+// same as above for a given object: in addition, bucket (props and AuthN)
+// per-prefix ACL entries that match the object name apply
+func (p *proxy) accessObj(ctx context.Context, hdr http.Header, bck *meta.Bck, objName string, ace apc.AccessAttrs) error {
+	claims, skip, err := p.caller(ctx, hdr, bck)
+	if skip || err != nil {
+		return err
+	}
 	// If auth is NOT enabled, only check bucket properties
-	if !cmn.Rom.AuthEnabled() {
+	if claims == nil {
 		if bck == nil {
 			return nil
 		}
-		// With Auth disabled, always allow read-only access, PATCH, and ACL
-		ace &^= apc.AcePATCH | apc.AceBckSetACL | apc.AccessRO
-		err = bck.Allow(ace)
+		err = allowNoAuth(bck, objName, ace)
 		if err != nil {
 			nlog.Warningln("bucket access check failed:", err)
 		}
 		return err
 	}
+	return p.checkTokenAccess(claims, bck, objName, ace)
+}
 
-	// Validate token and parse claims ONCE
-	claims, err := p.validateToken(ctx, hdr)
+// returns the caller's (validated) claims, or nil claims when auth is disabled;
+// skip=true: no access control (intra-cluster call, or 3rd party reading ht://bucket)
+func (p *proxy) caller(ctx context.Context, hdr http.Header, bck *meta.Bck) (claims *tok.AISClaims, skip bool, err error) {
+	claims, skip, err = p.identify(ctx, hdr)
+	if skip || (claims == nil && err == nil) {
+		return claims, skip, err
+	}
+	p.authStats(err)
 	if err != nil {
 		// NOTE: making exception to allow 3rd party clients read remote ht://bucket
 		if errors.Is(err, tok.ErrNoToken) && bck != nil && bck.IsHT() {
-			err = nil
-		} else {
-			nlog.Warningln("token validation failed:", err)
+			return nil, true, nil
 		}
-		return err
+		nlog.Warningln("token validation failed:", err)
 	}
-	return p.checkTokenAccess(claims, bck, ace)
+	return claims, false, err
 }
 
-func (p *proxy) checkTokenAccess(claims *tok.AISClaims, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
+// (compare with p.caller above)
+func (p *proxy) identify(ctx context.Context, hdr http.Header) (claims *tok.AISClaims, skip bool, err error) {
+	// Skip internal calls
+	if p.checkIntraCall(hdr, false /*from primary*/) == nil {
+		return nil, true, nil
+	}
+	if !cmn.Rom.AuthEnabled() {
+		return nil, false, nil
+	}
+
+	// S3 request signed with AuthN-issued access key and already verified (see p.s3verify)
+	if claims, ok := ctx.Value(cos.CtxS3Signer).(*tok.AISClaims); ok {
+		return claims, false, nil
+	}
+
+	// Validate token and parse claims ONCE
+	claims, err = p.extractAndValidate(ctx, hdr)
+	if errors.Is(err, tok.ErrNoToken) {
+		// no token: client certificate identity (mTLS), if any
+		if c, errC := p.certCaller(ctx); c != nil || errC != nil {
+			claims, err = c, errC
+		}
+	}
+	return claims, false, err
+}
+
+// With Auth disabled, always allow read-only access, PATCH, and ACL
+func allowNoAuth(bck *meta.Bck, objName string, ace apc.AccessAttrs) error {
+	ace &^= apc.AcePATCH | apc.AceBckSetACL | apc.AccessRO
+	if objName == "" {
+		return bck.Allow(ace)
+	}
+	return bck.AllowObj(ace, objName)
+}
+
+func (p *proxy) checkTokenAccess(claims *tok.AISClaims, bck *meta.Bck, objName string, ace apc.AccessAttrs) (err error) {
 	if bck == nil {
 		err = p.checkClaimPermissions(claims, nil, ace)
 		if err != nil {
 			nlog.Warningln("cluster access check failed:", err)
 		}
 	} else {
-		err = p.checkBucketAccess(claims, bck, ace)
+		err = p.checkBucketAccess(claims, bck, objName, ace)
 		if err != nil {
 			nlog.Warningln("bucket access check failed:", err)
 		}
@@ -446,6 +509,38 @@ func (p *proxy) checkTokenAccess(claims *tok.AISClaims, bck *meta.Bck, ace apc.A
 	return err
 }
 
+// list-objects access: bucket-wide permission to list or, otherwise, a per-prefix one
+// (in which case the returned filter selects the objects that the caller can read)
+func (p *proxy) lsoAccess(ctx context.Context, hdr http.Header, bck *meta.Bck) (filter func(string) bool, err error) {
+	claims, skip, err := p.caller(ctx, hdr, bck)
+	if skip || err != nil {
+		return nil, err
+	}
+	if claims == nil {
+		return nil, nil // auth disabled: read-only access is always granted
+	}
+	err = p.checkBucketAccess(claims, bck, "", apc.AceObjLIST)
+	if err != nil {
+		uid := p.owner.smap.Get().UUID
+		principals := claims.Principals()
+		props := bck.Props
+		if claims.PrefixGrants(uid, bck.Bucket(), apc.AceObjLIST) &&
+			(props.Access.Has(apc.AceObjLIST) || props.PrefixACL.Grants(apc.AceObjLIST, principals)) {
+			err = nil
+			filter = func(objName string) bool {
+				return claims.CheckObjPermissions(uid, bck.Bucket(), objName, apc.AceGET) == nil &&
+					bck.AllowObj(apc.AceGET, objName, principals...) == nil
+			}
+		}
+	}
+	p.statsT.Inc(stats.ACLTotalCount)
+	if err != nil {
+		nlog.Warningln("bucket access check failed:", err)
+		p.statsT.Inc(stats.ACLDeniedCount)
+	}
+	return filter, err
+}
+
 // checkClaimPermissions validates claims have the required permissions
 func (p *proxy) checkClaimPermissions(claims *tok.AISClaims, bucket *cmn.Bck, ace apc.AccessAttrs) error {
 	if claims == nil {
@@ -455,16 +550,24 @@ func (p *proxy) checkClaimPermissions(claims *tok.AISClaims, bucket *cmn.Bck, ac
 	return claims.CheckPermissions(uid, bucket, ace)
 }
 
-func (p *proxy) checkBucketAccess(claims *tok.AISClaims, bck *meta.Bck, ace apc.AccessAttrs) error {
-	err := p.checkClaimPermissions(claims, bck.Bucket(), ace)
-	if err != nil {
+// both the token (AuthN) and the bucket's props must allow; for a named object,
+// either one may grant extra permissions via its own per-prefix ACL entries
+func (p *proxy) checkBucketAccess(claims *tok.AISClaims, bck *meta.Bck, objName string, ace apc.AccessAttrs) error {
+	if claims == nil {
+		return tok.ErrInvalidToken
+	}
+	uid := p.owner.smap.Get().UUID
+	if err := claims.CheckObjPermissions(uid, bck.Bucket(), objName, ace); err != nil {
 		return err
 	}
 	// If an admin, bucket properties for access still apply, but admin can always patch and set ACL
 	if claims.IsAdmin {
 		ace &^= apc.AcePATCH | apc.AceBckSetACL
 	}
-	return bck.Allow(ace)
+	if objName == "" {
+		return bck.Allow(ace)
+	}
+	return bck.AllowObj(ace, objName, claims.Principals()...)
 }
 
 /////////////////////
@@ -550,6 +653,7 @@ func newTokenMap() *tokenMap {
 func newRevokedTokensMap() *RevokedTokensMap {
 	return &RevokedTokensMap{
 		revokedTokens: make(map[string]bool),
+		sessions:      make(map[string]int64),
 		version:       1,
 	}
 }
@@ -565,6 +669,9 @@ func (r *RevokedTokensMap) update(newRevoked *tokenList) error {
 	for _, token := range newRevoked.Tokens {
 		r.revokedTokens[token] = true
 	}
+	for sid, exp := range newRevoked.Sessions {
+		r.sessions[sid] = exp
+	}
 	return nil
 }
 
@@ -609,7 +716,19 @@ func (r *RevokedTokensMap) cleanup(ctx context.Context, tkParser tok.Parser) (al
 			nlog.Errorf("Unexpected token validation error: %v (token: %s)", err, token)
 		}
 	}
-	if len(allRevoked.Tokens) == 0 {
+	// Same for terminated sessions
+	now := time.Now().Unix()
+	for sid, exp := range r.sessions {
+		if exp <= now {
+			delete(r.sessions, sid)
+			continue
+		}
+		if allRevoked.Sessions == nil {
+			allRevoked.Sessions = make(map[string]int64, len(r.sessions))
+		}
+		allRevoked.Sessions[sid] = exp
+	}
+	if len(allRevoked.Tokens) == 0 && len(allRevoked.Sessions) == 0 {
 		allRevoked = nil
 	}
 	return allRevoked
@@ -622,16 +741,29 @@ func (r *RevokedTokensMap) contains(token string) bool {
 	return ok
 }
 
+func (r *RevokedTokensMap) containsSession(sid string) bool {
+	r.RLock()
+	_, ok := r.sessions[sid]
+	r.RUnlock()
+	return ok
+}
+
 func (r *RevokedTokensMap) getAll() *tokenList {
 	r.RLock()
 	defer r.RUnlock()
 	l := len(r.revokedTokens)
-	if l == 0 {
+	if l == 0 && len(r.sessions) == 0 {
 		return nil
 	}
 	allRevoked := &tokenList{Tokens: make([]string, 0, l), Version: r.version}
 	for token := range r.revokedTokens {
 		allRevoked.Tokens = append(allRevoked.Tokens, token)
 	}
+	if len(r.sessions) > 0 {
+		allRevoked.Sessions = make(map[string]int64, len(r.sessions))
+		for sid, exp := range r.sessions {
+			allRevoked.Sessions[sid] = exp
+		}
+	}
 	return allRevoked
 }
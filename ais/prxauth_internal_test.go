@@ -9,11 +9,13 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/tools/tassert"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -120,6 +122,34 @@ func TestAuth_Manager_ValidateToken_Revoked(t *testing.T) {
 	tassert.Error(t, err != nil, "Revoked token should not be valid")
 }
 
+func TestAuth_Manager_ValidateToken_RevokedSession(t *testing.T) {
+	// Tokens of a terminated session fail validation whether cached or not
+	var (
+		sessClaim = &tok.AISClaims{
+			RegisteredClaims: validClaim.RegisteredClaims,
+			SessionID:        "sid1",
+		}
+		parser = newMockTokenParser()
+		am     = &authManager{
+			tokenMap:      newShardedTokenMap(2),
+			revokedTokens: newRevokedTokensMap(),
+			tokenParser:   parser,
+		}
+	)
+	parser.claimsMap["cached"] = sessClaim
+	parser.claimsMap["new"] = sessClaim
+	_, err := am.validateToken(t.Context(), "cached")
+	tassert.CheckFatal(t, err)
+
+	exp := time.Now().Add(time.Hour).Unix()
+	res := am.updateRevokedList(t.Context(), &tokenList{Sessions: map[string]int64{"sid1": exp}})
+	tassert.Fatal(t, res != nil && res.Sessions["sid1"] == exp, "Expected revoked session in the result")
+	for _, token := range []string{"cached", "new"} {
+		_, err = am.validateToken(t.Context(), token)
+		tassert.Errorf(t, errors.Is(err, tok.ErrTokenRevoked), "Token %q of a terminated session should be revoked, got %v", token, err)
+	}
+}
+
 func TestAuth_Manager_ValidateToken_CachedValid(t *testing.T) {
 	token := "goodtoken"
 	tkMap := newShardedTokenMap(2)
@@ -210,6 +240,24 @@ func TestAuth_RevokedTokensMap_Cleanup(t *testing.T) {
 	tassert.Error(t, !r.contains("expired"), "expired token should have been removed")
 }
 
+func TestAuth_RevokedTokensMap_CleanupSessions(t *testing.T) {
+	r := newRevokedTokensMap()
+	now := time.Now()
+	err := r.update(&tokenList{Sessions: map[string]int64{
+		"active":  now.Add(time.Hour).Unix(),
+		"expired": now.Add(-time.Second).Unix(),
+	}})
+	tassert.Error(t, err == nil, "expected manual session update to succeed")
+	result := r.cleanup(t.Context(), newMockTokenParser())
+	tassert.Fatal(t, result != nil, "expected non-nil result with an active session")
+	tassert.Error(t, len(result.Sessions) == 1, "expected only the active session after cleanup")
+	tassert.Error(t, r.containsSession("active"), "active session should remain revoked")
+	tassert.Error(t, !r.containsSession("expired"), "expired session should have been removed")
+
+	r.sessions["active"] = now.Add(-time.Second).Unix()
+	tassert.Error(t, r.cleanup(t.Context(), newMockTokenParser()) == nil, "expected nil result when nothing is revoked")
+}
+
 func TestAuth_RevokedTokensMap_Concurrency(t *testing.T) {
 	r := newRevokedTokensMap()
 	wg := sync.WaitGroup{}
@@ -349,3 +397,17 @@ func TestAuth_ShardedTokenMap_Concurrency(_ *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestAuth_FilterLso(t *testing.T) {
+	lst := &cmn.LsoRes{
+		UUID:              "lso",
+		ContinuationToken: "next",
+		Entries:           cmn.LsoEntries{{Name: "team-a/1"}, {Name: "team-b/1"}, {Name: "team-a/2"}},
+	}
+	flst := filterLso(lst, func(name string) bool { return strings.HasPrefix(name, "team-a/") })
+	tassert.Fatalf(t, len(flst.Entries) == 2, "expected 2 entries, got %d", len(flst.Entries))
+	tassert.Errorf(t, flst.Entries[0].Name == "team-a/1" && flst.Entries[1].Name == "team-a/2", "unexpected %v", flst.Entries)
+	tassert.Errorf(t, flst.UUID == lst.UUID && flst.ContinuationToken == lst.ContinuationToken, "expected same page")
+	// the original (possibly cached) page remains intact
+	tassert.Errorf(t, len(lst.Entries) == 3 && lst.Entries[1].Name == "team-b/1", "original page modified: %v", lst.Entries)
+}
@@ -39,6 +39,8 @@ type bctx struct {
 
 	reqBody []byte          // request body of original request
 	perms   apc.AccessAttrs // apc.AceGET, apc.AcePATCH etc.
+	objName string          // object operation: to evaluate per-prefix ACL entries
+	lso     bool            // list-objects: may be allowed per prefix (see lsoFilter below)
 
 	// 5 user or caller-provided control flags followed by
 	// 3 result flags
@@ -51,6 +53,8 @@ type bctx struct {
 	// out
 	isPresent bool // the bucket is confirmed to be present (in the cluster's BMD) // caution wrt mem-pool
 	exists    bool // remote bucket is confirmed to exist                          // ditto; httpbckhead
+
+	lsoFilter func(string) bool // when listing is allowed only per prefix: objects the caller can read
 }
 
 ////////////////
@@ -227,7 +231,12 @@ rerr:
 
 // (compare w/ accessSupported)
 func (bctx *bctx) accessAllowed(bck *meta.Bck) (ecode int, err error) {
-	err = bctx.p.access(bctx.r.Context(), bctx.r.Header, bck, bctx.perms)
+	if bctx.lso {
+		debug.Assert(bctx.perms == apc.AceObjLIST, bctx.perms)
+		bctx.lsoFilter, err = bctx.p.lsoAccess(bctx.r.Context(), bctx.r.Header, bck)
+	} else {
+		err = bctx.p.accessObj(bctx.r.Context(), bctx.r.Header, bck, bctx.objName, bctx.perms)
+	}
 	ecode = aceErrToCode(err)
 	return ecode, err
 }
@@ -0,0 +1,594 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"container/list"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Proxy-side cache (bucket property `proxy_cache`) serves the following without redirecting:
+// - small hot objects: the second GET (within `ttl`) of an object that does not exceed
+//   `max_obj_size` makes the proxy read the object from its target (via intra-cluster
+//   data network) and keep it in memory
+// - HEAD(object) results
+// - in-cluster list-objects that fit in a single page (no continuation token -
+//   a cached page cannot be continued by the targets)
+//
+// Invalidation:
+// - any Smap or BMD change (including bucket props) purges the cache
+// - writes (PUT, DELETE, rename, etc.) via this proxy evict the object and its bucket's listings -
+//   upon redirect and, again, when the target reports the write completed (apc.Written);
+//   bucket-level PUT, POST, and DELETE evict the entire bucket (before and after)
+// - upon `ttl` expiration, cached objects get revalidated (intra-cluster HEAD: version and checksum),
+//   while cached HEAD results and listings get dropped
+// - therefore, writes via other proxies (and via S3 API) become visible within `ttl`
+//
+// Memory: bounded by `proxy.cache_size`; in addition, the cache gets purged and stops
+// admitting new entries when memsys reports high memory pressure (see housekeep).
+
+const (
+	pxcHotCnt   = 2                // number of GETs (within ttl) that makes an object "hot"
+	pxcOverhead = 256              // approx. per entry
+	pxcHKIval   = 30 * time.Second // housekeeping interval
+)
+
+type (
+	pxcEntry struct {
+		elem  *list.Element
+		hdr   http.Header            // GET(object) response header (nil when not cached)
+		body  []byte                 // object content
+		heads map[string]http.Header // HEAD(object) results by normalized query
+		lst   *cmn.LsoRes            // list-objects page
+		key   string                 // object uname or listing key
+		bck   string                 // bucket uname (listings only)
+		size  int64
+		exp   int64 // mono-time: revalidate cached object, drop listing, or reset `ngets` and `big`
+		hexp  int64 // ditto, HEAD results
+		ngets int   // GETs of the not (yet) cached object
+		big   bool  // exceeds max_obj_size
+	}
+	pxcache struct {
+		p       *proxy
+		objs    map[string]*pxcEntry            // by object uname
+		lsts    map[string]map[string]*pxcEntry // by bucket uname, by listing key
+		lru     list.List
+		size    int64
+		smapVer int64
+		bmdVer  int64
+		num     atomic.Int64
+		lowmem  atomic.Bool
+		mu      sync.Mutex
+	}
+)
+
+func (c *pxcache) init(p *proxy) {
+	c.p = p
+	c.objs = make(map[string]*pxcEntry, 64)
+	c.lsts = make(map[string]map[string]*pxcEntry, 4)
+	c.lru.Init()
+	hk.Reg("pxcache"+hk.NameSuffix, c.housekeep, pxcHKIval)
+}
+
+//
+// GET(object)
+//
+
+// plain GET of the entire object (no range, archived file, ETL, etc.)
+func _pxcGetOK(r *http.Request) bool {
+	if r.Header.Get(cos.HdrRange) != "" || r.Header.Get(apc.HdrBlobDownload) != "" {
+		return false
+	}
+	for k := range r.URL.Query() {
+		if k != apc.QparamProvider && k != apc.QparamNamespace {
+			return false
+		}
+	}
+	return true
+}
+
+// returns true if served (in which case the caller must not redirect)
+func (c *pxcache) get(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, tsi *meta.Snode, smap *smapX) bool {
+	if c.lowmem.Load() || !_pxcGetOK(r) {
+		return false
+	}
+	var (
+		conf  = &bck.Props.ProxyCache
+		uname = cos.UnsafeS(bck.MakeUname(objName))
+		now   = mono.NanoTime()
+		exp   = now + conf.TTLNano()
+	)
+	c.mu.Lock()
+	if !c._sync(smap.Version, c.p.owner.bmd.get().Version) {
+		c.mu.Unlock()
+		return false
+	}
+	e, ok := c.objs[uname]
+	if !ok {
+		e = &pxcEntry{key: uname, exp: exp, ngets: 1}
+		c.objs[uname] = e
+		c._add(e)
+		c.mu.Unlock()
+		c.p.statsT.IncBck(stats.PxcMissCount, bck.Bucket())
+		return false
+	}
+	c.lru.MoveToFront(e.elem)
+
+	if e.hdr != nil {
+		hdr, body, valid := e.hdr, e.body, now < e.exp
+		c.mu.Unlock()
+		if valid || c.revalidate(r, e, hdr, tsi, smap, exp) {
+			c.p.statsT.IncBck(stats.PxcHitCount, bck.Bucket())
+			_pxcWrite(w, hdr, body)
+			return true
+		}
+		// changed (or failed to revalidate) - re-read right away
+	} else {
+		if now >= e.exp {
+			e.big, e.ngets, e.exp = false, 0, exp
+		}
+		e.ngets++
+		fetch := !e.big && e.ngets >= pxcHotCnt
+		c.mu.Unlock()
+		if !fetch {
+			c.p.statsT.IncBck(stats.PxcMissCount, bck.Bucket())
+			return false
+		}
+	}
+	c.p.statsT.IncBck(stats.PxcMissCount, bck.Bucket())
+	return c.fetch(w, r, e, tsi, conf.MaxSize(), exp)
+}
+
+// HEAD the target and compare object version and checksum
+func (c *pxcache) revalidate(r *http.Request, e *pxcEntry, hdr http.Header, tsi *meta.Snode, smap *smapX, exp int64) bool {
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{Method: http.MethodHead, Path: r.URL.Path, RawQuery: r.URL.RawQuery}
+		cargs.timeout = apc.DefaultTimeout
+	}
+	res := c.p.call(cargs, smap)
+	freeCargs(cargs)
+
+	var (
+		version = hdr.Get(apc.HdrObjVersion)
+		cksum   = hdr.Get(apc.HdrObjCksumVal)
+		same    = res.err == nil && res.status == http.StatusOK && (version != "" || cksum != "") &&
+			res.header.Get(apc.HdrObjVersion) == version && res.header.Get(apc.HdrObjCksumVal) == cksum
+	)
+	freeCR(res)
+
+	c.mu.Lock()
+	if c.objs[e.key] == e {
+		if same {
+			e.exp = exp
+		} else {
+			e.hdr, e.body = nil, nil
+			c._resize(e)
+		}
+	}
+	c.mu.Unlock()
+	return same
+}
+
+// read the object from the target; cache it if small enough, otherwise pass it through
+func (c *pxcache) fetch(w http.ResponseWriter, r *http.Request, e *pxcEntry, tsi *meta.Snode, maxSize, exp int64) bool {
+	args := cmn.HreqArgs{Method: http.MethodGet, Base: tsi.URL(cmn.NetIntraData), Path: r.URL.Path, RawQuery: r.URL.RawQuery}
+	req, err := args.Req()
+	if err != nil {
+		return false
+	}
+	req.Header.Set(apc.HdrSenderID, c.p.SID())
+	req.Header.Set(apc.HdrSenderName, c.p.si.Name())
+	c.p.signReq(req, &args)
+	req.Header.Set(cos.HdrUserAgent, ua)
+
+	resp, err := g.client.data.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		cmn.HreqFree(req)
+		if cmn.Rom.V(4, cos.ModAIS) {
+			nlog.Warningln("pxcache: failed to GET", e.key, "from", tsi.StringEx(), "err:", err)
+		}
+		return false
+	}
+	defer func() {
+		resp.Body.Close()
+		cmn.HreqFree(req)
+	}()
+	if resp.StatusCode != http.StatusOK {
+		cos.DrainReader(resp.Body)
+		return false // redirect as usual
+	}
+
+	if resp.ContentLength < 0 || resp.ContentLength > maxSize {
+		c.mu.Lock()
+		if c.objs[e.key] == e {
+			e.big, e.exp = true, exp
+		}
+		c.mu.Unlock()
+
+		// this time around, pass it through
+		cmn.CopyHeaders(w.Header(), resp.Header)
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.Copy(w, resp.Body); err != nil && cmn.Rom.V(4, cos.ModAIS) {
+			nlog.Warningln("pxcache: failed to pass through", e.key, "err:", err)
+		}
+		return true
+	}
+
+	body := make([]byte, resp.ContentLength)
+	if _, err := io.ReadFull(resp.Body, body); err != nil {
+		return false
+	}
+	hdr := resp.Header.Clone()
+	hdr.Del("Date")
+
+	if !c.lowmem.Load() {
+		c.mu.Lock()
+		if c.objs[e.key] == e {
+			e.hdr, e.body, e.exp, e.ngets = hdr, body, exp, 0
+			c._resize(e)
+		}
+		c.mu.Unlock()
+	}
+	_pxcWrite(w, hdr, body)
+	return true
+}
+
+func _pxcWrite(w http.ResponseWriter, hdr http.Header, body []byte) {
+	cmn.CopyHeaders(w.Header(), hdr)
+	w.WriteHeader(http.StatusOK)
+	if len(body) > 0 {
+		w.Write(body) //nolint:errcheck // (client gone)
+	}
+}
+
+//
+// HEAD(object)
+//
+
+func _pxcHeadOK(q map[string][]string) bool {
+	for k := range q {
+		switch k {
+		case apc.QparamProvider, apc.QparamNamespace, apc.QparamFltPresence, apc.QparamSilent, apc.QparamProps:
+		default:
+			return false // including latest-version and validate-checksum
+		}
+	}
+	return true
+}
+
+func (c *pxcache) head(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, tsi *meta.Snode, smap *smapX) bool {
+	if c.lowmem.Load() {
+		return false
+	}
+	q := r.URL.Query()
+	if !_pxcHeadOK(q) {
+		return false
+	}
+	var (
+		qs     = q.Encode() // (sorted)
+		uname  = cos.UnsafeS(bck.MakeUname(objName))
+		now    = mono.NanoTime()
+		bmdVer = c.p.owner.bmd.get().Version
+	)
+	c.mu.Lock()
+	if !c._sync(smap.Version, bmdVer) {
+		c.mu.Unlock()
+		return false
+	}
+	if e, ok := c.objs[uname]; ok && now < e.hexp {
+		if hdr, ok := e.heads[qs]; ok {
+			c.lru.MoveToFront(e.elem)
+			c.mu.Unlock()
+			c.p.statsT.IncBck(stats.PxcHitCount, bck.Bucket())
+			_pxcWrite(w, hdr, nil)
+			return true
+		}
+	}
+	c.mu.Unlock()
+	c.p.statsT.IncBck(stats.PxcMissCount, bck.Bucket())
+
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{Method: http.MethodHead, Path: r.URL.Path, RawQuery: r.URL.RawQuery}
+		cargs.timeout = apc.DefaultTimeout
+	}
+	res := c.p.call(cargs, smap)
+	freeCargs(cargs)
+	if res.err != nil || res.status != http.StatusOK {
+		freeCR(res)
+		return false // redirect as usual (e.g., not found)
+	}
+	hdr := res.header
+	freeCR(res)
+	hdr.Del("Date")
+
+	c.mu.Lock()
+	if c._sync(smap.Version, bmdVer) {
+		e, ok := c.objs[uname]
+		if !ok {
+			e = &pxcEntry{key: uname}
+			c.objs[uname] = e
+			c._add(e)
+		}
+		if e.heads == nil || now >= e.hexp {
+			e.heads = make(map[string]http.Header, 1)
+			e.hexp = now + bck.Props.ProxyCache.TTLNano()
+		}
+		e.heads[qs] = hdr
+		c._resize(e)
+	}
+	c.mu.Unlock()
+
+	_pxcWrite(w, hdr, nil)
+	return true
+}
+
+//
+// list-objects (first pages)
+//
+
+// returns empty key when not cacheable
+func pxcLsoKey(bck *meta.Bck, lsmsg *apc.LsoMsg, hdr http.Header) string {
+	switch {
+	case lsmsg.UUID != "" || lsmsg.ContinuationToken != "" || lsmsg.Snap != "" || len(lsmsg.Header) > 0:
+		return ""
+	case lsmsg.IsFlagSet(apc.LsDiff) || lsmsg.IsFlagSet(apc.LsVerHistory):
+		return ""
+	case bck.IsRemote() && !lsmsg.IsFlagSet(apc.LsCached):
+		return "" // remote listing
+	case cos.IsParseBool(hdr.Get(apc.HdrInventory)):
+		return ""
+	}
+	const sepa = "\x00"
+	return lsmsg.Prefix + sepa + lsmsg.StartAfter + sepa + lsmsg.Props + sepa + lsmsg.TimeFormat + sepa +
+		strconv.FormatUint(lsmsg.Flags, 16) + sepa + strconv.FormatInt(lsmsg.PageSize, 10)
+}
+
+func (c *pxcache) getLso(bck *meta.Bck, key string, smap *smapX) *cmn.LsoRes {
+	bu := cos.UnsafeS(bck.MakeUname(""))
+	c.mu.Lock()
+	if !c._sync(smap.Version, c.p.owner.bmd.get().Version) {
+		c.mu.Unlock()
+		return nil
+	}
+	e, ok := c.lsts[bu][key]
+	if ok && mono.NanoTime() >= e.exp {
+		c._del(e)
+		ok = false
+	}
+	if !ok {
+		c.mu.Unlock()
+		c.p.statsT.IncBck(stats.PxcMissCount, bck.Bucket())
+		return nil
+	}
+	c.lru.MoveToFront(e.elem)
+	lst := &cmn.LsoRes{UUID: cos.GenUUID(), Entries: e.lst.Entries, Flags: e.lst.Flags}
+	c.mu.Unlock()
+
+	c.p.statsT.IncBck(stats.PxcHitCount, bck.Bucket())
+	return lst
+}
+
+// complete (single-page) listings only
+func (c *pxcache) putLso(bck *meta.Bck, key string, lst *cmn.LsoRes, smap *smapX) {
+	if c.lowmem.Load() || lst.ContinuationToken != "" {
+		return
+	}
+	clone := &cmn.LsoRes{Flags: lst.Flags, Entries: make(cmn.LsoEntries, len(lst.Entries))}
+	for i, en := range lst.Entries {
+		cp := *en
+		clone.Entries[i] = &cp
+	}
+	var (
+		bu = cos.UnsafeS(bck.MakeUname(""))
+		e  = &pxcEntry{key: key, bck: bu, lst: clone, exp: mono.NanoTime() + bck.Props.ProxyCache.TTLNano()}
+	)
+	e.size = e._size()
+
+	c.mu.Lock()
+	if c._sync(smap.Version, c.p.owner.bmd.get().Version) {
+		m, ok := c.lsts[bu]
+		if !ok {
+			m = make(map[string]*pxcEntry, 4)
+			c.lsts[bu] = m
+		}
+		if old, ok := m[key]; ok {
+			c._del(old)
+		}
+		m[key] = e
+		c._add(e)
+	}
+	c.mu.Unlock()
+}
+
+//
+// invalidation and housekeeping
+//
+
+// PUT, DELETE, etc. via this proxy
+func (c *pxcache) evict(r *http.Request) {
+	if c.num.Load() == 0 {
+		return
+	}
+	if bck, objName := _urlBck(r); bck != nil {
+		c._evictBck(bck, objName)
+	}
+}
+
+// target: redirected write completed (see t.pxcWritten) - evict again, in case
+// a GET in between (re)cached the previous content
+func (c *pxcache) written(r *http.Request) {
+	b, err := cos.ReadAllN(r.Body, r.ContentLength)
+	if err != nil || c.num.Load() == 0 {
+		return
+	}
+	bck, objName, err := meta.ParseUname(cos.UnsafeS(b), true)
+	if err != nil {
+		nlog.Warningln("pxcache:", err)
+		return
+	}
+	c._evictBck(bck, objName)
+}
+
+func (c *pxcache) _evictBck(bck *meta.Bck, objName string) {
+	bu := cos.UnsafeS(bck.MakeUname(""))
+	c.mu.Lock()
+	if objName == "" {
+		for uname, e := range c.objs {
+			if strings.HasPrefix(uname, bu) {
+				c._del(e)
+			}
+		}
+	} else if e, ok := c.objs[bu+objName]; ok {
+		c._del(e)
+	}
+	for _, e := range c.lsts[bu] {
+		c._del(e)
+	}
+	c.mu.Unlock()
+}
+
+func (c *pxcache) housekeep(int64) time.Duration {
+	if c.num.Load() == 0 && !c.lowmem.Load() {
+		return pxcHKIval
+	}
+	lowmem := c.p.gmm.Pressure() >= memsys.PressureHigh
+	if lowmem != c.lowmem.Load() {
+		nlog.Warningln("pxcache: high memory pressure:", lowmem)
+		c.lowmem.Store(lowmem)
+	}
+
+	c.mu.Lock()
+	if lowmem {
+		c._purge()
+		c.mu.Unlock()
+		return pxcHKIval
+	}
+	now := mono.NanoTime()
+	for elem := c.lru.Front(); elem != nil; {
+		e := elem.Value.(*pxcEntry)
+		elem = elem.Next()
+		if e.heads != nil && now >= e.hexp {
+			e.heads = nil
+			c._resize(e)
+		}
+		if e.hdr == nil && e.heads == nil && now >= e.exp {
+			c._del(e) // expired listing, ngets counter, or (too) big object
+		}
+	}
+	c.mu.Unlock()
+	return pxcHKIval
+}
+
+// (under lock) returns false if the caller's Smap or BMD is outdated; purges the cache upon newer versions
+func (c *pxcache) _sync(smapVer, bmdVer int64) bool {
+	if smapVer < c.smapVer || bmdVer < c.bmdVer {
+		return false
+	}
+	if smapVer > c.smapVer || bmdVer > c.bmdVer {
+		c._purge()
+		c.smapVer, c.bmdVer = smapVer, bmdVer
+	}
+	return true
+}
+
+func (c *pxcache) _purge() {
+	clear(c.objs)
+	clear(c.lsts)
+	c.lru.Init()
+	c.size = 0
+	c.num.Store(0)
+}
+
+func (c *pxcache) _add(e *pxcEntry) {
+	if e.size == 0 {
+		e.size = e._size()
+	}
+	e.elem = c.lru.PushFront(e)
+	c.size += e.size
+	c.num.Inc()
+	c._evict(e)
+}
+
+func (c *pxcache) _del(e *pxcEntry) {
+	c.lru.Remove(e.elem)
+	c.size -= e.size
+	c.num.Dec()
+	if e.lst == nil {
+		delete(c.objs, e.key)
+		return
+	}
+	m := c.lsts[e.bck]
+	delete(m, e.key)
+	if len(m) == 0 {
+		delete(c.lsts, e.bck)
+	}
+}
+
+func (c *pxcache) _resize(e *pxcEntry) {
+	size := e._size()
+	c.size += size - e.size
+	e.size = size
+	c._evict(e)
+}
+
+// evict least recently used entries (except the one that's being added)
+func (c *pxcache) _evict(keep *pxcEntry) {
+	budget := int64(cos.NonZero(cmn.GCO.Get().Proxy.CacheSize, cmn.DfltPxcacheSize))
+	for c.size > budget {
+		elem := c.lru.Back()
+		if elem == nil || elem.Value.(*pxcEntry) == keep {
+			break
+		}
+		c._del(elem.Value.(*pxcEntry))
+	}
+}
+
+//////////////
+// pxcEntry //
+//////////////
+
+func (e *pxcEntry) _size() int64 {
+	size := int64(pxcOverhead + len(e.key) + len(e.body))
+	size += _hdrSize(e.hdr)
+	for q, hdr := range e.heads {
+		size += int64(len(q)) + _hdrSize(hdr)
+	}
+	if e.lst != nil {
+		for _, en := range e.lst.Entries {
+			size += int64(pxcOverhead/4 + len(en.Name) + len(en.Checksum) + len(en.Atime) + len(en.Version) + len(en.Location) + len(en.Custom))
+		}
+	}
+	return size
+}
+
+func _hdrSize(hdr http.Header) (size int64) {
+	for k, vals := range hdr {
+		size += int64(len(k))
+		for _, v := range vals {
+			size += int64(len(v))
+		}
+	}
+	return size
+}
@@ -0,0 +1,103 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newTestPxcache(cacheSize int64) *pxcache {
+	config := cmn.GCO.BeginUpdate()
+	config.Proxy.CacheSize = cos.SizeIEC(cacheSize)
+	cmn.GCO.CommitUpdate(config)
+
+	c := &pxcache{}
+	c.objs = make(map[string]*pxcEntry)
+	c.lsts = make(map[string]map[string]*pxcEntry)
+	c.lru.Init()
+	return c
+}
+
+func _pxcAddObj(c *pxcache, bck *meta.Bck, objName string, size int) *pxcEntry {
+	e := &pxcEntry{key: string(bck.MakeUname(objName)), hdr: http.Header{}, body: make([]byte, size)}
+	c.objs[e.key] = e
+	c._add(e)
+	return e
+}
+
+func TestPxcacheBudget(t *testing.T) {
+	var (
+		c   = newTestPxcache(cos.MiB)
+		bck = meta.NewBck("abc", apc.AIS, cmn.NsGlobal)
+	)
+	tassert.Fatalf(t, c._sync(1, 1), "expected in-sync")
+	for i := range 20 {
+		_pxcAddObj(c, bck, "obj-"+strconv.Itoa(i), 100*cos.KiB)
+	}
+	tassert.Errorf(t, c.size <= cos.MiB, "cache size %d exceeds budget", c.size)
+	tassert.Errorf(t, int(c.num.Load()) == len(c.objs) && c.lru.Len() == len(c.objs),
+		"inconsistent: num %d, objs %d, lru %d", c.num.Load(), len(c.objs), c.lru.Len())
+
+	// least recently used get evicted first
+	_, ok := c.objs[string(bck.MakeUname("obj-0"))]
+	tassert.Errorf(t, !ok, "expected obj-0 to be evicted")
+	_, ok = c.objs[string(bck.MakeUname("obj-19"))]
+	tassert.Errorf(t, ok, "expected obj-19 to be cached")
+}
+
+func TestPxcacheInvalidate(t *testing.T) {
+	var (
+		c    = newTestPxcache(0)
+		bck1 = meta.NewBck("abc", apc.AIS, cmn.NsGlobal)
+		bck2 = meta.NewBck("xyz", apc.AIS, cmn.NsGlobal)
+	)
+	tassert.Fatalf(t, c._sync(1, 1), "expected in-sync")
+	for i := range 4 {
+		_pxcAddObj(c, bck1, "obj-"+strconv.Itoa(i), 10)
+		_pxcAddObj(c, bck2, "obj-"+strconv.Itoa(i), 10)
+	}
+	lst := &cmn.LsoRes{Entries: cmn.LsoEntries{{Name: "obj-0"}}}
+	e := &pxcEntry{key: "k", bck: string(bck1.MakeUname("")), lst: lst}
+	c.lsts[e.bck] = map[string]*pxcEntry{e.key: e}
+	c._add(e)
+
+	// object write: the object and its bucket's listings
+	r := httptest.NewRequest(http.MethodPut, "/"+apc.URLPathObjects.Join("abc", "obj-1")+"?"+apc.QparamProvider+"=ais", http.NoBody)
+	c.evict(r)
+	tassert.Errorf(t, len(c.objs) == 7, "expected 7 cached objects, got %d", len(c.objs))
+	tassert.Errorf(t, len(c.lsts) == 0, "expected no cached listings, got %d", len(c.lsts))
+
+	// bucket-level
+	r = httptest.NewRequest(http.MethodPost, "/"+apc.URLPathBuckets.Join("xyz")+"?"+apc.QparamProvider+"=ais", http.NoBody)
+	c.evict(r)
+	tassert.Errorf(t, len(c.objs) == 3, "expected 3 cached objects, got %d", len(c.objs))
+	for uname := range c.objs {
+		b, _ := cmn.ParseUname(uname)
+		tassert.Errorf(t, b.Name == "abc", "unexpected cached %q", uname)
+	}
+
+	// target: redirected write completed
+	r = httptest.NewRequest(http.MethodPost, "/"+apc.URLPathNotifs.Join(apc.Written), bytes.NewReader(bck1.MakeUname("obj-2")))
+	c.written(r)
+	tassert.Errorf(t, len(c.objs) == 2, "expected 2 cached objects, got %d", len(c.objs))
+	_, ok := c.objs[string(bck1.MakeUname("obj-2"))]
+	tassert.Errorf(t, !ok, "expected obj-2 to be evicted")
+
+	// outdated caller vs newer BMD
+	tassert.Errorf(t, !c._sync(1, 0), "expected outdated BMD")
+	tassert.Errorf(t, len(c.objs) == 2, "expected no purge")
+	tassert.Errorf(t, c._sync(1, 2), "expected in-sync")
+	tassert.Errorf(t, len(c.objs) == 0 && c.size == 0 && c.num.Load() == 0 && c.lru.Len() == 0, "expected empty cache")
+}
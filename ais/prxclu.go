@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
@@ -84,12 +85,20 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.xquery(w, r, what, query)
 	case apc.WhatAllRunningXacts:
 		p.xgetRunning(w, r, what, query)
+	case apc.WhatJobHistory:
+		p.xhistory(w, r, what)
+	case apc.WhatJobSched:
+		p.lsJobSched(w, r, what)
 	case apc.WhatNodeStats:
 		p.qcluStats(w, r, what, query)
 	case apc.WhatSysInfo:
 		p.qcluSysinfo(w, r, what, query)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
+	case apc.WhatHrwPreview:
+		p.qcluHrwPreview(w, r, what, query)
+	case apc.WhatTenants:
+		p.qcluTenants(w, r, what)
 	case apc.WhatBackends:
 		config := cmn.GCO.Get()
 		out := make([]string, 0, len(config.Backend.Providers))
@@ -320,6 +329,28 @@ func (p *proxy) qcluMountpaths(w http.ResponseWriter, r *http.Request, what stri
 	p.writeJSON(w, r, out, what)
 }
 
+// (walks all objects in the cluster - hence, long timeout)
+func (p *proxy) qcluHrwPreview(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query}
+	args.timeout = apc.LongTimeout
+	args.cresv = cresjGeneric[apc.HrwPreview]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	out := make(map[string]*apc.HrwPreview, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.errorf("%s failed to preview weighted HRW", res.si))
+			freeBcastRes(results)
+			return
+		}
+		out[res.si.ID()] = res.v.(*apc.HrwPreview)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, out, what)
+}
+
 // helper methods for querying targets
 
 func (p *proxy) _queryTs(w http.ResponseWriter, r *http.Request, query url.Values) (cos.JSONRawMsgs, bool) {
@@ -957,6 +988,15 @@ func (p *proxy) _joinedFinal(ctx *smapModifier, clone *smapX) {
 	if etlMD != nil && etlMD.version() > 0 {
 		pairs = append(pairs, revsPair{etlMD, actMsgExt})
 	}
+	if s3keys := p.s3keys.get(); s3keys.version() > 0 {
+		pairs = append(pairs, revsPair{s3keys, actMsgExt})
+	}
+	if certs := p.certs.get(); certs.version() > 0 {
+		pairs = append(pairs, revsPair{certs, actMsgExt})
+	}
+	if jsched := p.jsched.owner.get(); jsched.version() > 0 {
+		pairs = append(pairs, revsPair{jsched, actMsgExt})
+	}
 
 	reb := ctx.rmdCtx != nil && ctx.rmdCtx.rebID != ""
 	if !reb {
@@ -1022,7 +1062,7 @@ func (p *proxy) _syncFinal(ctx *smapModifier, clone *smapX) {
 // - cluster membership, including maintenance and decommission
 // - rebalance
 // - set-primary
-// +gen:endpoint PUT /v1/cluster[apc.QparamTransient=bool] action=[apc.ActSetConfig=cmn.ConfigToSet|apc.ActResetConfig=apc.ActMsg|apc.ActRotateLogs=apc.ActMsg|apc.ActShutdownCluster=apc.ActMsg|apc.ActDecommissionCluster=apc.ActValRmNode|apc.ActStartMaintenance=apc.ActValRmNode|apc.ActDecommissionNode=apc.ActValRmNode|apc.ActShutdownNode=apc.ActValRmNode|apc.ActRmNodeUnsafe=apc.ActValRmNode|apc.ActStopMaintenance=apc.ActMsg|apc.ActResetStats=apc.ActMsg|apc.ActClearLcache=apc.ActMsg|apc.ActXactStart=apc.ActMsg|apc.ActXactStop=apc.ActMsg|apc.ActReloadBackendCreds=apc.ActMsg|apc.ActBumpMetasync=apc.ActMsg]
+// +gen:endpoint PUT /v1/cluster[apc.QparamTransient=bool] action=[apc.ActSetConfig=cmn.ConfigToSet|apc.ActResetConfig=apc.ActMsg|apc.ActRotateLogs=apc.ActMsg|apc.ActShutdownCluster=apc.ActMsg|apc.ActDecommissionCluster=apc.ActValRmNode|apc.ActStartMaintenance=apc.ActValRmNode|apc.ActDecommissionNode=apc.ActValRmNode|apc.ActShutdownNode=apc.ActValRmNode|apc.ActRmNodeUnsafe=apc.ActValRmNode|apc.ActStopMaintenance=apc.ActMsg|apc.ActResetStats=apc.ActMsg|apc.ActClearLcache=apc.ActMsg|apc.ActXactStart=apc.ActMsg|apc.ActXactStop=apc.ActMsg|apc.ActXactPause=apc.ActMsg|apc.ActXactResume=apc.ActMsg|apc.ActReloadBackendCreds=apc.ActMsg|apc.ActBumpMetasync=apc.ActMsg]
 // +gen:payload apc.ActDecommissionCluster={"action": "decommission", "value": {"sid": "target_id", "skip_rebalance": false, "rm_user_data": true}}
 // +gen:payload apc.ActResetStats={"action": "reset-stats", "value": false}
 // Administrative cluster operations: configuration changes, node management, log rotation, shutdown/decommission operations.
@@ -1151,6 +1191,12 @@ func (p *proxy) cluputMsg(w http.ResponseWriter, r *http.Request) {
 		p.xstart(w, r, msg)
 	case apc.ActXactStop:
 		p.xstop(w, r, msg)
+	case apc.ActXactPause, apc.ActXactResume:
+		p.xpause(w, r, msg)
+	case apc.ActAddJobSched:
+		p.addJobSched(w, r, msg)
+	case apc.ActRmJobSched:
+		p.rmJobSched(w, r, msg)
 
 	case apc.ActReloadBackendCreds:
 		if msg.Name != "" {
@@ -1235,13 +1281,43 @@ func (p *proxy) setCluCfgPersistent(w http.ResponseWriter, r *http.Request, toUp
 		to, _ := jsoniter.Marshal(toUpdate.Tracing)
 		whingeToUpdate("config.tracing", string(from), string(to))
 	}
+	weighted := toUpdate.Features != nil && toUpdate.Features.IsSet(feat.WeightedHRW) != config.Features.IsSet(feat.WeightedHRW)
 
 	// do
 	if _, err := p.owner.config.modify(ctx); err != nil {
 		p.writeErr(w, r, err)
+		return
+	}
+	if weighted {
+		p.rebWeightedHRW(msg)
 	}
 }
 
+// toggled weighted HRW (feature flag 'Weighted-HRW'): object placement changes cluster-wide;
+// targets resilver upon receiving the updated config (see target.receiveConfig), while
+// primary starts global rebalance
+func (p *proxy) rebWeightedHRW(msg *apc.ActMsg) {
+	smap := p.owner.smap.get()
+	if smap.CountTargets() < 2 {
+		return
+	}
+	if err := p.canRebalance(); err != nil {
+		nlog.Errorln(p.String()+": toggled weighted HRW but cannot rebalance:", err, "- run global rebalance when possible")
+		return
+	}
+	rmdCtx := &rmdModifier{
+		pre:     rmdInc,
+		final:   rmdSync,
+		p:       p,
+		smapCtx: &smapModifier{smap: smap, msg: msg},
+	}
+	if _, err := p.owner.rmd.modify(rmdCtx); err != nil {
+		nlog.Errorln(p.String()+": toggled weighted HRW but failed to start rebalance:", err)
+		return
+	}
+	nlog.Infoln(p.String()+": toggled weighted HRW - started global rebalance", rmdCtx.rebID)
+}
+
 // switch http => https, or vice versa
 func switchHTTPS(toCfg *cmn.ProxyConfToSet, fromCfg *cmn.ProxyConf, use bool) {
 	toScheme, fromScheme := "http", "https"
@@ -1332,15 +1408,18 @@ func (p *proxy) _syncConfFinal(ctx *configModifier, clone *globalConfig) {
 	case clone.Auth.CSKEnabled():
 		var k *clusterKey
 		if ctx.oldConfig == nil || !ctx.oldConfig.Auth.CSKEnabled() {
-			k = p.owner.csk.gen(p.owner.smap.get().Version)
+			k = p.owner.csk.gen(p.owner.smap.get().Version, cskGrace(&clone.Auth))
 		} else {
 			k = p.owner.csk.load()
 		}
 		wg = p.metasyncer.sync(revsPair{clone, msg}, revsPair{k, msg})
 	case ctx.oldConfig != nil && ctx.oldConfig.Auth.CSKEnabled():
-		// clear locally; usage gated by Rom.CSKEnabled()
+		// sign the update with the current key and clear it upon delivery
+		// (the nodes do the same upon receiving - see _recvCfg);
+		// when (re)enabled, all nodes start over with a new key and enablement grace
+		wg = p.metasyncer.sync(revsPair{clone, msg})
+		wg.Wait()
 		p.owner.csk.reset()
-		fallthrough
 	default:
 		wg = p.metasyncer.sync(revsPair{clone, msg})
 	}
@@ -1364,6 +1443,21 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 		}
 	}
 	xargs.Kind, _ = xact.GetKindName(xargs.Kind) // display name => kind
+	if xargs.Prio == "" {
+		xargs.Prio = msg.Prio
+	}
+	if err := apc.ValidatePrio(xargs.Prio); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamDryRun)) {
+		if xargs.Kind != apc.ActLRU {
+			p.writeErr(w, r, cmn.NewErrUnsupp("dry-run", xargs.Kind))
+			return
+		}
+		p.dryRun(w, r, nil, xargs.Kind, &xargs)
+		return
+	}
 
 	// rebalance
 	if xargs.Kind == apc.ActRebalance {
@@ -1450,7 +1544,7 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 			srcs = meta.NodeMap{singleTarget.ID(): singleTarget}
 		}
 		nl := xact.NewXactNL(xargs.ID, xargs.Kind, &smap.Smap, srcs)
-		p.ic.registerEqual(regIC{smap: smap, nl: nl})
+		p.ic.registerEqual(regIC{smap: smap, nl: nl, user: msg.User})
 		writeXid(w, xargs.ID)
 	}
 }
@@ -1520,6 +1614,55 @@ func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	freeBcastRes(results)
 }
 
+// pause or resume: succeeds if at least one target has the matching xaction(s)
+func (p *proxy) xpause(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var xargs xact.ArgsMsg
+	if err := cos.MorphMarshal(msg.Value, &xargs); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if xargs.Kind != "" {
+		kind, dtor, err := xact.GetDescriptor(xargs.Kind) // display name => kind
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if !dtor.Pausable {
+			p.writeErr(w, r, cmn.NewErrUnsupp(msg.Action, "xaction kind '"+kind+"'"))
+			return
+		}
+		xargs.Kind = kind
+	}
+
+	body := cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs})
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: body}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var (
+		errNotFound error
+		found       bool
+	)
+	for _, res := range results {
+		if res.status == http.StatusNotFound {
+			errNotFound = res.toErr()
+			continue
+		}
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			freeBcastRes(results)
+			return
+		}
+		found = true
+	}
+	freeBcastRes(results)
+	if !found && errNotFound != nil {
+		p.writeErr(w, r, errNotFound, http.StatusNotFound)
+	}
+}
+
 func (p *proxy) _checkMaint(xargs *xact.ArgsMsg) error {
 	smap := p.owner.smap.get()
 	for _, tsi := range smap.Tmap {
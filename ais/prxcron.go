@@ -0,0 +1,555 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/cron"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// scheduled (recurring) jobs:
+// - job definitions (xact.CronJob) are stored in jobSchedMD - versioned, metasynced
+//   to all proxies (and persisted by each), and therefore surviving primary failover
+// - targets ignore jobSchedMD
+// - the primary evaluates all cron expressions once a minute (local time);
+//   a job that is due gets started via (loopback) API call, unless the previous
+//   run of the same kind on the same bucket is still running - in which
+//   case the run is skipped
+// - last run, last error, and number of skipped runs are kept in memory by the
+//   primary that triggered them
+// - the loopback call is intra-cluster (no access control); instead, each time a job
+//   fires, the primary re-checks the job owner's permissions (as per the owner's claims
+//   recorded when the job was added, the owner's session, and the current bucket ACL);
+//   a job without a recorded owner cannot run while authentication is enabled
+// - when the owner's session gets terminated (logout, user deletion), the primary
+//   drops the recorded owner for good (see revokeOwners)
+
+const (
+	jschedName  = "job-sched"
+	jschedDelay = time.Second // past the minute boundary
+)
+
+type (
+	jobSchedMD struct {
+		Jobs    map[string]*xact.CronJob  `json:"jobs"`
+		Owners  map[string]*tok.AISClaims `json:"owners,omitempty"` // by job name (see jobSched.authorize)
+		Version int64                     `json:"version,string"`
+	}
+	jobSchedOwner struct {
+		md    ratomic.Pointer[jobSchedMD]
+		fpath string
+		sync.Mutex
+	}
+
+	// primary-side runtime state
+	cronRun struct {
+		lastRun time.Time
+		lastID  string
+		lastErr string
+		skipped int64
+		running atomic.Bool // (loopback call in progress)
+	}
+	jobSched struct {
+		p     *proxy
+		owner jobSchedOwner
+		runs  map[string]*cronRun // by job name
+		last  time.Time           // last evaluated minute
+		mu    sync.Mutex
+	}
+)
+
+// interface guard
+var _ revs = (*jobSchedMD)(nil)
+
+////////////////
+// jobSchedMD //
+////////////////
+
+func newJobSchedMD() *jobSchedMD { return &jobSchedMD{Jobs: make(map[string]*xact.CronJob, 4)} }
+
+func (*jobSchedMD) JspOpts() jsp.Options { return jsp.CCSign(cmn.MetaverJobSched) }
+
+// as revs
+func (*jobSchedMD) tag() string       { return revsJobSchedTag }
+func (md *jobSchedMD) version() int64 { return md.Version }
+func (*jobSchedMD) uuid() string      { return "" }
+func (*jobSchedMD) jit(p *proxy) revs { return p.jsched.owner.get() }
+func (*jobSchedMD) sgl() *memsys.SGL  { return nil }
+
+func (md *jobSchedMD) marshal() []byte {
+	sgl := memsys.PageMM().NewSGL(memsys.PageSize)
+	err := jsp.Encode(sgl, md, md.JspOpts())
+	debug.AssertNoErr(err)
+	b := sgl.ReadAll()
+	sgl.Free()
+	return b
+}
+
+func (md *jobSchedMD) String() string {
+	if md == nil {
+		return "JobSched <nil>"
+	}
+	return "JobSched v" + strconv.FormatInt(md.Version, 10)
+}
+
+func (md *jobSchedMD) clone() *jobSchedMD {
+	dst := &jobSchedMD{Version: md.Version, Jobs: make(map[string]*xact.CronJob, len(md.Jobs))}
+	maps.Copy(dst.Jobs, md.Jobs)
+	if len(md.Owners) > 0 {
+		dst.Owners = maps.Clone(md.Owners)
+	}
+	return dst
+}
+
+///////////////////
+// jobSchedOwner //
+///////////////////
+
+func (o *jobSchedOwner) init(config *cmn.Config) {
+	o.fpath = filepath.Join(config.ConfigDir, fname.JobSched)
+	md := newJobSchedMD()
+	if _, err := jsp.LoadMeta(o.fpath, md); err != nil && !cos.IsNotExist(err) {
+		nlog.Errorf("failed to load %s from %s, err: %v", md, o.fpath, err)
+	}
+	o.put(md)
+}
+
+func (o *jobSchedOwner) get() *jobSchedMD   { return o.md.Load() }
+func (o *jobSchedOwner) put(md *jobSchedMD) { o.md.Store(md) }
+
+// write metasync-sent bytes directly (no json)
+func (o *jobSchedOwner) putPersist(md *jobSchedMD, payload msPayload) (err error) {
+	if b := payload[revsJobSchedTag]; b != nil {
+		var nomd *jobSchedMD
+		err = jsp.SaveMeta(o.fpath, nomd, cos.NewBuffer(b))
+	} else {
+		err = jsp.SaveMeta(o.fpath, md, nil)
+	}
+	if err == nil {
+		o.put(md)
+	}
+	return err
+}
+
+func (o *jobSchedOwner) modify(pre func(clone *jobSchedMD) error) (*jobSchedMD, error) {
+	o.Lock()
+	defer o.Unlock()
+	clone := o.get().clone()
+	if err := pre(clone); err != nil {
+		return nil, err
+	}
+	clone.Version++
+	if err := o.putPersist(clone, nil); err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
+
+//////////////
+// jobSched //
+//////////////
+
+// (owner is initialized prior to startup)
+func (s *jobSched) init(p *proxy) {
+	s.p = p
+	s.runs = make(map[string]*cronRun, 4)
+	hk.Reg(jschedName+hk.NameSuffix, s.housekeep, s.untilNext(time.Now()))
+}
+
+func (*jobSched) untilNext(now time.Time) time.Duration {
+	return now.Truncate(time.Minute).Add(time.Minute + jschedDelay).Sub(now)
+}
+
+func (s *jobSched) housekeep(int64) time.Duration {
+	var (
+		now  = time.Now()
+		smap = s.p.owner.smap.get()
+		md   = s.owner.get()
+	)
+	if !smap.isPrimary(s.p.si) || !s.p.ClusterStarted() || len(md.Jobs) == 0 {
+		return s.untilNext(now)
+	}
+	minute := now.Truncate(time.Minute)
+	if !minute.After(s.last) {
+		return s.untilNext(now)
+	}
+	s.last = minute
+	for _, job := range md.Jobs {
+		expr, err := cron.Parse(job.Cron)
+		if err != nil {
+			s.failed(job, err) // (received via metasync)
+			continue
+		}
+		if expr.Match(minute) {
+			s.trigger(job, md)
+		}
+	}
+	return s.untilNext(now)
+}
+
+func (s *jobSched) failed(job *xact.CronJob, err error) {
+	run := s.run(job.Name)
+	s.mu.Lock()
+	run.lastRun, run.lastErr = time.Now(), err.Error()
+	s.mu.Unlock()
+	nlog.Errorln(s.p.String(), "scheduled job", job.Name, "["+job.Cron+"]:", err)
+}
+
+func (s *jobSched) run(name string) *cronRun {
+	s.mu.Lock()
+	run, ok := s.runs[name]
+	if !ok {
+		run = &cronRun{}
+		s.runs[name] = run
+	}
+	s.mu.Unlock()
+	return run
+}
+
+func (s *jobSched) trigger(job *xact.CronJob, md *jobSchedMD) {
+	kind, bck, err := cronKind(job)
+	if err == nil {
+		err = s.authorize(job, md.Owners[job.Name])
+	}
+	if err != nil {
+		s.failed(job, err)
+		return
+	}
+	var (
+		run = s.run(job.Name)
+		flt = nlFilter{Kind: kind, OnlyRunning: apc.Ptr(true)}
+	)
+	if !bck.IsEmpty() {
+		flt.Bck = (*meta.Bck)(&bck)
+	}
+	if run.running.Load() || s.p.notifs.find(flt) != nil {
+		s.mu.Lock()
+		run.skipped++
+		s.mu.Unlock()
+		nlog.Warningln(s.p.String(), "scheduled job", job.Name, "["+job.Cron+"]: previous", kind, "is still running - skipping")
+		return
+	}
+	run.running.Store(true)
+	go s.start(job, run, kind)
+}
+
+// start via loopback API call, to go through the same validation
+// (and bucket initialization, transactions, IC registration, etc.) as user-initiated jobs
+func (s *jobSched) start(job *xact.CronJob, run *cronRun, kind string) {
+	var (
+		p     = s.p
+		smap  = p.owner.smap.get()
+		cargs = allocCargs()
+	)
+	cargs.si = p.si
+	cargs.timeout = apc.LongTimeout
+	if job.Msg.Action == apc.ActXactStart {
+		cargs.req = cmn.HreqArgs{Method: http.MethodPut, Base: p.si.ControlNet.URL, Path: apc.URLPathClu.S}
+	} else {
+		q := job.Bck.AddToQuery(nil)
+		if !job.BckTo.IsEmpty() {
+			q = job.BckTo.AddUnameToQuery(q, apc.QparamBckTo, "" /*objName*/)
+		}
+		cargs.req = cmn.HreqArgs{Method: http.MethodPost, Base: p.si.PubNet.URL, Path: apc.URLPathBuckets.Join(job.Bck.Name), Query: q}
+	}
+	cargs.req.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	cargs.req.Body = cos.MustMarshal(&job.Msg)
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+
+	s.mu.Lock()
+	run.lastRun = time.Now()
+	if res.err != nil {
+		run.lastErr = res.err.Error()
+		nlog.Errorln(p.String(), "scheduled job", job.Name, "failed to start:", res.err)
+	} else {
+		run.lastErr = ""
+		run.lastID = string(bytes.TrimSpace(res.bytes))
+		nlog.Infoln(p.String(), "scheduled job", job.Name, "started", xact.Cname(kind, run.lastID))
+	}
+	s.mu.Unlock()
+	freeCR(res)
+	run.running.Store(false)
+}
+
+// (job definitions arrive via metasync and are validated again)
+func cronKind(job *xact.CronJob) (string, cmn.Bck, error) {
+	kind, bck, err := job.Validate()
+	if err != nil {
+		return "", bck, fmt.Errorf("invalid scheduled job %q: %v", job.Name, err)
+	}
+	return kind, bck, nil
+}
+
+// re-check the owner's permissions to perform the scheduled action - the same
+// permissions that the respective user-initiated API call requires
+func (s *jobSched) authorize(job *xact.CronJob, owner *tok.AISClaims) error {
+	var (
+		p    = s.p
+		auth = cmn.Rom.AuthEnabled()
+	)
+	if auth {
+		switch {
+		case owner == nil:
+			return fmt.Errorf("scheduled job %q has no valid owner (added with authentication disabled, or owner's session terminated) - re-add the job",
+				job.Name)
+		case owner.SessionID != "" && p.authn.revokedTokens.containsSession(owner.SessionID):
+			return fmt.Errorf("scheduled job %q: session of the owner %q has been terminated", job.Name, job.User)
+		}
+	}
+	if job.Msg.Action == apc.ActXactStart {
+		if !auth {
+			return nil
+		}
+		return p.checkClaimPermissions(owner, nil, apc.AceAdmin) // (cluster-level action)
+	}
+	var (
+		ace = xact.Table[job.Msg.Action].Access
+		bck = meta.CloneBck(&job.Bck)
+	)
+	if err := bck.Init(p.owner.bmd); err != nil {
+		if !cmn.IsErrRemoteBckNotFound(err) {
+			return err
+		}
+		// remote bucket not in BMD yet (the loopback call adds it): no bucket ACL to check
+		if auth {
+			if err := p.checkClaimPermissions(owner, bck.Bucket(), ace); err != nil {
+				return err
+			}
+		}
+	} else if err := s.allow(owner, bck, ace); err != nil {
+		return err
+	}
+	if job.BckTo.IsEmpty() {
+		return nil
+	}
+	if bckTo := meta.CloneBck(&job.BckTo); bckTo.Init(p.owner.bmd) == nil {
+		return s.allow(owner, bckTo, apc.AccessRW)
+	}
+	if auth {
+		return p.checkClaimPermissions(owner, nil, apc.AceCreateBucket)
+	}
+	return nil
+}
+
+// primary: jobs added within a terminated session lose their authority for good
+// (terminated sessions are otherwise remembered only until the respective tokens expire)
+func (s *jobSched) revokeOwners(sessions map[string]int64) {
+	var names []string
+	for name, owner := range s.owner.get().Owners {
+		if _, ok := sessions[owner.SessionID]; ok && owner.SessionID != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	p := s.p
+	md, err := s.owner.modify(func(clone *jobSchedMD) error {
+		for _, name := range names {
+			delete(clone.Owners, name)
+		}
+		return nil
+	})
+	if err != nil {
+		nlog.Errorln(p.String(), "failed to revoke owners of scheduled jobs", names, "err:", err)
+		return
+	}
+	nlog.Warningln(p.String(), "owner's session terminated - scheduled jobs", names, "won't run until re-added,", md.String())
+	_ = p.metasyncer.sync(revsPair{md, p.newAmsgStr("revoke-job-owners", nil)})
+}
+
+func (s *jobSched) allow(owner *tok.AISClaims, bck *meta.Bck, ace apc.AccessAttrs) error {
+	if owner == nil {
+		return allowNoAuth(bck, "", ace) // (authentication disabled)
+	}
+	return s.p.checkBucketAccess(owner, bck, "", ace)
+}
+
+func (s *jobSched) status() []*xact.CronJobStatus {
+	var (
+		now = time.Now()
+		md  = s.owner.get()
+		out = make([]*xact.CronJobStatus, 0, len(md.Jobs))
+	)
+	s.mu.Lock()
+	for name, job := range md.Jobs {
+		st := &xact.CronJobStatus{CronJob: *job}
+		if expr, err := cron.Parse(job.Cron); err == nil {
+			st.NextRun = expr.Next(now)
+		}
+		if run, ok := s.runs[name]; ok {
+			st.LastRun, st.LastID, st.LastErr, st.Skipped = run.lastRun, run.lastID, run.lastErr, run.skipped
+		}
+		out = append(out, st)
+	}
+	s.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+//
+// API: add, remove, list
+//
+
+// +gen:payload apc.ActAddJobSched={"action": "add-job-schedule", "value": {"name": "nightly-lru", "cron": "@daily", "msg": {"action": "start", "value": {"kind": "lru"}}}}
+func (p *proxy) addJobSched(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	job := &xact.CronJob{}
+	if err := cos.MorphMarshal(msg.Value, job); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if job.Name == "" {
+		job.Name = msg.Name
+	}
+	if _, _, err := job.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	job.Added = time.Now()
+	job.User = msg.User
+	// the caller's claims - to re-check permissions each time the job fires
+	owner, _, err := p.caller(r.Context(), r.Header, nil)
+	if err != nil {
+		p.writeErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	if owner != nil {
+		owner = &tok.AISClaims{
+			UserID:      owner.UserID,
+			ClusterACLs: owner.ClusterACLs,
+			BucketACLs:  owner.BucketACLs,
+			Namespace:   owner.Namespace,
+			Roles:       owner.Roles,
+			SessionID:   owner.SessionID,
+			Scope:       owner.Scope,
+			IsAdmin:     owner.IsAdmin,
+		}
+		owner.Subject = claimsUser(owner)
+	}
+	md, err := p.jsched.owner.modify(func(clone *jobSchedMD) error {
+		if _, ok := clone.Jobs[job.Name]; ok {
+			return cmn.NewErrFailedTo(p, "add", "scheduled job "+strconv.Quote(job.Name), errors.New("already exists"))
+		}
+		clone.Jobs[job.Name] = job
+		if owner != nil {
+			if clone.Owners == nil {
+				clone.Owners = make(map[string]*tok.AISClaims, 4)
+			}
+			clone.Owners[job.Name] = owner
+		}
+		return nil
+	})
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusConflict)
+		return
+	}
+	nlog.Infoln(p.String(), "added scheduled job", job.Name, "["+job.Cron+"]", job.Msg.Action, md.String())
+	wg := p.metasyncer.sync(revsPair{md, p.newAmsg(msg, nil)})
+	wg.Wait()
+}
+
+func (p *proxy) rmJobSched(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	name := msg.Name
+	md, err := p.jsched.owner.modify(func(clone *jobSchedMD) error {
+		if _, ok := clone.Jobs[name]; !ok {
+			return cos.NewErrNotFound(p, "scheduled job "+strconv.Quote(name))
+		}
+		delete(clone.Jobs, name)
+		delete(clone.Owners, name)
+		return nil
+	})
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	p.jsched.mu.Lock()
+	delete(p.jsched.runs, name)
+	p.jsched.mu.Unlock()
+
+	nlog.Infoln(p.String(), "removed scheduled job", name, md.String())
+	wg := p.metasyncer.sync(revsPair{md, p.newAmsg(msg, nil)})
+	wg.Wait()
+}
+
+// GET /v1/cluster?what=job_sched
+func (p *proxy) lsJobSched(w http.ResponseWriter, r *http.Request, what string) {
+	// runtime state is the primary's
+	if p.forwardCP(w, r, nil, what) {
+		return
+	}
+	p.writeJSON(w, r, p.jsched.status(), what)
+}
+
+//
+// metasync Rx
+//
+
+func (p *proxy) extractJobSched(payload msPayload, sender string) (*jobSchedMD, *actMsgExt, error) {
+	b, ok := payload[revsJobSchedTag]
+	if !ok {
+		return nil, nil, nil
+	}
+	var (
+		newMD = newJobSchedMD()
+		msg   = &actMsgExt{}
+	)
+	if _, err := jsp.Decode(bytes.NewBuffer(b), newMD, newMD.JspOpts(), "extractJobSched"); err != nil {
+		return nil, nil, fmt.Errorf(cmn.FmtErrUnmarshal, p, "new "+revsJobSchedTag, cos.BHead(b), err)
+	}
+	if msgValue, ok := payload[revsJobSchedTag+revsActionTag]; ok {
+		if err := jsoniter.Unmarshal(msgValue, msg); err != nil {
+			return newMD, nil, fmt.Errorf(cmn.FmtErrUnmarshal, p, "action message", cos.BHead(msgValue), err)
+		}
+	}
+	md := p.jsched.owner.get()
+	if cmn.Rom.V(4, cos.ModAIS) {
+		logmsync(md.Version, newMD, msg, sender)
+	}
+	return newMD, msg, nil
+}
+
+func (p *proxy) receiveJobSched(newMD *jobSchedMD, msg *actMsgExt, payload msPayload, sender string) (err error) {
+	owner := &p.jsched.owner
+	owner.Lock()
+	md := owner.get()
+	if newMD.version() <= md.version() && msg.Action != apc.ActPrimaryForce {
+		owner.Unlock()
+		if newMD.version() < md.version() {
+			err = newErrDowngrade(p.si, md.String(), newMD.String())
+		}
+		return err
+	}
+	logmsync(md.Version, newMD, msg, sender)
+	err = owner.putPersist(newMD, payload)
+	owner.Unlock()
+	return err
+}
@@ -0,0 +1,77 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+func TestCronAuthorize(t *testing.T) {
+	p := newSecondary("p1")
+	smap := newSmap()
+	smap.UUID = "clu1"
+	p.owner.smap.put(smap)
+	p.authn = &authManager{revokedTokens: newRevokedTokensMap()}
+
+	var (
+		bck   = meta.NewBck("abc", apc.AIS, cmn.NsGlobal)
+		s     = &jobSched{p: p}
+		lru   = &xact.CronJob{Name: "lru", Msg: apc.ActMsg{Action: apc.ActXactStart}}
+		evict = &xact.CronJob{Name: "evict", Bck: *bck.Bucket(), Msg: apc.ActMsg{Action: apc.ActEvictObjects}}
+		admin = &tok.AISClaims{UserID: "admin", IsAdmin: true}
+		user  = &tok.AISClaims{
+			UserID:     "u1",
+			SessionID:  "s1",
+			BucketACLs: []*authn.BckACL{{Bck: cmn.Bck{Name: "abc", Provider: apc.AIS, Ns: cmn.Ns{UUID: "clu1"}}, Access: apc.AccessRO}},
+		}
+	)
+	setBckAccess := func(access apc.AccessAttrs) {
+		bmd := newBucketMD()
+		bmd.add(meta.CloneBck(bck.Bucket()), &cmn.Bprops{Access: access})
+		p.owner.bmd.put(bmd)
+	}
+	setBckAccess(apc.AccessAll)
+
+	// authentication disabled: bucket ACL only
+	tassert.CheckError(t, s.authorize(lru, nil))
+	tassert.CheckError(t, s.authorize(evict, nil))
+
+	setAuth := func(enabled bool) {
+		config := cmn.GCO.BeginUpdate()
+		config.Auth.Enabled = enabled
+		cmn.GCO.CommitUpdate(config)
+		cmn.Rom.Set(&config.ClusterConfig)
+	}
+	setAuth(true)
+	defer setAuth(false)
+
+	tassert.Errorf(t, s.authorize(evict, nil) != nil, "expected job without owner to fail")
+	tassert.CheckError(t, s.authorize(lru, admin))
+	tassert.CheckError(t, s.authorize(evict, admin))
+	tassert.Errorf(t, s.authorize(lru, user) != nil, "expected non-admin owner to fail starting xaction")
+	tassert.Errorf(t, s.authorize(evict, user) != nil, "expected read-only owner to fail evicting")
+
+	user.BucketACLs[0].Access = apc.AccessRW
+	tassert.CheckError(t, s.authorize(evict, user))
+
+	// bucket ACL changed since the job was added
+	setBckAccess(apc.AccessRO)
+	tassert.Errorf(t, s.authorize(evict, user) != nil, "expected bucket ACL to deny evicting")
+	setBckAccess(apc.AccessAll)
+
+	// owner's session terminated
+	err := p.authn.revokedTokens.update(&tokenList{Sessions: map[string]int64{"s1": time.Now().Add(time.Hour).Unix()}})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, s.authorize(evict, user) != nil, "expected terminated session to fail")
+}
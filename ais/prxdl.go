@@ -128,7 +128,7 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 		progressInterval,
 	)
 	nl.SetOwner(equalIC)
-	p.ic.registerEqual(regIC{nl: nl, smap: smap})
+	p.ic.registerEqual(regIC{nl: nl, smap: smap, user: p.auditUser(r)})
 
 	b := cos.MustMarshal(dload.DlPostResp{ID: jobID})
 	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
@@ -0,0 +1,209 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/xact"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// job history:
+// - IC proxies record each finished job (notification listener) in the local kvdb,
+//   so that the history survives restarts and outlives in-memory `notifs.fin`
+// - keys are ordered by the job's end time; the oldest entries are pruned
+//   once the total exceeds histMaxEntries
+// - non-IC proxies redirect history queries to IC
+
+const (
+	histCollection = "jobs"
+	histMaxEntries = 10000
+	histPruneBatch = histMaxEntries / 10
+)
+
+type jobHist struct {
+	db  kvdb.Driver
+	cnt atomic.Int64
+	mu  sync.Mutex // prune
+}
+
+func (h *jobHist) init(config *cmn.Config) {
+	db, err := kvdb.NewBuntDB(filepath.Join(config.ConfigDir, dbName))
+	if err != nil {
+		nlog.Errorln("failed to open job history db:", err)
+		return
+	}
+	keys, _, err := db.List(histCollection, "")
+	if err != nil {
+		nlog.Errorln("failed to load job history:", err)
+	}
+	h.cnt.Store(int64(len(keys)))
+	h.db = db
+}
+
+func (h *jobHist) close() {
+	if h.db != nil {
+		h.db.Close()
+	}
+}
+
+func histKey(e *xact.HistEntry) string {
+	return fmt.Sprintf("%019d-%s", e.EndTime.UnixNano(), e.ID)
+}
+
+// called upon (all notifiers) finished or aborted
+func (h *jobHist) add(nl nl.Listener) {
+	if h.db == nil || nl.Kind() == apc.ActList {
+		return
+	}
+	e := newHistEntry(nl)
+	if _, err := h.db.Set(histCollection, histKey(e), e); err != nil {
+		nlog.Errorln("failed to record", nl.String(), "in job history:", err)
+		return
+	}
+	if h.cnt.Inc() > histMaxEntries+histPruneBatch {
+		h.prune()
+	}
+}
+
+func newHistEntry(nl nl.Listener) *xact.HistEntry {
+	nl.RLock()
+	e := &xact.HistEntry{
+		ID:      nl.UUID(),
+		Kind:    nl.Kind(),
+		User:    nl.User(),
+		Aborted: nl.IsAborted(),
+		EndTime: time.Unix(0, nl.EndTime()),
+	}
+	for _, bck := range nl.Bcks() {
+		e.Buckets = append(e.Buckets, *bck)
+	}
+	if err := nl.Err(); err != nil {
+		e.Err = err.Error()
+	}
+	nl.NodeStats().Range(func(_ string, stats any) bool {
+		snap, ok := stats.(*core.Snap)
+		if !ok {
+			return true
+		}
+		e.Nodes++
+		if e.StartTime.IsZero() || (!snap.StartTime.IsZero() && snap.StartTime.Before(e.StartTime)) {
+			e.StartTime = snap.StartTime
+		}
+		e.CtlMsg = cos.Left(e.CtlMsg, snap.CtlMsg)
+		e.Stats.Objs += snap.Stats.Objs
+		e.Stats.Bytes += snap.Stats.Bytes
+		e.Stats.OutObjs += snap.Stats.OutObjs
+		e.Stats.OutBytes += snap.Stats.OutBytes
+		e.Stats.InObjs += snap.Stats.InObjs
+		e.Stats.InBytes += snap.Stats.InBytes
+		return true
+	})
+	if e.StartTime.IsZero() {
+		e.StartTime = time.Now().Add(-mono.Since(nl.AddedTime())) // (approx.)
+	}
+	nl.RUnlock()
+	return e
+}
+
+func (h *jobHist) prune() {
+	if !h.mu.TryLock() {
+		return
+	}
+	defer h.mu.Unlock()
+	keys, _, err := h.db.List(histCollection, "")
+	if err != nil {
+		nlog.Errorln("failed to list job history:", err)
+		return
+	}
+	n := len(keys) - histMaxEntries
+	for i := range max(n, 0) {
+		if _, err := h.db.Delete(histCollection, keys[i]); err != nil {
+			nlog.Errorln("failed to prune job history:", err)
+			break
+		}
+	}
+	h.cnt.Store(int64(min(len(keys), histMaxEntries)))
+}
+
+// most recent matching entries, oldest first
+func (h *jobHist) query(q *xact.HistQueryMsg) ([]*xact.HistEntry, error) {
+	if h.db == nil {
+		return nil, nil
+	}
+	keys, _, err := h.db.List(histCollection, "")
+	if err != nil {
+		return nil, err
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = xact.DfltHistLimit
+	}
+	out := make([]*xact.HistEntry, 0, min(limit, len(keys)))
+	for i := len(keys) - 1; i >= 0 && len(out) < limit; i-- {
+		s, _, err := h.db.GetString(histCollection, keys[i])
+		if err != nil {
+			continue // (pruned in the meantime)
+		}
+		e := &xact.HistEntry{}
+		if err := jsoniter.UnmarshalFromString(s, e); err != nil {
+			nlog.Errorln("failed to unmarshal job history entry", keys[i], "err:", err)
+			continue
+		}
+		if !q.Since.IsZero() && e.EndTime.Before(q.Since) {
+			break // (ordered by end time)
+		}
+		if e.Match(q) {
+			out = append(out, e)
+		}
+	}
+	// reverse
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out, nil
+}
+
+// GET /v1/cluster?what=job_history
+func (p *proxy) xhistory(w http.ResponseWriter, r *http.Request, what string) {
+	if p.ic.redirectToIC(w, r) {
+		return
+	}
+	q := &xact.HistQueryMsg{}
+	if err := cmn.ReadJSON(w, r, q); err != nil {
+		return
+	}
+	q.Kind, _ = xact.GetKindName(q.Kind) // display name => kind
+	entries, err := p.jhist.query(q)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	p.writeJSON(w, r, entries, what)
+}
+
+// shadows htrun.readActionMsg to remember the (authenticated) initiator (see regIC.user)
+func (p *proxy) readActionMsg(w http.ResponseWriter, r *http.Request) (*apc.ActMsg, error) {
+	msg, err := p.htrun.readActionMsg(w, r)
+	if err == nil {
+		msg.User = p.auditUser(r)
+	}
+	return msg, err
+}
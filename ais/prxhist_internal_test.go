@@ -0,0 +1,76 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+func TestJobHist(t *testing.T) {
+	var (
+		h      jobHist
+		config = &cmn.Config{}
+		bck    = &cmn.Bck{Name: "abc", Provider: apc.AIS}
+		tsi    = &meta.Snode{DaeID: "t1"}
+		smap   = &meta.Smap{Tmap: meta.NodeMap{tsi.ID(): tsi}}
+		start  = time.Now()
+	)
+	config.ConfigDir = t.TempDir()
+	h.init(config)
+	tassert.Fatalf(t, h.db != nil, "failed to open job history db")
+
+	for i := range 5 {
+		kind := apc.ActCopyBck
+		if i%2 == 1 {
+			kind = apc.ActPrefetchObjects
+		}
+		nl := xact.NewXactNL("x"+strconv.Itoa(i), kind, smap, nil, bck)
+		nl.SetUser("u" + strconv.Itoa(i%2))
+		nl.SetStats(tsi.ID(), &core.Snap{StartTime: start, Stats: core.Stats{Objs: 10, Bytes: 1000}})
+		nl.Callback(nl, start.Add(time.Duration(i+1)*time.Second).UnixNano())
+		h.add(nl)
+	}
+	h.close()
+
+	// restart
+	h = jobHist{}
+	h.init(config)
+	defer h.close()
+	tassert.Fatalf(t, h.cnt.Load() == 5, "expected 5 entries after restart, got %d", h.cnt.Load())
+
+	all, err := h.query(&xact.HistQueryMsg{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(all) == 5, "expected 5 entries, got %d", len(all))
+	for i, e := range all {
+		tassert.Errorf(t, e.ID == "x"+strconv.Itoa(i), "entry %d: expected oldest first, got %s", i, e.ID)
+		tassert.Errorf(t, e.Stats.Objs == 10 && e.Nodes == 1, "entry %d: unexpected stats %+v", i, e.Stats)
+	}
+
+	// filters
+	lst, err := h.query(&xact.HistQueryMsg{Kind: apc.ActPrefetchObjects, User: "u1"})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst) == 2, "expected 2 prefetch entries, got %d", len(lst))
+
+	lst, err = h.query(&xact.HistQueryMsg{Since: start.Add(4 * time.Second)})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst) == 2, "expected 2 most recent entries, got %d", len(lst))
+
+	lst, err = h.query(&xact.HistQueryMsg{Limit: 1})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst) == 1 && lst[0].ID == "x4", "expected the most recent entry, got %+v", lst)
+
+	lst, err = h.query(&xact.HistQueryMsg{Bck: cmn.Bck{Name: "xyz", Provider: apc.AIS}})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst) == 0, "expected no entries for another bucket, got %d", len(lst))
+}
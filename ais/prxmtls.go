@@ -0,0 +1,349 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/memsys"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Client certificate (mTLS) identities (see api/authn.CertIdentity):
+// - with auth.mtls enabled, proxies request client certificates and verify them against
+//   the configured CA bundle (auth.mtls.ca_file) - independently of net.http.client_auth_tls
+//   that only governs the TLS handshake
+// - certificate's SAN URIs, DNS names, emails, and subject CN (in that order) are looked up
+//   in the list of identities that AuthN pushes to registered clusters - separately from (but
+//   in the same way as) S3 access keys (see prxs3keys.go)
+// - the first match provides the caller's claims; from there on, permission checks are
+//   identical to token-based access
+// - bearer token, if present, takes precedence
+
+const (
+	mtlsRecheckCA = time.Minute // (re)load the CA bundle if modified
+	mtlsMaxCached = 4096        // verified leaf certificates
+)
+
+type (
+	mtlsLeaf struct {
+		notAfter time.Time
+		ids      []string
+	}
+	mtlsIDs struct {
+		pool    *x509.CertPool
+		cache   map[[sha256.Size]byte]*mtlsLeaf
+		fpath   string
+		mtime   time.Time
+		checked int64 // mono.NanoTime
+		mu      sync.Mutex
+	}
+	certList struct {
+		byID map[string]*authn.CertIdentity // by client certificate identity
+		authn.CertList
+	}
+	certs struct {
+		list  ratomic.Pointer[certList]
+		fpath string
+		seal  []byte // node-local sealing key (cmn.SealKeyPath)
+		sync.Mutex
+	}
+)
+
+// interface guard
+var _ revs = (*certList)(nil)
+
+// ConnContext callback: make TLS connection state available to request handlers
+func tlsConnContext(ctx context.Context, c net.Conn) context.Context {
+	if tc, ok := c.(*tls.Conn); ok {
+		ctx = context.WithValue(ctx, cos.CtxClientConn, tc)
+	}
+	return ctx
+}
+
+// returns the claims of the client certificate's identity, or nil if there's none
+// (no certificate, no mapped identity, or mTLS disabled)
+func (p *proxy) certCaller(ctx context.Context) (*tok.AISClaims, error) {
+	tc, ok := ctx.Value(cos.CtxClientConn).(*tls.Conn)
+	if !ok {
+		return nil, nil
+	}
+	config := cmn.GCO.Get()
+	if !config.Auth.MTLSEnabled() {
+		return nil, nil
+	}
+	cs := tc.ConnectionState()
+	if len(cs.PeerCertificates) == 0 {
+		return nil, nil
+	}
+	ids, err := p.mtls.verify(cs.PeerCertificates, config.Auth.MTLS.CAFile, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: client certificate %q: %v", tok.ErrInvalidToken, cs.PeerCertificates[0].Subject, err)
+	}
+	ci := p.certs.get().getCert(ids)
+	if ci == nil {
+		if cmn.Rom.V(4, cos.ModAIS) {
+			nlog.Infoln(p.String()+": no mapping for client certificate identities", ids)
+		}
+		return nil, nil
+	}
+	claims, err := p.authn.validateToken(ctx, ci.Token)
+	if err != nil {
+		nlog.Warningln("client certificate identity", ci.String(), "validation failed:", err)
+	}
+	return claims, err
+}
+
+/////////////
+// mtlsIDs //
+/////////////
+
+// verify the chain (leaf first) and return the leaf certificate's identities;
+// verified leaves are cached until they expire or the CA bundle changes
+func (v *mtlsIDs) verify(chain []*x509.Certificate, caFile string, now time.Time) ([]string, error) {
+	var (
+		leaf   = chain[0]
+		digest = sha256.Sum256(leaf.Raw)
+	)
+	v.mu.Lock()
+	if err := v.loadCA(caFile); err != nil {
+		v.mu.Unlock()
+		return nil, err
+	}
+	pool := v.pool
+	if e, ok := v.cache[digest]; ok && now.Before(e.notAfter) {
+		v.mu.Unlock()
+		return e.ids, nil
+	}
+	v.mu.Unlock()
+
+	opts := x509.VerifyOptions{
+		Roots:       pool,
+		CurrentTime: now,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if len(chain) > 1 {
+		opts.Intermediates = x509.NewCertPool()
+		for _, cert := range chain[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+	}
+	if _, err := leaf.Verify(opts); err != nil {
+		return nil, err
+	}
+	ids := certIdentities(leaf)
+
+	v.mu.Lock()
+	if v.pool == pool {
+		if len(v.cache) >= mtlsMaxCached {
+			clear(v.cache)
+		}
+		v.cache[digest] = &mtlsLeaf{notAfter: leaf.NotAfter, ids: ids}
+	}
+	v.mu.Unlock()
+	return ids, nil
+}
+
+// (under lock)
+func (v *mtlsIDs) loadCA(fpath string) error {
+	now := mono.NanoTime()
+	if v.pool != nil && v.fpath == fpath && time.Duration(now-v.checked) < mtlsRecheckCA {
+		return nil
+	}
+	finfo, err := os.Stat(fpath)
+	if err != nil {
+		return fmt.Errorf("auth.mtls.ca_file: %w", err)
+	}
+	v.checked = now
+	if v.pool != nil && v.fpath == fpath && finfo.ModTime().Equal(v.mtime) {
+		return nil
+	}
+	pem, err := os.ReadFile(fpath)
+	if err != nil {
+		return fmt.Errorf("auth.mtls.ca_file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return fmt.Errorf("auth.mtls.ca_file: failed to append CA certs from PEM %q", fpath)
+	}
+	if v.pool != nil {
+		nlog.Infoln("reloaded client certificate CA bundle", fpath)
+	}
+	v.pool, v.fpath, v.mtime = pool, fpath, finfo.ModTime()
+	v.cache = make(map[[sha256.Size]byte]*mtlsLeaf, 16)
+	return nil
+}
+
+// in the order of precedence (see authn.CertID* constants)
+func certIdentities(cert *x509.Certificate) []string {
+	ids := make([]string, 0, len(cert.URIs)+len(cert.DNSNames)+len(cert.EmailAddresses)+1)
+	for _, u := range cert.URIs {
+		ids = append(ids, authn.CertIDURI+u.String())
+	}
+	for _, name := range cert.DNSNames {
+		ids = append(ids, authn.CertIDDNS+name)
+	}
+	for _, email := range cert.EmailAddresses {
+		ids = append(ids, authn.CertIDEmail+email)
+	}
+	if cn := cert.Subject.CommonName; cn != "" {
+		ids = append(ids, authn.CertIDCommon+cn)
+	}
+	return ids
+}
+
+//////////////
+// certList //
+//////////////
+
+// as revs
+func (*certList) tag() string       { return revsCertsTag }
+func (l *certList) version() int64  { return l.Version }
+func (*certList) uuid() string      { return "" }
+func (l *certList) marshal() []byte { return cos.MustMarshal(l) }
+func (*certList) jit(p *proxy) revs { return p.certs.get() }
+func (*certList) sgl() *memsys.SGL  { return nil }
+func (l *certList) String() string  { return "Certs v" + strconv.FormatInt(l.Version, 10) }
+
+// the first of the (verified) client certificate's identities that is mapped to a user or roles
+func (l *certList) getCert(ids []string) *authn.CertIdentity {
+	for _, id := range ids {
+		if ci, ok := l.byID[id]; ok {
+			return ci
+		}
+	}
+	return nil
+}
+
+///////////
+// certs //
+///////////
+
+func (o *certs) init(config *cmn.Config) {
+	var (
+		err  error
+		list = &certList{}
+	)
+	defer o.put(list)
+	if o.seal, err = cos.LoadSealKey(cmn.SealKeyPath(config.ConfigDir)); err != nil {
+		nlog.Errorf("failed to load sealing key - won't persist %s: %v", list, err)
+		return
+	}
+	o.fpath = filepath.Join(config.ConfigDir, fname.Certs)
+	ver, err := loadSealed(o.fpath, o.seal, &list.CertList)
+	switch {
+	case err == nil:
+	case cos.IsNotExist(err):
+	default:
+		nlog.Errorf("invalid Certs v%d in %s (err %v) - ignoring", ver, o.fpath, err)
+		list.CertList = authn.CertList{}
+	}
+}
+
+func (o *certs) get() *certList { return o.list.Load() }
+
+func (o *certs) put(list *certList) {
+	list.byID = make(map[string]*authn.CertIdentity, len(list.Certs))
+	for _, ci := range list.Certs {
+		list.byID[ci.Identity] = ci
+	}
+	o.list.Store(list)
+}
+
+// (caller must hold the lock)
+func (o *certs) putPersist(list *certList) error {
+	if o.fpath != "" {
+		if err := saveSealed(o.fpath, o.seal, list.marshal(), list.Version); err != nil {
+			return err
+		}
+	}
+	o.put(list)
+	return nil
+}
+
+// PUT /v1/tokens/certs (see putAuthnList)
+func (p *proxy) putCerts(w http.ResponseWriter, r *http.Request) {
+	newList := &certList{}
+	if err := cmn.ReadJSON(w, r, &newList.CertList); err != nil {
+		return
+	}
+
+	o := &p.certs
+	o.Lock()
+	if list := o.get(); newList.Version <= list.Version {
+		o.Unlock()
+		nlog.Warningln(p.String()+": ignoring stale", newList.String(), "(have", list.String()+")")
+		return
+	}
+	err := o.putPersist(newList)
+	o.Unlock()
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusInternalServerError)
+		return
+	}
+	nlog.Infoln(p.String()+":", newList.String(), "num identities:", len(newList.Certs))
+	_ = p.metasyncer.sync(revsPair{newList, p.newAmsgStr("update client certificate identities", nil)})
+}
+
+func (p *proxy) extractCerts(payload msPayload, sender string) (*certList, *actMsgExt, error) {
+	b, ok := payload[revsCertsTag]
+	if !ok {
+		return nil, nil, nil
+	}
+	var (
+		newList = &certList{}
+		msg     = &actMsgExt{}
+	)
+	if err := jsoniter.Unmarshal(b, &newList.CertList); err != nil {
+		return nil, nil, fmt.Errorf(cmn.FmtErrUnmarshal, p, "new "+revsCertsTag, cos.BHead(b), err)
+	}
+	if msgValue, ok := payload[revsCertsTag+revsActionTag]; ok {
+		if err := jsoniter.Unmarshal(msgValue, msg); err != nil {
+			return newList, nil, fmt.Errorf(cmn.FmtErrUnmarshal, p, "action message", cos.BHead(msgValue), err)
+		}
+	}
+	if cmn.Rom.V(4, cos.ModAIS) {
+		logmsync(p.certs.get().Version, newList, msg, sender)
+	}
+	return newList, msg, nil
+}
+
+func (p *proxy) receiveCerts(newList *certList, msg *actMsgExt, sender string) (err error) {
+	o := &p.certs
+	o.Lock()
+	list := o.get()
+	if newList.version() <= list.version() && msg.Action != apc.ActPrimaryForce {
+		o.Unlock()
+		if newList.version() < list.version() {
+			err = newErrDowngrade(p.si, list.String(), newList.String())
+		}
+		return err
+	}
+	logmsync(list.Version, newList, msg, sender)
+	err = o.putPersist(newList)
+	o.Unlock()
+	return err
+}
@@ -0,0 +1,152 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, cn string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	tassert.CheckFatal(t, err)
+	cert, err := x509.ParseCertificate(der)
+	tassert.CheckFatal(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) save(t *testing.T, fpath string) {
+	b := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw})
+	tassert.CheckFatal(t, os.WriteFile(fpath, b, 0o600))
+}
+
+func (ca *testCA) issue(t *testing.T, tmpl *x509.Certificate) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	tmpl.NotBefore = time.Now().Add(-time.Minute)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	tassert.CheckFatal(t, err)
+	cert, err := x509.ParseCertificate(der)
+	tassert.CheckFatal(t, err)
+	return cert
+}
+
+func TestMTLS_Identities(t *testing.T) {
+	var (
+		ca   = newTestCA(t, "test-ca")
+		u, _ = url.Parse("spiffe://example.org/ns/ml/sa/trainer")
+		leaf = ca.issue(t, &x509.Certificate{
+			Subject:        pkix.Name{CommonName: "trainer"},
+			DNSNames:       []string{"trainer.example.org"},
+			EmailAddresses: []string{"trainer@example.org"},
+			URIs:           []*url.URL{u},
+			ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		expected = []string{
+			authn.CertIDURI + "spiffe://example.org/ns/ml/sa/trainer",
+			authn.CertIDDNS + "trainer.example.org",
+			authn.CertIDEmail + "trainer@example.org",
+			authn.CertIDCommon + "trainer",
+		}
+	)
+	ids := certIdentities(leaf)
+	tassert.Fatalf(t, slices.Equal(ids, expected), "expected %v, got %v", expected, ids)
+
+	// the first mapped identity wins
+	list := &certList{}
+	list.Certs = []*authn.CertIdentity{
+		{Identity: authn.CertIDCommon + "trainer", UserID: "alice"},
+		{Identity: authn.CertIDDNS + "trainer.example.org", UserID: "bob"},
+	}
+	(&certs{}).put(list)
+	ci := list.getCert(ids)
+	tassert.Fatalf(t, ci != nil && ci.UserID == "bob", "expected dns identity mapped to bob, got %+v", ci)
+	ci = list.getCert([]string{authn.CertIDCommon + "loader"})
+	tassert.Errorf(t, ci == nil, "unexpected mapping %+v", ci)
+}
+
+func TestMTLS_Verify(t *testing.T) {
+	var (
+		v      = &mtlsIDs{}
+		now    = time.Now()
+		fpath  = filepath.Join(t.TempDir(), "client-ca.pem")
+		ca     = newTestCA(t, "test-ca")
+		other  = newTestCA(t, "other-ca")
+		client = ca.issue(t, &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "trainer"},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+	)
+	ca.save(t, fpath)
+
+	ids, err := v.verify([]*x509.Certificate{client}, fpath, now)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, slices.Equal(ids, []string{authn.CertIDCommon + "trainer"}), "unexpected identities %v", ids)
+	tassert.Errorf(t, len(v.cache) == 1, "expected verified certificate to be cached")
+	_, err = v.verify([]*x509.Certificate{client}, fpath, now)
+	tassert.CheckFatal(t, err)
+
+	// not a client certificate
+	server := ca.issue(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "server"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	_, err = v.verify([]*x509.Certificate{server}, fpath, now)
+	tassert.Errorf(t, err != nil, "server certificate accepted as client identity")
+
+	// issued by untrusted CA
+	untrusted := other.issue(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "trainer"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	_, err = v.verify([]*x509.Certificate{untrusted}, fpath, now)
+	tassert.Errorf(t, err != nil, "certificate issued by untrusted CA accepted")
+
+	// expired
+	_, err = v.verify([]*x509.Certificate{client}, fpath, now.Add(2*time.Hour))
+	tassert.Errorf(t, err != nil, "expired certificate accepted")
+
+	// CA bundle replaced: previously verified certificates must be re-verified
+	other.save(t, fpath)
+	mtime := now.Add(time.Minute)
+	tassert.CheckFatal(t, os.Chtimes(fpath, mtime, mtime))
+	v.checked = 0
+	_, err = v.verify([]*x509.Certificate{client}, fpath, now)
+	tassert.Errorf(t, err != nil, "certificate accepted after its CA was removed from the bundle")
+	_, err = v.verify([]*x509.Certificate{untrusted}, fpath, now)
+	tassert.CheckFatal(t, err)
+}
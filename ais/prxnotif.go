@@ -130,6 +130,10 @@ func (n *notifs) handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if apiItems[0] == apc.Written {
+		n.p.pxc.written(r)
+		return
+	}
 	if apiItems[0] != apc.Progress && apiItems[0] != apc.Finished {
 		n.p.writeErrf(w, r, "Invalid route /notifs/%s", apiItems[0])
 		return
@@ -351,6 +355,8 @@ func (n *notifs) done(nl nl.Listener) {
 	}
 	// may race vs notifs.apply (benign)
 	nl.Callback(nl, time.Now().UnixNano())
+
+	n.p.jhist.add(nl)
 }
 
 func abortReq(nl nl.Listener) cmn.HreqArgs {
@@ -575,6 +575,8 @@ func (f *fakeNL) Name() string                                            { retu
 func (f *fakeNL) String() string                                          { return f.id }
 func (f *fakeNL) GetOwner() string                                        { return f.owner }
 func (f *fakeNL) SetOwner(s string)                                       { f.owner = s }
+func (*fakeNL) User() string                                              { return "" }
+func (*fakeNL) SetUser(string)                                            {}
 func (*fakeNL) LastUpdated(*meta.Snode) int64                             { return 0 }
 func (*fakeNL) ProgressInterval() time.Duration                           { return 0 }
 func (f *fakeNL) ActiveNotifiers() meta.NodeMap                           { return f.notifiers }
@@ -56,7 +56,8 @@ func (p *proxy) redurl(r *http.Request, si *meta.Snode, smapVer, now int64, netI
 	var (
 		sign    *signer
 		enabled = cmn.Rom.CSKEnabled()
-		special = cmn.HasSpecialSymbols(r.URL.Path)
+		user    = p.auditRedUser(r)
+		special = cmn.HasSpecialSymbols(r.URL.Path) || user != "" // (the latter to pass apc.QparamAuditRedUser)
 	)
 	switch {
 	case enabled:
@@ -70,8 +71,9 @@ func (p *proxy) redurl(r *http.Request, si *meta.Snode, smapVer, now int64, netI
 			sb:      sb,
 			smapVer: smapVer,
 			nonce:   p.owner.csk.nonce.Add(1),
+			user:    user,
 		}
-		sign.compute(p.SID())
+		sign.compute(p.SID(), p.owner.csk.signKey())
 		if !special {
 			// fast signing path
 			out = sign.buildURL(nodeURL, now)
@@ -80,6 +82,9 @@ func (p *proxy) redurl(r *http.Request, si *meta.Snode, smapVer, now int64, netI
 		fallthrough
 	case !enabled && special:
 		scheme, host, q := _preparse(nodeURL, r)
+		if user != "" {
+			q.Set(apc.QparamAuditRedUser, user)
+		}
 		raw := p.qencode(q, now, sign)
 		u := url.URL{
 			Scheme:   scheme,
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -40,6 +41,14 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 		nlog.Infoln("s3Handler", p.String(), r.Method, r.URL)
 	}
 
+	if cmn.Rom.AuthEnabled() {
+		var err error
+		if r, err = p.s3verify(r); err != nil {
+			s3.WriteErr(w, r, err, http.StatusForbidden)
+			return
+		}
+	}
+
 	// TODO: Fix the hack, https://github.com/tensorflow/tensorflow/issues/41798
 	cos.ReparseQuery(r)
 	apiItems, err := p.parseURL(w, r, apc.URLPathS3.L, 0, true)
@@ -69,7 +78,12 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				s3.WriteErr(w, r, err, http.StatusForbidden)
 				return
 			}
-			p.bckNamesFromBMD(w)
+			tenant, err := p.tenantOf(r, true)
+			if err != nil {
+				s3.WriteErr(w, r, err, http.StatusForbidden)
+				return
+			}
+			p.bckNamesFromBMD(w, tenant)
 			return
 		}
 
@@ -92,6 +106,10 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 			// perms: apc.AceObjLIST
+			if q.Has(s3.QparamVersions) {
+				p.listVersionsS3(w, r, apiItems[0], q)
+				return
+			}
 			p.listObjectsS3(w, r, apiItems[0], q)
 			return
 		}
@@ -157,14 +175,20 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 }
 
 // NOTE: unlike native API, this one is limited to list only those that are currently present in the BMD.
+// Tenant callers see only their own buckets (see prxtenant.go).
 // +gen:endpoint GET /s3
 // List all buckets from the bucket metadata
-func (p *proxy) bckNamesFromBMD(w http.ResponseWriter) {
+func (p *proxy) bckNamesFromBMD(w http.ResponseWriter, tenant string) {
 	var (
 		bmd  = p.owner.bmd.get()
 		resp = s3.NewListBucketResult() // https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListBuckets.html
+		nsq  *cmn.Ns                    // nil: any namespace
 	)
-	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+	if tenant != "" {
+		ns := cmn.TenantNs(tenant)
+		nsq = &ns
+	}
+	bmd.Range(nil /*any provider*/, nsq, func(bck *meta.Bck) bool {
 		resp.Add(bck)
 		return false
 	})
@@ -186,7 +210,16 @@ func (p *proxy) putBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
 		return
 	}
-	bck := meta.NewBck(bucket, apc.AIS, cmn.NsGlobal)
+	tenant, err := p.tenantOf(r, true)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	ns := cmn.NsGlobal
+	if tenant != "" {
+		ns = cmn.TenantNs(tenant)
+	}
+	bck := meta.NewBck(bucket, apc.AIS, ns)
 	if err := bck.Validate(); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -234,7 +267,7 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, items []
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Context(), r.Header, bck, apc.AcePUT); err != nil {
+	if err := p.accessObj(r.Context(), r.Header, bck, s3.ObjName(items), apc.AcePUT); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -296,6 +329,9 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 		query = make(url.Values, 1)
 	)
 	query.Set(apc.QparamProvider, apc.AIS)
+	if !bck.Ns.IsGlobal() {
+		query.Set(apc.QparamNamespace, bck.Ns.Uname())
+	}
 	if err := jsoniter.Unmarshal(bt, &msg2); err != nil {
 		err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "list-range action message", cos.BHead(bt), err)
 		s3.WriteErr(w, r, err, 0)
@@ -353,7 +389,8 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Context(), r.Header, bck, apc.AceObjLIST); err != nil {
+	filter, err := p.lsoAccess(r.Context(), r.Header, bck)
+	if err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -392,6 +429,9 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if filter != nil {
+		lst = filterLso(lst, filter)
+	}
 
 	// NOTE:
 	// - the following few lines of code translate (using additional memory) list-objects
@@ -416,6 +456,52 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	lst.Entries = nil
 }
 
+// GET /s3/<bucket-name>?versions (ListObjectVersions)
+// one page at a time (compare w/ listObjectsS3); ais:// buckets with version history only
+func (p *proxy) listVersionsS3(w http.ResponseWriter, r *http.Request, bucket string, q url.Values) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	filter, err := p.lsoAccess(r.Context(), r.Header, bck)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	amsg := &apc.ActMsg{Action: apc.ActList}
+	if p.forwardCP(w, r, amsg, lsotag+" "+bck.String()) {
+		return
+	}
+	lsmsg := &apc.LsoMsg{TimeFormat: time.RFC3339, Flags: apc.LsIsS3 | apc.LsVerHistory, PageSize: apc.MaxPageSizeAWS}
+	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime, apc.GetPropsCustom)
+	if pageSize, err := strconv.Atoi(q.Get(s3.QparamMaxKeys)); err == nil && pageSize > 0 {
+		lsmsg.PageSize = int64(min(pageSize, apc.MaxPageSizeAWS))
+	}
+	lsmsg.Prefix = q.Get(s3.QparamPrefix)
+	lsmsg.StartAfter = q.Get(s3.QparamKeyMarker)
+	amsg.Value = lsmsg
+
+	smap := p.owner.smap.get()
+	lst, err := p.lsPage(bck, amsg, lsmsg, r.Header, smap)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if filter != nil {
+		if lst, err = p.lsFill(bck, amsg, lsmsg, r.Header, smap, lst, filter); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+	}
+	resp := s3.NewListVersionsResult(bucket, lsmsg)
+	resp.FromLsoResult(lst)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
 func (p *proxy) lsAllPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header) (lst *cmn.LsoRes, _ error) {
 	smap := p.owner.smap.get()
 	for pageNum := 1; ; pageNum++ {
@@ -474,7 +560,7 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bckSrc == nil {
 		return
 	}
-	if err := p.access(r.Context(), r.Header, bckSrc, apc.AceGET); err != nil {
+	if err := p.accessObj(r.Context(), r.Header, bckSrc, strings.Trim(parts[1], "/"), apc.AceGET); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -510,7 +596,7 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Context(), r.Header, bck, apc.AcePUT); err != nil {
+	if err := p.accessObj(r.Context(), r.Header, bck, s3.ObjName(items), apc.AcePUT); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -525,6 +611,14 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 	}
 
 	smap := p.owner.smap.get()
+	if ecode, err := p.ratelimitTenant(bck, smap); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	if ecode, err := p.tenants.checkQuota(bck); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -546,7 +640,7 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Context(), r.Header, bck, apc.AceGET); err != nil {
+	if err := p.accessObj(r.Context(), r.Header, bck, s3.ObjName(items), apc.AceGET); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -565,6 +659,10 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 	}
 
 	smap := p.owner.smap.get()
+	if ecode, err := p.ratelimitTenant(bck, smap); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -637,7 +735,7 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Context(), r.Header, bck, apc.AceObjHEAD); err != nil {
+	if err := p.accessObj(r.Context(), r.Header, bck, s3.ObjName(items), apc.AceObjHEAD); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -676,7 +774,7 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Context(), r.Header, bck, apc.AceObjDELETE); err != nil {
+	if err := p.accessObj(r.Context(), r.Header, bck, s3.ObjName(items), apc.AceObjDELETE); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -687,6 +785,10 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	}
 
 	smap := p.owner.smap.get()
+	if ecode, err := p.ratelimitTenant(bck, smap); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
 	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -761,23 +863,43 @@ func (p *proxy) putBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 // misc. utils
 //
 
+// tenant callers: resolve within the tenant's namespace and forward the latter
+// to the target (see t.initByNameOnly)
 func (p *proxy) initByNameOnly(w http.ResponseWriter, r *http.Request, bucket string) *meta.Bck {
-	bck, ecode, err := meta.InitByNameOnly(bucket, p.owner.bmd)
+	var (
+		bck   *meta.Bck
+		ecode int
+	)
+	tenant, err := p.tenantOf(r, true)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return nil
+	}
+	if tenant == "" {
+		bck, ecode, err = meta.InitByNameOnly(bucket, p.owner.bmd)
+	} else {
+		bck, ecode, err = meta.InitByNameNs(bucket, cmn.TenantNs(tenant), p.owner.bmd)
+	}
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return nil
 	}
 	debug.Assert(bck != nil)
+	if tenant != "" && !r.URL.Query().Has(apc.QparamNamespace) {
+		q := apc.QparamNamespace + "=" + url.QueryEscape(bck.Ns.Uname())
+		r.URL.RawQuery = cos.Ternary(r.URL.RawQuery == "", q, r.URL.RawQuery+"&"+q)
+	}
 	return bck
 }
 
 // either reverse-proxy call _or_ HTTP-redirect to a designated node
 // see also: docs/s3compat.md
 func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snode, redurl, bucket string) {
-	if cmn.Rom.Features().IsSet(feat.S3ReverseProxy) {
+	if cmn.Rom.Features().IsSet(feat.S3ReverseProxy) || s3.IsVerifiedPayload(r.Body) {
 		// [intra-cluster communications]
 		// instead of regular HTTP redirect (below) reverse-proxy S3 API call to a designated target
 		// forward using pub net
+		// (always when the signed payload is verified in-flight - see s3.VerifySigV4)
 		parsedURL, err := url.Parse(si.URL(cmn.NetPublic))
 		debug.AssertNoErr(err)
 		p.reverseRequest(w, r, si.ID(), parsedURL)
@@ -0,0 +1,307 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/memsys"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// S3 access keys issued by AuthN (see api/authn.S3Key):
+// - upon any change AuthN pushes the entire list to each registered cluster,
+//   along with per-user tokens; the list is versioned by AuthN
+// - primary persists the list and metasyncs it to all proxies (targets ignore)
+// - proxies verify SigV4-signed (header or presigned query) S3 requests locally
+//   and then check the signer's permissions exactly as for native tokens
+// - requests signed with unknown access keys are handled as before (anonymous,
+//   X-Amz-Security-Token, or presigned pass-through - see s3.PresignedReq)
+// - users' tokens expire (and get re-pushed by AuthN prior to expiration);
+//   locally, the list is persisted sealed (see cos.Seal), owner-only
+// - client certificate identities (see prxmtls.go) are pushed, versioned,
+//   persisted, and metasynced in the same way - but separately
+
+type (
+	s3KeyList struct {
+		byID map[string]*authn.S3Key // by access key ID
+		authn.S3KeyList
+	}
+	// persistent (jsp) version of the lists pushed by AuthN: secrets and tokens are sealed
+	sealedList struct {
+		Sealed  []byte `json:"sealed"`
+		Version int64  `json:"version,string"`
+	}
+	s3keys struct {
+		list  ratomic.Pointer[s3KeyList]
+		fpath string
+		seal  []byte // node-local sealing key (cmn.SealKeyPath)
+		sync.Mutex
+	}
+)
+
+// interface guard
+var (
+	_ revs     = (*s3KeyList)(nil)
+	_ jsp.Opts = (*sealedList)(nil)
+)
+
+///////////////
+// s3KeyList //
+///////////////
+
+func (*s3KeyList) JspOpts() jsp.Options { return jsp.CCSign(cmn.MetaverS3Keys) }
+
+// as revs
+func (*s3KeyList) tag() string       { return revsS3KeysTag }
+func (l *s3KeyList) version() int64  { return l.Version }
+func (*s3KeyList) uuid() string      { return "" }
+func (l *s3KeyList) marshal() []byte { return cos.MustMarshal(l) }
+func (*s3KeyList) jit(p *proxy) revs { return p.s3keys.get() }
+func (*s3KeyList) sgl() *memsys.SGL  { return nil }
+func (l *s3KeyList) String() string  { return "S3Keys v" + strconv.FormatInt(l.Version, 10) }
+
+func (l *s3KeyList) get(akid string) *authn.S3Key { return l.byID[akid] }
+
+////////////////
+// sealedList //
+////////////////
+
+func (*sealedList) JspOpts() jsp.Options { return jsp.CCSign(cmn.MetaverS3Keys) }
+
+func loadSealed(fpath string, seal []byte, v any) (int64, error) {
+	disk := &sealedList{}
+	if _, err := jsp.LoadMeta(fpath, disk); err != nil {
+		return 0, err
+	}
+	b, err := cos.Unseal(seal, disk.Sealed)
+	if err == nil {
+		err = jsoniter.Unmarshal(b, v)
+	}
+	return disk.Version, err
+}
+
+func saveSealed(fpath string, seal, b []byte, version int64) error {
+	sealed, err := cos.Seal(seal, b)
+	if err != nil {
+		return err
+	}
+	if err := jsp.SaveMeta(fpath, &sealedList{Sealed: sealed, Version: version}, nil); err != nil {
+		return err
+	}
+	return os.Chmod(fpath, cos.PermRW)
+}
+
+////////////
+// s3keys //
+////////////
+
+func (o *s3keys) init(config *cmn.Config) {
+	var (
+		err  error
+		list = &s3KeyList{}
+	)
+	defer o.put(list)
+	if o.seal, err = cos.LoadSealKey(cmn.SealKeyPath(config.ConfigDir)); err != nil {
+		nlog.Errorf("failed to load sealing key - won't persist %s: %v", list, err)
+		return
+	}
+	o.fpath = filepath.Join(config.ConfigDir, fname.S3Keys)
+	ver, err := loadSealed(o.fpath, o.seal, &list.S3KeyList)
+	switch {
+	case err == nil:
+	case cos.IsNotExist(err):
+	default:
+		nlog.Errorf("invalid S3Keys v%d in %s (err %v) - ignoring", ver, o.fpath, err)
+		list.S3KeyList = authn.S3KeyList{}
+	}
+}
+
+func (o *s3keys) get() *s3KeyList { return o.list.Load() }
+
+func (o *s3keys) put(list *s3KeyList) {
+	list.byID = make(map[string]*authn.S3Key, len(list.Keys))
+	for _, key := range list.Keys {
+		list.byID[key.AccessKeyID] = key
+	}
+	o.list.Store(list)
+}
+
+// (caller must hold the lock)
+func (o *s3keys) putPersist(list *s3KeyList) error {
+	if o.fpath != "" {
+		if err := saveSealed(o.fpath, o.seal, list.marshal(), list.Version); err != nil {
+			return err
+		}
+	}
+	o.put(list)
+	return nil
+}
+
+//
+// AuthN => primary
+//
+
+// PUT /v1/tokens/{s3keys|certs} (by AuthN, with admin token)
+func (p *proxy) putAuthnList(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := p.parseURL(w, r, apc.URLPathTokens.L, 1, false)
+	if err != nil {
+		return
+	}
+	var what string
+	switch apiItems[0] {
+	case apc.S3Keys:
+		what = "S3 access keys"
+	case apc.Certs:
+		what = "client certificate identities"
+	default:
+		p.writeErrURL(w, r)
+		return
+	}
+	if !cmn.Rom.AuthEnabled() {
+		p.writeErrf(w, r, "%s: cannot accept %s: authentication is disabled", p, what)
+		return
+	}
+	if p.forwardCP(w, r, nil, what) {
+		return
+	}
+	claims, err := p.validateToken(r.Context(), r.Header)
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	if !claims.IsAdmin {
+		p.writeErrf(w, r, "%s: %s can be only updated by admin (%s)", p, what, claims)
+		return
+	}
+	if apiItems[0] == apc.Certs {
+		p.putCerts(w, r)
+	} else {
+		p.putS3Keys(w, r)
+	}
+}
+
+func (p *proxy) putS3Keys(w http.ResponseWriter, r *http.Request) {
+	newList := &s3KeyList{}
+	if err := cmn.ReadJSON(w, r, &newList.S3KeyList); err != nil {
+		return
+	}
+
+	o := &p.s3keys
+	o.Lock()
+	if list := o.get(); newList.Version <= list.Version {
+		o.Unlock()
+		nlog.Warningln(p.String()+": ignoring stale", newList.String(), "(have", list.String()+")")
+		return
+	}
+	err := o.putPersist(newList)
+	o.Unlock()
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusInternalServerError)
+		return
+	}
+	nlog.Infoln(p.String()+":", newList.String(), "num keys:", len(newList.Keys))
+	_ = p.metasyncer.sync(revsPair{newList, p.newAmsgStr("update S3 access keys", nil)})
+}
+
+//
+// metasync Rx
+//
+
+func (p *proxy) extractS3Keys(payload msPayload, sender string) (*s3KeyList, *actMsgExt, error) {
+	b, ok := payload[revsS3KeysTag]
+	if !ok {
+		return nil, nil, nil
+	}
+	var (
+		newList = &s3KeyList{}
+		msg     = &actMsgExt{}
+	)
+	if err := jsoniter.Unmarshal(b, &newList.S3KeyList); err != nil {
+		return nil, nil, fmt.Errorf(cmn.FmtErrUnmarshal, p, "new "+revsS3KeysTag, cos.BHead(b), err)
+	}
+	if msgValue, ok := payload[revsS3KeysTag+revsActionTag]; ok {
+		if err := jsoniter.Unmarshal(msgValue, msg); err != nil {
+			return newList, nil, fmt.Errorf(cmn.FmtErrUnmarshal, p, "action message", cos.BHead(msgValue), err)
+		}
+	}
+	if cmn.Rom.V(4, cos.ModAIS) {
+		logmsync(p.s3keys.get().Version, newList, msg, sender)
+	}
+	return newList, msg, nil
+}
+
+func (p *proxy) receiveS3Keys(newList *s3KeyList, msg *actMsgExt, sender string) (err error) {
+	o := &p.s3keys
+	o.Lock()
+	list := o.get()
+	if newList.version() <= list.version() && msg.Action != apc.ActPrimaryForce {
+		o.Unlock()
+		if newList.version() < list.version() {
+			err = newErrDowngrade(p.si, list.String(), newList.String())
+		}
+		return err
+	}
+	logmsync(list.Version, newList, msg, sender)
+	err = o.putPersist(newList)
+	o.Unlock()
+	return err
+}
+
+//
+// verify
+//
+
+// Verify SigV4 signature of the S3 request signed with AuthN-issued access key
+// and, if successful, return the request with the signer's claims in its context
+// (see p.access). Must be called prior to any modification of the request's URL.
+func (p *proxy) s3verify(r *http.Request) (*http.Request, error) {
+	if _, ok := r.Context().Value(cos.CtxS3Signer).(*tok.AISClaims); ok {
+		return r, nil // already verified (S3 API via root)
+	}
+	akid := s3.AccessKeyID(r.URL.Query(), r.Header)
+	if akid == "" {
+		return r, nil
+	}
+	key := p.s3keys.get().get(akid)
+	if key == nil {
+		return r, nil // not ours
+	}
+	var (
+		claims *tok.AISClaims
+		ctx    = r.Context()
+		err    = s3.VerifySigV4(r, key.Secret, time.Now(), cmn.Rom.Features().IsSet(feat.S3UnsignedPayload))
+	)
+	if err == nil {
+		if claims, err = p.authn.validateToken(ctx, key.Token); err == nil {
+			ctx = context.WithValue(ctx, cos.CtxS3Signer, claims)
+		}
+	}
+	p.authStats(err)
+	if err != nil {
+		nlog.Warningln("S3 access key", akid, "user", key.UserID, "verification failed:", err)
+		return r, err
+	}
+	return r.WithContext(ctx), nil
+}
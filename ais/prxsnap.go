@@ -0,0 +1,169 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// bucket snapshots (see package bsnap):
+// - create: add snapshot to the bucket props => metasync BMD => each target activates
+//   (copy-on-write) and runs x-snapshot to build its part of the snapshot
+// - destroy: remove from the props => metasync => targets remove snapshot data
+
+const maxSnapsPerBck = 64
+
+func _checkSnapBck(bck *meta.Bck) error {
+	if !bck.IsAIS() {
+		return cmn.NewErrUnsupp("snapshot", bck.Cname("")+" (snapshots are supported only for ais:// buckets)")
+	}
+	return nil
+}
+
+// returns snapshot ID (that is also x-snapshot UUID)
+func (p *proxy) createSnap(msg *apc.ActMsg, bck *meta.Bck) (string, error) {
+	if err := _checkSnapBck(bck); err != nil {
+		return "", err
+	}
+	if msg.Name == "" {
+		return "", errors.New("snapshot name is required")
+	}
+	if err := cos.CheckAlphaPlus(msg.Name, "snapshot name"); err != nil {
+		return "", err
+	}
+	si := &cmn.SnapInfo{Name: msg.Name, ID: cos.GenUUID(), Created: time.Now().UnixNano()}
+
+	// 1. validate vs current BMD
+	bmd := p.owner.bmd.get()
+	if err := _checkNewSnap(bmd, bck, si); err != nil {
+		return "", err
+	}
+
+	// 2. IC: x-snapshot (all targets) is started upon BMD update - register prior
+	smap := p.owner.smap.get()
+	nl := xact.NewXactNL(si.ID, msg.Action, &smap.Smap, nil, bck.Bucket())
+	nl.SetOwner(equalIC)
+	p.ic.registerEqual(regIC{nl: nl, smap: smap, user: msg.User})
+
+	// 3. update BMD and metasync
+	ctx := &bmdModifier{
+		pre:   bmodCreateSnap,
+		final: p.bmodSync,
+		msg:   msg,
+		bcks:  []*meta.Bck{bck},
+		wait:  true,
+	}
+	ctx.msg.Value = si
+	if _, err := p.owner.bmd.modify(ctx); err != nil {
+		p.notifs.del(nl, false /*locked*/) // (unlikely race)
+		return "", err
+	}
+	nlog.Infoln(p.String(), "created", bck.Cname(""), si.String())
+	return si.ID, nil
+}
+
+func _checkNewSnap(bmd *bucketMD, bck *meta.Bck, si *cmn.SnapInfo) error {
+	bprops, present := bmd.Get(bck)
+	if !present {
+		return cmn.NewErrAisBckNotFound(bck.Bucket())
+	}
+	if bprops.GetSnap(si.Name) != nil {
+		return fmt.Errorf("%s: snapshot %q already exists", bck.Cname(""), si.Name)
+	}
+	if bprops.EC.Enabled {
+		return cmn.NewErrUnsupp("create snapshot of", bck.Cname("")+" (erasure-coded bucket)")
+	}
+	if len(bprops.Snaps) >= maxSnapsPerBck {
+		return fmt.Errorf("%s: too many snapshots (max %d)", bck.Cname(""), maxSnapsPerBck)
+	}
+	return nil
+}
+
+func bmodCreateSnap(ctx *bmdModifier, clone *bucketMD) error {
+	var (
+		bck = ctx.bcks[0]
+		si  = ctx.msg.Value.(*cmn.SnapInfo)
+	)
+	if err := _checkNewSnap(clone, bck, si); err != nil {
+		return err
+	}
+	bprops, _ := clone.Get(bck)
+	nprops := bprops.Clone()
+	nprops.Snaps = append(slices.Clone(bprops.Snaps), *si)
+	clone.set(bck, nprops)
+	return nil
+}
+
+func (p *proxy) destroySnap(msg *apc.ActMsg, bck *meta.Bck) error {
+	if err := _checkSnapBck(bck); err != nil {
+		return err
+	}
+	ctx := &bmdModifier{
+		pre:   bmodDestroySnap,
+		final: p.bmodSync,
+		msg:   msg,
+		bcks:  []*meta.Bck{bck},
+		wait:  true,
+	}
+	_, err := p.owner.bmd.modify(ctx)
+	return err
+}
+
+func bmodDestroySnap(ctx *bmdModifier, clone *bucketMD) error {
+	var (
+		bck             = ctx.bcks[0]
+		name            = ctx.msg.Name
+		bprops, present = clone.Get(bck)
+	)
+	if !present {
+		return cmn.NewErrAisBckNotFound(bck.Bucket())
+	}
+	si := bprops.GetSnap(name)
+	if si == nil {
+		return cos.NewErrNotFound(nil, bck.Cname("")+" snapshot \""+name+"\"")
+	}
+	nprops := bprops.Clone()
+	nprops.Snaps = slices.DeleteFunc(slices.Clone(bprops.Snaps), func(s cmn.SnapInfo) bool { return s.Name == name })
+	clone.set(bck, nprops)
+	return nil
+}
+
+// inventory (see p.inventory): destroy the temporary snapshot upon completion
+type _invfin struct {
+	p    *proxy
+	bck  *meta.Bck
+	snap string
+}
+
+func (f *_invfin) cb(nl.Listener) {
+	if err := f.p.destroySnap(&apc.ActMsg{Action: apc.ActDestroySnap, Name: f.snap}, f.bck); err != nil {
+		nlog.Errorln("failed to destroy temporary", f.bck.Cname(""), "snapshot", f.snap, "err:", err)
+	}
+}
+
+// list snapshotted objects (all targets)
+func (p *proxy) lsSnap(bck *meta.Bck, lsmsg *apc.LsoMsg) (*cmn.LsoRes, error) {
+	if err := _checkSnapBck(bck); err != nil {
+		return nil, err
+	}
+	if bck.Props.GetSnap(lsmsg.Snap) == nil {
+		return nil, cos.NewErrNotFound(p, bck.Cname("")+" snapshot \""+lsmsg.Snap+"\"")
+	}
+	if lsmsg.UUID == "" {
+		lsmsg.UUID = cos.GenUUID()
+	}
+	return p.lsObjsA(bck, lsmsg)
+}
@@ -0,0 +1,245 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Tenants: named local namespaces, e.g. ais://@#acme/bucket (see cmn.TenantConf)
+// - tenant of a given request: namespace of the caller's authenticated identity - AuthN token,
+//   verified S3 signature, or client certificate (authn.Role.Namespace) or, for S3 requests
+//   signed with a verified (AuthN-issued) access key, the namespace that the key maps to (`tenant.s3_keys`)
+// - the caller's tenant is never taken from unverified credentials: S3 request signed with
+//   a mapped access key that cannot be verified is denied (rather than treated as global)
+// - tenant callers list and create buckets only in their own namespace; S3 API resolves
+//   bucket names within the namespace (and forwards the latter to targets)
+// - rate limit: cluster-wide `tenant.rate_limit` divided among active proxies
+// - soft quota: PUTs into the tenant's buckets fail with 507 once the tenant's used capacity
+//   (periodically collected from all targets - see tgttenant.go) reaches `tenant.quota`
+
+const ptenantHKIval = time.Minute
+
+var errTenantUnknown = errors.New("cannot establish the caller's tenant")
+
+type ptenants struct {
+	p     *proxy
+	usage ratomic.Pointer[apc.TenantsUsage] // cluster-wide; nil when no quotas configured
+}
+
+func (pt *ptenants) init(p *proxy) {
+	pt.p = p
+	hk.Reg("tenants"+hk.NameSuffix, pt.housekeep, ptenantHKIval)
+}
+
+func (pt *ptenants) housekeep(int64) time.Duration {
+	config := cmn.GCO.Get()
+	if !config.Tenant.Enabled || config.Tenant.Quota == "" || !pt.p.ClusterStarted() {
+		pt.usage.Store(nil)
+		return ptenantHKIval
+	}
+	usage, err := pt.p.tenantsUsage(&config.Tenant)
+	if err != nil {
+		nlog.Warningln(pt.p.String(), "failed to collect tenants usage:", err)
+		return ptenantHKIval
+	}
+	pt.usage.Store(&usage)
+	return ptenantHKIval
+}
+
+// soft quota
+func (pt *ptenants) checkQuota(bck *meta.Bck) (int, error) {
+	if !bck.Ns.IsTenant() {
+		return 0, nil
+	}
+	usage := pt.usage.Load()
+	if usage == nil {
+		return 0, nil
+	}
+	tu, ok := (*usage)[bck.Ns.Name]
+	if !ok || tu.Quota == 0 || tu.Size < tu.Quota {
+		return 0, nil
+	}
+	return http.StatusInsufficientStorage, fmt.Errorf("tenant %q exceeded its capacity quota (used %s, quota %s)",
+		bck.Ns.Name, cos.ToSizeIEC(tu.Size, 2), cos.ToSizeIEC(tu.Quota, 2))
+}
+
+// returns empty string when tenants are disabled or the (authenticated) caller is not bound to any;
+// fails when the caller's tenant cannot be established
+func (p *proxy) tenantOf(r *http.Request, isS3 bool) (string, error) {
+	config := cmn.GCO.Get()
+	if !config.Tenant.Enabled {
+		return "", nil
+	}
+	ctx := r.Context()
+	claims, skip, err := p.identify(ctx, r.Header)
+	switch {
+	case skip:
+		return "", nil
+	case err != nil && !errors.Is(err, tok.ErrNoToken):
+		return "", err
+	case claims != nil && claims.Namespace != "":
+		return claims.Namespace, nil
+	}
+	if !isS3 {
+		return "", nil
+	}
+	akid := s3.AccessKeyID(r.URL.Query(), r.Header)
+	if akid == "" {
+		return "", nil
+	}
+	tenant := config.Tenant.S3KeyTenant(akid)
+	if tenant == "" {
+		return "", nil
+	}
+	if _, verified := ctx.Value(cos.CtxS3Signer).(*tok.AISClaims); !verified {
+		return "", fmt.Errorf("%w: access key %q (tenant %q) is not verified", errTenantUnknown, akid, tenant)
+	}
+	return tenant, nil
+}
+
+func (p *proxy) writeErrTenant(w http.ResponseWriter, r *http.Request, err error) {
+	p.writeErr(w, r, err, http.StatusForbidden)
+}
+
+// list-buckets: tenant callers see only their own (ais://) buckets
+func (p *proxy) tenantQbck(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks) bool {
+	tenant, err := p.tenantOf(r, false)
+	if err != nil {
+		p.writeErrTenant(w, r, err)
+		return false
+	}
+	if tenant == "" {
+		return true
+	}
+	ns := cmn.TenantNs(tenant)
+	if (qbck.Provider != "" && qbck.Provider != apc.AIS) || (!qbck.Ns.IsGlobal() && qbck.Ns != ns) {
+		p.writeErrStatusf(w, r, http.StatusForbidden, "tenant %q: cannot list %s buckets", tenant, qbck.String())
+		return false
+	}
+	qbck.Provider, qbck.Ns = apc.AIS, ns
+	return true
+}
+
+// create bucket: tenant callers create buckets only in their own namespace
+func (p *proxy) tenantBck(w http.ResponseWriter, r *http.Request, bck *meta.Bck) bool {
+	tenant, err := p.tenantOf(r, false)
+	if err != nil {
+		p.writeErrTenant(w, r, err)
+		return false
+	}
+	if tenant == "" || bck.Ns == cmn.TenantNs(tenant) {
+		return true
+	}
+	p.writeErrStatusf(w, r, http.StatusForbidden, "tenant %q: cannot create %s outside its namespace", tenant, bck.String())
+	return false
+}
+
+func (p *proxy) ratelimitTenant(bck *meta.Bck, smap *smapX) (int, error) {
+	if !bck.Ns.IsTenant() {
+		return 0, nil
+	}
+	config := cmn.GCO.Get()
+	if !config.Tenant.Enabled {
+		return 0, nil
+	}
+	maxTokens := config.Tenant.RateLimitOf(bck.Ns.Name)
+	if maxTokens == 0 {
+		return 0, nil
+	}
+	var (
+		brl *cos.BurstRateLim
+		rl  = &p.ratelim
+		key = "tenant:" + bck.Ns.Name + ":" + strconv.Itoa(maxTokens) // (config change => new limiter)
+	)
+	if v, ok := rl.Load(key); ok {
+		brl = v.(*cos.BurstRateLim)
+	} else {
+		na := max(smap.CountActivePs(), 1)
+		tokens := max(cos.DivRound(maxTokens, na), 2) // (min burst)
+		burst := max(tokens>>1-tokens>>3, 1)
+		var err error
+		if brl, err = cos.NewBurstRateLim("tenant "+bck.Ns.Name, tokens, burst, config.RateLimit.Frontend.Interval.D()); err != nil {
+			return 0, err
+		}
+		rl.Store(key, brl)
+	}
+	if !brl.TryAcquire() {
+		return http.StatusTooManyRequests, cmn.NewErrRateLimitFrontend()
+	}
+	return 0, nil
+}
+
+// cluster-wide usage: sum over all targets
+func (p *proxy) tenantsUsage(conf *cmn.TenantConf) (apc.TenantsUsage, error) {
+	query := url.Values{apc.QparamWhat: []string{apc.WhatTenants}}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query}
+	args.cresv = cresjGeneric[apc.TenantsUsage]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	out := make(apc.TenantsUsage, 4)
+	for _, res := range results {
+		if res.err != nil {
+			err := res.errorf("%s failed to get tenants usage", res.si)
+			freeBcastRes(results)
+			return nil, err
+		}
+		for name, tu := range *res.v.(*apc.TenantsUsage) {
+			if all, ok := out[name]; ok {
+				all.Size += tu.Size
+			} else {
+				out[name] = &apc.TenantUsage{Buckets: tu.Buckets, Size: tu.Size}
+			}
+		}
+	}
+	freeBcastRes(results)
+	for name, tu := range out {
+		tu.Quota = conf.QuotaOf(name)
+	}
+	return out, nil
+}
+
+// (apc.WhatTenants) tenant callers get only their own
+func (p *proxy) qcluTenants(w http.ResponseWriter, r *http.Request, what string) {
+	config := cmn.GCO.Get()
+	if !config.Tenant.Enabled {
+		p.writeErrf(w, r, "%s: tenants are disabled (see config 'tenant')", p)
+		return
+	}
+	out, err := p.tenantsUsage(&config.Tenant)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	tenant, err := p.tenantOf(r, false)
+	if err != nil {
+		p.writeErrTenant(w, r, err)
+		return
+	}
+	if tenant != "" {
+		out = apc.TenantsUsage{tenant: out[tenant]}
+		if out[tenant] == nil {
+			out[tenant] = &apc.TenantUsage{Quota: config.Tenant.QuotaOf(tenant)}
+		}
+	}
+	p.writeJSON(w, r, out, what)
+}
@@ -0,0 +1,57 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestTenantOf(t *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	config.Tenant = cmn.TenantConf{Enabled: true, S3Keys: "AKIDACME:acme"}
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Tenant = cmn.TenantConf{}
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	p := &proxy{}
+	p.owner.smap = newSmapOwner(cmn.GCO.Get())
+	signed := func(akid string) string {
+		return "AWS4-HMAC-SHA256 Credential=" + akid + "/20260101/us-east-1/s3/aws4_request, SignedHeaders=host, Signature=00"
+	}
+
+	// unsigned and unmapped: no tenant
+	r := httptest.NewRequest("GET", "/s3", nil)
+	tenant, err := p.tenantOf(r, true)
+	tassert.Errorf(t, err == nil && tenant == "", "expected no tenant, got %q (%v)", tenant, err)
+	r.Header.Set(apc.HdrAuthorization, signed("AKIDOTHER"))
+	tenant, err = p.tenantOf(r, true)
+	tassert.Errorf(t, err == nil && tenant == "", "expected no tenant, got %q (%v)", tenant, err)
+
+	// mapped but not verified: denied (no fallback to global namespace)
+	r.Header.Set(apc.HdrAuthorization, signed("AKIDACME"))
+	_, err = p.tenantOf(r, true)
+	tassert.Errorf(t, errors.Is(err, errTenantUnknown), "expected unverified access key to be denied, got %v", err)
+
+	// mapped and verified
+	ctx := context.WithValue(r.Context(), cos.CtxS3Signer, &tok.AISClaims{})
+	tenant, err = p.tenantOf(r.WithContext(ctx), true)
+	tassert.Errorf(t, err == nil && tenant == "acme", "expected tenant %q, got %q (%v)", "acme", tenant, err)
+
+	// native API: access key is never consulted
+	tenant, err = p.tenantOf(r, false)
+	tassert.Errorf(t, err == nil && tenant == "", "expected no tenant, got %q (%v)", tenant, err)
+}
@@ -0,0 +1,237 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// trash (soft delete): proxy side - see also ais/tgttrash.go
+
+// GET /v1/buckets/bucket-name (apc.ActListTrash)
+func (p *proxy) listTrash(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg) {
+	var tmsg apc.TrashMsg
+	if err := cos.MorphMarshal(msg.Value, &tmsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	ents, err := p._lsTrash(bck, tmsg.Prefix)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	p.writeJSON(w, r, ents, apc.ActListTrash)
+}
+
+// broadcast to all targets, merge and sort (by name and then most recently deleted first)
+func (p *proxy) _lsTrash(bck *meta.Bck, prefix string) (apc.TrashEntries, error) {
+	if !bck.IsAIS() {
+		return nil, cmn.NewErrUnsupp("list deleted objects in", bck.Cname("")+" (trash is supported only for ais:// buckets)")
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.AddToQuery(nil),
+		Body:   cos.MustMarshal(p.newAmsg(&apc.ActMsg{Action: apc.ActListTrash, Value: &apc.TrashMsg{Prefix: prefix}}, nil)),
+	}
+	args.timeout = apc.LongTimeout
+	args.cresv = cresjGeneric[apc.TrashEntries]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var all apc.TrashEntries
+	for _, res := range results {
+		if res.err != nil {
+			err := res.errorf("%s failed to list trash in %s", res.si, bck.Cname(""))
+			freeBcastRes(results)
+			return nil, err
+		}
+		all = append(all, *res.v.(*apc.TrashEntries)...)
+	}
+	freeBcastRes(results)
+	sortTrashed(all)
+	return all, nil
+}
+
+func sortTrashed(ents apc.TrashEntries) {
+	slices.SortFunc(ents, func(a, b *apc.TrashEntry) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Deleted, a.Deleted)
+	})
+}
+
+// given sorted entries, select deleted copies to restore:
+// a) the one specified by ID, or b) the most recently deleted copy of each selected object
+func selectTrashed(ents apc.TrashEntries, umsg *apc.UndeleteMsg) (selected apc.TrashEntries) {
+	for i, e := range ents {
+		switch {
+		case umsg.ObjName != "":
+			if e.Name != umsg.ObjName {
+				continue
+			}
+			if umsg.ID != "" && e.ID() != umsg.ID {
+				continue
+			}
+		case !strings.HasPrefix(e.Name, umsg.Prefix):
+			continue
+		}
+		if umsg.ID == "" && i > 0 && ents[i-1].Name == e.Name {
+			continue // older copy
+		}
+		selected = append(selected, e)
+	}
+	return selected
+}
+
+// POST /v1/buckets/bucket-name (apc.ActUndelete)
+func (p *proxy) undelete(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg) {
+	if err := p.checkAccess(w, r, bck, apc.AcePUT); err != nil {
+		return
+	}
+	umsg := &apc.UndeleteMsg{}
+	if err := cos.MorphMarshal(msg.Value, umsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if err := umsg.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	prefix := umsg.Prefix
+	if umsg.ObjName != "" {
+		prefix = umsg.ObjName
+	}
+	ents, err := p._lsTrash(bck, prefix)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	selected := selectTrashed(ents, umsg)
+	if len(selected) == 0 {
+		what := bck.Cname(prefix)
+		if umsg.ID != "" {
+			what += " (ID " + umsg.ID + ")"
+		}
+		p.writeErr(w, r, cos.NewErrNotFound(p, what+" in trash"), http.StatusNotFound)
+		return
+	}
+
+	// each target restores its own share
+	var (
+		smap     = p.owner.smap.get()
+		byTarget = make(map[string]apc.TrashEntries, 4)
+		restored = make(apc.TrashEntries, 0, len(selected))
+	)
+	for _, e := range selected {
+		byTarget[e.Location] = append(byTarget[e.Location], e)
+	}
+	for tid, tents := range byTarget {
+		tsi := smap.GetTarget(tid)
+		if tsi == nil {
+			p.writeErr(w, r, &errNodeNotFound{p.si, smap, "cannot restore deleted objects:", tid})
+			return
+		}
+		cargs := allocCargs()
+		{
+			cargs.si = tsi
+			cargs.req = cmn.HreqArgs{
+				Method: http.MethodPost,
+				Path:   apc.URLPathBuckets.Join(bck.Name),
+				Query:  bck.AddToQuery(nil),
+				Body:   cos.MustMarshal(p.newAmsg(&apc.ActMsg{Action: apc.ActUndelete, Value: tents}, nil)),
+			}
+			cargs.timeout = apc.LongTimeout
+			cargs.cresv = cresjGeneric[apc.TrashEntries]{}
+		}
+		res := p.call(cargs, smap)
+		err := res.toErr()
+		if err == nil {
+			restored = append(restored, *res.v.(*apc.TrashEntries)...)
+		}
+		ecode := res.status
+		freeCargs(cargs)
+		freeCR(res)
+		if err != nil {
+			p.writeErr(w, r, err, ecode)
+			return
+		}
+	}
+	sortTrashed(restored)
+	p.writeJSON(w, r, restored, apc.ActUndelete)
+}
+
+// POST /v1/buckets/bucket-name (apc.ActUndeleteBck)
+// restore the most recently destroyed incarnation of a given ais:// bucket, with its original props
+func (p *proxy) undeleteBucket(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck) {
+	if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
+		return
+	}
+	if bck.Provider == "" {
+		bck.Provider = apc.AIS
+	}
+	if !bck.IsAIS() {
+		p.writeErr(w, r, cmn.NewErrUnsupp("restore", bck.Cname("")+" (trash is supported only for ais:// buckets)"))
+		return
+	}
+	if err := bck.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if p.forwardCP(w, r, msg, bck.Name) {
+		return
+	}
+	if _, present := p.owner.bmd.get().Get(bck); present {
+		p.writeErr(w, r, cmn.NewErrBckAlreadyExists(bck.Bucket()))
+		return
+	}
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.AddToQuery(nil),
+		Body:   cos.MustMarshal(p.newAmsg(&apc.ActMsg{Action: apc.ActUndeleteBck}, nil)),
+	}
+	args.timeout = apc.LongTimeout
+	args.cresv = cresjGeneric[[]fs.TrashedBck]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var newest *fs.TrashedBck
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.errorf("%s failed to list trashed %s", res.si, bck.Cname("")))
+			freeBcastRes(results)
+			return
+		}
+		if tbcks := *res.v.(*[]fs.TrashedBck); len(tbcks) > 0 && (newest == nil || tbcks[0].Deleted > newest.Deleted) {
+			newest = &tbcks[0]
+		}
+	}
+	freeBcastRes(results)
+	if newest == nil {
+		p.writeErr(w, r, cos.NewErrNotFound(p, bck.Cname("")+" in trash"), http.StatusNotFound)
+		return
+	}
+
+	nlog.Infoln(msg.Action, bck.Cname(""), "BID", fmt.Sprintf("%#x", newest.Props.BID))
+	if err := p._createBucketWithProps(msg, bck, newest.Props); err != nil {
+		p.writeErr(w, r, err, crerrStatus(err))
+	}
+}
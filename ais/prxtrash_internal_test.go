@@ -0,0 +1,48 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSelectTrashed(t *testing.T) {
+	ent := func(name string, deleted int64, tid string) *apc.TrashEntry {
+		return &apc.TrashEntry{Name: name, Deleted: deleted, Location: tid}
+	}
+	// (as if merged from two targets)
+	ents := apc.TrashEntries{ent("a/1", 10, "t1"), ent("b/1", 5, "t2"), ent("a/1", 30, "t2"), ent("a/2", 20, "t1"), ent("a/1", 20, "t1")}
+	sortTrashed(ents)
+
+	names := func(sel apc.TrashEntries) (s []string) {
+		for _, e := range sel {
+			s = append(s, e.Name+"@"+e.ID())
+		}
+		return s
+	}
+	tests := []struct {
+		msg      apc.UndeleteMsg
+		expected []string
+	}{
+		{apc.UndeleteMsg{ObjName: "a/1"}, []string{"a/1@30"}},
+		{apc.UndeleteMsg{ObjName: "a/1", ID: "10"}, []string{"a/1@10"}},
+		{apc.UndeleteMsg{ObjName: "a/1", ID: "11"}, nil},
+		{apc.UndeleteMsg{ObjName: "a/"}, nil},
+		{apc.UndeleteMsg{Prefix: "a/"}, []string{"a/1@30", "a/2@20"}},
+		{apc.UndeleteMsg{}, []string{"a/1@30", "a/2@20", "b/1@5"}},
+	}
+	for i, test := range tests {
+		got := names(selectTrashed(ents, &test.msg))
+		tassert.Errorf(t, len(got) == len(test.expected), "%d: expected %v, got %v", i, test.expected, got)
+		for j := range min(len(got), len(test.expected)) {
+			tassert.Errorf(t, got[j] == test.expected[j], "%d: expected %v, got %v", i, test.expected, got)
+		}
+	}
+	tassert.Errorf(t, ents[0].ID() == strconv.Itoa(30), "expecting most recently deleted first, got %s", ents[0].ID())
+}
@@ -322,10 +322,17 @@ func (p *proxy) _createBucketWithProps(msg *apc.ActMsg, bck *meta.Bck, bprops *c
 }
 
 func bmodCreate(ctx *bmdModifier, clone *bucketMD) (err error) {
-	bck := ctx.bcks[0]
+	var (
+		bck = ctx.bcks[0]
+		bid = ctx.setProps.BID
+	)
 	added := clone.add(bck, ctx.setProps)
 	if !added {
 		err = cmn.NewErrBckAlreadyExists(bck.Bucket())
+	} else if ctx.msg.Action == apc.ActUndeleteBck {
+		// restoring destroyed bucket: its content (metadata) refers to the original BID
+		debug.Assert(bid != 0)
+		bck.Props.BID = bid
 	}
 	return
 }
@@ -388,7 +395,7 @@ func (p *proxy) makeNCopies(msg *apc.ActMsg, bck *meta.Bck) (string, error) {
 	// 4. IC
 	nl := xact.NewXactNL(c.uuid, msg.Action, &c.smap.Smap, nil, bck.Bucket())
 	nl.SetOwner(equalIC)
-	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
+	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query, user: c.msg.User})
 
 	// 5. commit
 	xid, _, errCommit := c.commit(bck, c.cmtTout(waitmsync))
@@ -491,7 +498,7 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 		action := cos.Ternary(ctx.needReEC, apc.ActECEncode, apc.ActMakeNCopies)
 		nl := xact.NewXactNL(c.uuid, action, &c.smap.Smap, nil, bck.Bucket())
 		nl.SetOwner(equalIC)
-		p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
+		p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query, user: c.msg.User})
 	}
 
 	// 5. commit
@@ -516,6 +523,7 @@ func (p *proxy) bmodSetProps(ctx *bmdModifier, clone *bucketMD) (err error) {
 	ctx.needReMirror = _reMirror(bprops, ctx.setProps)
 	targetCnt, ctx.needReEC = _reEC(bprops, ctx.setProps, bck, p.owner.smap.get())
 	debug.Assert(!ctx.needReEC || ctx.setProps.Validate(targetCnt) == nil)
+	ctx.setProps.Snaps = bprops.Snaps // (not settable via props - see createSnap)
 	clone.set(bck, ctx.setProps)
 	return nil
 }
@@ -564,7 +572,7 @@ func (p *proxy) renameBucket(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg) (xid str
 	// add success/abort cleanup via notifications
 	r := &_brenameFinalizer{p, bckTo}
 	nl.F = r.cb
-	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
+	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query, user: c.msg.User})
 
 	// 5. commit
 	c.req.Body = cos.MustMarshal(c.msg)
@@ -631,7 +639,7 @@ func (p *proxy) tcb(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg, dryRun bool) (str
 	// (also, note immediate cleanup below on failure to commit)
 	r := &_tcbfin{p, bckTo, existsTo}
 	nl.F = r.cb
-	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
+	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query, user: c.msg.User})
 
 	// 5. commit
 	xid, _, errV := c.commit(bckFrom, c.cmtTout(waitmsync))
@@ -771,7 +779,7 @@ func (p *proxy) ecEncode(bck *meta.Bck, msg *apc.ActMsg) (string, error) {
 	// 5. IC
 	nl := xact.NewXactNL(c.uuid, msg.Action, &c.smap.Smap, nil, bck.Bucket())
 	nl.SetOwner(equalIC)
-	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
+	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query, user: c.msg.User})
 
 	// 6. commit
 	xid, _, err := c.commit(bck, c.cmtTout(waitmsync))
@@ -961,7 +969,7 @@ func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode) (string
 		// IC
 		nl := xact.NewXactNL(c.uuid, msg.Action, &c.smap.Smap, nil, bck.Bucket())
 		nl.SetOwner(equalIC)
-		p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
+		p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query, user: c.msg.User})
 	}
 
 	// commit
@@ -1260,7 +1268,7 @@ func (p *proxy) etlInitTxn(initMsg etl.InitMsg, xid, secret string) (string, etl
 	nl.F = ef.cb
 
 	nl.SetOwner(equalIC)
-	p.ic.registerEqual(regIC{nl: nl, smap: smap, query: c.req.Query})
+	p.ic.registerEqual(regIC{nl: nl, smap: smap, query: c.req.Query, user: c.msg.User})
 
 	// 3. begin - broadcast initMsg, xid, secret to targets and collect their pod info
 	podMap, err := etlTxnBegin(c, initMsg)
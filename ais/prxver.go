@@ -0,0 +1,68 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// list all versions: current and non-current (see core/lver.go)
+func (p *proxy) lsVersions(bck *meta.Bck, lsmsg *apc.LsoMsg) (*cmn.LsoRes, error) {
+	if err := _checkVerBck(bck); err != nil {
+		return nil, err
+	}
+	if lsmsg.UUID == "" {
+		lsmsg.UUID = cos.GenUUID()
+	}
+	return p.lsObjsA(bck, lsmsg)
+}
+
+// (compare w/ concatLso and finLsoA)
+// merge per-target pages: keep all versions of the same object on the same page
+func concatLsoVer(lists []*cmn.LsoRes, lsmsg *apc.LsoMsg) *cmn.LsoRes {
+	page := &cmn.LsoRes{UUID: lsmsg.UUID}
+	for _, l := range lists {
+		page.Entries = append(page.Entries, l.Entries...)
+	}
+	slices.SortStableFunc(page.Entries, func(a, b *cmn.LsoEnt) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return cmpVer(b.Version, a.Version) // newest first
+	})
+	var (
+		cnt      int
+		pageSize = int(lsmsg.PageSize)
+	)
+	for i, en := range page.Entries {
+		if i > 0 && page.Entries[i-1].Name == en.Name {
+			continue
+		}
+		cnt++
+		if cnt > pageSize {
+			clear(page.Entries[i:])
+			page.Entries = page.Entries[:i]
+			break
+		}
+	}
+	if cnt >= pageSize && len(page.Entries) > 0 {
+		page.ContinuationToken = page.Entries[len(page.Entries)-1].Name
+	}
+	return page
+}
+
+// numeric versions
+func cmpVer(a, b string) int {
+	if c := len(a) - len(b); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
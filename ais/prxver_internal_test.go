@@ -0,0 +1,36 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestConcatLsoVer(t *testing.T) {
+	ent := func(name, ver string) *cmn.LsoEnt { return &cmn.LsoEnt{Name: name, Version: ver} }
+	lists := []*cmn.LsoRes{
+		{Entries: cmn.LsoEntries{ent("a", "2"), ent("a", "10"), ent("c", "1")}},
+		{Entries: cmn.LsoEntries{ent("b", "1"), ent("a", "9"), ent("b", "3")}},
+	}
+
+	// all versions of the same object stay on the same page, newest first
+	page := concatLsoVer(lists, &apc.LsoMsg{PageSize: 2})
+	expected := []string{"a/10", "a/9", "a/2", "b/3", "b/1"}
+	tassert.Fatalf(t, len(page.Entries) == len(expected), "expected %d entries, got %d", len(expected), len(page.Entries))
+	for i, en := range page.Entries {
+		got := en.Name + "/" + en.Version
+		tassert.Errorf(t, got == expected[i], "entry %d: got %q, expected %q", i, got, expected[i])
+	}
+	tassert.Errorf(t, page.ContinuationToken == "b", "expected continuation token %q, got %q", "b", page.ContinuationToken)
+
+	// last page
+	page = concatLsoVer(lists, &apc.LsoMsg{PageSize: 10})
+	tassert.Errorf(t, len(page.Entries) == 6, "expected 6 entries, got %d", len(page.Entries))
+	tassert.Errorf(t, page.ContinuationToken == "", "expected no continuation token, got %q", page.ContinuationToken)
+}
@@ -653,6 +653,9 @@ func (p *proxy) _becomeFinal(ctx *smapModifier, clone *smapX) {
 		pairs = append(pairs, revsPair{etl, msg})
 		nlog.Infof("%s: plus %s", p, etl)
 	}
+	if jsched := p.jsched.owner.get(); jsched.version() > 0 {
+		pairs = append(pairs, revsPair{jsched, msg})
+	}
 	// metasync
 	debug.Assert(clone._sgl != nil)
 	_ = p.metasyncer.sync(pairs...)
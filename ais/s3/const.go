@@ -23,6 +23,12 @@ const (
 	QparamStartAfter        = "start-after"        // Start listing after this object key
 	QparamDelimiter         = "delimiter"          // Delimiter for grouping object keys
 
+	// object versions (ais:// buckets with version history - see `versioning.max_history`)
+	QparamVersionID = "versionId"  // GET, HEAD, or DELETE a given object version
+	QparamVersions  = "versions"   // list object versions
+	QparamKeyMarker = "key-marker" // list object versions starting after this object key
+	VersionNull     = "null"       // (S3) version ID of an unversioned object - same as no version ID
+
 	// multipart
 	QparamMptUploads        = "uploads"    // Start multipart upload or list active uploads
 	QparamMptUploadID       = "uploadId"   // Complete, abort, or list parts of specific multipart upload
@@ -70,6 +70,7 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
 	var (
 		out       Error
 		in        *cmn.ErrHTTP
+		esig      *errSigV4
 		ok        bool
 		allocated bool
 	)
@@ -85,6 +86,8 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
 		out.Code = "NoSuchBucket"
 	case isErrNoSuchUpload(err):
 		out.Code = "NoSuchUpload"
+	case errors.As(err, &esig):
+		out.Code = esig.code
 	case in.TypeCode != "":
 		out.Code = in.TypeCode
 	default:
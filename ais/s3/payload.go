@@ -0,0 +1,233 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Verification of the SigV4-signed payload (see VerifySigV4):
+// - hashReader: sha256 of the body must match the declared `X-Amz-Content-Sha256`;
+//   the mismatch is reported in place of io.EOF, which fails the (streaming) PUT
+//   prior to commit;
+// - chunkReader: decodes aws-chunked content (STREAMING-AWS4-HMAC-SHA256-PAYLOAD)
+//   while verifying each chunk's signature, chained off the request's (seed) signature;
+//   a chunk is released to the reader only after it has been verified.
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-streaming.html
+
+const (
+	sigV4Payload    = "AWS4-HMAC-SHA256-PAYLOAD"
+	chunkSigPrefix  = ";chunk-signature="
+	maxChunkSize    = 16 * cos.MiB
+	maxChunkHdrSize = 128
+	awsChunked      = "aws-chunked"
+	hdrEncoding     = "Content-Encoding"
+	emptySHA256     = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+type (
+	hashReader struct {
+		body     io.ReadCloser
+		h        hash.Hash
+		expected string
+		akid     string
+	}
+	chunkReader struct {
+		body    io.ReadCloser
+		br      *bufio.Reader
+		key     []byte
+		prefix  string // sigV4Payload + amzDate + scope
+		prevSig string
+		akid    string
+		buf     []byte
+		off     int
+		err     error
+		last    bool
+	}
+)
+
+// interface guard
+var (
+	_ io.ReadCloser = (*hashReader)(nil)
+	_ io.ReadCloser = (*chunkReader)(nil)
+)
+
+// whether the request body is being verified against the signed payload
+// (and therefore must be read by this node rather than redirected)
+func IsVerifiedPayload(body io.Reader) bool {
+	switch body.(type) {
+	case *hashReader, *chunkReader:
+		return true
+	default:
+		return false
+	}
+}
+
+func hasBody(r *http.Request) bool {
+	return r.Body != nil && r.Body != http.NoBody && r.ContentLength != 0
+}
+
+func (sig *sigV4) wrapBody(r *http.Request, key []byte, scope string, unsignedOK bool) error {
+	switch sig.payloadHash {
+	case UnsignedPayload:
+		if hasBody(r) && !unsignedOK {
+			return newErrSigV4("AccessDenied", "%s is not permitted (access key %q)", UnsignedPayload, sig.akid)
+		}
+		return nil
+	case StreamingPayload:
+		decoded, err := strconv.ParseInt(r.Header.Get(HeaderDecodedLength), 10, 64)
+		if err != nil || decoded < 0 {
+			return newErrSigV4("MissingContentLength", "invalid or missing %s (access key %q)", HeaderDecodedLength, sig.akid)
+		}
+		r.Body = &chunkReader{
+			body:    r.Body,
+			br:      bufio.NewReaderSize(r.Body, 64*cos.KiB),
+			key:     key,
+			prefix:  sigV4Payload + "\n" + sig.amzDate + "\n" + scope + "\n",
+			prevSig: sig.signature,
+			akid:    sig.akid,
+		}
+		// from here on: decoded content
+		r.ContentLength = decoded
+		r.Header.Del(cos.HdrContentLength)
+		r.Header.Del(HeaderDecodedLength)
+		r.Header.Set(HeaderContentSHA256, UnsignedPayload)
+		if enc := r.Header.Get(hdrEncoding); enc != "" {
+			var encs []string
+			for e := range strings.SplitSeq(enc, ",") {
+				if e = strings.TrimSpace(e); e != "" && e != awsChunked {
+					encs = append(encs, e)
+				}
+			}
+			if len(encs) == 0 {
+				r.Header.Del(hdrEncoding)
+			} else {
+				r.Header.Set(hdrEncoding, strings.Join(encs, ","))
+			}
+		}
+		return nil
+	}
+
+	if _, err := hex.DecodeString(sig.payloadHash); err != nil || len(sig.payloadHash) != sha256.Size*2 {
+		if strings.HasPrefix(sig.payloadHash, "STREAMING-") {
+			return newErrSigV4("NotImplemented", "payload %q is not supported (access key %q)", sig.payloadHash, sig.akid)
+		}
+		return newErrSigV4("InvalidArgument", "invalid %s %q", HeaderContentSHA256, sig.payloadHash)
+	}
+	if !hasBody(r) {
+		if sig.payloadHash != emptySHA256 {
+			return newErrSigV4("XAmzContentSHA256Mismatch", "%s does not match empty payload (access key %q)",
+				HeaderContentSHA256, sig.akid)
+		}
+		return nil
+	}
+	r.Body = &hashReader{body: r.Body, h: sha256.New(), expected: sig.payloadHash, akid: sig.akid}
+	return nil
+}
+
+////////////////
+// hashReader //
+////////////////
+
+func (hr *hashReader) Read(p []byte) (int, error) {
+	n, err := hr.body.Read(p)
+	hr.h.Write(p[:n])
+	if err == io.EOF {
+		if computed := hex.EncodeToString(hr.h.Sum(nil)); computed != hr.expected {
+			err = newErrSigV4("XAmzContentSHA256Mismatch", "payload sha256 %s does not match declared %s (access key %q)",
+				computed, hr.expected, hr.akid)
+		}
+	}
+	return n, err
+}
+
+func (hr *hashReader) Close() error { return hr.body.Close() }
+
+/////////////////
+// chunkReader //
+/////////////////
+
+func (cr *chunkReader) Read(p []byte) (int, error) {
+	for cr.off >= len(cr.buf) {
+		if cr.err != nil {
+			return 0, cr.err
+		}
+		if cr.last {
+			cr.err = io.EOF
+			return 0, io.EOF
+		}
+		if err := cr.next(); err != nil {
+			cr.err = err
+			return 0, err
+		}
+	}
+	n := copy(p, cr.buf[cr.off:])
+	cr.off += n
+	return n, nil
+}
+
+// read and verify the next chunk: "<hex size>;chunk-signature=<sig>\r\n<data>\r\n"
+func (cr *chunkReader) next() error {
+	line, err := cr.br.ReadSlice('\n')
+	if err != nil {
+		if err == io.EOF || errors.Is(err, bufio.ErrBufferFull) {
+			err = cr.malformed("truncated or oversized chunk header")
+		}
+		return err
+	}
+	if len(line) > maxChunkHdrSize || !bytes.HasSuffix(line, []byte("\r\n")) {
+		return cr.malformed("invalid chunk header")
+	}
+	hdr := string(line[:len(line)-2])
+	ssize, chunkSig, ok := strings.Cut(hdr, chunkSigPrefix)
+	if !ok || len(chunkSig) != sha256.Size*2 {
+		return cr.malformed("missing chunk signature")
+	}
+	size, err := strconv.ParseInt(ssize, 16, 64)
+	if err != nil || size < 0 || size > maxChunkSize {
+		return cr.malformed("invalid chunk size")
+	}
+	if int64(cap(cr.buf)) < size {
+		cr.buf = make([]byte, size)
+	}
+	cr.buf, cr.off = cr.buf[:size], 0
+	if _, err := io.ReadFull(cr.br, cr.buf); err != nil {
+		return cr.malformed("truncated chunk")
+	}
+	var crlf [2]byte
+	if _, err := io.ReadFull(cr.br, crlf[:]); err != nil || crlf[0] != '\r' || crlf[1] != '\n' {
+		return cr.malformed("invalid chunk trailer")
+	}
+
+	sum := sha256.Sum256(cr.buf)
+	sts := cr.prefix + cr.prevSig + "\n" + emptySHA256 + "\n" + hex.EncodeToString(sum[:])
+	expected := hex.EncodeToString(hmacSHA256(cr.key, sts))
+	if !hmac.Equal(cos.UnsafeB(expected), cos.UnsafeB(chunkSig)) {
+		cr.buf = cr.buf[:0]
+		return newErrSigV4("SignatureDoesNotMatch", "chunk signature does not match (access key %q)", cr.akid)
+	}
+	cr.prevSig = chunkSig
+	cr.last = size == 0
+	return nil
+}
+
+func (cr *chunkReader) malformed(what string) error {
+	return newErrSigV4("IncompleteBody", "aws-chunked payload: %s (access key %q)", what, cr.akid)
+}
+
+func (cr *chunkReader) Close() error { return cr.body.Close() }
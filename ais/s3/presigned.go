@@ -98,6 +98,28 @@ func parseSignatureV4(query url.Values, header http.Header) (region string) {
 	return region
 }
 
+// AccessKeyID returns the (unverified) access key ID of the request, if any:
+// SigV4 (header or presigned query) and SigV2 (ditto)
+func AccessKeyID(query url.Values, header http.Header) string {
+	credentials := query.Get(HeaderCredentials)
+	if credentials == "" {
+		authorization := header.Get(apc.HdrAuthorization)
+		switch {
+		case strings.HasPrefix(authorization, signatureV4):
+			authorization = strings.TrimSpace(strings.TrimPrefix(authorization, signatureV4))
+			credentials = strings.Split(authorization, ", ")[0]
+		case strings.HasPrefix(authorization, "AWS "):
+			akid, _, _ := strings.Cut(strings.TrimPrefix(authorization, "AWS "), ":")
+			return akid
+		default:
+			return query.Get(QparamAccessKeyID)
+		}
+	}
+	credentials = strings.TrimPrefix(strings.TrimSpace(credentials), "Credential=")
+	akid, _, _ := strings.Cut(credentials, "/")
+	return akid
+}
+
 // See https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
 func parseCredentialHeader(hdr string) (region string) {
 	credentials := strings.TrimPrefix(strings.TrimSpace(hdr), "Credential=")
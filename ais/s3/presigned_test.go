@@ -6,6 +6,8 @@ package s3 //nolint:testpackage // We use private functions here...
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -38,4 +40,21 @@ var _ = Describe("Presigned", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	DescribeTable("AccessKeyID", func(query url.Values, authorization, expected string) {
+		header := http.Header{}
+		if authorization != "" {
+			header.Set("Authorization", authorization)
+		}
+		Expect(AccessKeyID(query, header)).To(Equal(expected))
+	},
+		Entry("sigv4 header", url.Values{},
+			"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20260101/us-east-1/s3/aws4_request, SignedHeaders=host, Signature=abc",
+			"AKIDEXAMPLE"),
+		Entry("sigv4 presigned", url.Values{HeaderCredentials: {"AKIDEXAMPLE/20260101/us-east-1/s3/aws4_request"}},
+			"", "AKIDEXAMPLE"),
+		Entry("sigv2 header", url.Values{}, "AWS AKIDEXAMPLE:c2lnbmF0dXJl", "AKIDEXAMPLE"),
+		Entry("sigv2 presigned", url.Values{QparamAccessKeyID: {"AKIDEXAMPLE"}}, "", "AKIDEXAMPLE"),
+		Entry("anonymous", url.Values{}, "", ""),
+	)
 })
@@ -0,0 +1,293 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// SigV4 verification of the requests signed with AuthN-issued access keys.
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+//
+// The payload is verified as well (see payload.go):
+// - declared sha256 (`X-Amz-Content-Sha256`) against the body;
+// - aws-chunked streaming uploads - chunk by chunk, against chained chunk signatures;
+// - UNSIGNED-PAYLOAD with a non-empty body is accepted only when explicitly permitted
+//   (feature flag S3-Unsigned-Payload).
+
+const (
+	QparamAlgorithm     = "X-Amz-Algorithm"
+	QparamDate          = "X-Amz-Date"
+	QparamSignedHeaders = "X-Amz-SignedHeaders"
+	QparamSigV4         = "X-Amz-Signature"
+	QparamExpiresV4     = "X-Amz-Expires"
+
+	HeaderDate          = "X-Amz-Date"
+	HeaderContentSHA256 = "X-Amz-Content-Sha256"
+	HeaderDecodedLength = "X-Amz-Decoded-Content-Length"
+
+	UnsignedPayload  = "UNSIGNED-PAYLOAD"
+	StreamingPayload = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+)
+
+const (
+	sigV4Terminator = "aws4_request"
+	sigV4DateFormat = "20060102T150405Z"
+	sigV4MaxSkew    = 15 * time.Minute
+	sigV4MaxExpires = 7 * 24 * time.Hour
+)
+
+type (
+	sigV4 struct {
+		akid          string
+		date          string // yyyymmdd (credential scope)
+		region        string
+		service       string
+		amzDate       string
+		signature     string
+		payloadHash   string
+		signedHeaders []string
+		expires       time.Duration // presigned only
+		presigned     bool
+	}
+	errSigV4 struct {
+		code string
+		msg  string
+	}
+)
+
+func (e *errSigV4) Error() string { return e.msg }
+
+func newErrSigV4(code, format string, a ...any) error {
+	return &errSigV4{code: code, msg: fmt.Sprintf(format, a...)}
+}
+
+// VerifySigV4 validates SigV4 signature of the request given the signer's secret;
+// must be called prior to any modification of the request's URL.
+// Upon success, the request body (if any) is wrapped to verify the signed payload
+// as it's being read (see IsVerifiedPayload).
+func VerifySigV4(r *http.Request, secret string, now time.Time, unsignedOK bool) error {
+	sig, err := parseSigV4(r)
+	if err != nil {
+		return err
+	}
+	t, err := time.Parse(sigV4DateFormat, sig.amzDate)
+	if err != nil {
+		return newErrSigV4("AuthorizationHeaderMalformed", "invalid SigV4 date %q", sig.amzDate)
+	}
+	if t.Format("20060102") != sig.date {
+		return newErrSigV4("AuthorizationHeaderMalformed", "SigV4 date %q does not match credential scope %q", sig.amzDate, sig.date)
+	}
+	if sig.presigned {
+		if now.Before(t.Add(-sigV4MaxSkew)) {
+			return newErrSigV4("AccessDenied", "presigned request is not yet valid (%s)", sig.amzDate)
+		}
+		if now.After(t.Add(sig.expires)) {
+			return newErrSigV4("AccessDenied", "presigned request has expired (%s + %v)", sig.amzDate, sig.expires)
+		}
+	} else if d := now.Sub(t); d > sigV4MaxSkew || d < -sigV4MaxSkew {
+		return newErrSigV4("RequestTimeTooSkewed", "request time %s is too skewed (max %v)", sig.amzDate, sigV4MaxSkew)
+	}
+
+	var (
+		creq  = canonicalRequest(r, sig)
+		hash  = sha256.Sum256(cos.UnsafeB(creq))
+		scope = sig.date + "/" + sig.region + "/" + sig.service + "/" + sigV4Terminator
+		sts   = signatureV4 + "\n" + sig.amzDate + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+	)
+	key := hmacSHA256([]byte("AWS4"+secret), sig.date)
+	key = hmacSHA256(key, sig.region)
+	key = hmacSHA256(key, sig.service)
+	key = hmacSHA256(key, sigV4Terminator)
+	expected := hex.EncodeToString(hmacSHA256(key, sts))
+	if !hmac.Equal(cos.UnsafeB(expected), cos.UnsafeB(sig.signature)) {
+		return newErrSigV4("SignatureDoesNotMatch", "SigV4 signature does not match (access key %q)", sig.akid)
+	}
+	return sig.wrapBody(r, key, scope, unsignedOK)
+}
+
+func parseSigV4(r *http.Request) (*sigV4, error) {
+	var (
+		sig           = &sigV4{}
+		credential    string
+		signedHeaders string
+		query         = r.URL.Query()
+	)
+	if query.Get(QparamAlgorithm) == signatureV4 {
+		sig.presigned = true
+		credential = query.Get(HeaderCredentials)
+		signedHeaders = query.Get(QparamSignedHeaders)
+		sig.signature = query.Get(QparamSigV4)
+		sig.amzDate = query.Get(QparamDate)
+		sig.payloadHash = UnsignedPayload
+		secs, err := strconv.ParseInt(query.Get(QparamExpiresV4), 10, 64)
+		if err != nil || secs <= 0 || time.Duration(secs)*time.Second > sigV4MaxExpires {
+			return nil, newErrSigV4("AuthorizationQueryParametersError", "invalid %s %q", QparamExpiresV4, query.Get(QparamExpiresV4))
+		}
+		sig.expires = time.Duration(secs) * time.Second
+	} else {
+		authorization := r.Header.Get(apc.HdrAuthorization)
+		if !strings.HasPrefix(authorization, signatureV4) {
+			return nil, newErrSigV4("AccessDenied", "request is not signed with %s", signatureV4)
+		}
+		for part := range strings.SplitSeq(strings.TrimPrefix(authorization, signatureV4), ",") {
+			k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+			switch k {
+			case "Credential":
+				credential = v
+			case "SignedHeaders":
+				signedHeaders = v
+			case "Signature":
+				sig.signature = v
+			}
+		}
+		sig.amzDate = r.Header.Get(HeaderDate)
+		if sig.amzDate == "" {
+			if t, err := http.ParseTime(r.Header.Get("Date")); err == nil {
+				sig.amzDate = t.UTC().Format(sigV4DateFormat)
+			}
+		}
+		sig.payloadHash = r.Header.Get(HeaderContentSHA256)
+		if sig.payloadHash == "" {
+			return nil, newErrSigV4("InvalidRequest", "missing required header %s", HeaderContentSHA256)
+		}
+	}
+
+	// credential: <access-key-id>/<yyyymmdd>/<region>/<service>/aws4_request
+	parts := strings.Split(credential, "/")
+	if len(parts) != 5 || parts[4] != sigV4Terminator || parts[0] == "" {
+		return nil, newErrSigV4("AuthorizationHeaderMalformed", "invalid SigV4 credential %q", credential)
+	}
+	sig.akid, sig.date, sig.region, sig.service = parts[0], parts[1], parts[2], parts[3]
+	if signedHeaders == "" || sig.signature == "" || sig.amzDate == "" {
+		return nil, newErrSigV4("AuthorizationHeaderMalformed", "incomplete SigV4 signature (access key %q)", sig.akid)
+	}
+	sig.signedHeaders = strings.Split(signedHeaders, ";")
+	return sig, nil
+}
+
+func canonicalRequest(r *http.Request, sig *sigV4) string {
+	var sb strings.Builder
+	sb.Grow(256)
+	sb.WriteString(r.Method)
+	sb.WriteByte('\n')
+	path := r.URL.Path
+	if path == "" {
+		path = "/"
+	}
+	sb.WriteString(uriEncode(path, false))
+	sb.WriteByte('\n')
+	sb.WriteString(canonicalQuery(r.URL.RawQuery, sig.presigned))
+	sb.WriteByte('\n')
+	for _, h := range sig.signedHeaders {
+		sb.WriteString(h)
+		sb.WriteByte(':')
+		sb.WriteString(canonicalHeader(r, h))
+		sb.WriteByte('\n')
+	}
+	sb.WriteByte('\n')
+	sb.WriteString(strings.Join(sig.signedHeaders, ";"))
+	sb.WriteByte('\n')
+	sb.WriteString(sig.payloadHash)
+	return sb.String()
+}
+
+func canonicalQuery(rawQuery string, presigned bool) string {
+	if rawQuery == "" {
+		return ""
+	}
+	type kv struct{ k, v string }
+	params := make([]kv, 0, 8)
+	for pair := range strings.SplitSeq(rawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+		k, v, _ := strings.Cut(pair, "=")
+		if uk, err := url.QueryUnescape(k); err == nil {
+			k = uk
+		}
+		if presigned && k == QparamSigV4 {
+			continue
+		}
+		if uv, err := url.QueryUnescape(v); err == nil {
+			v = uv
+		}
+		params = append(params, kv{uriEncode(k, true), uriEncode(v, true)})
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i].k != params[j].k {
+			return params[i].k < params[j].k
+		}
+		return params[i].v < params[j].v
+	})
+	var sb strings.Builder
+	for i, p := range params {
+		if i > 0 {
+			sb.WriteByte('&')
+		}
+		sb.WriteString(p.k)
+		sb.WriteByte('=')
+		sb.WriteString(p.v)
+	}
+	return sb.String()
+}
+
+func canonicalHeader(r *http.Request, name string) string {
+	var values []string
+	switch name {
+	case "host":
+		values = []string{r.Host}
+	case "content-length":
+		values = r.Header.Values(cos.HdrContentLength)
+		if len(values) == 0 && r.ContentLength >= 0 {
+			values = []string{strconv.FormatInt(r.ContentLength, 10)}
+		}
+	default:
+		values = r.Header.Values(name)
+	}
+	for i, v := range values {
+		values[i] = strings.Join(strings.Fields(v), " ") // trim and collapse spaces
+	}
+	return strings.Join(values, ",")
+}
+
+// URI-encode as per AWS: everything except unreserved characters (and, optionally, '/')
+func uriEncode(s string, encodeSlash bool) string {
+	const hexUpper = "0123456789ABCDEF"
+	var sb strings.Builder
+	sb.Grow(len(s) + 8)
+	for i := range len(s) {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(hexUpper[c>>4])
+		sb.WriteByte(hexUpper[c&0xf])
+	}
+	return sb.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(cos.UnsafeB(data))
+	return h.Sum(nil)
+}
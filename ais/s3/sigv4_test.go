@@ -0,0 +1,195 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SigV4", func() {
+	const (
+		akid    = "AISKEYEXAMPLE"
+		secret  = "wJalrXUtnFEMIK7MDENGbPxRfiCYEXAMPLEKEY"
+		region  = "us-east-1"
+		urlPath = "http://localhost:8080/s3/bucket/dir/object%20name.txt"
+		// sha256 of an empty payload
+		emptyHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	)
+	var (
+		creds = aws.Credentials{AccessKeyID: akid, SecretAccessKey: secret}
+		now   = time.Now()
+	)
+
+	sign := func(method, u string, signingTime time.Time) *http.Request {
+		req, err := http.NewRequestWithContext(context.Background(), method, u, http.NoBody)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set(s3.HeaderContentSHA256, emptyHash)
+		req.Header.Set("Range", "bytes=0-9")
+		err = v4.NewSigner(func(o *v4.SignerOptions) { o.DisableURIPathEscaping = true }).
+			SignHTTP(context.Background(), creds, req, emptyHash, "s3", region, signingTime)
+		Expect(err).NotTo(HaveOccurred())
+		return req
+	}
+	presign := func(method, u string, signingTime time.Time, expires time.Duration) *http.Request {
+		q := "?" + s3.QparamExpiresV4 + "=" + strconv.Itoa(int(expires/time.Second))
+		req, err := http.NewRequestWithContext(context.Background(), method, u+q, http.NoBody)
+		Expect(err).NotTo(HaveOccurred())
+		signed, _, err := v4.NewSigner(func(o *v4.SignerOptions) { o.DisableURIPathEscaping = true }).
+			PresignHTTP(context.Background(), creds, req, s3.UnsignedPayload, "s3", region, signingTime)
+		Expect(err).NotTo(HaveOccurred())
+		preq, err := http.NewRequestWithContext(context.Background(), method, signed, http.NoBody)
+		Expect(err).NotTo(HaveOccurred())
+		return preq
+	}
+
+	Describe("header", func() {
+		It("should verify valid signature", func() {
+			req := sign(http.MethodGet, urlPath+"?list-type=2&prefix=a%2Fb", now)
+			Expect(s3.AccessKeyID(req.URL.Query(), req.Header)).To(Equal(akid))
+			Expect(s3.VerifySigV4(req, secret, now, false)).To(Succeed())
+		})
+		It("should fail with wrong secret", func() {
+			req := sign(http.MethodGet, urlPath, now)
+			Expect(s3.VerifySigV4(req, secret+"x", now, false)).NotTo(Succeed())
+		})
+		It("should fail when request is modified", func() {
+			req := sign(http.MethodGet, urlPath, now)
+			req.Header.Set("Range", "bytes=0-99")
+			Expect(s3.VerifySigV4(req, secret, now, false)).NotTo(Succeed())
+
+			req = sign(http.MethodGet, urlPath, now)
+			req.Method = http.MethodDelete
+			Expect(s3.VerifySigV4(req, secret, now, false)).NotTo(Succeed())
+		})
+		It("should fail when skewed", func() {
+			req := sign(http.MethodGet, urlPath, now.Add(-time.Hour))
+			Expect(s3.VerifySigV4(req, secret, now, false)).NotTo(Succeed())
+		})
+	})
+
+	Describe("presigned", func() {
+		It("should verify valid signature", func() {
+			req := presign(http.MethodGet, urlPath, now, time.Hour)
+			Expect(s3.AccessKeyID(req.URL.Query(), req.Header)).To(Equal(akid))
+			Expect(s3.VerifySigV4(req, secret, now.Add(time.Minute), false)).To(Succeed())
+		})
+		It("should fail when expired", func() {
+			req := presign(http.MethodGet, urlPath, now, time.Hour)
+			Expect(s3.VerifySigV4(req, secret, now.Add(2*time.Hour), false)).NotTo(Succeed())
+		})
+		It("should fail with wrong secret", func() {
+			req := presign(http.MethodPut, urlPath, now, time.Hour)
+			Expect(s3.VerifySigV4(req, "x"+secret, now, false)).NotTo(Succeed())
+		})
+	})
+
+	Describe("payload", func() {
+		const payload = "The quick brown fox jumps over the lazy dog"
+
+		signPut := func(body, payloadHash string) *http.Request {
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, urlPath, strings.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set(s3.HeaderContentSHA256, payloadHash)
+			err = v4.NewSigner(func(o *v4.SignerOptions) { o.DisableURIPathEscaping = true }).
+				SignHTTP(context.Background(), creds, req, payloadHash, "s3", region, now)
+			Expect(err).NotTo(HaveOccurred())
+			return req
+		}
+		sha := func(s string) string {
+			sum := sha256.Sum256([]byte(s))
+			return hex.EncodeToString(sum[:])
+		}
+		// aws-chunked, with chained chunk signatures
+		signStreaming := func(chunks []string) *http.Request {
+			var size int
+			for _, c := range chunks {
+				size += len(c)
+			}
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, urlPath, http.NoBody)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set(s3.HeaderContentSHA256, s3.StreamingPayload)
+			req.Header.Set(s3.HeaderDecodedLength, strconv.Itoa(size))
+			req.Header.Set("Content-Encoding", "aws-chunked")
+			err = v4.NewSigner(func(o *v4.SignerOptions) { o.DisableURIPathEscaping = true }).
+				SignHTTP(context.Background(), creds, req, s3.StreamingPayload, "s3", region, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			auth := req.Header.Get("Authorization")
+			seed, err := hex.DecodeString(auth[strings.LastIndex(auth, "Signature=")+len("Signature="):])
+			Expect(err).NotTo(HaveOccurred())
+			var (
+				sb     strings.Builder
+				signer = v4.NewStreamSigner(creds, "s3", region, seed)
+			)
+			for _, c := range append(chunks, "") {
+				sig, err := signer.GetSignature(context.Background(), nil, []byte(c), now)
+				Expect(err).NotTo(HaveOccurred())
+				sb.WriteString(strconv.FormatInt(int64(len(c)), 16) + ";chunk-signature=" + hex.EncodeToString(sig) + "\r\n")
+				sb.WriteString(c + "\r\n")
+			}
+			req.Body = io.NopCloser(strings.NewReader(sb.String()))
+			req.ContentLength = int64(sb.Len())
+			return req
+		}
+
+		It("should verify payload hash", func() {
+			req := signPut(payload, sha(payload))
+			Expect(s3.VerifySigV4(req, secret, now, false)).To(Succeed())
+			Expect(s3.IsVerifiedPayload(req.Body)).To(BeTrue())
+			b, err := io.ReadAll(req.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal(payload))
+		})
+		It("should fail when payload does not match", func() {
+			req := signPut(payload, sha(payload))
+			req.Body = io.NopCloser(strings.NewReader(payload + "!"))
+			Expect(s3.VerifySigV4(req, secret, now, false)).To(Succeed())
+			_, err := io.ReadAll(req.Body)
+			Expect(err).To(HaveOccurred())
+		})
+		It("should require explicit opt-in for unsigned payload", func() {
+			req := signPut(payload, s3.UnsignedPayload)
+			Expect(s3.VerifySigV4(req, secret, now, false)).NotTo(Succeed())
+			req = signPut(payload, s3.UnsignedPayload)
+			Expect(s3.VerifySigV4(req, secret, now, true)).To(Succeed())
+			Expect(s3.IsVerifiedPayload(req.Body)).To(BeFalse())
+		})
+		It("should decode and verify signed chunks", func() {
+			chunks := []string{payload[:10], payload[10:]}
+			req := signStreaming(chunks)
+			Expect(s3.VerifySigV4(req, secret, now, false)).To(Succeed())
+			Expect(req.ContentLength).To(BeEquivalentTo(len(payload)))
+			Expect(req.Header.Get("Content-Encoding")).To(BeEmpty())
+			b, err := io.ReadAll(req.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal(payload))
+		})
+		It("should fail when chunk is modified", func() {
+			req := signStreaming([]string{payload[:10], payload[10:]})
+			b, err := io.ReadAll(req.Body)
+			Expect(err).NotTo(HaveOccurred())
+			req.Body = io.NopCloser(strings.NewReader(strings.Replace(string(b), "lazy", "busy", 1)))
+			Expect(s3.VerifySigV4(req, secret, now, false)).To(Succeed())
+			b, err = io.ReadAll(req.Body)
+			Expect(err).To(HaveOccurred())
+			Expect(string(b)).To(Equal(payload[:10])) // (only verified chunks get released)
+		})
+	})
+})
@@ -51,6 +51,34 @@ type (
 		Prefix string `xml:"Prefix"`
 	}
 
+	// List object versions response (ais:// buckets with version history)
+	ListVersionsResult struct {
+		Name          string           `xml:"Name"`
+		Ns            string           `xml:"xmlns,attr"`
+		Prefix        string           `xml:"Prefix"`
+		KeyMarker     string           `xml:"KeyMarker"`
+		NextKeyMarker string           `xml:"NextKeyMarker,omitempty"`
+		Versions      []*VerInfo       `xml:"Version"`
+		DelMarkers    []*DelMarkerInfo `xml:"DeleteMarker"`
+		MaxKeys       int              `xml:"MaxKeys"`
+		IsTruncated   bool             `xml:"IsTruncated"`
+	}
+	VerInfo struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Class        string `xml:"StorageClass"`
+		Size         int64  `xml:"Size"`
+		IsLatest     bool   `xml:"IsLatest"`
+	}
+	DelMarkerInfo struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		LastModified string `xml:"LastModified"`
+		IsLatest     bool   `xml:"IsLatest"`
+	}
+
 	// Response for object copy request
 	CopyObjectResult struct {
 		LastModified string `xml:"LastModified"` // e.g. <LastModified>2009-10-12T17:50:30.000Z</LastModified>
@@ -189,6 +217,44 @@ func (r *ListObjectResult) FromLsoResult(lst *cmn.LsoRes) {
 	}
 }
 
+func NewListVersionsResult(bucket string, lsmsg *apc.LsoMsg) *ListVersionsResult {
+	return &ListVersionsResult{
+		Name:      bucket,
+		Ns:        s3Namespace,
+		Prefix:    lsmsg.Prefix,
+		KeyMarker: lsmsg.StartAfter,
+		MaxKeys:   int(lsmsg.PageSize),
+	}
+}
+
+func (r *ListVersionsResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// (see apc.LsVerHistory)
+func (r *ListVersionsResult) FromLsoResult(lst *cmn.LsoRes) {
+	r.IsTruncated = lst.ContinuationToken != ""
+	r.NextKeyMarker = lst.ContinuationToken
+	for _, e := range lst.Entries {
+		latest := e.Flags&apc.EntryVerNoncurrent == 0
+		if e.Flags&apc.EntryIsDelMarker != 0 {
+			r.DelMarkers = append(r.DelMarkers, &DelMarkerInfo{Key: e.Name, VersionID: e.Version, LastModified: e.Atime, IsLatest: latest})
+			continue
+		}
+		oi := entryToS3(e)
+		r.Versions = append(r.Versions, &VerInfo{
+			Key:          oi.Key,
+			VersionID:    e.Version,
+			LastModified: oi.LastModified,
+			ETag:         oi.ETag,
+			Size:         oi.Size,
+			IsLatest:     latest,
+		})
+	}
+}
+
 func SetS3Headers(hdr http.Header, lom *core.LOM) {
 	// 1. Last-Modified
 	var (
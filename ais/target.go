@@ -19,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/ais/backend"
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/bsnap"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -40,6 +41,7 @@ import (
 	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
+	"github.com/NVIDIA/aistore/repl"
 	"github.com/NVIDIA/aistore/res"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/transport"
@@ -70,7 +72,9 @@ type (
 		res      *res.Res
 		fsprg    fsprungroup
 		txns     txns
+		tenants  ttenants // per-tenant capacity accounting (see tgttenant.go)
 		ups      ups
+		mdx      mdidx
 		htrun    // common w/ proxy
 		regstate regstate
 	}
@@ -282,6 +286,7 @@ func (t *target) init(config *cmn.Config) {
 	}
 	newVol := volume.Init(t, config, vini)
 	fs.ComputeDiskSize()
+	t.si.Weight = max(fs.GetDiskSize()>>30, 1) // GiB (weighted HRW)
 
 	t.initHostIP(config)
 	daemon.rg.add(t)
@@ -448,8 +453,11 @@ func (t *target) Run() error {
 		nlog.Errorln(t.String(), "failed to initialize kvdb:", err)
 		return err
 	}
+	t.mdx.init(db)
 
 	t.txns.init(t)
+	t.tenants.init(t)
+	xact.Admit, xact.Unadmit = t.admitXact, t.unadmitXact
 
 	t.reb = reb.New(config)
 	t.res = res.New()
@@ -459,6 +467,8 @@ func (t *target) Run() error {
 
 	ec.Init()
 	mirror.Init()
+	repl.Init()
+	bsnap.Init()
 
 	xreg.RegWithHK()
 
@@ -501,6 +511,18 @@ func (t *target) gojoin(config *cmn.Config) {
 	}
 	t.markClusterStarted()
 
+	// resume shipping replication journals (if any)
+	repl.Resume(t.owner.bmd)
+
+	// activate bucket snapshots; resume building (if interrupted)
+	t.reconcileSnaps(&t.owner.bmd.get().BMD, true /*startup*/)
+
+	// load persisted metadata indexes; build new ones (if any)
+	t.mdx.reconcile(&t.owner.bmd.get().BMD)
+
+	// restart xactions that were paused prior to shutdown (if any)
+	t.restartPaused()
+
 	if t.fsprg.newVol && !config.TestingEnv() {
 		config := cmn.GCO.BeginUpdate()
 		fspathsSave(config)
@@ -548,6 +570,7 @@ func (t *target) runResilver(args *res.Args) {
 	smap := t.owner.smap.get()
 	args.Custom.Smap = &smap.Smap
 	t.res.Run(args, t.statsT)
+	bsnap.Relocated(apc.ActResilver, nil)
 }
 
 func (t *target) endStartupStandby() (err error) {
@@ -576,7 +599,7 @@ func (t *target) initRecvHandlers() {
 	networkHandlers := make([]networkHandler, 0, 18)
 	networkHandlers = append(networkHandlers,
 		networkHandler{r: apc.Buckets, h: t.bucketHandler, net: accessNetAll},
-		networkHandler{r: apc.Objects, h: t.objectHandler, net: accessNetAll},
+		networkHandler{r: apc.Objects, h: t.audited(t.objectHandler), net: accessNetAll},
 		networkHandler{r: apc.Daemon, h: t.daemonHandler, net: accessNetPublicControl},
 		networkHandler{r: apc.Metasync, h: t.metasyncHandler, net: accessNetIntraControl},
 		networkHandler{r: apc.Health, h: t.healthHandler, net: accessNetPublicControl},
@@ -592,7 +615,7 @@ func (t *target) initRecvHandlers() {
 		// machine learning
 		networkHandler{r: apc.ML, h: t.mlHandler, net: accessNetPublicControl},
 
-		networkHandler{r: "/" + apc.S3, h: t.s3Handler, net: accessNetPublicData},
+		networkHandler{r: "/" + apc.S3, h: t.audited(t.s3Handler), net: accessNetPublicData},
 		networkHandler{r: "/", h: t.errURL, net: accessNetAll},
 
 		// plus, PromHandler() at "/metrics" (see ais/htrun)
@@ -726,19 +749,23 @@ func (t *target) objectHandler(w http.ResponseWriter, r *http.Request) {
 			lom := core.AllocLOM(apireq.items[1])
 			t.httpobjput(w, r, apireq, lom)
 			core.FreeLOM(lom)
+			t.pxcWritten(apireq)
 		}
 		apiReqFree(apireq)
 	case http.MethodDelete:
 		apireq := apiReqAlloc(2, apc.URLPathObjects.L, false)
 		t.httpobjdelete(w, r, apireq)
+		t.pxcWritten(apireq)
 		apiReqFree(apireq)
 	case http.MethodPost:
 		apireq := apiReqAlloc(2, apc.URLPathObjects.L, true)
 		t.httpobjpost(w, r, apireq)
+		t.pxcWritten(apireq)
 		apiReqFree(apireq)
 	case http.MethodPatch:
 		apireq := apiReqAlloc(2, apc.URLPathObjects.L, false)
 		t.httpobjpatch(w, r, apireq)
+		t.pxcWritten(apireq)
 		apiReqFree(apireq)
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead,
@@ -746,6 +773,46 @@ func (t *target) objectHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// proxy-side cache: the redirecting proxy evicts the object (and its bucket's listings)
+// upon redirect; notify it when done, to evict again whatever may have been (re)cached in between
+func (t *target) pxcWritten(apireq *apiRequest) {
+	bck := apireq.bck
+	if bck == nil || bck.Props == nil || !bck.Props.ProxyCache.Enabled || len(apireq.items) < 2 {
+		return
+	}
+	var pid string
+	if apireq.dpq != nil {
+		pid = apireq.dpq.sys.pid
+	} else {
+		pid = apireq.query.Get(apc.QparamPID)
+	}
+	smap := t.owner.smap.get()
+	psi := smap.GetProxy(pid)
+	if psi == nil {
+		return
+	}
+	uname := bck.MakeUname(apireq.items[1])
+	go func() {
+		cargs := allocCargs()
+		{
+			cargs.si = psi
+			cargs.req = cmn.HreqArgs{
+				Method: http.MethodPost,
+				Base:   psi.URL(cmn.NetIntraControl),
+				Path:   apc.URLPathNotifs.Join(apc.Written),
+				Body:   uname,
+			}
+			cargs.timeout = cmn.Rom.CplaneOperation()
+		}
+		res := t.call(cargs, smap)
+		if res.err != nil && cmn.Rom.V(4, cos.ModAIS) {
+			nlog.Warningln(t.String(), "failed to notify", psi.StringEx(), "[pxcache]:", res.err)
+		}
+		freeCargs(cargs)
+		freeCR(res)
+	}()
+}
+
 //
 // httpobj* handlers
 //
@@ -796,11 +863,15 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		}
 	}
 
-	// two special flows
+	// special flows
 	switch {
 	case dpq.get(apc.QparamETLName) != "":
 		t.inlineETL(w, r, dpq, lom)
 		return lom, nil
+	case dpq.get(apc.QparamSnap) != "":
+		return lom, t.getSnap(w, lom, dpq.get(apc.QparamSnap))
+	case dpq.get(apc.QparamVersionID) != "":
+		return lom, t.getVersion(w, lom, dpq.get(apc.QparamVersionID))
 	case cos.IsParseBool(r.Header.Get(apc.HdrBlobDownload)):
 		var msg apc.BlobMsg
 		if err := msg.FromHeader(r.Header); err != nil {
@@ -1091,6 +1162,13 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		core.FreeLOM(lom)
 		return
 	}
+	if ver := apireq.query.Get(apc.QparamVersionID); ver != "" {
+		if err := t.delVersion(lom, ver); err != nil {
+			t.writeErr(w, r, err)
+		}
+		core.FreeLOM(lom)
+		return
+	}
 
 	ecode, err := t.DeleteObject(lom, evict)
 	if err == nil && ecode == 0 {
@@ -1274,6 +1352,8 @@ func (t *target) httpobjhead(w http.ResponseWriter, r *http.Request, apireq *api
 
 	lom := core.AllocLOM(apireq.items[1])
 	switch {
+	case apireq.dpq.get(apc.QparamVersionID) != "":
+		ecode, err = t.headVersion(w.Header(), apireq.bck, lom, apireq.dpq.get(apc.QparamVersionID))
 	case apireq.dpq.get(apc.QparamProps) != "":
 		ecode, err = t.objHeadV2(r, w.Header(), apireq.dpq, apireq.bck, lom)
 	default:
@@ -1479,7 +1559,9 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 			lom.SetCustomKey(key, val)
 		}
 	}
-	lom.Persist()
+	if err := lom.Persist(); err == nil {
+		t.mdx.put(lom)
+	}
 }
 
 // called under lock
@@ -1556,6 +1638,10 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	switch {
 	case err == nil:
 		t.statsT.IncWith(stats.DeleteCount, vlabs)
+		t.mdx.del(lom)
+		if !evict {
+			t.replicate(lom, repl.OpDel)
+		}
 	case cos.IsNotExist(err, code) || cmn.IsErrObjNought(err):
 		if !evict {
 			t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
@@ -1662,6 +1748,14 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 	}
 	if delFromAIS {
 		size := lom.Lsize()
+		if !evict {
+			if err := lom.KeepVersion(true /*del*/); err != nil {
+				return 0, err, false
+			}
+			if err := lom.MoveToTrash(); err != nil {
+				return 0, err, false
+			}
+		}
 		aisErr = lom.RemoveObj()
 		if aisErr != nil {
 			if !cos.IsNotExist(aisErr) {
@@ -1719,6 +1813,9 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
 	lom.Lock(true)
 	if err := lom.RemoveObj(); err != nil {
 		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
+	} else {
+		t.mdx.del(lom)
+		t.replicate(lom, repl.OpDel)
 	}
 	lom.Unlock(true)
 	return nil
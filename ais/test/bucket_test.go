@@ -28,6 +28,7 @@ import (
 	"github.com/NVIDIA/aistore/tools/trand"
 	"github.com/NVIDIA/aistore/xact"
 
+	"github.com/parquet-go/parquet-go"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -2952,3 +2953,221 @@ func TestBucketListAndSummary(t *testing.T) {
 		})
 	}
 }
+
+func TestMDSearch(t *testing.T) {
+	var (
+		m = ioContext{
+			t:         t,
+			num:       100,
+			fileSize:  cos.KiB,
+			fixedSize: true,
+			prefix:    "mdsearch",
+			ordered:   true,
+		}
+		proxyURL = tools.RandomProxyURL(t)
+		bp       = tools.BaseAPIParams(proxyURL)
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	tools.CreateBucket(t, proxyURL, m.bck, nil, true /*cleanup*/)
+	m.puts()
+
+	// not enabled
+	_, err := api.SearchObjects(bp, m.bck, &apc.MDQuery{})
+	tassert.Fatalf(t, err != nil, "expected search to fail when md_index is disabled")
+
+	// enable and wait for targets to build the index
+	_, err = api.SetBucketProps(bp, m.bck, &cmn.BpropsToSet{MDIndex: &cmn.MDIndexConfToSet{Enabled: apc.Ptr(true)}})
+	tassert.CheckFatal(t, err)
+
+	search := func(query *apc.MDQuery) apc.MDEntries {
+		for range 30 {
+			entries, err := api.SearchObjects(bp, m.bck, query)
+			if err == nil {
+				return entries
+			}
+			if !cmn.IsStatusServiceUnavailable(err) {
+				tassert.CheckFatal(t, err)
+			}
+			time.Sleep(time.Second)
+		}
+		t.Fatal("timed out waiting for metadata index")
+		return nil
+	}
+
+	entries := search(&apc.MDQuery{Limit: apc.MDSearchMaxLimit})
+	tassert.Fatalf(t, len(entries) == m.num, "expected %d objects, got %d", m.num, len(entries))
+	tassert.Errorf(t, slices.IsSortedFunc(entries, func(a, b *apc.MDEntry) int { return strings.Compare(a.Name, b.Name) }),
+		"expected results sorted by name")
+
+	// update in place: custom metadata, PUT, and DELETE
+	for _, objName := range m.objNames[:10] {
+		err := api.SetObjectCustomProps(bp, m.bck, objName, cos.StrKVs{"color": "red"}, false)
+		tassert.CheckFatal(t, err)
+	}
+	bigName := m.prefix + "/big"
+	r, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: cos.MiB, CksumType: cos.ChecksumNone})
+	_, err = api.PutObject(&api.PutArgs{BaseParams: bp, Bck: m.bck, ObjName: bigName, Reader: r, Size: cos.MiB})
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, api.DeleteObject(bp, m.bck, m.objNames[0]))
+
+	size, _ := apc.ParseMDPred("size>=1MiB")
+	entries = search(&apc.MDQuery{Preds: []*apc.MDPred{size}})
+	tassert.Fatalf(t, len(entries) == 1 && entries[0].Name == bigName, "expected %q, got %d entries", bigName, len(entries))
+	tassert.Errorf(t, entries[0].Location != "", "expected target ID")
+
+	color, _ := apc.ParseMDPred("custom.color=red")
+	entries = search(&apc.MDQuery{Preds: []*apc.MDPred{color}, Sort: apc.MDFieldName, Desc: true, Limit: 5})
+	tassert.Fatalf(t, len(entries) == 5, "expected 5 (limited) red objects, got %d", len(entries))
+	tassert.Errorf(t, entries[0].Name == m.objNames[9], "expected %q first (descending), got %q", m.objNames[9], entries[0].Name)
+	entries = search(&apc.MDQuery{Preds: []*apc.MDPred{color}})
+	tassert.Errorf(t, len(entries) == 9, "expected 9 red objects (one deleted), got %d", len(entries))
+}
+
+func TestInventoryExport(t *testing.T) {
+	var (
+		m = ioContext{
+			t:        t,
+			num:      100,
+			fileSize: cos.KiB,
+			prefix:   "inv",
+		}
+		bckTo    = cmn.Bck{Name: "inv-dst-" + trand.String(6), Provider: apc.AIS}
+		proxyURL = tools.RandomProxyURL(t)
+		bp       = tools.BaseAPIParams(proxyURL)
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	tools.CreateBucket(t, proxyURL, m.bck, nil, true /*cleanup*/)
+	tools.CreateBucket(t, proxyURL, bckTo, nil, true /*cleanup*/)
+	m.puts()
+
+	for _, format := range []string{apc.InvFormatCSV, apc.InvFormatJSONL, apc.InvFormatParquet} {
+		t.Run(format, func(t *testing.T) {
+			num, err := exportInventory(t, bp, m.bck, bckTo, &apc.InventoryMsg{Format: format}, m.prefix, m.smap.CountActiveTs())
+			if err != nil && format == apc.InvFormatParquet && strings.Contains(err.Error(), "build tag") {
+				t.Skipf("test %q requires 'aisnode' built with build tag 'parquet'", t.Name())
+			}
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, num == m.num, "expected %d inventory records, got %d", m.num, num)
+		})
+	}
+
+	// point-in-time: objects written after the snapshot is taken are not included
+	t.Run("snapshot", func(t *testing.T) {
+		const snap = "inv-snap"
+		xid, err := api.CreateSnapshot(bp, m.bck, snap)
+		tassert.CheckFatal(t, err)
+		args := xact.ArgsMsg{ID: xid, Kind: apc.ActCreateSnap, Timeout: tools.RebalanceTimeout}
+		_, err = api.WaitForXactionIC(bp, &args)
+		tassert.CheckFatal(t, err)
+		t.Cleanup(func() { api.DestroySnapshot(bp, m.bck, snap) })
+
+		r, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: cos.KiB, CksumType: cos.ChecksumNone})
+		_, err = api.PutObject(&api.PutArgs{BaseParams: bp, Bck: m.bck, ObjName: m.prefix + "/after-snap", Reader: r, Size: cos.KiB})
+		tassert.CheckFatal(t, err)
+
+		msg := &apc.InventoryMsg{Format: apc.InvFormatJSONL, Snap: snap}
+		num, err := exportInventory(t, bp, m.bck, bckTo, msg, m.prefix, m.smap.CountActiveTs())
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, num == m.num, "expected %d inventory records (as of snapshot %q), got %d", m.num, snap, num)
+	})
+}
+
+// run inventory job, wait, and count the records (one inventory object per target)
+func exportInventory(t *testing.T, bp api.BaseParams, bck, bckTo cmn.Bck, msg *apc.InventoryMsg, objPrefix string, numTs int) (int, error) {
+	xid, err := api.ExportInventory(bp, bck, bckTo, msg)
+	if err != nil {
+		return 0, err
+	}
+	args := xact.ArgsMsg{ID: xid, Kind: apc.ActInventory, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(bp, &args)
+	tassert.CheckFatal(t, err)
+
+	prefix := apc.InvDfltPrefix + bck.Name + "/" + xid + "/"
+	lst, err := api.ListObjects(bp, bckTo, &apc.LsoMsg{Prefix: prefix}, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == numTs, "expected %d inventory objects, got %d", numTs, len(lst.Entries))
+
+	var num int
+	for _, en := range lst.Entries {
+		tassert.Errorf(t, strings.HasSuffix(en.Name, "."+msg.Format), "unexpected inventory object name %q", en.Name)
+		var sb strings.Builder
+		_, err := api.GetObject(bp, bckTo, en.Name, &api.GetArgs{Writer: &sb})
+		tassert.CheckFatal(t, err)
+		if msg.Format == apc.InvFormatParquet {
+			rows, err := parquet.Read[apc.InvEntry](strings.NewReader(sb.String()), int64(sb.Len()))
+			tassert.CheckFatal(t, err)
+			for i := range rows {
+				tassert.Errorf(t, strings.HasPrefix(rows[i].Name, objPrefix), "unexpected row %+v", rows[i])
+			}
+			num += len(rows)
+			continue
+		}
+		lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+		if msg.Format == apc.InvFormatCSV {
+			tassert.Errorf(t, strings.HasPrefix(lines[0], "name,size,"), "expected CSV header, got %q", lines[0])
+			lines = lines[1:]
+		}
+		for _, line := range lines {
+			if line == "" {
+				continue
+			}
+			tassert.Errorf(t, strings.Contains(line, objPrefix), "unexpected record %q", line)
+			num++
+		}
+	}
+	return num, nil
+}
+
+func TestTrashUndelete(t *testing.T) {
+	var (
+		m = ioContext{
+			t:        t,
+			num:      20,
+			fileSize: cos.KiB,
+			prefix:   "trash/",
+		}
+		proxyURL = tools.RandomProxyURL(t)
+		bp       = tools.BaseAPIParams(proxyURL)
+		props    = &cmn.BpropsToSet{Trash: &cmn.TrashConfToSet{Enabled: apc.Ptr(true)}}
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	tools.CreateBucket(t, proxyURL, m.bck, props, true /*cleanup*/)
+	m.puts()
+
+	// 1. single object
+	objName := m.objNames[0]
+	tassert.CheckFatal(t, api.DeleteObject(bp, m.bck, objName))
+	_, err := api.HeadObject(bp, m.bck, objName, api.HeadArgs{FltPresence: apc.FltPresent})
+	tassert.Fatalf(t, err != nil, "expected %s to be deleted", m.bck.Cname(objName))
+
+	ents, err := api.ListTrash(bp, m.bck, "")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(ents) == 1 && ents[0].Name == objName, "expected %q in trash, got %+v", objName, ents)
+	tassert.Errorf(t, ents[0].Size == int64(m.fileSize), "expected size %d, got %d", m.fileSize, ents[0].Size)
+
+	restored, err := api.Undelete(bp, m.bck, &apc.UndeleteMsg{ObjName: objName})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(restored) == 1, "expected 1 restored object, got %d", len(restored))
+	_, err = api.GetObject(bp, m.bck, objName, nil)
+	tassert.CheckFatal(t, err)
+
+	_, err = api.Undelete(bp, m.bck, &apc.UndeleteMsg{ObjName: objName})
+	tassert.Errorf(t, err != nil, "expected restoring %s (again) to fail", m.bck.Cname(objName))
+
+	// 2. all objects by prefix
+	m.del(-1 /* delete all */)
+	ents, err = api.ListTrash(bp, m.bck, m.prefix)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(ents) == m.num, "expected %d deleted objects, got %d", m.num, len(ents))
+	restored, err = api.Undelete(bp, m.bck, &apc.UndeleteMsg{Prefix: m.prefix})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(restored) == m.num, "expected %d restored objects, got %d", m.num, len(restored))
+
+	// 3. destroyed bucket
+	tassert.CheckFatal(t, api.DestroyBucket(bp, m.bck))
+	tassert.CheckFatal(t, api.UndeleteBucket(bp, m.bck))
+	lst, err := api.ListObjects(bp, m.bck, &apc.LsoMsg{Prefix: m.prefix}, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == m.num, "expected %d objects in restored bucket, got %d", m.num, len(lst.Entries))
+	m.gets(nil, false /*withValidation*/)
+}
@@ -1729,6 +1729,54 @@ func TestRangeRead(t *testing.T) {
 	})
 }
 
+// end-to-end protection: range-read chunked objects (validating overlapping chunks on the fly)
+// and verify trailing checksums client-side (see api.GetObject)
+func TestRangeReadEndToEnd(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: "e2e-" + trand.String(6), Provider: apc.AIS}
+		m          = ioContext{
+			t:         t,
+			bck:       bck,
+			num:       4,
+			fileSize:  256 * cos.KiB,
+			fixedSize: true,
+			prefix:    "e2e/",
+			chunksConf: &ioCtxChunksConf{
+				numChunks: 5,
+				multipart: true,
+			},
+		}
+		props = &cmn.BpropsToSet{
+			Cksum: &cmn.CksumConfToSet{EndToEnd: apc.Ptr(true)},
+		}
+	)
+	m.init(true /*cleanup*/)
+	tools.CreateBucket(t, proxyURL, bck, props, true /*cleanup*/)
+	m.puts()
+
+	for _, objName := range m.objNames {
+		full := bytes.NewBuffer(nil)
+		oah, err := api.GetObjectWithValidation(baseParams, bck, objName, &api.GetArgs{Writer: full})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, oah.RespHeader().Get(apc.HdrTrailerCksumType) != "", "%s: expecting trailing checksum", objName)
+		tassert.Fatalf(t, full.Len() == int(m.fileSize), "%s: size %d != %d", objName, full.Len(), m.fileSize)
+
+		for _, rng := range [][2]int64{{0, 1}, {1000, 50 * cos.KiB}, {100 * cos.KiB, 100 * cos.KiB}, {int64(m.fileSize) - 7, 7}} {
+			var (
+				w    = bytes.NewBuffer(nil)
+				hdr  = http.Header{cos.HdrRange: []string{cmn.MakeRangeHdr(rng[0], rng[1])}}
+				args = api.GetArgs{Writer: w, Header: hdr}
+			)
+			_, err := api.GetObject(baseParams, bck, objName, &args)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, bytes.Equal(w.Bytes(), full.Bytes()[rng[0]:rng[0]+rng[1]]),
+				"%s: range [%d, %d) mismatch", objName, rng[0], rng[0]+rng[1])
+		}
+	}
+}
+
 func testValidCases(m *ioContext, bck cmn.Bck, cksumConf *cmn.CksumConf, fileSize uint64, objName string) {
 	const byteRange = int64(500)
 	iterations := int64(fileSize) / byteRange
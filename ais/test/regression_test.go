@@ -686,6 +686,69 @@ func TestDeleteList(t *testing.T) {
 	})
 }
 
+// dry-run multi-object delete, destroy, and rename: nothing must change
+func TestDryRunDestructive(t *testing.T) {
+	var (
+		m = ioContext{
+			t:         t,
+			num:       100,
+			fileSize:  cos.KiB,
+			fixedSize: true,
+			prefix:    "dry-run",
+			ordered:   true,
+		}
+		proxyURL = tools.RandomProxyURL(t)
+		bp       = tools.BaseAPIParams(proxyURL)
+		bckTo    = cmn.Bck{Name: "dry-run-" + cos.GenTie(), Provider: apc.AIS}
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	m.expectTargets(1)
+	tools.CreateBucket(t, proxyURL, m.bck, nil, true /*cleanup*/)
+	m.puts()
+
+	check := func(all apc.DryRunResults, err error, expected int, tag string) {
+		tassert.CheckFatal(t, err)
+		objs, size := all.Totals()
+		tlog.Logfln("%s: %d objects, %s", tag, objs, cos.ToSizeIEC(size, 2))
+		tassert.Errorf(t, objs == int64(expected), "%s: expected %d objects, got %d", tag, expected, objs)
+		tassert.Errorf(t, size == int64(expected)*int64(m.fileSize), "%s: expected size %d, got %d",
+			tag, int64(expected)*int64(m.fileSize), size)
+		tassert.Errorf(t, len(all) == m.smap.CountActiveTs(), "%s: expecting results from all targets", tag)
+		for tid, res := range all {
+			tassert.Errorf(t, len(res.Sample) == min(int(res.Objs), apc.DryRunSampleSize),
+				"%s: %s: invalid sample size %d (objs %d)", tag, tid, len(res.Sample), res.Objs)
+		}
+	}
+
+	// 1. delete list, range, and prefix
+	evdMsg := &apc.EvdMsg{ListRange: apc.ListRange{ObjNames: m.objNames[:10]}}
+	all, err := api.DryRunMultiObj(bp, m.bck, apc.ActDeleteObjects, evdMsg)
+	check(all, err, 10, "delete-list")
+
+	evdMsg = &apc.EvdMsg{ListRange: apc.ListRange{Template: m.prefix + "/{0..49}"}}
+	all, err = api.DryRunMultiObj(bp, m.bck, apc.ActDeleteObjects, evdMsg)
+	check(all, err, 50, "delete-range")
+
+	evdMsg = &apc.EvdMsg{ListRange: apc.ListRange{Template: m.prefix}}
+	all, err = api.DryRunMultiObj(bp, m.bck, apc.ActDeleteObjects, evdMsg)
+	check(all, err, m.num, "delete-prefix")
+
+	// 2. destroy and rename
+	all, err = api.DryRunDestroyBucket(bp, m.bck, apc.ActDestroyBck)
+	check(all, err, m.num, "destroy")
+
+	all, err = api.DryRunRenameBucket(bp, m.bck, bckTo)
+	check(all, err, m.num, "rename")
+
+	// 3. nothing's changed
+	m.ensureNumCopies(bp, 1, false /*greaterOk*/)
+	_, err = api.HeadBucket(bp, bckTo, true /*don't add*/)
+	tassert.Errorf(t, err != nil, "%s must not exist", bckTo.Cname(""))
+	lst, err := api.ListObjects(bp, m.bck, &apc.LsoMsg{Prefix: m.prefix}, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == m.num, "expected %d objects, got %d", m.num, len(lst.Entries))
+}
+
 func TestPrefetchRange(t *testing.T) {
 	var (
 		m = ioContext{
@@ -0,0 +1,94 @@
+// Package integration_test.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/docker"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/tools/tlog"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// run scrub and sum up per-target summaries
+func scrub(t *testing.T, bp api.BaseParams, bck cmn.Bck, msg *apc.ScrubMsg) (total apc.ScrubStats) {
+	xid, err := api.ScrubBucket(bp, bck, msg)
+	tassert.CheckFatal(t, err)
+	args := &xact.ArgsMsg{ID: xid, Kind: apc.ActScrub, Bck: bck, Timeout: 2 * time.Minute}
+	_, err = api.WaitForXactionIC(bp, args)
+	tassert.CheckFatal(t, err)
+
+	snaps, err := api.QueryXactionSnaps(bp, args)
+	tassert.CheckFatal(t, err)
+	for tid, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			var stats apc.ScrubStats
+			tassert.CheckFatal(t, cos.MorphMarshal(snap.Ext, &stats))
+			tlog.Logfln("%s: %+v", meta.Tname(tid), stats)
+			total.Visited += stats.Visited
+			total.Corrupted += stats.Corrupted
+			total.MissingCopies += stats.MissingCopies
+			total.ECGaps += stats.ECGaps
+			total.OrphanChunks += stats.OrphanChunks
+			total.Repaired += stats.Repaired
+			total.Failed += stats.Failed
+		}
+	}
+	return total
+}
+
+func TestScrubMirrored(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{MinMountpaths: 2})
+	var (
+		m = ioContext{
+			t:        t,
+			num:      100,
+			fileSize: 16 * cos.KiB,
+			prefix:   "scrub/",
+		}
+		numCorrupted = 5
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	bp := tools.BaseAPIParams(m.proxyURL)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+
+	_, err := api.SetBucketProps(bp, m.bck, &cmn.BpropsToSet{
+		Mirror: &cmn.MirrorConfToSet{Enabled: apc.Ptr(true), Copies: apc.Ptr[int64](2)},
+	})
+	tassert.CheckFatal(t, err)
+	m.puts()
+	api.WaitForSnapsIdle(bp, &xact.ArgsMsg{Kind: apc.ActPutCopies, Bck: m.bck, Timeout: time.Minute})
+
+	// 1. clean
+	stats := scrub(t, bp, m.bck, &apc.ScrubMsg{Checksum: true})
+	tassert.Errorf(t, stats.Visited == int64(m.num), "expected %d visited, got %d", m.num, stats.Visited)
+	tassert.Errorf(t, stats.Corrupted == 0 && stats.MissingCopies == 0, "unexpected inconsistencies: %+v", stats)
+
+	if docker.IsRunning() {
+		tlog.Logfln("skipping %s object corruption (docker is not supported)", t.Name())
+		return
+	}
+
+	// 2. corrupt, detect, and repair
+	initMountpaths(t, m.proxyURL)
+	for i := range numCorrupted {
+		corruptSingleBitInFile(&m, m.objNames[i], false /*eced*/)
+	}
+	stats = scrub(t, bp, m.bck, &apc.ScrubMsg{Checksum: true, Fix: true})
+	tassert.Errorf(t, stats.Corrupted <= int64(numCorrupted), "expected at most %d corrupted, got %d", numCorrupted, stats.Corrupted)
+	tassert.Errorf(t, stats.Failed == 0 && stats.Repaired == stats.Corrupted, "failed to repair: %+v", stats)
+
+	// 3. clean again
+	stats = scrub(t, bp, m.bck, &apc.ScrubMsg{Checksum: true})
+	tassert.Errorf(t, stats.Corrupted == 0, "expected no corrupted objects after repair, got %d", stats.Corrupted)
+}
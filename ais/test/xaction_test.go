@@ -1,6 +1,6 @@
 // Package integration_test.
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package integration_test
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/nl"
@@ -121,3 +122,204 @@ func TestXactionAllStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestXactionPauseResume(t *testing.T) {
+	var (
+		m = ioContext{
+			t:        t,
+			num:      5000,
+			fileSize: cos.KiB,
+			prefix:   "pause/",
+		}
+		bckTo = cmn.Bck{Name: "pause-dst-" + cos.GenTie(), Provider: apc.AIS}
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	bp := tools.BaseAPIParams(m.proxyURL)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+	m.puts()
+	t.Cleanup(func() { tools.DestroyBucket(t, m.proxyURL, bckTo) })
+
+	xid, err := api.CopyBucket(bp, m.bck, bckTo, &apc.TCBMsg{})
+	tassert.CheckFatal(t, err)
+	args := &xact.ArgsMsg{ID: xid, Kind: apc.ActCopyBck}
+
+	if err := api.PauseXaction(bp, args); err != nil {
+		if cmn.IsStatusNotFound(err) {
+			t.Skipf("%s finished before it could be paused", xid)
+		}
+		tassert.CheckFatal(t, err)
+	}
+	snaps, err := api.QueryXactionSnaps(bp, args)
+	tassert.CheckFatal(t, err)
+	for tid, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			tassert.Errorf(t, snap.IsPaused() || snap.IsFinished(), "%s: expected %s to be paused", tid, snap.ID)
+		}
+	}
+
+	// no progress while paused
+	time.Sleep(2 * time.Second)
+	snaps, err = api.QueryXactionSnaps(bp, args)
+	tassert.CheckFatal(t, err)
+	locObjs, outObjs, _ := snaps.ObjCounts(xid)
+	time.Sleep(3 * time.Second)
+	snaps, err = api.QueryXactionSnaps(bp, args)
+	tassert.CheckFatal(t, err)
+	locObjs2, outObjs2, _ := snaps.ObjCounts(xid)
+	tassert.Errorf(t, locObjs == locObjs2 && outObjs == outObjs2, "paused %s made progress: (%d, %d) vs (%d, %d)",
+		xid, locObjs, outObjs, locObjs2, outObjs2)
+
+	// resume and finish
+	tassert.CheckFatal(t, api.ResumeXaction(bp, args))
+	args.Timeout = tools.CopyBucketTimeout
+	_, err = api.WaitForXactionIC(bp, args)
+	tassert.CheckFatal(t, err)
+
+	lst, err := api.ListObjects(bp, bckTo, &apc.LsoMsg{Prefix: m.prefix}, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == m.num, "expected %d objects in %s, got %d", m.num, bckTo.Cname(""), len(lst.Entries))
+}
+
+func TestXactionPrio(t *testing.T) {
+	var (
+		m = ioContext{
+			t:        t,
+			num:      1000,
+			fileSize: cos.KiB,
+			prefix:   "prio/",
+		}
+		bckTo = cmn.Bck{Name: "prio-dst-" + cos.GenTie(), Provider: apc.AIS}
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	bp := tools.BaseAPIParams(m.proxyURL)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+	m.puts()
+	t.Cleanup(func() { tools.DestroyBucket(t, m.proxyURL, bckTo) })
+
+	// invalid class
+	bp.Prio = "urgent"
+	_, err := api.CopyBucket(bp, m.bck, bckTo, &apc.TCBMsg{})
+	tassert.Fatalf(t, err != nil, "expected invalid priority class %q to fail", bp.Prio)
+
+	bp.Prio = apc.PrioLow
+	xid, err := api.CopyBucket(bp, m.bck, bckTo, &apc.TCBMsg{})
+	tassert.CheckFatal(t, err)
+	args := &xact.ArgsMsg{ID: xid, Kind: apc.ActCopyBck, Timeout: tools.CopyBucketTimeout}
+
+	snaps, err := api.QueryXactionSnaps(bp, args)
+	tassert.CheckFatal(t, err)
+	for tid, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			tassert.Errorf(t, snap.Prio == apc.PrioLow, "%s: expected %s to have %q priority, got %q",
+				tid, snap.ID, apc.PrioLow, snap.Prio)
+		}
+	}
+	_, err = api.WaitForXactionIC(bp, args)
+	tassert.CheckFatal(t, err)
+}
+
+func TestJobHistory(t *testing.T) {
+	var (
+		m = ioContext{
+			t:        t,
+			num:      100,
+			fileSize: cos.KiB,
+			prefix:   "hist/",
+		}
+		bckTo = cmn.Bck{Name: "hist-dst-" + cos.GenTie(), Provider: apc.AIS}
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	bp := tools.BaseAPIParams(m.proxyURL)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+	m.puts()
+	t.Cleanup(func() { tools.DestroyBucket(t, m.proxyURL, bckTo) })
+
+	xid, err := api.CopyBucket(bp, m.bck, bckTo, &apc.TCBMsg{})
+	tassert.CheckFatal(t, err)
+	args := &xact.ArgsMsg{ID: xid, Kind: apc.ActCopyBck, Timeout: tools.CopyBucketTimeout}
+	_, err = api.WaitForXactionIC(bp, args)
+	tassert.CheckFatal(t, err)
+
+	var entries []*xact.HistEntry
+	for range 10 { // (recorded upon the last target's notification)
+		entries, err = api.GetJobHistory(bp, &xact.HistQueryMsg{ID: xid})
+		tassert.CheckFatal(t, err)
+		if len(entries) > 0 {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	tassert.Fatalf(t, len(entries) == 1, "expected %s in the job history, got %d entries", xid, len(entries))
+	e := entries[0]
+	tassert.Errorf(t, e.Kind == apc.ActCopyBck && !e.Aborted && e.Err == "", "unexpected history entry %+v", e)
+	tassert.Errorf(t, e.Stats.Objs+e.Stats.InObjs >= int64(m.num), "expected at least %d copied objects, got %+v", m.num, e.Stats)
+
+	// filter by bucket
+	entries, err = api.GetJobHistory(bp, &xact.HistQueryMsg{Bck: bckTo, Kind: apc.ActCopyBck})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(entries) == 1 && entries[0].ID == xid, "expected %s when filtering by %s, got %d entries",
+		xid, bckTo.Cname(""), len(entries))
+}
+
+func TestJobSchedule(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
+	var (
+		m = ioContext{
+			t:        t,
+			num:      100,
+			fileSize: cos.KiB,
+			prefix:   "sched/",
+		}
+		bckTo = cmn.Bck{Name: "sched-dst-" + cos.GenTie(), Provider: apc.AIS}
+		name  = "sched-" + cos.GenTie()
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	bp := tools.BaseAPIParams(m.proxyURL)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+	m.puts()
+	t.Cleanup(func() { tools.DestroyBucket(t, m.proxyURL, bckTo) })
+
+	// invalid
+	err := api.AddJobSchedule(bp, &xact.CronJob{Name: name, Cron: "61 * * * *", Bck: m.bck, BckTo: bckTo,
+		Msg: apc.ActMsg{Action: apc.ActCopyBck, Value: &apc.TCBMsg{}}})
+	tassert.Fatalf(t, err != nil, "expected invalid cron expression to fail")
+	err = api.AddJobSchedule(bp, &xact.CronJob{Name: name, Cron: "@hourly", Msg: apc.ActMsg{Action: apc.ActList}})
+	tassert.Fatalf(t, err != nil, "expected %q to fail", apc.ActList)
+
+	// every minute
+	job := &xact.CronJob{Name: name, Cron: "* * * * *", Bck: m.bck, BckTo: bckTo, Msg: apc.ActMsg{Action: apc.ActCopyBck, Value: &apc.TCBMsg{}}}
+	tassert.CheckFatal(t, api.AddJobSchedule(bp, job))
+	t.Cleanup(func() { api.RemoveJobSchedule(bp, name) })
+
+	err = api.AddJobSchedule(bp, job)
+	tassert.Fatalf(t, err != nil, "expected duplicate %q to fail", name)
+
+	var st *xact.CronJobStatus
+	for range 80 {
+		jobs, err := api.GetJobSchedules(bp)
+		tassert.CheckFatal(t, err)
+		st = nil
+		for _, j := range jobs {
+			if j.Name == name {
+				st = j
+			}
+		}
+		tassert.Fatalf(t, st != nil, "scheduled job %q not found", name)
+		tassert.Errorf(t, !st.NextRun.IsZero(), "expected next run")
+		if st.LastID != "" || st.LastErr != "" {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	tassert.Fatalf(t, st.LastErr == "" && st.LastID != "", "expected scheduled job to start, got %+v", st)
+	args := &xact.ArgsMsg{ID: st.LastID, Kind: apc.ActCopyBck, Timeout: tools.CopyBucketTimeout}
+	_, err = api.WaitForXactionIC(bp, args)
+	tassert.CheckFatal(t, err)
+	lst, err := api.ListObjects(bp, bckTo, &apc.LsoMsg{Prefix: m.prefix}, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == m.num, "expected %d copied objects, got %d", m.num, len(lst.Entries))
+
+	tassert.CheckFatal(t, api.RemoveJobSchedule(bp, name))
+	err = api.RemoveJobSchedule(bp, name)
+	tassert.Fatalf(t, err != nil, "expected removing non-existing %q to fail", name)
+}
@@ -14,6 +14,7 @@ import (
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/bsnap"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -161,11 +162,69 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 			}
 		}
 		t.bsumm(w, r, phase, bck, &bsumMsg, dpq)
+	case apc.ActDryRun:
+		t.dryRun(w, r, apiItems, msg, dpq)
+	case apc.ActSearchBck:
+		t.searchBck(w, r, apiItems, msg, dpq)
+	case apc.ActListTrash:
+		t.lsTrash(w, r, apiItems, msg, dpq)
+	case apc.ActUndeleteBck:
+		t.lsTrashedBcks(w, r, apiItems, dpq)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
 }
 
+// execute selection logic of the (destructive) action named by the proxy (see p.dryRun)
+func (t *target) dryRun(w http.ResponseWriter, r *http.Request, apiItems []string, msg *actMsgExt, dpq *dpq) {
+	var res *apc.DryRunRes
+	if msg.Name == apc.ActLRU {
+		var xargs xact.ArgsMsg
+		if err := cos.MorphMarshal(msg.Value, &xargs); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		res, err := t.dryRunLRU(&xargs)
+		if err != nil {
+			t.writeErr(w, r, err, http.StatusConflict)
+			return
+		}
+		t.writeJSON(w, r, res, apc.ActDryRun)
+		return
+	}
+
+	if len(apiItems) == 0 {
+		t.writeErrURL(w, r)
+		return
+	}
+	bck, err := newBckFromQ(apiItems[0], nil, dpq)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if err := bck.Init(t.owner.bmd); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	evdMsg := &apc.EvdMsg{} // entire bucket unless specified
+	switch msg.Name {
+	case apc.ActDeleteObjects, apc.ActEvictObjects:
+		if err := cos.MorphMarshal(msg.Value, evdMsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+	case apc.ActEvictRemoteBck, apc.ActDestroyBck, apc.ActMoveBck:
+	default:
+		t.writeErrAct(w, r, msg.Name)
+		return
+	}
+	if res, err = xs.DryRun(msg.Name, bck, evdMsg); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	t.writeJSON(w, r, res, apc.ActDryRun)
+}
+
 // there's a difference between looking for all (any) provider vs a specific one -
 // in the former case the fact that (the corresponding backend is not configured)
 // is not an error
@@ -261,6 +320,26 @@ func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.B
 		}
 	}
 
+	// list bucket snapshot (not an xaction)
+	if lsmsg.Snap != "" {
+		lst, err := bsnap.List(bck, lsmsg)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return false
+		}
+		return t.writeMsgPack(w, lst, "list_objects")
+	}
+
+	// list all object versions (not an xaction)
+	if lsmsg.IsFlagSet(apc.LsVerHistory) {
+		lst, err := t.lsVersions(bck, lsmsg)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return false
+		}
+		return t.writeMsgPack(w, lst, "list_objects")
+	}
+
 	var (
 		xctn core.Xact
 		rns  = xreg.RenewLso(bck, lsmsg.UUID, lsmsg, r.Header)
@@ -418,6 +497,7 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 			return
 		}
 		xctn := rns.Entry.Get()
+		xctn.SetPrio(msg.Prio)
 		notif := &xact.NotifXact{
 			Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
 			Xact: xctn,
@@ -464,7 +544,7 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
 			return
 		}
-		if ecode, err := t.runPrefetch(msg.UUID, apireq.bck, prfMsg); err != nil {
+		if ecode, err := t.runPrefetch(msg.UUID, apireq.bck, prfMsg, msg.Prio); err != nil {
 			t.writeErr(w, r, err, ecode)
 			return
 		}
@@ -474,7 +554,29 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
 			return
 		}
-		_, err = t.runRechunk(msg.UUID, apireq.bck, rechunkMsg)
+		_, err = t.runRechunk(msg.UUID, apireq.bck, rechunkMsg, msg.Prio)
+	case apc.ActScrub:
+		scrubMsg := &apc.ScrubMsg{}
+		if err = cos.MorphMarshal(msg.Value, scrubMsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		_, err = t.runScrub(msg.UUID, apireq.bck, scrubMsg, msg.Prio)
+	case apc.ActUndelete:
+		t.undelete(w, r, apireq.bck, msg)
+		return
+	case apc.ActInventory:
+		invMsg := &apc.InventoryMsg{}
+		if err = cos.MorphMarshal(msg.Value, invMsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		var bckTo *meta.Bck
+		if bckTo, err = newBckFromQuname(apireq.query, true /*required*/); err == nil {
+			if err = bckTo.Init(t.owner.bmd); err == nil {
+				err = t.runInventory(msg.UUID, apireq.bck, &xreg.InvArgs{BckTo: bckTo, Msg: invMsg}, msg.Prio)
+			}
+		}
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -485,7 +587,7 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 	}
 }
 
-func (t *target) runRechunk(xactID string, bck *meta.Bck, rechunkMsg *apc.RechunkMsg) (xid string, err error) {
+func (t *target) runRechunk(xactID string, bck *meta.Bck, rechunkMsg *apc.RechunkMsg, prio string) (xid string, err error) {
 	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActRechunk); err != nil {
 		return "", err
 	}
@@ -498,6 +600,7 @@ func (t *target) runRechunk(xactID string, bck *meta.Bck, rechunkMsg *apc.Rechun
 		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
 		Xact: xctn,
 	}
+	xctn.SetPrio(prio)
 	xctn.AddNotif(notif)
 
 	if cmn.Rom.V(5, cos.ModAIS) {
@@ -507,8 +610,79 @@ func (t *target) runRechunk(xactID string, bck *meta.Bck, rechunkMsg *apc.Rechun
 	return xctn.ID(), nil
 }
 
+func (t *target) runScrub(xactID string, bck *meta.Bck, scrubMsg *apc.ScrubMsg, prio string) (xid string, err error) {
+	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActScrub); err != nil {
+		return "", err
+	}
+	rns := xreg.RenewBckScrub(bck, xactID, scrubMsg)
+	if rns.Err != nil {
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.SetPrio(prio)
+	xctn.AddNotif(notif)
+
+	if cmn.Rom.V(5, cos.ModAIS) {
+		nlog.Infoln("start scrub", bck.String(), "xid", xactID)
+	}
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
+func (t *target) runTier(xactID string, bck *meta.Bck, prio string) (xid string, err error) {
+	if !bck.Props.Tier.Enabled {
+		return "", cmn.NewErrUnsupp("start tiering", bck.Cname("")+" (storage classes not configured - see bucket property 'tier')")
+	}
+	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActTier); err != nil {
+		return "", err
+	}
+	rns := xreg.RenewBckTier(bck, xactID)
+	if rns.Err != nil {
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.SetPrio(prio)
+	xctn.AddNotif(notif)
+
+	if cmn.Rom.V(5, cos.ModAIS) {
+		nlog.Infoln("start tier", bck.String(), "xid", xactID)
+	}
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
+func (t *target) runInventory(xactID string, bck *meta.Bck, args *xreg.InvArgs, prio string) error {
+	if err := args.Msg.Validate(); err != nil {
+		return err
+	}
+	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActInventory); err != nil {
+		return err
+	}
+	rns := xreg.RenewBckInventory(bck, xactID, args)
+	if rns.Err != nil {
+		return rns.Err
+	}
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.SetPrio(prio)
+	xctn.AddNotif(notif)
+	xact.GoRunW(xctn)
+	return nil
+}
+
 // handle apc.ActPrefetchObjects <-- via api.Prefetch* and api.StartX*
-func (t *target) runPrefetch(xactID string, bck *meta.Bck, prfMsg *apc.PrefetchMsg) (int, error) {
+func (t *target) runPrefetch(xactID string, bck *meta.Bck, prfMsg *apc.PrefetchMsg, prio string) (int, error) {
 	cs := fs.Cap()
 	if err := cs.Err(); err != nil {
 		return http.StatusInsufficientStorage, err
@@ -523,6 +697,7 @@ func (t *target) runPrefetch(xactID string, bck *meta.Bck, prfMsg *apc.PrefetchM
 		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
 		Xact: xctn,
 	}
+	xctn.SetPrio(prio)
 	xctn.AddNotif(notif)
 
 	xact.GoRunW(xctn)
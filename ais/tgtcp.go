@@ -17,9 +17,11 @@ import (
 
 	"github.com/NVIDIA/aistore/ais/backend"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/bsnap"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -28,6 +30,7 @@ import (
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
+	"github.com/NVIDIA/aistore/res"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
@@ -41,6 +44,7 @@ const (
 type delb struct {
 	obck    *meta.Bck
 	present bool
+	retier  bool // storage class placement changed
 }
 
 func (t *target) joinCluster(action string, primaryURLs ...string) (status int, err error) {
@@ -400,7 +404,7 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 	)
 	switch what {
 	case apc.WhatNodeConfig, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote,
-		apc.WhatSnode, apc.WhatLog, apc.WhatMetricNames:
+		apc.WhatSnode, apc.WhatLog, apc.WhatAudit, apc.WhatMetricNames:
 		t.htrun.httpdaeget(w, r, query, t /*htext*/)
 	case apc.WhatSysInfo:
 		tsysinfo := apc.TSysInfo{MemCPUInfo: apc.GetMemCPU(), CapacityInfo: fs.CapStatusGetWhat()}
@@ -432,6 +436,10 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		fs.DiskStats(dstats, nil, config, true /*refresh cap*/)
 		mpl := fs.ToMPL()
 		t.writeJSON(w, r, mpl, httpdaeWhat)
+	case apc.WhatHrwPreview:
+		t.hrwPreview(w, r)
+	case apc.WhatTenants:
+		t.tenants.get(w, r)
 	case apc.WhatDiskRWUtilCap:
 		var (
 			tcdfExt fs.TcdfExt
@@ -824,7 +832,12 @@ skip:
 		if _, present := bmd.Get(bck); present {
 			return false
 		}
-		errs := fs.CreateBucket(bck.Bucket(), nilbmd)
+		var errs []error
+		if msg.Action == apc.ActUndeleteBck {
+			errs = fs.UntrashBucket(bck.Bucket(), bck.Props.BID)
+		} else {
+			errs = fs.CreateBucket(bck.Bucket(), nilbmd)
+		}
 		if len(errs) > 0 {
 			createErrs = append(createErrs, errs...)
 		}
@@ -847,13 +860,21 @@ skip:
 		destroyErrs []error
 		rmbcks      []*meta.Bck
 		emsg        string
+		retier      bool
 	)
 	bmd.Range(nil, nil, func(obck *meta.Bck) bool {
 		f := &delb{obck: obck}
 		newBMD.Range(nil, nil, f.do)
+		retier = retier || f.retier
 		if !f.present {
 			rmbcks = append(rmbcks, obck)
-			if errD := fs.DestroyBucket("recv-bmd-"+msg.Action, obck.Bucket(), obck.Props.BID); errD != nil {
+			var errD error
+			if msg.Action == apc.ActDestroyBck && obck.IsAIS() && obck.Props.Trash.Enabled {
+				errD = fs.TrashBucket("recv-bmd-"+msg.Action, obck.Bucket(), obck.Props)
+			} else {
+				errD = fs.DestroyBucket("recv-bmd-"+msg.Action, obck.Bucket(), obck.Props.BID)
+			}
+			if errD != nil {
 				destroyErrs = append(destroyErrs, errD)
 			}
 		}
@@ -863,6 +884,11 @@ skip:
 		emsg = fmt.Sprintf("%s: failed to cleanup destroyed buckets: %s, old/cur %s(%t): %v",
 			t, newBMD, bmd, nilbmd, errors.Join(destroyErrs...))
 	}
+	if retier {
+		// relocate objects as per new storage class placement (see core/ltier.go)
+		nlog.Infoln(t.String(), "starting resilver, reason: bucket tier placement changed", newBMD.String())
+		go t.runResilver(&res.Args{Custom: xreg.ResArgs{Config: cmn.GCO.Get()}})
+	}
 	return rmbcks, oldVer, emsg, nil
 }
 
@@ -882,6 +908,11 @@ func (f *delb) do(nbck *meta.Bck) bool {
 		flt := xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}
 		xreg.DoAbort(&flt, errors.New("apply-bmd"))
 	}
+	if !f.obck.Props.Tier.SamePlacement(&nbck.Props.Tier) {
+		f.retier = true
+		flt := xreg.Flt{Kind: apc.ActTier, Bck: nbck}
+		xreg.DoAbort(&flt, errors.New("apply-bmd"))
+	}
 	return true // break
 }
 
@@ -901,6 +932,10 @@ func (t *target) _postBMD(newBMD *bucketMD, tag string, rmbcks []*meta.Bck) {
 		if err := ec.ECM.BMDChanged(); err != nil {
 			nlog.Errorln("failed to initialize EC upon BMD change:", err)
 		}
+		// bucket snapshots
+		t.reconcileSnaps(&newBMD.BMD, false /*startup*/)
+		// metadata indexes
+		t.mdx.reconcile(&newBMD.BMD)
 	}
 	// capacity (since some buckets may have been destroyed)
 	cs := fs.Cap()
@@ -990,7 +1025,7 @@ func (t *target) _runRe(newRMD *rebMD, msg *actMsgExt, smap *smapX, oxid string)
 		nlog.Infoln(tname, "starting user-requested", xname, nxid)
 
 		// (##a)
-		go t.reb.Run(&smap.Smap, &extArgs)
+		go t.runReb(&smap.Smap, &extArgs)
 		return
 	}
 
@@ -1012,7 +1047,7 @@ func (t *target) _runRe(newRMD *rebMD, msg *actMsgExt, smap *smapX, oxid string)
 		}
 		nlog.Infoln(tname, "starting", msg.String(), "-triggered", xname, s, opts)
 		// (##b)
-		go t.reb.Run(&smap.Smap, &extArgs)
+		go t.runReb(&smap.Smap, &extArgs)
 
 	// 2.2. "pure" metasync(newRMD) w/ no action - double-check with cluster config
 	default:
@@ -1022,7 +1057,7 @@ func (t *target) _runRe(newRMD *rebMD, msg *actMsgExt, smap *smapX, oxid string)
 		if config.Rebalance.Enabled {
 			nlog.Infoln(tname, "starting", xname)
 			// (##c)
-			go t.reb.Run(&smap.Smap, &extArgs)
+			go t.runReb(&smap.Smap, &extArgs)
 		} else {
 			runtime.Gosched()
 
@@ -1043,7 +1078,7 @@ func (t *target) _runRe(newRMD *rebMD, msg *actMsgExt, smap *smapX, oxid string)
 
 				// (##d)
 				nlog.Infoln(tname, "starting", xname)
-				t.reb.Run(&smap.Smap, &extArgs)
+				t.runReb(&smap.Smap, &extArgs)
 			}()
 		}
 	}
@@ -1051,6 +1086,12 @@ func (t *target) _runRe(newRMD *rebMD, msg *actMsgExt, smap *smapX, oxid string)
 	t.owner.rmd.put(newRMD)
 }
 
+// upon completion, rebuild bucket snapshots' manifests (see bsnap.Relocated)
+func (t *target) runReb(smap *meta.Smap, extArgs *reb.ExtArgs) {
+	t.reb.Run(smap, extArgs)
+	bsnap.Relocated(apc.ActRebalance, extArgs.Bck)
+}
+
 func (t *target) ensureLatestBMD(msg *actMsgExt, r *http.Request) {
 	bmd, bmdVersion := t.owner.bmd.Get(), msg.BMDVersion
 	if bmd.Version < bmdVersion {
@@ -1254,6 +1295,11 @@ func (t *target) receiveConfig(newConfig *globalConfig, msg *actMsgExt, payload
 	if oldConfig.Space != newConfig.Space {
 		fs.ExpireCapCache()
 	}
+	if oldConfig.Features.IsSet(feat.WeightedHRW) != newConfig.Features.IsSet(feat.WeightedHRW) {
+		// mountpath placement changes as well (cluster-wide, primary runs rebalance)
+		nlog.Infoln(t.String(), "starting resilver, reason: toggled weighted HRW")
+		go t.runResilver(&res.Args{Custom: xreg.ResArgs{Config: cmn.GCO.Get()}})
+	}
 
 	// special: remais update
 	if msg.Action == apc.ActAttachRemAis || msg.Action == apc.ActDetachRemAis {
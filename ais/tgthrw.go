@@ -0,0 +1,106 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// (apc.WhatHrwPreview) walk all local objects of all buckets (in BMD) and count those
+// that would move if weighted HRW were toggled - see apc.HrwPreview
+//   - compares placement under the current setting with placement under the opposite one,
+//     using the current Smap and the current set of available mountpaths
+//   - storage classes (cmn.TierConf) are not taken into account
+func (t *target) hrwPreview(w http.ResponseWriter, r *http.Request) {
+	var (
+		smap     = t.owner.smap.get()
+		bmd      = t.owner.bmd.get()
+		avail    = fs.GetAvail()
+		weighted = !meta.HrwWeighted() // toggled
+		bcks     = make([]*meta.Bck, 0, 8)
+		results  = make([]apc.HrwPreview, 0, len(avail))
+		wg       = &sync.WaitGroup{}
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		bcks = append(bcks, bck)
+		return false
+	})
+	for range avail {
+		results = append(results, apc.HrwPreview{})
+	}
+	var i int
+	for _, mi := range avail {
+		wg.Add(1)
+		go t._hrwPreview(mi, bcks, smap, avail, weighted, &results[i], wg)
+		i++
+	}
+	wg.Wait()
+
+	out := &apc.HrwPreview{Weight: t.si.Weight, Weighted: !weighted}
+	for i := range results {
+		res := &results[i]
+		out.Objects += res.Objects
+		out.Size += res.Size
+		out.ToTargets += res.ToTargets
+		out.ToTargetsSz += res.ToTargetsSz
+		out.ToMpaths += res.ToMpaths
+		out.ToMpathsSz += res.ToMpathsSz
+	}
+	t.writeJSON(w, r, out, apc.WhatHrwPreview)
+}
+
+func (t *target) _hrwPreview(mi *fs.Mountpath, bcks []*meta.Bck, smap *smapX, avail fs.MPI, weighted bool,
+	res *apc.HrwPreview, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for _, bck := range bcks {
+		opts := &fs.WalkOpts{Mi: mi, Bck: *bck.Bucket(), CTs: []string{fs.ObjCT}}
+		opts.Callback = func(fqn string, de fs.DirEntry) error {
+			if de.IsDir() {
+				return nil
+			}
+			var parsed fs.ParsedFQN
+			if err := parsed.Init(fqn); err != nil {
+				return nil
+			}
+			finfo, err := os.Lstat(fqn)
+			if err != nil {
+				return nil
+			}
+			var (
+				size   = finfo.Size()
+				digest = onexxh.Checksum64S(bck.MakeUname(parsed.ObjName), cos.MLCG32)
+			)
+			res.Objects++
+			res.Size += size
+			tsi, err := smap.HrwHash2Tw(digest, weighted)
+			if err != nil {
+				return err
+			}
+			if tsi.ID() != t.SID() {
+				res.ToTargets++
+				res.ToTargetsSz += size
+				return nil
+			}
+			if dst, err := avail.HrwDigest(digest, "", weighted); err == nil && dst.Path != mi.Path {
+				res.ToMpaths++
+				res.ToMpathsSz += size
+			}
+			return nil
+		}
+		if err := fs.Walk(opts); err != nil && !cos.IsNotExist(err) {
+			nlog.Warningln(t.String(), apc.WhatHrwPreview, mi.String(), bck.Cname(""), "err:", err)
+		}
+	}
+}
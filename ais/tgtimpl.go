@@ -238,6 +238,7 @@ func (t *target) GetFromNeighbor(params *core.GfnParams) (*http.Response, error)
 		cmn.FreeHra(reqArgs)
 		return nil, err
 	}
+	t.signReq(req, reqArgs)
 
 	config := params.Config
 	if config == nil {
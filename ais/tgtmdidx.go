@@ -0,0 +1,463 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"maps"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Per-bucket secondary index of object metadata (bucket property `md_index`):
+// - each target indexes the objects it stores: name, size, atime, version, and custom metadata
+// - the index is persistent: entries live in the target's kvdb (one collection per bucket ID),
+//   and survive restarts
+// - the index is built (once) in the background by walking the bucket when the property
+//   gets enabled; while building, search returns the entries indexed so far and in-place
+//   updates take precedence over the walk
+// - PUT (including copy, append, and multipart completion), set-custom, and DELETE update the index
+//   incrementally
+// - search results are verified against the (current) object metadata - entries of
+//   objects that are no longer present (e.g., evicted by LRU or migrated by rebalance)
+//   get purged, those that changed (e.g., atime) get refreshed
+// - remote buckets: only the in-cluster objects are indexed
+// See also: apc.MDQuery and api.SearchObjects
+
+const fmtErrMDIndexDisabled = "%s: metadata index is disabled (hint: set bucket property 'md_index.enabled=true')"
+
+// kvdb collections
+const (
+	mdidxCollection = "mdidx"  // bucket ID => mdstate
+	mdentPrefix     = "mdidx-" // + bucket ID: object name => apc.MDEntry
+)
+
+type (
+	mdidx struct {
+		db kvdb.Driver
+		m  map[uint64]*bidx // bucket ID => index
+		mu sync.RWMutex
+	}
+	bidx struct {
+		db       kvdb.Driver
+		bck      *meta.Bck
+		tombs    map[string]struct{} // deleted while building
+		coll     string              // kvdb collection
+		stopCh   cos.StopCh
+		mu       sync.RWMutex
+		building bool
+		dropped  bool
+	}
+	// persisted per bucket
+	mdstate struct {
+		Bck   string `json:"bck"`
+		Built bool   `json:"built"`
+	}
+)
+
+func newMDEntry(lom *core.LOM) *apc.MDEntry {
+	e := &apc.MDEntry{
+		Name:    lom.ObjName,
+		Size:    lom.Lsize(),
+		Atime:   lom.AtimeUnix(),
+		Version: lom.Version(),
+	}
+	if md := lom.GetCustomMD(); len(md) > 0 {
+		e.Custom = maps.Clone(md)
+	}
+	return e
+}
+
+func sameMDEntry(a, b *apc.MDEntry) bool {
+	return a.Size == b.Size && a.Atime == b.Atime && a.Version == b.Version && maps.Equal(a.Custom, b.Custom)
+}
+
+func mdentColl(bid uint64) string { return mdentPrefix + strconv.FormatUint(bid, 10) }
+
+///////////
+// mdidx //
+///////////
+
+func (mdx *mdidx) init(db kvdb.Driver) {
+	mdx.mu.Lock()
+	mdx.db = db
+	mdx.mu.Unlock()
+}
+
+func (mdx *mdidx) get(bid uint64) (bi *bidx) {
+	mdx.mu.RLock()
+	bi = mdx.m[bid]
+	mdx.mu.RUnlock()
+	return bi
+}
+
+// drop indexes of the buckets that are gone or have `md_index` disabled;
+// load persisted ones; start building new ones
+func (mdx *mdidx) reconcile(bmd *meta.BMD) {
+	enabled := make(map[uint64]*meta.Bck)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if bck.Props.MDIndex.Enabled {
+			enabled[bck.Props.BID] = bck
+		}
+		return false
+	})
+
+	mdx.mu.Lock()
+	defer mdx.mu.Unlock()
+	if mdx.db == nil {
+		return // not yet initialized (see target.Run)
+	}
+	if mdx.m == nil {
+		mdx.m = make(map[uint64]*bidx, len(enabled))
+	}
+	for bid, bi := range mdx.m {
+		if _, ok := enabled[bid]; !ok {
+			bi.drop()
+			delete(mdx.m, bid)
+		}
+	}
+	persisted, _, err := mdx.db.GetAll(mdidxCollection, "")
+	if err != nil {
+		nlog.Errorln("failed to load metadata index state:", err)
+	}
+	for sbid := range persisted {
+		bid, err := strconv.ParseUint(sbid, 10, 64)
+		if err != nil {
+			continue
+		}
+		if _, ok := enabled[bid]; !ok {
+			mdx.destroy(bid)
+		}
+	}
+	for bid, bck := range enabled {
+		if _, ok := mdx.m[bid]; ok {
+			continue
+		}
+		bi := &bidx{db: mdx.db, bck: bck, coll: mdentColl(bid)}
+		bi.stopCh.Init()
+		mdx.m[bid] = bi
+
+		var state mdstate
+		if v, ok := persisted[strconv.FormatUint(bid, 10)]; ok {
+			if err := jsoniter.UnmarshalFromString(v, &state); err != nil {
+				nlog.Errorln("failed to load metadata index state", bck.Cname(""), "[", err, "]")
+			}
+		}
+		if state.Built {
+			nlog.Infoln("loaded metadata index", bck.Cname(""))
+			continue
+		}
+		// first time or interrupted: (re)build
+		bi.building, bi.tombs = true, make(map[string]struct{})
+		bi.setState(false)
+		go bi.build(cmn.GCO.Get())
+	}
+}
+
+// remove persisted index of the bucket that is gone or has `md_index` disabled
+func (mdx *mdidx) destroy(bid uint64) {
+	sbid := strconv.FormatUint(bid, 10)
+	if _, err := mdx.db.DeleteCollection(mdentColl(bid)); err != nil {
+		nlog.Errorln("failed to remove metadata index", sbid, "[", err, "]")
+		return
+	}
+	if _, err := mdx.db.Delete(mdidxCollection, sbid); err != nil && !cos.IsNotExist(err) {
+		nlog.Errorln("failed to remove metadata index state", sbid, "[", err, "]")
+	}
+}
+
+// PUT and friends
+func (mdx *mdidx) put(lom *core.LOM) {
+	bprops := lom.Bprops()
+	if bprops == nil || !bprops.MDIndex.Enabled {
+		return
+	}
+	if bi := mdx.get(bprops.BID); bi != nil {
+		bi.upsert(newMDEntry(lom))
+	}
+}
+
+// DELETE, evict, and rename
+func (mdx *mdidx) del(lom *core.LOM) {
+	bprops := lom.Bprops()
+	if bprops == nil || !bprops.MDIndex.Enabled {
+		return
+	}
+	if bi := mdx.get(bprops.BID); bi != nil {
+		bi.remove(lom.ObjName)
+	}
+}
+
+//////////
+// bidx //
+//////////
+
+func (bi *bidx) setState(built bool) {
+	state := &mdstate{Bck: bi.bck.Cname(""), Built: built}
+	if _, err := bi.db.Set(mdidxCollection, strconv.FormatUint(bi.bck.Props.BID, 10), state); err != nil {
+		nlog.Errorln("failed to persist metadata index state", bi.bck.Cname(""), "[", err, "]")
+	}
+}
+
+// stop building (if in progress); subsequent updates become no-ops
+func (bi *bidx) drop() {
+	bi.mu.Lock()
+	bi.dropped, bi.building, bi.tombs = true, false, nil
+	bi.mu.Unlock()
+	bi.stopCh.Close()
+	nlog.Infoln("dropped metadata index", bi.bck.Cname(""))
+}
+
+func (bi *bidx) build(config *cmn.Config) {
+	opts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjCT},
+		VisitObj: bi.visit,
+	}
+	opts.Bck.Copy(bi.bck.Bucket())
+	jg := mpather.NewJgroup(opts, config, nil)
+	jg.Run()
+	var stopped bool
+	select {
+	case <-jg.ListenFinished():
+	case <-bi.stopCh.Listen():
+		stopped = true
+	}
+	err := jg.Stop()
+	if stopped {
+		return
+	}
+
+	bi.mu.Lock()
+	if bi.dropped {
+		bi.mu.Unlock()
+		return
+	}
+	bi.building = false
+	bi.tombs = nil
+	if err == nil {
+		bi.setState(true)
+	}
+	bi.mu.Unlock()
+
+	if err != nil {
+		nlog.Errorln("failed to build metadata index", bi.bck.Cname(""), "[", err, "]")
+	} else {
+		nlog.Infoln("built metadata index", bi.bck.Cname(""))
+	}
+}
+
+func (bi *bidx) visit(lom *core.LOM, _ []byte) error {
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return nil // (e.g., deleted in the meantime)
+	}
+	if lom.IsCopy() {
+		return nil
+	}
+	bi.add(newMDEntry(lom))
+	return nil
+}
+
+// caller must hold the lock
+func (bi *bidx) _get(objName string) *apc.MDEntry {
+	e := &apc.MDEntry{}
+	if _, err := bi.db.Get(bi.coll, objName, e); err != nil {
+		if !cos.IsNotExist(err) {
+			nlog.Errorln("failed to read metadata index", bi.bck.Cname(objName), "[", err, "]")
+		}
+		return nil
+	}
+	return e
+}
+
+func (bi *bidx) _set(e *apc.MDEntry) {
+	if _, err := bi.db.Set(bi.coll, e.Name, e); err != nil {
+		nlog.Errorln("failed to update metadata index", bi.bck.Cname(e.Name), "[", err, "]")
+	}
+}
+
+func (bi *bidx) _del(objName string) {
+	if _, err := bi.db.Delete(bi.coll, objName); err != nil && !cos.IsNotExist(err) {
+		nlog.Errorln("failed to update metadata index", bi.bck.Cname(objName), "[", err, "]")
+	}
+}
+
+// add walked entry unless already updated or deleted in place
+func (bi *bidx) add(e *apc.MDEntry) {
+	bi.mu.Lock()
+	if !bi.dropped && bi._get(e.Name) == nil {
+		if _, deleted := bi.tombs[e.Name]; !deleted {
+			bi._set(e)
+		}
+	}
+	bi.mu.Unlock()
+}
+
+func (bi *bidx) upsert(e *apc.MDEntry) {
+	bi.mu.Lock()
+	if !bi.dropped {
+		bi._set(e)
+		if bi.building {
+			delete(bi.tombs, e.Name)
+		}
+	}
+	bi.mu.Unlock()
+}
+
+func (bi *bidx) remove(objName string) {
+	bi.mu.Lock()
+	if !bi.dropped {
+		bi._del(objName)
+		if bi.building {
+			bi.tombs[objName] = struct{}{}
+		}
+	}
+	bi.mu.Unlock()
+}
+
+// select, sort, and verify (up to the limit) matching entries;
+// while building, the result includes only the entries indexed so far
+func (bi *bidx) search(q *apc.MDQuery, verify func(e *apc.MDEntry) *apc.MDEntry) (apc.MDEntries, error) {
+	bi.mu.RLock()
+	all, _, err := bi.db.GetAll(bi.coll, "")
+	bi.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	matched := make(apc.MDEntries, 0, min(len(all), q.Limit))
+	for name, v := range all {
+		e := &apc.MDEntry{}
+		if err := jsoniter.UnmarshalFromString(v, e); err != nil {
+			nlog.Errorln("failed to read metadata index", bi.bck.Cname(name), "[", err, "]")
+			continue
+		}
+		if q.Match(e) {
+			matched = append(matched, e)
+		}
+	}
+
+	q.SortEntries(matched)
+	res := make(apc.MDEntries, 0, min(len(matched), q.Limit))
+	for _, e := range matched {
+		if len(res) >= q.Limit {
+			break
+		}
+		ne := verify(e)
+		switch {
+		case ne == nil:
+			bi.purge(e)
+		case ne != e:
+			bi.refresh(e, ne)
+			if q.Match(ne) {
+				res = append(res, ne)
+			}
+		default:
+			res = append(res, e)
+		}
+	}
+	// (verification may have changed the sorting field)
+	return q.Finalize(res), nil
+}
+
+// remove unless concurrently updated
+func (bi *bidx) purge(e *apc.MDEntry) {
+	bi.mu.Lock()
+	if cur := bi._get(e.Name); cur != nil && sameMDEntry(cur, e) {
+		bi._del(e.Name)
+	}
+	bi.mu.Unlock()
+}
+
+func (bi *bidx) refresh(e, ne *apc.MDEntry) {
+	bi.mu.Lock()
+	if cur := bi._get(e.Name); cur != nil && sameMDEntry(cur, e) {
+		bi._set(ne)
+	}
+	bi.mu.Unlock()
+}
+
+////////////
+// target //
+////////////
+
+// GET /v1/buckets/bucket-name (apc.ActSearchBck): proxy => target
+func (t *target) searchBck(w http.ResponseWriter, r *http.Request, apiItems []string, msg *actMsgExt, dpq *dpq) {
+	if len(apiItems) == 0 {
+		t.writeErrURL(w, r)
+		return
+	}
+	bck, err := newBckFromQ(apiItems[0], nil, dpq)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if err := bck.Init(t.owner.bmd); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if !bck.Props.MDIndex.Enabled {
+		t.writeErrf(w, r, fmtErrMDIndexDisabled, bck.Cname(""))
+		return
+	}
+	var q apc.MDQuery
+	if err := cos.MorphMarshal(msg.Value, &q); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	if err := q.Validate(); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	bi := t.mdx.get(bck.Props.BID)
+	if bi == nil {
+		// (BMD race)
+		t.mdx.reconcile(&t.owner.bmd.get().BMD)
+		if bi = t.mdx.get(bck.Props.BID); bi == nil {
+			t.writeErrf(w, r, fmtErrMDIndexDisabled, bck.Cname(""))
+			return
+		}
+	}
+	res, err := bi.search(&q, func(e *apc.MDEntry) *apc.MDEntry { return t.verifyMDEntry(bck, e) })
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	for i, e := range res {
+		ce := *e // (do not modify indexed entries)
+		ce.Location = t.SID()
+		res[i] = &ce
+	}
+	t.writeJSON(w, r, res, apc.ActSearchBck)
+}
+
+// returns nil if the object is not present, same entry if unchanged, new entry otherwise
+func (*target) verifyMDEntry(bck *meta.Bck, e *apc.MDEntry) *apc.MDEntry {
+	lom := core.AllocLOM(e.Name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return nil
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		if !cos.IsNotExist(err) {
+			return e // keep it
+		}
+		return nil
+	}
+	if ne := newMDEntry(lom); !sameMDEntry(ne, e) {
+		return ne
+	}
+	return e
+}
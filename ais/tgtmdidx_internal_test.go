@@ -0,0 +1,207 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newTestDB(t *testing.T) kvdb.Driver {
+	db, err := kvdb.NewBuntDB(":memory:")
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newTestBidx(t *testing.T, building bool) *bidx {
+	bck := meta.NewBck("mdidx", apc.AIS, cmn.NsGlobal)
+	bck.Props = &cmn.Bprops{BID: 1}
+	bi := &bidx{
+		db:       newTestDB(t),
+		bck:      bck,
+		coll:     mdentColl(1),
+		building: building,
+	}
+	if building {
+		bi.tombs = make(map[string]struct{})
+	}
+	bi.stopCh.Init()
+	return bi
+}
+
+func _mdQuery(t *testing.T, sort string, desc bool, limit int, preds ...string) *apc.MDQuery {
+	q := &apc.MDQuery{Sort: sort, Desc: desc, Limit: limit}
+	for _, s := range preds {
+		pred, err := apc.ParseMDPred(s)
+		tassert.CheckFatal(t, err)
+		q.Preds = append(q.Preds, pred)
+	}
+	tassert.CheckFatal(t, q.Validate())
+	return q
+}
+
+func _mdNames(entries apc.MDEntries) (names []string) {
+	for _, e := range entries {
+		names = append(names, e.Name)
+	}
+	return names
+}
+
+func _noVerify(e *apc.MDEntry) *apc.MDEntry { return e }
+
+func TestMDPredParse(t *testing.T) {
+	for _, tc := range []struct {
+		s, field, op, value string
+	}{
+		{"size>=1MiB", apc.MDFieldSize, apc.MDOpGe, "1MiB"},
+		{"size<10", apc.MDFieldSize, apc.MDOpLt, "10"},
+		{"name^=a/b", apc.MDFieldName, apc.MDOpPrefix, "a/b"},
+		{"version!=2", apc.MDFieldVersion, apc.MDOpNe, "2"},
+		{"custom.k=a<b", "custom.k", apc.MDOpEq, "a<b"},
+		{"custom.label~=cat", "custom.label", apc.MDOpContains, "cat"},
+		{"custom.label", "custom.label", apc.MDOpExists, ""},
+		{"atime>24h", apc.MDFieldAtime, apc.MDOpGt, "24h"},
+	} {
+		pred, err := apc.ParseMDPred(tc.s)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, pred.Field == tc.field && pred.Op == tc.op && pred.Value == tc.value,
+			"%q: got (%q, %q, %q)", tc.s, pred.Field, pred.Op, pred.Value)
+	}
+	for _, s := range []string{"size", "size^=1", "atime~=x", "bogus=1", "size>abc", "atime<yesterday", "=1", "custom."} {
+		_, err := apc.ParseMDPred(s)
+		tassert.Errorf(t, err != nil, "expected %q to fail", s)
+	}
+}
+
+func TestMDIndexSearch(t *testing.T) {
+	var (
+		bi  = newTestBidx(t, false)
+		now = time.Now().UnixNano()
+	)
+	for i := range 10 {
+		e := &apc.MDEntry{
+			Name:    "obj-" + strconv.Itoa(i),
+			Size:    int64(i) * cos.KiB,
+			Atime:   now - int64(i)*int64(time.Hour),
+			Version: strconv.Itoa(i % 3),
+		}
+		if i%2 == 0 {
+			e.Custom = cos.StrKVs{"color": "red", "n": strconv.Itoa(i)}
+		}
+		bi.upsert(e)
+	}
+
+	res, err := bi.search(_mdQuery(t, "", false, 0, "size>=4KiB", "custom.color=red"), _noVerify)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, slices.Equal(_mdNames(res), []string{"obj-4", "obj-6", "obj-8"}), "got %v", _mdNames(res))
+
+	// sort by size, descending, limited
+	res, err = bi.search(_mdQuery(t, apc.MDFieldSize, true, 2), _noVerify)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, slices.Equal(_mdNames(res), []string{"obj-9", "obj-8"}), "got %v", _mdNames(res))
+
+	// not accessed in the last 5.5 hours; custom numeric comparison; existence
+	res, err = bi.search(_mdQuery(t, apc.MDFieldAtime, false, 0, "atime<330m", "custom.n>=6"), _noVerify)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, slices.Equal(_mdNames(res), []string{"obj-8", "obj-6"}), "got %v", _mdNames(res))
+	res, err = bi.search(_mdQuery(t, "", false, 0, "custom.color!=red"), _noVerify)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(res) == 5, "expected 5 objects without custom color, got %v", _mdNames(res))
+
+	// delete
+	bi.remove("obj-8")
+	res, err = bi.search(_mdQuery(t, "", false, 0, "custom.color"), _noVerify)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, slices.Equal(_mdNames(res), []string{"obj-0", "obj-2", "obj-4", "obj-6"}), "got %v", _mdNames(res))
+
+	// verification purges stale entries and refreshes the changed ones
+	verify := func(e *apc.MDEntry) *apc.MDEntry {
+		switch e.Name {
+		case "obj-0":
+			return nil
+		case "obj-2":
+			ne := *e
+			ne.Size = cos.MiB
+			return &ne
+		}
+		return e
+	}
+	res, err = bi.search(_mdQuery(t, apc.MDFieldSize, true, 0, "custom.color=red"), verify)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, slices.Equal(_mdNames(res), []string{"obj-2", "obj-6", "obj-4"}), "got %v", _mdNames(res))
+	tassert.Errorf(t, bi._get("obj-0") == nil, "expected stale entry to be purged")
+	tassert.Errorf(t, bi._get("obj-2").Size == cos.MiB, "expected entry to be refreshed")
+}
+
+func TestMDIndexBuilding(t *testing.T) {
+	bi := newTestBidx(t, true)
+
+	// in-place updates take precedence over the (concurrent) walk
+	bi.upsert(&apc.MDEntry{Name: "a", Size: 2})
+	bi.remove("b")
+	bi.add(&apc.MDEntry{Name: "a", Size: 1})
+	bi.add(&apc.MDEntry{Name: "b", Size: 1})
+	bi.add(&apc.MDEntry{Name: "c", Size: 1})
+
+	// while building: entries indexed so far
+	res, err := bi.search(_mdQuery(t, "", false, 0), _noVerify)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, slices.Equal(_mdNames(res), []string{"a", "c"}), "got %v", _mdNames(res))
+
+	bi.mu.Lock()
+	bi.building, bi.tombs = false, nil
+	bi.mu.Unlock()
+
+	res, err = bi.search(_mdQuery(t, "", false, 0), _noVerify)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, slices.Equal(_mdNames(res), []string{"a", "c"}), "got %v", _mdNames(res))
+	tassert.Errorf(t, res[0].Size == 2, "expected PUT to take precedence, got size %d", res[0].Size)
+}
+
+func TestMDIndexPersist(t *testing.T) {
+	var (
+		db  = newTestDB(t)
+		bmd = newBucketMD()
+		bck = meta.NewBck("mdidx", apc.AIS, cmn.NsGlobal)
+	)
+	bmd.add(bck, &cmn.Bprops{MDIndex: cmn.MDIndexConf{Enabled: true}})
+	bid := bck.Props.BID
+
+	// persisted and built prior to restart
+	_, err := db.Set(mdidxCollection, strconv.FormatUint(bid, 10), &mdstate{Bck: bck.Cname(""), Built: true})
+	tassert.CheckFatal(t, err)
+	_, err = db.Set(mdentColl(bid), "obj", &apc.MDEntry{Name: "obj", Size: 1})
+	tassert.CheckFatal(t, err)
+
+	// restart: loaded, not rebuilt
+	mdx := &mdidx{}
+	mdx.init(db)
+	mdx.reconcile(&bmd.BMD)
+	bi := mdx.get(bid)
+	tassert.Fatalf(t, bi != nil && !bi.building, "expected persisted index to be loaded")
+	res, err := bi.search(_mdQuery(t, "", false, 0), _noVerify)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, slices.Equal(_mdNames(res), []string{"obj"}), "got %v", _mdNames(res))
+
+	// bucket gone: index removed
+	mdx.reconcile(&newBucketMD().BMD)
+	tassert.Errorf(t, mdx.get(bid) == nil, "expected index to be dropped")
+	keys, _, err := db.List(mdentColl(bid), "")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(keys) == 0, "expected persisted entries to be removed, got %v", keys)
+	keys, _, err = db.List(mdidxCollection, "")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(keys) == 0, "expected persisted state to be removed, got %v", keys)
+}
@@ -521,6 +521,7 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 	}
 
 	ups.del(uploadID)
+	t.mdx.put(lom)
 
 	if cmn.Rom.V(4, cos.ModAIS) {
 		nlog.Infoln(uploadID, "completed")
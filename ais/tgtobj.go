@@ -36,6 +36,7 @@ import (
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/reb"
+	"github.com/NVIDIA/aistore/repl"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
@@ -90,6 +91,8 @@ type (
 		latestVer  bool       // QparamLatestVer || 'versioning.*_warm_get'
 		isIOErr    bool       // to count GET error as a "IO error"; see `Trunner._softErrs()`
 		rget       bool       // when reading remote source via backend.GetObjReader, scenarios including: cold-GET, copy, transform, blob
+		e2e        bool       // 'checksum.end_to_end'
+		trailer    bool       // e2e && "TE: trailers" (see apc.HdrTrailerCksumType)
 	}
 	_uplock struct {
 		timeout time.Duration
@@ -414,6 +417,10 @@ func (poi *putOI) finalize() (ecode int, err error) {
 		}
 	}
 	poi.t.putMirror(poi.lom)
+	poi.t.mdx.put(poi.lom)
+	if poi.owt < cmn.OwtRebalance {
+		poi.t.replicate(poi.lom, repl.OpPut)
+	}
 	return 0, nil
 }
 
@@ -483,6 +490,9 @@ func (poi *putOI) fini() (ecode int, err error) {
 		default:
 			// best effort
 			if remSrc, ok := lom.GetCustomKey(cmn.SourceObjMD); !ok || remSrc == "" {
+				if err = lom.KeepVersion(false /*del*/); err != nil {
+					return 0, err
+				}
 				if err = lom.IncVersion(); err != nil {
 					nlog.Errorln(err) // (unlikely)
 				}
@@ -848,6 +858,7 @@ func (goi *getOI) expostfacto(fqn string) error {
 	}
 	lom.SetAtimeUnix(goi.atime)
 	lom.Recache()
+	lom.TierHit()
 	return nil
 }
 
@@ -1155,6 +1166,12 @@ func (goi *getOI) txfini() (fqn string, ecode int, err error) {
 
 	whdr := goi.w.Header()
 
+	// end-to-end protection
+	if lom.ValidateEndToEnd() {
+		goi.e2e = true
+		goi.trailer = !dpq.isS3 && goi.req != nil && strings.Contains(goi.req.Header.Get(cos.HdrTE), "trailers")
+	}
+
 	// transmit (range, arch, regular)
 	switch {
 	case goi.ranges.Range != "":
@@ -1199,7 +1216,14 @@ func (goi *getOI) _txrng(fqn string, lmfh cos.LomReader, whdr http.Header, hrng
 	)
 	debug.Assertf(ok, "expecting ReaderAt, got (%T)", lmfh) // m.b. fh or UfestReader
 
-	r = io.NewSectionReader(ra, hrng.Start, size)
+	if ur, chunked := lmfh.(*core.UfestReader); chunked && goi.e2e {
+		// validate (each overlapping chunk in its entirety) while reading
+		v := ur.Verifier(hrng.Start, size)
+		defer v.Close()
+		r = v
+	} else {
+		r = io.NewSectionReader(ra, hrng.Start, size)
+	}
 
 	if goi._ckrange(hrng) {
 		if !goi.lom.IsChunked() && size <= checksumRangeSizeThreshold {
@@ -1259,27 +1283,57 @@ func (goi *getOI) setwhdr(whdr http.Header, cksum *cos.Cksum, size int64) {
 	} else {
 		cmn.ToHeader(goi.lom.ObjAttrs(), whdr, size, cksum)
 	}
+	if goi.trailer {
+		goi.setTrailer(whdr)
+	}
+}
+
+// trailer fields require chunked transfer encoding, and so - no Content-Length
+// (the trailing checksum itself gets set upon successful transmission - see goi.transmit)
+func (goi *getOI) setTrailer(whdr http.Header) {
+	whdr.Del(cos.HdrContentLength)
+	whdr.Set(apc.HdrTrailerCksumType, goi.lom.CksumType())
+	whdr.Set(cos.HdrTrailer, apc.HdrTrailerCksumVal)
 }
 
 // in particular, setup reader and writer and set headers
 func (goi *getOI) _txreg(fqn string, lmfh cos.LomReader, whdr http.Header) (err error) {
+	var (
+		r    io.Reader = lmfh
+		size           = goi.lom.Lsize()
+	)
 	// set response header
-	size := goi.lom.Lsize()
 	goi.setwhdr(whdr, goi.lom.Checksum(), size)
 
+	if ur, chunked := lmfh.(*core.UfestReader); chunked && goi.e2e {
+		v := ur.Verifier(0, size)
+		defer v.Close()
+		r = v
+	}
+
 	// Tx
 	buf, slab := goi.t.gmm.AllocSize(min(size, memsys.MaxPageSlabSize))
-	err = goi.transmit(lmfh, buf, fqn, size)
+	err = goi.transmit(r, buf, fqn, size)
 	slab.Free(buf)
 	return err
 }
 
-// TODO: checksum
 func (goi *getOI) _txarch(fqn string, lmfh cos.LomReader, whdr http.Header) error {
 	var (
 		dpq = goi.dpq
 		lom = goi.lom
 	)
+	// end-to-end: validate chunked shard up front (monolithic - see lom.ValidateWarmGet)
+	if ur, chunked := lmfh.(*core.UfestReader); chunked && goi.e2e {
+		v := ur.Verifier(0, lom.Lsize())
+		_, err := io.Copy(io.Discard, v)
+		v.Close()
+		if err != nil {
+			goi.isIOErr = true
+			return err
+		}
+	}
+
 	// read single
 	if dpq.arch.path != "" {
 		csl, err := lom.NewArchpathReader(lmfh, dpq.arch.path, dpq.arch.mime)
@@ -1288,6 +1342,8 @@ func (goi *getOI) _txarch(fqn string, lmfh cos.LomReader, whdr http.Header) erro
 		}
 		// found
 		var (
+			r    io.Reader = csl
+			sgl  *memsys.SGL
 			size = csl.Size()
 		)
 		debug.Assert(size >= 0, "negative archive entry size for", lom.Cname(), "/", dpq.arch.path)
@@ -1295,9 +1351,30 @@ func (goi *getOI) _txarch(fqn string, lmfh cos.LomReader, whdr http.Header) erro
 		whdr.Set(cos.HdrContentType, cos.ContentBinary)
 		whdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
 
+		// end-to-end: per-file checksum, either trailing or computed up front (compare w/ goi._txrng)
+		switch {
+		case goi.trailer:
+			goi.setTrailer(whdr)
+		case goi.e2e:
+			sgl = goi.t.gmm.NewSGL(size)
+			_, cksumH, err := cos.CopyAndChecksum(sgl /*as ReaderFrom*/, csl, nil, lom.CksumType())
+			if err != nil {
+				sgl.Free()
+				csl.Close()
+				goi.isIOErr = true
+				return err
+			}
+			whdr.Set(apc.HdrObjCksumType, cksumH.Ty())
+			whdr.Set(apc.HdrObjCksumVal, cksumH.Val())
+			r = sgl
+		}
+
 		buf, slab := goi.t.gmm.AllocSize(_txsize(size))
-		err = goi.transmit(csl, buf, fqn, size)
+		err = goi.transmit(r, buf, fqn, size)
 		slab.Free(buf)
+		if sgl != nil {
+			sgl.Free()
+		}
 		csl.Close()
 		return err
 	}
@@ -1332,14 +1409,24 @@ func (goi *getOI) _txarch(fqn string, lmfh cos.LomReader, whdr http.Header) erro
 
 func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string, size int64) error {
 	var (
-		errTx error
+		errTx   error
+		err     error
+		written int64
+		cksumH  *cos.CksumHash
 	)
-	written, err := cos.CopyBuffer(goi.w, r, buf)
+	if goi.trailer {
+		written, cksumH, err = cos.CopyAndChecksum(goi.w, r, buf, goi.lom.CksumType())
+	} else {
+		written, err = cos.CopyBuffer(goi.w, r, buf)
+	}
 	if err != nil || written != size {
 		errTx = goi._txerr(err, fqn /*lbget*/, written, size)
 	}
 	if errTx != nil {
-		return errTx
+		return errTx // (and no trailing checksum)
+	}
+	if cksumH != nil {
+		goi.w.Header().Set(apc.HdrTrailerCksumVal, cksumH.Val())
 	}
 
 	//
@@ -1377,6 +1464,12 @@ func (goi *getOI) _txerr(err error, fqn string, written, size int64) error {
 		if cmn.Rom.V(4, cos.ModAIS) {
 			nlog.WarningDepth(1, act, cname, "err:", err)
 		}
+	case cos.IsErrBadCksum(err):
+		// [corruption] end-to-end validation (see core.UfestVerifier)
+		goi.isIOErr = true
+		lom.UncacheDel()
+		nlog.ErrorDepth(1, act, cname, "err:", err)
+		return &errGetTxSevere{msg: fmt.Sprintf("%s %s: %v", act, cname, err)}
 	default: // notwithstanding
 		goi.t.FSHC(err, lom.Mountpath(), fqn)
 		lom.UncacheDel()
@@ -1879,6 +1972,8 @@ func (coi *coi) _regular(t *target, lom, dst *core.LOM, lcopy bool) (res xs.CoiR
 		res.Lsize = lom.Lsize()
 		if coi.Finalize {
 			t.putMirror(dst2)
+			t.mdx.put(dst2)
+			t.replicate(dst2, repl.OpPut)
 		}
 	}
 	if dst2 != nil {
@@ -2010,6 +2105,7 @@ func (coi *coi) put(t *target, sargs *sendArgs) error {
 		cos.Close(sargs.reader)
 		return fmt.Errorf("unexpected failure to create request, err: %w", errN)
 	}
+	t.signReq(req, &reqArgs)
 
 	resp, err := g.client.data.Do(req)
 	if err != nil {
@@ -2176,6 +2272,8 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 		}
 	}
 	a.t.putMirror(a.lom)
+	a.t.mdx.put(a.lom)
+	a.t.replicate(a.lom, repl.OpPut)
 	return nil
 }
 
@@ -2211,6 +2309,13 @@ func (t *target) putMirror(lom *core.LOM) {
 	xputlrep.Repl(lom)
 }
 
+// journal the change for asynchronous replication (see package repl)
+func (*target) replicate(lom *core.LOM, op byte) {
+	if lom.Bprops().Repl.Enabled {
+		repl.Enqueue(lom, op)
+	}
+}
+
 //
 // uplock
 //
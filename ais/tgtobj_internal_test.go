@@ -63,6 +63,13 @@ func TestMain(m *testing.M) {
 	// target
 	config := cmn.GCO.Get()
 	config.Log.Level = "3"
+	// node-local secrets (e.g., sealing key) - never in the source tree
+	confDir, err := os.MkdirTemp("", "ais-confdir")
+	if err != nil {
+		cos.Exitf("%v", err)
+	}
+	defer os.RemoveAll(confDir)
+	config.ConfigDir = confDir
 	co := newConfigOwner(config)
 	t = newTarget(co)
 	t.initPhase1(config)
@@ -67,7 +67,7 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 		s3.WriteErr(w, r, cs.Err(), http.StatusInsufficientStorage)
 		return
 	}
-	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	bck, ecode, err := t.initByNameOnly(r, items[0])
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -123,7 +123,7 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 		return
 	}
 	// src
-	bckSrc, ecode, err := meta.InitByNameOnly(parts[0], t.owner.bmd)
+	bckSrc, ecode, err := t.initByNameOnly(r, parts[0])
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -147,7 +147,7 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 	}
 
 	// dst
-	bckTo, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	bckTo, ecode, err := t.initByNameOnly(r, items[0])
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -227,7 +227,7 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 // GET s3/<bucket-name[/<object-name>]
 func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	bucket := items[0]
-	bck, ecode, err := meta.InitByNameOnly(bucket, t.owner.bmd)
+	bck, ecode, err := t.initByNameOnly(r, bucket)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -267,6 +267,19 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		return
 	}
 
+	if ver := q.Get(s3.QparamVersionID); ver != "" && ver != s3.VersionNull {
+		lom := core.AllocLOM(objName)
+		if err = lom.InitBck(bck); err == nil {
+			w.Header().Set(cos.S3VersionHeader, ver)
+			err = t.getVersion(w, lom, ver)
+		}
+		core.FreeLOM(lom)
+		if err != nil {
+			s3.WriteErr(w, r, err, 0)
+		}
+		return
+	}
+
 	dpq := dpqAlloc()
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
 		dpqFree(dpq)
@@ -289,7 +302,7 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 // See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_HeadObject.html
 func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	bucket, objName := items[0], s3.ObjName(items)
-	bck, ecode, err := meta.InitByNameOnly(bucket, t.owner.bmd)
+	bck, ecode, err := t.initByNameOnly(r, bucket)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -300,6 +313,12 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if ver := r.URL.Query().Get(s3.QparamVersionID); ver != "" && ver != s3.VersionNull {
+		if err := headVersionS3(w.Header(), lom, ver); err != nil {
+			s3.WriteErr(w, r, err, 0)
+		}
+		return
+	}
 	exists := true
 	err = lom.Load(true /*cache it*/, false /*locked*/)
 	if err != nil {
@@ -349,7 +368,7 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 
 // DELETE /s3/<bucket-name>/<object-name>
 func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string) {
-	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	bck, ecode, err := t.initByNameOnly(r, items[0])
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -361,6 +380,14 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if ver := r.URL.Query().Get(s3.QparamVersionID); ver != "" && ver != s3.VersionNull {
+		if err := t.delVersion(lom, ver); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		w.Header().Set(cos.S3VersionHeader, ver)
+		return
+	}
 	ecode, err = t.DeleteObject(lom, false)
 	if err != nil {
 		name := lom.Cname()
@@ -377,7 +404,7 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 
 // POST /s3/<bucket-name>/<object-name>
 func (t *target) postObjS3(w http.ResponseWriter, r *http.Request, items []string) {
-	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	bck, ecode, err := t.initByNameOnly(r, items[0])
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -401,3 +428,12 @@ func (t *target) postObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		s3.QparamMptUploads, s3.QparamMptUploadID)
 	s3.WriteErr(w, r, err, 0)
 }
+
+// resolve bucket by name - within the tenant's namespace when forwarded by the proxy
+// (see p.initByNameOnly)
+func (t *target) initByNameOnly(r *http.Request, bucket string) (*meta.Bck, int, error) {
+	if uname := r.URL.Query().Get(apc.QparamNamespace); uname != "" {
+		return meta.InitByNameNs(bucket, cmn.ParseNsUname(uname), t.owner.bmd)
+	}
+	return meta.InitByNameOnly(bucket, t.owner.bmd)
+}
@@ -204,7 +204,7 @@ func (t *target) completeMptS3(w http.ResponseWriter, r *http.Request, items []s
 // 3. Remove all info from in-memory structs
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_AbortMultipartUpload.html
 func (t *target) abortMptS3(w http.ResponseWriter, r *http.Request, items []string, q url.Values) {
-	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	bck, ecode, err := t.initByNameOnly(r, items[0])
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
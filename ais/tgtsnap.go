@@ -0,0 +1,101 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/bsnap"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// activate new and remove destroyed bucket snapshots (see package bsnap);
+// upon startup, the snapshots that are still being built get resumed
+// without notifying IC
+func (t *target) reconcileSnaps(bmd *meta.BMD, startup bool) {
+	xctns := bsnap.Reconcile(bmd, startup)
+	for _, xctn := range xctns {
+		if !startup {
+			xctn.AddNotif(&xact.NotifXact{
+				Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+				Xact: xctn,
+			})
+		}
+		xact.GoRunW(xctn)
+	}
+}
+
+// GET ?snap=<name>
+func (t *target) getSnap(w http.ResponseWriter, lom *core.LOM, name string) error {
+	if !lom.Bck().IsAIS() {
+		return cmn.NewErrUnsupp("read snapshot of", lom.Bck().Cname("")+" (not an ais:// bucket)")
+	}
+	lom.Lock(false)
+	fqn, id, err := bsnap.Resolve(lom, name)
+	if err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	return t.getPreserved(w, lom, fqn, fs.SnapCT, id, "snapshot "+name)
+}
+
+// GET preserved content: snapshotted object or (non-current) object version
+// (given its content type and extra - see core.LOM.OpenPreserved);
+// the caller rlocks the object - unlocking here
+func (t *target) getPreserved(w http.ResponseWriter, lom *core.LOM, fqn, ct, extra, tag string) error {
+	slom := lom.CloneTo(fqn)
+	defer core.FreeLOM(slom)
+	if err := slom.LoadMetaFromFS(); err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	var (
+		fh  io.ReadCloser
+		err error
+	)
+	switch {
+	case !slom.IsChunked():
+		fh, err = os.Open(fqn)
+		lom.Unlock(false) // (an open file is immune to subsequent overwrites)
+	case fqn == lom.FQN:
+		defer lom.Unlock(false) // (chunks are opened one at a time)
+		fh, err = slom.Open()
+	default:
+		defer lom.Unlock(false)
+		fh, err = lom.OpenPreserved(slom, ct, extra)
+	}
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+
+	var (
+		size = slom.Lsize()
+		whdr = w.Header()
+	)
+	whdr.Set(cos.HdrContentType, cos.ContentBinary)
+	whdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+	cmn.ToHeader(slom.ObjAttrs(), whdr, size, slom.Checksum())
+
+	buf, slab := t.gmm.AllocSize(min(size, memsys.MaxPageSlabSize))
+	written, err := cos.CopyBuffer(w, fh, buf)
+	slab.Free(buf)
+	if err != nil || written != size {
+		// (headers already sent - compare w/ goi.transmit)
+		nlog.Warningln("GET", lom.Cname(), tag, "[", written, size, err, "]")
+	}
+	return nil
+}
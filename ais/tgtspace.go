@@ -85,14 +85,14 @@ func (t *target) OOS(csRefreshed *fs.CapStatus, config *cmn.Config, tcdf *fs.Tcd
 		lastTrigOOS.Store(mono.NanoTime())
 		if cs2.Err() != nil {
 			nlog.Warningln(t.String(), "still out of space, running LRU eviction now:", cs2.String())
-			t.runLRU("" /*uuid*/, nil /*wg*/, false)
+			t.runLRU("" /*uuid*/, nil /*wg*/, false, apc.PrioHigh /*out of space: never queue or throttle*/)
 		}
 	}()
 
 	return cs
 }
 
-func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.Bck) {
+func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, prio string, bcks ...cmn.Bck) {
 	var (
 		ctlmsg  string
 		regToIC = id == ""
@@ -118,6 +118,7 @@ func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.B
 		msg := t.newAmsgActVal(apc.ActRegGlobalXaction, regMsg)
 		t.bcastAsyncIC(msg)
 	}
+	xlru.SetPrio(prio)
 	ini := space.IniLRU{
 		Xaction:             xlru.(*space.XactLRU),
 		StatsT:              t.statsT,
@@ -134,6 +135,24 @@ func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.B
 	space.RunLRU(&ini)
 }
 
+// (rejected while LRU is running - concurrent eviction would skew the numbers)
+func (t *target) dryRunLRU(xargs *xact.ArgsMsg) (*apc.DryRunRes, error) {
+	if e := xreg.GetRunning(&xreg.Flt{Kind: apc.ActLRU}); e != nil {
+		return nil, fmt.Errorf("%s: cannot dry-run LRU while %s is running", t, e.Get())
+	}
+	if len(xargs.Buckets) == 0 && !xargs.Bck.IsEmpty() {
+		xargs.Buckets = []cmn.Bck{xargs.Bck}
+	}
+	ini := space.IniLRU{
+		StatsT:              t.statsT,
+		Buckets:             xargs.Buckets,
+		GetFSUsedPercentage: ios.GetFSUsedPercentage,
+		GetFSStats:          ios.GetFSStats,
+		Force:               xargs.Force,
+	}
+	return space.DryRunLRU(&ini), nil
+}
+
 func (t *target) runSpaceCleanup(xargs *xact.ArgsMsg, wg *sync.WaitGroup) fs.CapStatus {
 	var (
 		ctlmsg  string
@@ -0,0 +1,111 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Per-tenant capacity accounting (see cmn.TenantConf):
+// - periodically, compute on-disk size of all buckets in tenants' namespaces
+// - publish the result as `stats.TenantUsed` (prometheus gauge labeled with tenant name)
+// - serve apc.WhatTenants queries (proxies use the latter to enforce soft quotas)
+
+const tenantHKIval = time.Minute
+
+type ttenants struct {
+	t     *target
+	usage apc.TenantsUsage
+	mu    sync.RWMutex
+}
+
+func (tt *ttenants) init(t *target) {
+	tt.t = t
+	tt.usage = make(apc.TenantsUsage, 4)
+	hk.Reg("tenants"+hk.NameSuffix, tt.housekeep, tenantHKIval)
+}
+
+func (tt *ttenants) housekeep(int64) time.Duration {
+	config := cmn.GCO.Get()
+	if !config.Tenant.Enabled {
+		tt.reset()
+		return tenantHKIval
+	}
+	var (
+		bmd   = tt.t.owner.bmd.get()
+		usage = make(apc.TenantsUsage, len(tt.usage))
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if !bck.Ns.IsTenant() {
+			return false
+		}
+		tu, ok := usage[bck.Ns.Name]
+		if !ok {
+			tu = &apc.TenantUsage{Quota: config.Tenant.QuotaOf(bck.Ns.Name)}
+			usage[bck.Ns.Name] = tu
+		}
+		tu.Buckets++
+		tu.Size += int64(fs.OnDiskSize(bck.Bucket(), ""))
+		return false
+	})
+	tt.update(usage)
+	return tenantHKIval
+}
+
+// publish deltas (gauge)
+func (tt *ttenants) update(usage apc.TenantsUsage) {
+	tt.mu.Lock()
+	for name, tu := range usage {
+		var prev int64
+		if ptu, ok := tt.usage[name]; ok {
+			prev = ptu.Size
+		}
+		tt._add(name, tu.Size-prev)
+	}
+	for name, ptu := range tt.usage {
+		if _, ok := usage[name]; !ok {
+			tt._add(name, -ptu.Size)
+		}
+	}
+	tt.usage = usage
+	tt.mu.Unlock()
+}
+
+func (tt *ttenants) reset() {
+	if len(tt.usage) > 0 {
+		tt.update(apc.TenantsUsage{})
+	}
+}
+
+func (tt *ttenants) _add(name string, delta int64) {
+	if delta == 0 {
+		return
+	}
+	tt.t.statsT.AddWith(
+		cos.NamedVal64{Name: stats.TenantUsed, Value: delta, VarLabs: map[string]string{stats.VlabTenant: name}},
+	)
+}
+
+// (apc.WhatTenants)
+func (tt *ttenants) get(w http.ResponseWriter, r *http.Request) {
+	tt.mu.RLock()
+	out := make(apc.TenantsUsage, len(tt.usage))
+	for name, tu := range tt.usage {
+		v := *tu
+		out[name] = &v
+	}
+	tt.mu.RUnlock()
+	tt.t.writeJSON(w, r, out, apc.WhatTenants)
+}
@@ -0,0 +1,221 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// trash (soft delete): list and restore deleted objects (see core/ltrash.go)
+// and destroyed buckets (see fs/trash.go)
+
+// GET /v1/buckets/bucket-name (apc.ActListTrash): proxy => target
+func (t *target) lsTrash(w http.ResponseWriter, r *http.Request, apiItems []string, msg *actMsgExt, dpq *dpq) {
+	bck, err := t._trashBck(apiItems, dpq)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	var tmsg apc.TrashMsg
+	if err := cos.MorphMarshal(msg.Value, &tmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	ents, err := t.trashed(bck, tmsg.Prefix)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	t.writeJSON(w, r, ents, apc.ActListTrash)
+}
+
+func (t *target) _trashBck(apiItems []string, dpq *dpq) (*meta.Bck, error) {
+	if len(apiItems) == 0 {
+		return nil, fmt.Errorf("%s: missing bucket name", t)
+	}
+	bck, err := newBckFromQ(apiItems[0], nil, dpq)
+	if err != nil {
+		return nil, err
+	}
+	if err := bck.Init(t.owner.bmd); err != nil {
+		return nil, err
+	}
+	return bck, _checkTrashBck(bck)
+}
+
+func _checkTrashBck(bck *meta.Bck) error {
+	if !bck.IsAIS() {
+		return cmn.NewErrUnsupp("restore deleted objects in", bck.Cname("")+" (trash is supported only for ais:// buckets)")
+	}
+	return nil
+}
+
+// deleted objects stored on this target that have not expired yet
+func (t *target) trashed(bck *meta.Bck, prefix string) (apc.TrashEntries, error) {
+	var (
+		ents      = make(apc.TrashEntries, 0, 64)
+		retention = int64(bck.Props.Trash.RetentionD())
+		now       = time.Now().UnixNano()
+	)
+	for _, mi := range fs.GetAvail() {
+		opts := &fs.WalkOpts{
+			Mi:     mi,
+			Bck:    *bck.Bucket(),
+			Prefix: prefix,
+			CTs:    []string{fs.TrashCT},
+			Callback: func(fqn string, de fs.DirEntry) error {
+				if de.IsDir() {
+					return nil
+				}
+				var parsed fs.ParsedFQN
+				if err := parsed.Init(fqn); err != nil {
+					return nil
+				}
+				ci := fs.CSM.ParseUbase(parsed.ObjName, fs.TrashCT)
+				if !ci.Ok {
+					return nil
+				}
+				deleted, main, err := core.ParseTrashed(ci.Extras[0])
+				if err != nil || !main || deleted+retention < now {
+					return nil // (manifests and chunk links go with their main file)
+				}
+				e := &apc.TrashEntry{Name: ci.Base, Location: t.SID(), Deleted: deleted, Expires: deleted + retention}
+				lom := core.AllocLOM(ci.Base)
+				if lom.InitBck(bck) == nil {
+					tlom := lom.CloneTo(fqn)
+					if tlom.LoadMetaFromFS() == nil {
+						e.Size, e.Version = tlom.Lsize(true), tlom.Version(true)
+						if tlom.IsFntl() {
+							if orig := tlom.OrigFntl(); orig != nil {
+								e.Name = orig[1]
+							}
+						}
+					}
+					core.FreeLOM(tlom)
+				}
+				core.FreeLOM(lom)
+				if strings.HasPrefix(e.Name, prefix) {
+					ents = append(ents, e)
+				}
+				return nil
+			},
+		}
+		if err := fs.Walk(opts); err != nil && !cos.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return ents, nil
+}
+
+// POST /v1/buckets/bucket-name (apc.ActUndelete): proxy => target
+// (the proxy selects which deleted copies to restore and sends each target its own share)
+func (t *target) undelete(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *actMsgExt) {
+	if err := _checkTrashBck(bck); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	var ents apc.TrashEntries
+	if err := cos.MorphMarshal(msg.Value, &ents); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	restored := make(apc.TrashEntries, 0, len(ents))
+	for _, e := range ents {
+		if ecode, err := t.restoreTrashed(bck, e); err != nil {
+			t.writeErr(w, r, err, ecode)
+			return
+		}
+		restored = append(restored, e)
+	}
+	t.writeJSON(w, r, restored, apc.ActUndelete)
+}
+
+// restore via regular copy that takes care of the placement (the object's current
+// owner may well be another target) and of the bucket's mirroring, EC, et al.
+func (t *target) restoreTrashed(bck *meta.Bck, e *apc.TrashEntry) (int, error) {
+	lom := core.AllocLOM(e.Name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return 0, err
+	}
+	tfqn, err := lom.FindTrashed(e.ID())
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	// not overwriting
+	tsi, local, err := lom.HrwTarget(&t.owner.smap.get().Smap)
+	if err != nil {
+		return 0, err
+	}
+	exists := lom.Load(false /*cache it*/, false /*locked*/) == nil
+	if !local {
+		exists = t.HeadObjT2T(lom, tsi)
+	}
+	if exists {
+		return http.StatusConflict, fmt.Errorf("cannot restore %s: object exists", lom.Cname())
+	}
+
+	tlom := lom.CloneTo(tfqn)
+	defer core.FreeLOM(tlom)
+	if err := tlom.LoadMetaFromFS(); err != nil {
+		return 0, err
+	}
+	roc, err := lom.OpenPreserved(tlom, fs.TrashCT, e.ID())
+	if err != nil {
+		return 0, err
+	}
+	oah := tlom.ObjAttrs()
+	coiParams := xs.AllocCOI()
+	{
+		coiParams.GetROC = func(*core.LOM, bool, bool, *core.ETLArgs) core.ReadResp {
+			return core.ReadResp{R: roc, OAH: oah}
+		}
+		coiParams.BckTo = bck
+		coiParams.ObjnameTo = e.Name
+		coiParams.Config = cmn.GCO.Get()
+		coiParams.OWT = cmn.OwtCopy // (preserving version and custom metadata)
+		coiParams.Finalize = true
+	}
+	coi := (*coi)(coiParams)
+	res := coi.do(t, nil /*DM*/, tlom)
+	xs.FreeCOI(coiParams)
+	if res.Err != nil {
+		return res.Ecode, res.Err
+	}
+	return 0, lom.RemovePreserved(tfqn, fs.TrashCT, e.ID())
+}
+
+// GET /v1/buckets/bucket-name (apc.ActUndeleteBck): proxy => target
+// the first (query) phase of restoring destroyed bucket: its trashed incarnations, newest first
+// (the bucket is not in the BMD)
+func (t *target) lsTrashedBcks(w http.ResponseWriter, r *http.Request, apiItems []string, dpq *dpq) {
+	if len(apiItems) == 0 {
+		t.writeErrURL(w, r)
+		return
+	}
+	bck, err := newBckFromQ(apiItems[0], nil, dpq)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	tbcks, err := fs.ListTrashedBcks(bck.Bucket())
+	if err != nil && len(tbcks) == 0 {
+		t.writeErr(w, r, err)
+		return
+	}
+	t.writeJSON(w, r, tbcks, apc.ActUndeleteBck)
+}
@@ -108,7 +108,7 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 
 	var xid string
 	switch msg.Action {
-	case apc.ActCreateBck, apc.ActAddRemoteBck:
+	case apc.ActCreateBck, apc.ActAddRemoteBck, apc.ActUndeleteBck:
 		err = t.createBucket(c)
 	case apc.ActMakeNCopies:
 		xid, err = t.makeNCopies(c)
@@ -292,6 +292,7 @@ func (t *target) makeNCopies(c *txnSrv) (string, error) {
 		xctn := rns.Entry.Get()
 		flt := xreg.Flt{Kind: apc.ActPutCopies, Bck: c.bck}
 		xreg.DoAbort(&flt, errors.New("make-n-copies"))
+		xctn.SetPrio(c.msg.Prio)
 		c.addNotif(xctn) // notify upon completion
 		xact.GoRunW(xctn)
 
@@ -373,6 +374,7 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 			xctn := rns.Entry.Get()
 			flt := xreg.Flt{Kind: apc.ActPutCopies, Bck: c.bck}
 			xreg.DoAbort(&flt, errors.New("re-mirror"))
+			xctn.SetPrio(c.msg.Prio)
 			c.addNotif(xctn) // notify upon completion
 			xact.GoRunW(xctn)
 			xid = xctn.ID()
@@ -387,6 +389,7 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 				return "", rns.Err
 			}
 			xctn := rns.Entry.Get()
+			xctn.SetPrio(c.msg.Prio)
 			c.addNotif(xctn) // ditto
 			xact.GoRunW(xctn)
 
@@ -611,6 +614,7 @@ func (t *target) tcb(c *txnSrv, msg *apc.TCBMsg, disableDM bool) (string, error)
 		xctn := rns.Entry.Get()
 		xid := xctn.ID()
 		debug.Assert(xid == txnTcb.xtcb.ID())
+		xctn.SetPrio(c.msg.Prio)
 		c.addNotif(xctn) // notify upon completion
 		xact.GoRunW(xctn)
 		return xid, nil
@@ -703,6 +707,9 @@ func (t *target) tcobjs(c *txnSrv, msg *cmn.TCOMsg, disableDM bool) (xid string,
 		}
 		xctn := rns.Entry.Get()
 		xid = xctn.ID()
+		if !rns.IsRunning() {
+			xctn.SetPrio(c.msg.Prio) // (demand xaction: the first request determines its class)
+		}
 
 		xtco := xctn.(*xs.XactTCO)
 
@@ -798,6 +805,7 @@ func (t *target) ecEncode(c *txnSrv) (string, error) {
 			return "", rns.Err
 		}
 		xctn := rns.Entry.Get()
+		xctn.SetPrio(c.msg.Prio)
 		c.addNotif(xctn) // notify upon completion
 		xact.GoRunW(xctn)
 
@@ -859,6 +867,9 @@ func (t *target) createArchMultiObj(c *txnSrv) (string /*xaction uuid*/, error)
 		}
 		xctn := rns.Entry.Get()
 		xid = xctn.ID()
+		if !rns.IsRunning() {
+			xctn.SetPrio(c.msg.Prio) // ditto
+		}
 
 		xarch := xctn.(*xs.XactArch)
 		// finalize the message and begin local transaction
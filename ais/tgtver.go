@@ -0,0 +1,256 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// version history (see core/lver.go):
+// GET, HEAD, and DELETE a given version (apc.QparamVersionID or, via S3 API, s3.QparamVersionID),
+// and list versions (apc.LsVerHistory or, via S3 API, ListObjectVersions)
+
+func _checkVerBck(bck *meta.Bck) error {
+	if !bck.IsAIS() {
+		return cmn.NewErrUnsupp("access object versions in", bck.Cname("")+" (version history is supported only for ais:// buckets)")
+	}
+	return nil
+}
+
+// GET ?version_id=<ver>
+func (t *target) getVersion(w http.ResponseWriter, lom *core.LOM, ver string) error {
+	if err := _checkVerBck(lom.Bck()); err != nil {
+		return err
+	}
+	lom.Lock(false)
+	fqn, err := lom.ResolveVersion(ver)
+	if err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	return t.getPreserved(w, lom, fqn, fs.VersionCT, "" /*(non-current versions are not chunked)*/, "v"+ver)
+}
+
+// HEAD ?version_id=<ver>
+func (*target) headVersion(whdr http.Header, bck *meta.Bck, lom *core.LOM, ver string) (int, error) {
+	if err := lom.InitBck(bck); err != nil {
+		if cmn.IsErrBucketNought(err) {
+			return http.StatusNotFound, err
+		}
+		return 0, err
+	}
+	vlom, err := _loadVersion(lom, ver)
+	if err != nil {
+		return 0, err
+	}
+	op := cmn.ObjectProps{Name: lom.ObjName, Bck: *lom.Bucket(), Present: true, ObjAttrs: *vlom.ObjAttrs()}
+	core.FreeLOM(vlom)
+	objPropsToHeader(&op, whdr, false /*hasEC*/)
+	return 0, nil
+}
+
+// HEAD /s3/<bucket-name>/<object-name>?versionId=<ver>
+func headVersionS3(whdr http.Header, lom *core.LOM, ver string) error {
+	vlom, err := _loadVersion(lom, ver)
+	if err != nil {
+		return err
+	}
+	whdr.Set(cos.S3VersionHeader, ver)
+	s3.SetS3Headers(whdr, vlom)
+	whdr.Set(cos.HdrContentLength, strconv.FormatInt(vlom.Lsize(), 10))
+	core.FreeLOM(vlom)
+	return nil
+}
+
+// load metadata of a given (current or non-current) version; the caller frees the returned LOM
+func _loadVersion(lom *core.LOM, ver string) (*core.LOM, error) {
+	if err := _checkVerBck(lom.Bck()); err != nil {
+		return nil, err
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	fqn, err := lom.ResolveVersion(ver)
+	if err != nil {
+		return nil, err
+	}
+	vlom := lom.CloneTo(fqn)
+	if err := vlom.LoadMetaFromFS(); err != nil {
+		core.FreeLOM(vlom)
+		return nil, err
+	}
+	return vlom, nil
+}
+
+// DELETE ?version_id=<ver> (permanently)
+func (*target) delVersion(lom *core.LOM, ver string) error {
+	if err := _checkVerBck(lom.Bck()); err != nil {
+		return err
+	}
+	lom.Lock(true)
+	err := lom.DelVersion(ver)
+	lom.Unlock(true)
+	return err
+}
+
+// list versions of the objects stored on this target: up to `PageSize` object
+// names (each with all its versions) that follow the continuation token;
+// two sorted walks - current objects and version history - that skip directories
+// preceding the token and stop as soon as the page is full
+func (*target) lsVersions(bck *meta.Bck, lsmsg *apc.LsoMsg) (*cmn.LsoRes, error) {
+	if err := _checkVerBck(bck); err != nil {
+		return nil, err
+	}
+	pageSize := int(lsmsg.PageSize)
+	if pageSize <= 0 {
+		pageSize = apc.MaxPageSizeAIS
+	}
+	after := max(lsmsg.ContinuationToken, lsmsg.StartAfter)
+	names, err := _lsVerNames(bck, lsmsg.Prefix, after, pageSize, fs.ObjCT)
+	if err != nil {
+		return nil, err
+	}
+	vnames, err := _lsVerNames(bck, lsmsg.Prefix, after, pageSize, fs.VersionCT)
+	if err != nil {
+		return nil, err
+	}
+	names = append(names, vnames...)
+	slices.Sort(names)
+	names = slices.Compact(names)
+	if len(names) > pageSize {
+		names = names[:pageSize]
+	}
+
+	lst := &cmn.LsoRes{UUID: lsmsg.UUID, Entries: make(cmn.LsoEntries, 0, len(names))}
+	for _, name := range names {
+		lom := core.AllocLOM(name)
+		if err := lom.InitBck(bck); err == nil {
+			lst.Entries = _lsVersions(lom, lsmsg, lst.Entries)
+		}
+		core.FreeLOM(lom)
+	}
+	return lst, nil
+}
+
+var errVerPageFull = errors.New("page is full")
+
+// object names that have content of a given type (current object or version history)
+func _lsVerNames(bck *meta.Bck, prefix, after string, pageSize int, ct string) ([]string, error) {
+	names := make([]string, 0, min(pageSize, 256))
+	opts := &fs.WalkBckOpts{
+		ValidateCb: func(fqn string, de fs.DirEntry) error {
+			if !de.IsDir() {
+				return nil
+			}
+			var parsed fs.ParsedFQN
+			if parsed.Init(fqn) != nil || parsed.ObjName == "" {
+				return nil
+			}
+			dir := parsed.ObjName
+			if prefix != "" && !cmn.DirHasOrIsPrefix(dir, prefix) {
+				return filepath.SkipDir
+			}
+			// all names under dir precede the token
+			if after != "" && after > dir+"/" && !strings.HasPrefix(after, dir+"/") {
+				return filepath.SkipDir
+			}
+			return nil
+		},
+		WalkOpts: fs.WalkOpts{
+			CTs:    []string{ct},
+			Sorted: true,
+			Callback: func(fqn string, de fs.DirEntry) error {
+				if de.IsDir() {
+					return nil
+				}
+				var parsed fs.ParsedFQN
+				if err := parsed.Init(fqn); err != nil {
+					return nil
+				}
+				name := parsed.ObjName
+				if ct == fs.VersionCT {
+					ci := fs.CSM.ParseUbase(name, fs.VersionCT)
+					if !ci.Ok {
+						return nil
+					}
+					name = ci.Base
+				}
+				if !strings.HasPrefix(name, prefix) || name <= after {
+					return nil
+				}
+				if l := len(names); l > 0 && names[l-1] == name {
+					return nil
+				}
+				if len(names) == pageSize {
+					return errVerPageFull
+				}
+				names = append(names, name)
+				return nil
+			},
+		},
+	}
+	opts.WalkOpts.Bck.Copy(bck.Bucket())
+	if err := fs.WalkBck(opts); err != nil && err != errVerPageFull && !cos.IsNotExist(err) {
+		return nil, err
+	}
+	return names, nil
+}
+
+// current (if exists) followed by non-current versions and delete markers, newest first
+func _lsVersions(lom *core.LOM, lsmsg *apc.LsoMsg, entries cmn.LsoEntries) cmn.LsoEntries {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	hasCurr := lom.Load(false /*cache it*/, true /*locked*/) == nil && lom.IsHRW()
+	if hasCurr {
+		entries = append(entries, _verEntry(lom, lsmsg, apc.EntryIsCached))
+	}
+	hist, err := lom.VerHistory()
+	if err != nil {
+		return entries
+	}
+	for i := range hist {
+		e := &hist[i]
+		if e.DelMarker {
+			var flags uint16 = apc.EntryIsDelMarker
+			if hasCurr || i > 0 {
+				flags |= apc.EntryVerNoncurrent // (the latest delete marker is current)
+			}
+			entries = append(entries, &cmn.LsoEnt{Name: lom.ObjName, Version: e.Version, Flags: flags})
+			continue
+		}
+		vlom := lom.CloneTo(e.FQN)
+		if vlom.LoadMetaFromFS() == nil {
+			entries = append(entries, _verEntry(vlom, lsmsg, apc.EntryIsCached|apc.EntryVerNoncurrent))
+		}
+		core.FreeLOM(vlom)
+	}
+	return entries
+}
+
+func _verEntry(lom *core.LOM, lsmsg *apc.LsoMsg, flags uint16) *cmn.LsoEnt {
+	en := &cmn.LsoEnt{
+		Name:    lom.ObjName,
+		Version: lom.Version(true),
+		Size:    lom.Lsize(true),
+		Atime:   cos.FormatNanoTime(lom.AtimeUnix(), lsmsg.TimeFormat),
+		Flags:   flags,
+	}
+	if cksum := lom.Checksum(); cksum != nil {
+		en.Checksum = cksum.Value()
+	}
+	return en
+}
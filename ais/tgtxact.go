@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs/mpather"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/res"
 	"github.com/NVIDIA/aistore/xact"
@@ -130,7 +131,7 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		if xargs.Kind == apc.ActPrefetchObjects {
-			ecode, err := t.runPrefetch(xargs.ID, bck, &apc.PrefetchMsg{})
+			ecode, err := t.runPrefetch(xargs.ID, bck, &apc.PrefetchMsg{}, xargs.Prio)
 			if err != nil {
 				t.writeErr(w, r, err, ecode)
 			}
@@ -146,31 +147,53 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 			writeXid(w, xid)
 		}
 	case apc.ActXactStop:
-		if xargs.Kind != "" {
-			if err := xact.CheckValidKind(xargs.Kind); err != nil {
-				t.writeErrf(w, r, "%v: %s", err, xargs.String())
-				return
-			}
-		}
-		if xargs.ID != "" {
-			if err := xact.CheckValidUUID(xargs.ID); err != nil {
-				t.writeErrf(w, r, "%v: %s", err, xargs.String())
-				return
-			}
-		}
-		if xargs.Kind == "" && xargs.ID == "" {
-			t.writeErrf(w, r, "cannot stop xaction given '%s' - expecting a valid kind and/or UUID", xargs.String())
+		if !t.xvalid(w, r, &xargs, msg.Action) {
 			return
 		}
-
 		err := cos.Ternary(msg.Name == cmn.ErrXactICNotifAbort.Error(), cmn.ErrXactICNotifAbort, cmn.ErrXactUserAbort)
 		flt := xreg.Flt{ID: xargs.ID, Kind: xargs.Kind, Bck: bck}
 		xreg.DoAbort(&flt, err)
+	case apc.ActXactPause, apc.ActXactResume:
+		if !t.xvalid(w, r, &xargs, msg.Action) {
+			return
+		}
+		flt := xreg.Flt{ID: xargs.ID, Kind: xargs.Kind, Bck: bck}
+		n, err := xreg.DoPause(&flt, msg.Action == apc.ActXactResume)
+		switch {
+		case err != nil && cmn.IsErrXactNotFound(err):
+			t.writeErr(w, r, err, http.StatusNotFound, Silent)
+		case err != nil:
+			t.writeErr(w, r, err)
+		case n == 0:
+			// (not running here - the caller checks other targets)
+			t.writeErr(w, r, cmn.NewErrXactNotFoundError(xargs.String()), http.StatusNotFound, Silent)
+		}
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
 }
 
+// stop, pause, or resume: expecting valid kind and/or UUID
+func (t *target) xvalid(w http.ResponseWriter, r *http.Request, xargs *xact.ArgsMsg, action string) bool {
+	if xargs.Kind != "" {
+		if err := xact.CheckValidKind(xargs.Kind); err != nil {
+			t.writeErrf(w, r, "%v: %s", err, xargs.String())
+			return false
+		}
+	}
+	if xargs.ID != "" {
+		if err := xact.CheckValidUUID(xargs.ID); err != nil {
+			t.writeErrf(w, r, "%v: %s", err, xargs.String())
+			return false
+		}
+	}
+	if xargs.Kind == "" && xargs.ID == "" {
+		t.writeErrf(w, r, "cannot %s xaction given '%s' - expecting a valid kind and/or UUID", action, xargs.String())
+		return false
+	}
+	return true
+}
+
 func (t *target) xget(w http.ResponseWriter, r *http.Request, what, uuid string) {
 	if what != apc.WhatXactStats {
 		t.writeErrf(w, r, fmtUnknownQue, what)
@@ -246,7 +269,7 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 		if len(args.Buckets) == 0 && !args.Bck.IsEmpty() {
 			args.Buckets = []cmn.Bck{args.Bck}
 		}
-		go t.runLRU(args.ID, wg, args.Force, args.Buckets...)
+		go t.runLRU(args.ID, wg, args.Force, args.Prio, args.Buckets...)
 		wg.Wait()
 	case apc.ActStoreCleanup:
 		wg := &sync.WaitGroup{}
@@ -289,9 +312,16 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 		return t.runRechunk(args.ID, bck, &apc.RechunkMsg{
 			ObjSizeLimit: int64(bck.Props.Chunks.ObjSizeLimit),
 			ChunkSize:    int64(bck.Props.Chunks.ChunkSize),
-		})
+		}, args.Prio)
+	case apc.ActScrub:
+		return t.runScrub(args.ID, bck, &apc.ScrubMsg{}, args.Prio) // (detect only)
+	case apc.ActTier:
+		return t.runTier(args.ID, bck, args.Prio)
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
+		if rns.Err == nil {
+			rns.Entry.Get().SetPrio(args.Prio)
+		}
 		return xid, rns.Err
 	case apc.ActBlobDl:
 		debug.Assert(msg.Name != "")
@@ -365,3 +395,89 @@ func (t *target) httpxpost(w http.ResponseWriter, r *http.Request) {
 	}
 	xtco.ContMsg(&tcomsg)
 }
+
+// restart xactions that were paused when this target went down
+// (their state remains paused until resumed - see fs/mpather/ckpt.go)
+func (t *target) restartPaused() {
+	for xid, ckpt := range mpather.LoadCkpts() {
+		var (
+			err error
+			bck = meta.CloneBck(&ckpt.Bck)
+		)
+		if err = bck.Init(t.owner.bmd); err == nil {
+			switch ckpt.Kind {
+			case apc.ActScrub:
+				msg := &apc.ScrubMsg{}
+				if err = cos.MorphMarshal(ckpt.Args, msg); err == nil {
+					_, err = t.runScrub(xid, bck, msg, "")
+				}
+			case apc.ActRechunk:
+				msg := &apc.RechunkMsg{}
+				if err = cos.MorphMarshal(ckpt.Args, msg); err == nil {
+					_, err = t.runRechunk(xid, bck, msg, "")
+				}
+			default:
+				err = cmn.NewErrUnsupp("restart", ckpt.Kind)
+			}
+		}
+		if err != nil {
+			nlog.Errorln(t.String(), "failed to restart paused", ckpt.Kind, xid, "on", bck.Cname("")+":", err)
+			mpather.RemoveCkpts(xid)
+			continue
+		}
+		nlog.Infoln(t.String(), "restarted paused", ckpt.Kind, xid, "on", bck.Cname(""))
+	}
+}
+
+//
+// cluster-wide admission of prioritized batch jobs (see prxadmit.go and xact/sched.go)
+//
+
+func (t *target) admitXact(xid, prio string) (admitted bool, err error) {
+	smap := t.owner.smap.get()
+	if err := smap.validate(); err != nil {
+		return false, err
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = smap.Primary
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPost,
+			Path:   apc.URLPathXactions.Join(xid),
+			Header: http.Header{apc.HdrJobPrio: []string{prio}},
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+		cargs.cresv = cresjGeneric[bool]{}
+	}
+	res := t.call(cargs, smap)
+	if res.err != nil {
+		err = res.toErr()
+	} else {
+		admitted = *res.v.(*bool)
+	}
+	freeCargs(cargs)
+	freeCR(res)
+	return admitted, err
+}
+
+// async: called upon finishing
+func (t *target) unadmitXact(xid, _ string) {
+	go func() {
+		smap := t.owner.smap.get()
+		if smap.validate() != nil {
+			return
+		}
+		cargs := allocCargs()
+		{
+			cargs.si = smap.Primary
+			cargs.req = cmn.HreqArgs{Method: http.MethodDelete, Path: apc.URLPathXactions.Join(xid)}
+			cargs.timeout = cmn.Rom.CplaneOperation()
+		}
+		res := t.call(cargs, smap)
+		if res.err != nil {
+			nlog.Warningln(t.String(), "failed to release", xid, "admission:", res.err) // (the primary will free it upon housekeeping)
+		}
+		freeCargs(cargs)
+		freeCR(res)
+	}()
+}
@@ -15,12 +15,16 @@ import (
 // ActMsg.Action
 // includes Xaction.Kind == ActMsg.Action (when the action is asynchronous)
 const (
-	ActCreateBck   = "create-bck"  // NOTE: compare w/ ActAddRemoteBck below
-	ActDestroyBck  = "destroy-bck" // destroy bucket data and metadata
+	ActCreateBck   = "create-bck"   // NOTE: compare w/ ActAddRemoteBck below
+	ActDestroyBck  = "destroy-bck"  // destroy bucket data and metadata
+	ActUndeleteBck = "undelete-bck" // restore destroyed ais:// bucket from trash (see bucket prop "trash")
 	ActSetBprops   = "set-bprops"
 	ActResetBprops = "reset-bprops"
 
 	ActSummaryBck = "summary-bck"
+	ActSearchBck  = "search-bck" // query per-bucket metadata index (see MDQuery)
+	ActListTrash  = "list-trash" // list deleted objects that can still be restored (see TrashEntry)
+	ActUndelete   = "undelete"   // restore deleted objects from trash (see UndeleteMsg)
 
 	ActECEncode  = "ec-encode" // erasure code a bucket
 	ActECGet     = "ec-get"    // read erasure coded objects
@@ -40,6 +44,14 @@ const (
 	ActMakeNCopies = "make-n-copies"
 	ActPutCopies   = "put-copies"
 	ActRechunk     = "rechunk"
+	ActScrub       = "scrub"     // target-side: verify checksums, copies, EC, and chunks; optionally repair
+	ActInventory   = "inventory" // export point-in-time bucket inventory (manifest) into a destination bucket
+	ActTier        = "tier"      // demote and promote objects between storage classes (see bucket prop "tier")
+
+	ActReplicate = "replicate" // ship journaled bucket changes to remote AIS or cloud (see bucket prop "replication")
+
+	ActCreateSnap  = "create-snapshot"  // point-in-time (copy-on-write) snapshot of an ais:// bucket
+	ActDestroySnap = "destroy-snapshot" // ditto, remove
 
 	ActRebalance = "rebalance"
 	ActMoveBck   = "move-bck"
@@ -126,6 +138,7 @@ const (
 	ActSelfRemove   = "self-initiated-removal" // e.g., when losing last mountpath
 	ActPrimaryForce = "primary-force"          // set primary with force (BEWARE! advanced usage only)
 	ActBumpMetasync = "bump-metasync"          // when executing ActPrimaryForce - the final step
+	ActDryRun       = "dry-run"                // proxy => targets: execute selection logic (only) of the named action (see DryRunRes)
 )
 
 const (
@@ -139,8 +152,14 @@ const (
 	ActMountpathFSHC   = "fshc-mp"
 
 	// Actions on xactions
-	ActXactStop  = Stop
-	ActXactStart = Start
+	ActXactStop   = Stop
+	ActXactStart  = Start
+	ActXactPause  = Pause  // (see xact.Table: "Pausable")
+	ActXactResume = Resume // ditto
+
+	// Scheduled (recurring) jobs (see xact.CronJob)
+	ActAddJobSched = "add-job-schedule"
+	ActRmJobSched  = "rm-job-schedule"
 )
 
 const (
@@ -163,9 +182,11 @@ const (
 type (
 	// swagger:model
 	ActMsg struct {
-		Value  any    `json:"value"`  // action-specific and optional
-		Action string `json:"action"` // ActShutdown, ActRebalance, and many more (see apc/const.go)
-		Name   string `json:"name"`   // action-specific info of any kind (not necessarily "name")
+		Value  any    `json:"value"`          // action-specific and optional
+		Action string `json:"action"`         // ActShutdown, ActRebalance, and many more (see apc/const.go)
+		Name   string `json:"name"`           // action-specific info of any kind (not necessarily "name")
+		Prio   string `json:"prio,omitempty"` // batch job priority class: PrioLow, PrioNormal (default), or PrioHigh
+		User   string `json:"-"`              // (proxy-side only) authenticated user that initiated the action, if any
 	}
 	// swagger:model
 	ActValRmNode struct {
@@ -0,0 +1,21 @@
+// Package apc: API constant and control messages
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// AuditEvent is a single audit log record (one JSON line in the audit log)
+type AuditEvent struct {
+	Time    int64  `json:"time,string"`       // Unix time (nanoseconds)
+	Node    string `json:"node"`              // recording node ID
+	User    string `json:"user,omitempty"`    // authenticated user (AuthN token subject), if any
+	Method  string `json:"method"`            // HTTP method
+	Path    string `json:"path"`              // URL path
+	Action  string `json:"action,omitempty"`  // ActMsg.Action
+	Name    string `json:"name,omitempty"`    // ActMsg.Name (e.g., destination bucket, list of objects)
+	Bucket  string `json:"bucket,omitempty"`  // bucket (cname)
+	Object  string `json:"object,omitempty"`  // object name
+	Remote  string `json:"remote,omitempty"`  // client address
+	Status  int    `json:"status"`            // HTTP status
+	Latency int64  `json:"latency_ns,string"` // request latency
+}
@@ -32,6 +32,7 @@ type (
 			PresentObjs uint64 `json:"size_all_present_objs,string"` // sum(cached object sizes)
 			RemoteObjs  uint64 `json:"size_all_remote_objs,string"`  // sum(all object sizes in a remote bucket)
 			Disks       uint64 `json:"total_disks_size,string"`
+			Snapshots   uint64 `json:"size_snapshots,string,omitempty"` // preserved (copy-on-write) objects of all bucket snapshots
 		}
 		UsedPct      uint64 `json:"used_pct"`
 		IsBckPresent bool   `json:"is_present"` // in BMD
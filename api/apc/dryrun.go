@@ -0,0 +1,44 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// Dry-run of a destructive (or bulk) operation: each target executes the same
+// listing and selection logic without modifying any data, and reports
+// what would be affected.
+// Supported operations:
+// - multi-object evict and delete (`EvdMsg.DryRun`)
+// - destroy bucket and evict remote bucket (`QparamDryRun`)
+// - rename bucket (ditto)
+// - LRU eviction (ditto)
+// See also: `CopyBckMsg.DryRun`
+
+// max number of object names (per target) in the DryRunRes.Sample
+const DryRunSampleSize = 10
+
+type (
+	DryRunRes struct {
+		Sample []string `json:"sample,omitempty"` // first selected object names
+		Objs   int64    `json:"objs,string"`      // number of selected objects
+		Bytes  int64    `json:"bytes,string"`     // their total (in-cluster) size
+	}
+	// all targets: target ID => result
+	DryRunResults map[string]*DryRunRes
+)
+
+func (res *DryRunRes) Add(objName string, size int64) {
+	res.Objs++
+	res.Bytes += size
+	if len(res.Sample) < DryRunSampleSize {
+		res.Sample = append(res.Sample, objName)
+	}
+}
+
+func (all DryRunResults) Totals() (objs, size int64) {
+	for _, res := range all {
+		objs += res.Objs
+		size += res.Bytes
+	}
+	return objs, size
+}
@@ -33,6 +33,9 @@ const (
 	HdrBlobChunk    = aisPrefix + "Blob-Chunk"    // optional; e.g., 1mb, 2MIB, 3m, or 1234567 (bytes)
 	HdrBlobWorkers  = aisPrefix + "Blob-Workers"  // optional; the default number of workers is dfltNumWorkers in xs/blob_download.go
 
+	// priority class of the batch job(s) started by this request, unless specified via ActMsg.Prio (see apc/prio.go)
+	HdrJobPrio = aisPrefix + "Job-Prio"
+
 	// Bucket props headers
 	HdrBucketProps      = aisPrefix + "Bucket-Props"       // => cmn.Bprops
 	HdrBucketSumm       = aisPrefix + "Bucket-Summ"        // => cmn.BsummResult (see also: QparamFltPresence)
@@ -61,6 +64,11 @@ const (
 	HdrObjCustomMD  = aisPrefix + "Custom-Md"      // Object custom metadata.
 	HdrObjVersion   = aisPrefix + "Version"        // Object version/generation - ais or cloud.
 
+	// end-to-end protection (`checksum.end_to_end`): GET response that carries a trailing checksum
+	// of the transmitted bytes - iff requested via cos.HdrTE ("TE: trailers")
+	HdrTrailerCksumType = aisPrefix + "Trailer-Checksum-Type"  // (header)
+	HdrTrailerCksumVal  = aisPrefix + "Trailer-Checksum-Value" // (trailer)
+
 	// Append object header
 	HdrAppendHandle = aisPrefix + "Append-Handle"
 
@@ -108,6 +116,7 @@ const (
 	HdrSenderName      = aisPrefix + "Caller-Name"
 	HdrSenderIsPrimary = aisPrefix + "Caller-Is-Primary"
 	HdrSenderSmapVer   = aisPrefix + "Caller-Smap-Ver"
+	HdrSenderSig       = aisPrefix + "Caller-Sig" // HMAC signature (when auth.cluster_key enabled)
 
 	HdrT2TPutterID = aisPrefix + "Putter-Id" // DaemonID of the target that performs intra-cluster PUT
 
@@ -0,0 +1,20 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// HrwPreview (apc.WhatHrwPreview) estimates data movement that would follow toggling
+// weighted HRW (feature flag "Weighted-HRW"), per target:
+// - objects (and bytes) that would migrate to other targets (global rebalance)
+// - objects that would stay but move to another local mountpath (resilver)
+type HrwPreview struct {
+	Weight      uint64 `json:"weight"`                 // target capacity (GiB)
+	Objects     int64  `json:"objects,string"`         // total objects visited
+	Size        int64  `json:"size,string"`            // total size (bytes)
+	ToTargets   int64  `json:"to_targets,string"`      // objects that would migrate to other targets
+	ToTargetsSz int64  `json:"to_targets_size,string"` // (ditto) bytes
+	ToMpaths    int64  `json:"to_mpaths,string"`       // objects that would move between local mountpaths
+	ToMpathsSz  int64  `json:"to_mpaths_size,string"`  // (ditto) bytes
+	Weighted    bool   `json:"weighted"`               // current setting
+}
@@ -0,0 +1,80 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Bucket inventory (manifest) export: each target walks its share of the (source)
+// ais:// bucket and writes a single inventory object into the destination bucket:
+//
+//	<Dst prefix><source bucket name>/<job ID>/<target ID>.<format>
+//
+// with one record per object (columns/fields below). The inventory is point-in-time:
+// it reflects the bucket snapshot named by InventoryMsg.Snap or, if unspecified,
+// a temporary snapshot (InvSnapPrefix) taken when the job starts and destroyed when it
+// finishes. Not supported for erasure-coded buckets (no snapshots).
+// Parquet output requires aisnode built with 'parquet' build tag.
+// See also: HdrInventory and friends - _consuming_ S3 inventories of remote buckets.
+
+// supported formats
+const (
+	InvFormatCSV     = "csv"     // with header row; custom metadata as JSON object
+	InvFormatJSONL   = "jsonl"   // one InvEntry per line
+	InvFormatParquet = "parquet" // InvEntry schema; custom metadata as MAP<string, string>
+)
+
+const (
+	InvDfltPrefix = ".inventory/"
+	InvSnapPrefix = "inventory-" // temporary snapshot: InvSnapPrefix + <generated ID>
+)
+
+// CSV columns (and InvEntry JSON tags)
+var InvColumns = [...]string{"name", "size", "checksum_type", "checksum", "version", "atime", "custom"}
+
+type (
+	// swagger:model
+	InventoryMsg struct {
+		Prefix    string `json:"prefix,omitempty"`     // only include objects with this prefix
+		Format    string `json:"format,omitempty"`     // InvFormatCSV (default), InvFormatJSONL, or InvFormatParquet
+		DstPrefix string `json:"dst_prefix,omitempty"` // destination object name prefix (default: InvDfltPrefix)
+		Snap      string `json:"snap,omitempty"`       // existing bucket snapshot (default: temporary, taken at start)
+		NoCustom  bool   `json:"no_custom,omitempty"`  // exclude custom metadata
+	}
+
+	// JSONL record and Parquet row
+	InvEntry struct {
+		Custom    map[string]string `json:"custom,omitempty" parquet:"custom,optional"`
+		Name      string            `json:"name" parquet:"name"`
+		CksumType string            `json:"checksum_type,omitempty" parquet:"checksum_type,optional"`
+		CksumVal  string            `json:"checksum,omitempty" parquet:"checksum,optional"`
+		Version   string            `json:"version,omitempty" parquet:"version,optional"`
+		Atime     string            `json:"atime,omitempty" parquet:"atime,optional"` // RFC3339Nano
+		Size      int64             `json:"size,string" parquet:"size"`
+	}
+)
+
+func (msg *InventoryMsg) Validate() error {
+	switch msg.Format {
+	case "":
+		msg.Format = InvFormatCSV
+	case InvFormatCSV, InvFormatJSONL, InvFormatParquet:
+	default:
+		return fmt.Errorf("invalid inventory format %q (expecting %s, %s, or %s)", msg.Format, InvFormatCSV, InvFormatJSONL, InvFormatParquet)
+	}
+	if msg.DstPrefix == "" {
+		msg.DstPrefix = InvDfltPrefix
+	} else if !strings.HasSuffix(msg.DstPrefix, "/") {
+		msg.DstPrefix += "/"
+	}
+	return nil
+}
+
+// destination object name
+func (msg *InventoryMsg) ObjName(bckName, xid, tid string) string {
+	return msg.DstPrefix + bckName + "/" + xid + "/" + tid + "." + msg.Format
+}
@@ -89,6 +89,11 @@ const (
 
 	// the caller is s3 compatibility API
 	LsIsS3
+
+	// list all versions: current and non-current (including delete markers)
+	// of the objects in an ais:// bucket with version history (`versioning.max_history`);
+	// see related `cmn.LsoEnt` flags: `EntryVerNoncurrent` and `EntryIsDelMarker`
+	LsVerHistory
 )
 
 // max page sizes
@@ -107,7 +112,7 @@ const (
 	LsoStatusMask = (1 << statusBits) - 1
 )
 
-// NOTE: approaching uint16 limit - bits 9,14,15 remaining
+// NOTE: approaching uint16 limit - bit 9 remaining
 const (
 	// location _status_
 	LocOK = iota
@@ -126,6 +131,9 @@ const (
 	EntryHeadFail   = 1 << (statusBits + 7)
 	// added v4.0
 	EntryIsChunked = 1 << (statusBits + 8) // see NOTE above
+	// version history (LsVerHistory)
+	EntryVerNoncurrent = 1 << (statusBits + 9)
+	EntryIsDelMarker   = 1 << (statusBits + 10)
 )
 
 // LsoMsg and HEAD(object) enum
@@ -172,6 +180,7 @@ type LsoMsg struct {
 	StartAfter        string      `json:"start_after,omitempty"` // start listing after (AIS buckets only)
 	ContinuationToken string      `json:"continuation_token"`    // => LsoResult.ContinuationToken => LsoMsg.ContinuationToken
 	SID               string      `json:"target"`                // selected target to solely execute backend.list-objects
+	Snap              string      `json:"snap,omitempty"`        // list named bucket snapshot (ais:// buckets only)
 	Flags             uint64      `json:"flags,string"`          // enum {LsCached, ...} - "LsoMsg flags" above
 	PageSize          int64       `json:"pagesize"`              // max entries returned by list objects call
 }
@@ -238,6 +247,10 @@ func (lsmsg *LsoMsg) Str(cname string, sb *cos.SB) {
 		sb.WriteString(", flags:")
 		lsmsg.appendFlags(sb)
 	}
+	if lsmsg.Snap != "" {
+		sb.WriteString(", snapshot:")
+		sb.WriteString(lsmsg.Snap)
+	}
 }
 
 func (lsmsg *LsoMsg) appendFlags(sb *cos.SB) {
@@ -0,0 +1,333 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Metadata search: query per-bucket secondary index of object metadata that
+// targets maintain when the bucket property `md_index` is enabled.
+// All predicates must match (logical AND); the results are sorted and limited
+// by each target and then merged, sorted, and limited (again) by the proxy.
+//
+// Predicate syntax (CLI):  FIELD OP VALUE, or `custom.KEY` to test for existence, e.g.:
+// - "size>=1MiB"
+// - "atime<24h"              (durations are relative to now: not accessed in the last 24 hours)
+// - "atime>2026-01-02T15:04:05Z"
+// - "name^=images/"          (prefix)
+// - "custom.color=red"
+// - "custom.label~=cat"      (contains)
+
+// searchable fields
+const (
+	MDFieldName    = "name"
+	MDFieldSize    = "size"
+	MDFieldAtime   = "atime"
+	MDFieldVersion = "version"
+
+	MDFieldCustom = "custom." // prefix, followed by the custom metadata key
+)
+
+// predicate operators
+const (
+	MDOpEq       = "="
+	MDOpNe       = "!="
+	MDOpLt       = "<"
+	MDOpLe       = "<="
+	MDOpGt       = ">"
+	MDOpGe       = ">="
+	MDOpPrefix   = "^="
+	MDOpContains = "~="
+	MDOpExists   = "exists" // custom metadata only
+)
+
+const (
+	MDSearchDfltLimit = 1000
+	MDSearchMaxLimit  = 100_000
+)
+
+type (
+	MDPred struct {
+		Field string `json:"field"`
+		Op    string `json:"op"`
+		Value string `json:"value,omitempty"`
+		num   int64  // parsed size or atime (unix nano)
+	}
+	MDQuery struct {
+		Preds []*MDPred `json:"preds,omitempty"`
+		Sort  string    `json:"sort,omitempty"` // one of the MDField* above except custom (default: name)
+		Desc  bool      `json:"desc,omitempty"` // descending order
+		Limit int       `json:"limit,omitempty"`
+	}
+
+	MDEntry struct {
+		Custom   cos.StrKVs `json:"custom,omitempty"`
+		Name     string     `json:"name"`
+		Version  string     `json:"version,omitempty"`
+		Location string     `json:"location,omitempty"` // target ID
+		Size     int64      `json:"size,string"`
+		Atime    int64      `json:"atime,string"` // unix nano
+	}
+	MDEntries []*MDEntry
+)
+
+// all operators in parsing order (longest first)
+var mdops = [...]string{MDOpNe, MDOpLe, MDOpGe, MDOpPrefix, MDOpContains, MDOpEq, MDOpLt, MDOpGt}
+
+////////////
+// MDPred //
+////////////
+
+func ParseMDPred(s string) (*MDPred, error) {
+	s = strings.TrimSpace(s)
+	var (
+		pos = -1
+		op  string
+	)
+	for _, o := range mdops {
+		if i := strings.Index(s, o); i > 0 && (pos < 0 || i < pos || (i == pos && len(o) > len(op))) {
+			pos, op = i, o
+		}
+	}
+	if pos < 0 {
+		if strings.HasPrefix(s, MDFieldCustom) {
+			pred := &MDPred{Field: s, Op: MDOpExists}
+			return pred, pred.validate()
+		}
+		return nil, fmt.Errorf("invalid search predicate %q (expecting FIELD OP VALUE, e.g. \"size>=1MiB\")", s)
+	}
+	pred := &MDPred{Field: strings.TrimSpace(s[:pos]), Op: op, Value: strings.TrimSpace(s[pos+len(op):])}
+	return pred, pred.validate()
+}
+
+func (pred *MDPred) String() string {
+	if pred.Op == MDOpExists {
+		return pred.Field
+	}
+	return pred.Field + pred.Op + pred.Value
+}
+
+func (pred *MDPred) validate() (err error) {
+	switch {
+	case pred.Field == MDFieldSize:
+		if !pred.numeric() {
+			return pred.errOp()
+		}
+		pred.num, err = cos.ParseSize(pred.Value, cos.UnitsIEC)
+	case pred.Field == MDFieldAtime:
+		if !pred.numeric() {
+			return pred.errOp()
+		}
+		pred.num, err = parseAtime(pred.Value)
+	case pred.Field == MDFieldName, pred.Field == MDFieldVersion:
+		if pred.Op == MDOpExists || pred.Op == "" {
+			return pred.errOp()
+		}
+	case strings.HasPrefix(pred.Field, MDFieldCustom) && len(pred.Field) > len(MDFieldCustom):
+		if pred.Op == "" {
+			return pred.errOp()
+		}
+	default:
+		return fmt.Errorf("invalid search field %q (expecting one of: %s, %s, %s, %s, %sKEY)",
+			pred.Field, MDFieldName, MDFieldSize, MDFieldAtime, MDFieldVersion, MDFieldCustom)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid search predicate %q: %v", pred.String(), err)
+	}
+	return nil
+}
+
+func (pred *MDPred) numeric() bool {
+	switch pred.Op {
+	case MDOpEq, MDOpNe, MDOpLt, MDOpLe, MDOpGt, MDOpGe:
+		return true
+	}
+	return false
+}
+
+func (pred *MDPred) errOp() error {
+	return fmt.Errorf("invalid search predicate %q: operator %q is not supported for %q", pred.String(), pred.Op, pred.Field)
+}
+
+// atime: either RFC3339 timestamp or duration relative to now
+func parseAtime(v string) (int64, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.UnixNano(), nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, errors.New("expecting RFC3339 timestamp or duration (e.g. \"24h\")")
+	}
+	return time.Now().Add(-d).UnixNano(), nil
+}
+
+func (pred *MDPred) match(e *MDEntry) bool {
+	switch pred.Field {
+	case MDFieldSize:
+		return cmpnum(e.Size, pred.num, pred.Op)
+	case MDFieldAtime:
+		return cmpnum(e.Atime, pred.num, pred.Op)
+	case MDFieldName:
+		return cmpstr(e.Name, pred.Value, pred.Op)
+	case MDFieldVersion:
+		return cmpstr(e.Version, pred.Value, pred.Op)
+	}
+	v, ok := e.Custom[pred.Field[len(MDFieldCustom):]]
+	if !ok {
+		return pred.Op == MDOpNe
+	}
+	if pred.Op == MDOpExists {
+		return true
+	}
+	// numeric comparison when both are numbers
+	if a, err := strconv.ParseFloat(v, 64); err == nil {
+		if b, err := strconv.ParseFloat(pred.Value, 64); err == nil && pred.numeric() {
+			return cmpf(a, b, pred.Op)
+		}
+	}
+	return cmpstr(v, pred.Value, pred.Op)
+}
+
+func cmpnum(a, b int64, op string) bool {
+	switch op {
+	case MDOpEq:
+		return a == b
+	case MDOpNe:
+		return a != b
+	case MDOpLt:
+		return a < b
+	case MDOpLe:
+		return a <= b
+	case MDOpGt:
+		return a > b
+	case MDOpGe:
+		return a >= b
+	}
+	return false
+}
+
+func cmpf(a, b float64, op string) bool {
+	switch op {
+	case MDOpEq:
+		return a == b
+	case MDOpNe:
+		return a != b
+	case MDOpLt:
+		return a < b
+	case MDOpLe:
+		return a <= b
+	case MDOpGt:
+		return a > b
+	case MDOpGe:
+		return a >= b
+	}
+	return false
+}
+
+func cmpstr(a, b, op string) bool {
+	switch op {
+	case MDOpPrefix:
+		return strings.HasPrefix(a, b)
+	case MDOpContains:
+		return strings.Contains(a, b)
+	case MDOpEq:
+		return a == b
+	case MDOpNe:
+		return a != b
+	case MDOpLt:
+		return a < b
+	case MDOpLe:
+		return a <= b
+	case MDOpGt:
+		return a > b
+	case MDOpGe:
+		return a >= b
+	}
+	return false
+}
+
+/////////////
+// MDQuery //
+/////////////
+
+// must be called prior to Match (parses predicate values)
+func (q *MDQuery) Validate() error {
+	for _, pred := range q.Preds {
+		if pred == nil {
+			return errors.New("invalid search query: nil predicate")
+		}
+		if err := pred.validate(); err != nil {
+			return err
+		}
+	}
+	switch q.Sort {
+	case "":
+		q.Sort = MDFieldName
+	case MDFieldName, MDFieldSize, MDFieldAtime, MDFieldVersion:
+	default:
+		return fmt.Errorf("invalid search sort field %q (expecting one of: %s, %s, %s, %s)",
+			q.Sort, MDFieldName, MDFieldSize, MDFieldAtime, MDFieldVersion)
+	}
+	switch {
+	case q.Limit < 0:
+		return fmt.Errorf("invalid search limit %d", q.Limit)
+	case q.Limit == 0:
+		q.Limit = MDSearchDfltLimit
+	case q.Limit > MDSearchMaxLimit:
+		return fmt.Errorf("search limit %d exceeds the maximum %d", q.Limit, MDSearchMaxLimit)
+	}
+	return nil
+}
+
+func (q *MDQuery) Match(e *MDEntry) bool {
+	for _, pred := range q.Preds {
+		if !pred.match(e) {
+			return false
+		}
+	}
+	return true
+}
+
+func (q *MDQuery) SortEntries(entries MDEntries) {
+	sort.Slice(entries, func(i, j int) bool { return q.less(entries[i], entries[j]) })
+}
+
+// sort and truncate to the limit
+func (q *MDQuery) Finalize(entries MDEntries) MDEntries {
+	q.SortEntries(entries)
+	if q.Limit > 0 && len(entries) > q.Limit {
+		clear(entries[q.Limit:])
+		entries = entries[:q.Limit]
+	}
+	return entries
+}
+
+func (q *MDQuery) less(a, b *MDEntry) bool {
+	var (
+		less, eq bool
+	)
+	switch q.Sort {
+	case MDFieldSize:
+		less, eq = a.Size < b.Size, a.Size == b.Size
+	case MDFieldAtime:
+		less, eq = a.Atime < b.Atime, a.Atime == b.Atime
+	case MDFieldVersion:
+		less, eq = a.Version < b.Version, a.Version == b.Version
+	default:
+		less, eq = a.Name < b.Name, a.Name == b.Name
+	}
+	if eq {
+		return a.Name < b.Name // (stable across targets)
+	}
+	return less != q.Desc
+}
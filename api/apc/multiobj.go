@@ -25,6 +25,7 @@ type (
 		NumWorkers      int  `json:"num-workers,omitempty"` // number of concurrent workers; 0 - number of mountpaths (default); (-1) none
 		ContinueOnError bool `json:"coer,omitempty"`        // ignore non-critical errors, keep going
 		NonRecurs       bool `json:"non-recurs,omitempty"`  // do not evict (delete) nested subdirs (see also: `apc.LsNoRecursion`, `apc.CopyBckMsg`)
+		DryRun          bool `json:"dry_run,omitempty"`     // visit all selected objects, don't make any modifications (see DryRunRes)
 	}
 )
 
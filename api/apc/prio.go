@@ -0,0 +1,23 @@
+// Package apc: API constant and control messages
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "fmt"
+
+// Batch job priority classes (see ActMsg.Prio, xact.ArgsMsg.Prio, and xact/sched.go)
+const (
+	PrioLow    = "low"
+	PrioNormal = "normal" // default
+	PrioHigh   = "high"
+)
+
+func ValidatePrio(prio string) error {
+	switch prio {
+	case "", PrioLow, PrioNormal, PrioHigh:
+		return nil
+	default:
+		return fmt.Errorf("invalid priority class %q (expecting one of: %s, %s, %s)", prio, PrioLow, PrioNormal, PrioHigh)
+	}
+}
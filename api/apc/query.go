@@ -61,6 +61,11 @@ const (
 	// When evicting, keep remote bucket in BMD (i.e., evict data only)
 	QparamKeepRemote = "keep_bck_md" // Keep bucket metadata when evicting remote bucket data
 
+	// Do not modify anything - return what would be affected (see apc.DryRunRes)
+	// - usage: destroy (evict) bucket, rename bucket, and LRU
+	// - multi-object evict and delete: see `EvdMsg.DryRun`
+	QparamDryRun = "dry_run"
+
 	// (api.GetBucketInfo)
 	// NOTE: non-empty value indicates api.GetBucketInfo; "true" value further requires "with remote obj-s"
 	QparamBinfoWithOrWithoutRemote = "bsumm_remote" // Include remote objects in bucket summary/info
@@ -83,6 +88,13 @@ const (
 	QparamLogOff  = "offset"
 	QparamAllLogs = "all"
 
+	// Get audit log records (see WhatAudit and AuditEvent)
+	QparamAuditUser   = "audit_user"
+	QparamAuditAction = "audit_action"
+	QparamAuditBck    = "audit_bck"   // bucket (cname), e.g. "ais://abc"
+	QparamAuditSince  = "audit_since" // Unix time (nanoseconds)
+	QparamAuditLimit  = "audit_limit" // max number of (most recent) records to return
+
 	// The following 4 (four) QparamArch* parameters are all intended for usage with sharded datasets,
 	// whereby the shards are (.tar, .tgz (or .tar.gz), .zip, and/or .tar.lz4) formatted objects.
 	//
@@ -135,6 +147,15 @@ const (
 	// - implies remote backend
 	QparamLatestVer = "latest-ver" // Get latest version of objects from remote backend
 
+	// read (GET, HEAD) a named bucket snapshot rather than the current object
+	// (compare w/ `LsoMsg.Snap` to list snapshotted objects)
+	QparamSnap = "snap"
+
+	// GET, HEAD, or DELETE a given (current or non-current) object version
+	// (ais:// buckets with version history - see `versioning.max_history`)
+	// DELETE with QparamVersionID removes the version permanently
+	QparamVersionID = "version_id"
+
 	// in addition to the latest-ver (above), also entails removing remotely
 	// deleted objects
 	QparamSync = "synchronize"
@@ -205,6 +226,7 @@ const (
 // Internal query params.
 const (
 	QparamPID              = "pid" // ID of a redirecting proxy.
+	QparamAuditRedUser     = "aru" // authenticated user (from the redirecting proxy) to record in the audit log; covered by HMAC
 	QparamPrimaryCandidate = "can" // candidate for the primary proxy (voting ID, force URL)
 	QparamPrepare          = "prp" // 2-phase commit where 'true' corresponds to 'begin'; usage: (primary election; set-primary)
 	QparamUnixTime         = "utm" // Unix time since 01/01/70 UTC (nanoseconds)
@@ -269,16 +291,21 @@ const (
 	WhatRemoteAIS  = "remote"
 	WhatSmapVote   = "smapvote"
 	WhatSysInfo    = "sysinfo"
-	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatTargetIPs  = "target_ips"  // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatHrwPreview = "hrw_preview" // objects that would move if weighted HRW were toggled (see HrwPreview)
+	WhatTenants    = "tenants"     // per-tenant capacity usage (see TenantsUsage)
 
 	// log
-	WhatLog = "log"
+	WhatLog   = "log"
+	WhatAudit = "audit" // audit log records (see QparamAudit*)
 
 	// xactions
 	WhatOneXactStatus   = "status"      // IC status by uuid (returns a single matching xaction or none)
 	WhatAllXactStatus   = "status_all"  // ditto - all matching xactions
 	WhatXactStats       = "getxstats"   // stats: xaction by uuid
 	WhatQueryXactStats  = "qryxstats"   // stats: all matching xactions
+	WhatJobHistory      = "job_history" // finished jobs recorded by IC (see xact.HistQueryMsg)
+	WhatJobSched        = "job_sched"   // scheduled jobs and their status (see xact.CronJobStatus)
 	WhatAllRunningXacts = "running_all" // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
 
 	// internal
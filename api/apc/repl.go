@@ -0,0 +1,19 @@
+// Package apc: API constant and control messages
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "github.com/NVIDIA/aistore/cmn/cos"
+
+// ReplStats is per-target summary of the bucket's continuous replication (see core.Snap.Ext)
+type ReplStats struct {
+	Dst         string       `json:"repl.dst"`                 // destination bucket
+	BacklogCnt  int64        `json:"repl.backlog.n,string"`    // journaled and not yet shipped changes
+	BacklogSize int64        `json:"repl.backlog.size,string"` // (journal bytes)
+	Lag         cos.Duration `json:"repl.lag.ns"`              // age of the oldest not-yet-shipped change
+	PutCount    int64        `json:"repl.put.n,string"`        // replicated PUTs
+	DelCount    int64        `json:"repl.del.n,string"`        // replicated deletions
+	RetryCount  int64        `json:"repl.retry.n,string"`      // retries
+	DeadCount   int64        `json:"repl.dead.n,string"`       // failed permanently (dead-lettered)
+}
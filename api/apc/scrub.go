@@ -0,0 +1,26 @@
+// Package apc: API constant and control messages
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// ScrubMsg contains parameters of the (target-side) scrub xaction.
+// By default, scrub only detects and counts inconsistencies; use `Fix` to repair.
+// swagger:model
+type ScrubMsg struct {
+	Prefix   string `json:"prefix"`   // only visit objects with this prefix
+	Checksum bool   `json:"checksum"` // recompute content checksums (slow) and compare with object metadata
+	Fix      bool   `json:"fix"`      // repair: re-mirror, EC-recover, re-fetch from remote backend, remove orphans
+}
+
+// ScrubStats is scrub's per-bucket summary (see core.Snap.Ext)
+type ScrubStats struct {
+	Visited       int64 `json:"scrub.visited.n,string"`        // visited objects
+	Corrupted     int64 `json:"scrub.corrupted.n,string"`      // checksum mismatch or damaged metadata
+	MissingCopies int64 `json:"scrub.missing-copies.n,string"` // fewer copies than configured (bucket's mirror.copies)
+	ECGaps        int64 `json:"scrub.ec-gaps.n,string"`        // missing EC metafile, slice, or full replica
+	OrphanChunks  int64 `json:"scrub.orphan-chunks.n,string"`  // chunks that belong to no manifest
+	Domains       int64 `json:"scrub.domains.n,string"`        // EC objects not spread across distinct failure domains (re-encoded with Fix)
+	Repaired      int64 `json:"scrub.repaired.n,string"`       // successfully fixed (with ScrubMsg.Fix)
+	Failed        int64 `json:"scrub.failed.n,string"`         // failed to fix
+}
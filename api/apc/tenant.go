@@ -0,0 +1,16 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+type (
+	// TenantUsage (apc.WhatTenants) - capacity used by a given tenant, i.e., by
+	// all buckets in the tenant's namespace (see cmn.TenantConf)
+	TenantUsage struct {
+		Buckets int   `json:"buckets"`      // number of buckets
+		Size    int64 `json:"size,string"`  // on-disk size (bytes), as of the last periodic update
+		Quota   int64 `json:"quota,string"` // configured quota (bytes); zero: unlimited
+	}
+	TenantsUsage map[string]*TenantUsage // by tenant name
+)
@@ -0,0 +1,15 @@
+// Package apc: API constant and control messages
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// TierStats is the tiering xaction's per-bucket summary (see core.Snap.Ext)
+// and bucket property "tier" (cmn.TierConf)
+type TierStats struct {
+	Visited  int64 `json:"tier.visited.n,string"`  // visited objects
+	Demoted  int64 `json:"tier.demoted.n,string"`  // moved to the cold class (not accessed for tier.demote_after)
+	Promoted int64 `json:"tier.promoted.n,string"` // moved to the hot class (read at least tier.promote_hits times)
+	Pinned   int64 `json:"tier.pinned.n,string"`   // moved to the hot class (e.g., written prior to configuring the bucket)
+	Skipped  int64 `json:"tier.skipped.n,string"`  // not tiered: chunked, mirrored, or extra-long name
+}
@@ -0,0 +1,56 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Soft delete (bucket property "trash"): deleted objects (and destroyed ais:// buckets)
+// can be listed and restored until `space` cleanup purges them upon expiration of
+// the bucket's `trash.retention`.
+
+type (
+	// list deleted objects (ActListTrash)
+	TrashMsg struct {
+		Prefix string `json:"prefix,omitempty"`
+	}
+
+	// restore (ActUndelete) a given deleted object or all deleted objects with a given prefix;
+	// unless ID is specified, the most recently deleted copy gets restored
+	UndeleteMsg struct {
+		ObjName string `json:"name,omitempty"`
+		ID      string `json:"id,omitempty"` // TrashEntry.ID()
+		Prefix  string `json:"prefix,omitempty"`
+	}
+
+	TrashEntry struct {
+		Name     string `json:"name"`
+		Version  string `json:"version,omitempty"`
+		Location string `json:"location,omitempty"` // target ID
+		Size     int64  `json:"size,string"`
+		Deleted  int64  `json:"deleted,string"` // unix nano (also identifies a given deleted copy)
+		Expires  int64  `json:"expires,string"` // ditto; when it'll be gone
+	}
+	TrashEntries []*TrashEntry
+)
+
+func (e *TrashEntry) ID() string { return strconv.FormatInt(e.Deleted, 10) }
+
+func (msg *UndeleteMsg) Validate() error {
+	if msg.ObjName != "" && msg.Prefix != "" {
+		return errors.New("undelete: object name and prefix are mutually exclusive")
+	}
+	if msg.ID != "" {
+		if msg.ObjName == "" {
+			return errors.New("undelete: deleted copy ID requires object name")
+		}
+		if _, err := strconv.ParseInt(msg.ID, 10, 64); err != nil {
+			return errors.New("undelete: invalid deleted copy ID " + strconv.Quote(msg.ID))
+		}
+	}
+	return nil
+}
@@ -48,6 +48,9 @@ const (
 	Users      = "users"
 	Clusters   = "clusters"
 	Roles      = "roles"
+	S3Keys     = "s3keys"
+	Certs      = "certs"
+	Sessions   = "sessions"
 	OIDCPrefix = ".well-known"
 	OIDCConfig = "openid-configuration"
 	JWKS       = "jwks.json"
@@ -101,13 +104,16 @@ const (
 
 // common
 const (
-	Init  = "init"
-	Start = "start"
-	Stop  = "stop"
-	Abort = "abort"
+	Init   = "init"
+	Start  = "start"
+	Stop   = "stop"
+	Abort  = "abort"
+	Pause  = "pause"
+	Resume = "resume"
 
 	Finished = "finished"
 	Progress = "progress"
+	Written  = "written" // object write completed (proxy-side cache)
 )
 
 // 2PC
@@ -200,6 +206,9 @@ var (
 	URLPathUsers    = urlpath(Version, Users)
 	URLPathClusters = urlpath(Version, Clusters)
 	URLPathRoles    = urlpath(Version, Roles)
+	URLPathS3Keys   = urlpath(Version, S3Keys)
+	URLPathCerts    = urlpath(Version, Certs)
+	URLPathSessions = urlpath(Version, Sessions)
 	URLPathOIDC     = urlpath(OIDCPrefix, OIDCConfig)
 	URLPathJWKS     = urlpath(OIDCPrefix, JWKS)
 
@@ -64,14 +64,19 @@ func DeleteUser(bp api.BaseParams, userID string) error {
 // The token expires in `expire` time. If `expire` is `nil` the expiration
 // time is set by AuthN (default AuthN expiration time is 24 hours)
 func LoginUser(bp api.BaseParams, userID, pass string, expire *time.Duration) (token *TokenMsg, err error) {
+	return Login(bp, userID, &LoginMsg{Password: pass, ExpiresIn: expire})
+}
+
+// Same as LoginUser with additional login options, e.g. scope - a subset of the user's
+// bucket permissions that the issued tokens will be limited to
+func Login(bp api.BaseParams, userID string, msg *LoginMsg) (token *TokenMsg, err error) {
 	bp.Method = http.MethodPost
-	rec := LoginMsg{Password: pass, ExpiresIn: expire}
 	reqParams := api.AllocRp()
 	defer api.FreeRp(reqParams)
 	{
 		reqParams.BaseParams = bp
 		reqParams.Path = apc.URLPathUsers.Join(userID)
-		reqParams.Body = cos.MustMarshal(rec)
+		reqParams.Body = cos.MustMarshal(msg)
 		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
 	}
 	if _, err = reqParams.DoReqAny(&token); err != nil {
@@ -274,6 +279,164 @@ func RevokeToken(bp api.BaseParams, token string) error {
 	return reqParams.DoRequest()
 }
 
+// Exchange refresh token for a new access token; the refresh token gets rotated,
+// and the returned TokenMsg contains its replacement
+func RefreshToken(bp api.BaseParams, refreshToken string) (token *TokenMsg, err error) {
+	bp.Method = http.MethodPost
+	msg := &TokenMsg{RefreshToken: refreshToken}
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err = reqParams.DoReqAny(&token); err != nil {
+		return nil, err
+	}
+	if token.Token == "" {
+		return nil, errors.New("refresh failed: empty response from AuthN server")
+	}
+	return token, nil
+}
+
+// List active sessions of a given user or, if empty, of all users
+func GetSessions(bp api.BaseParams, userID string) ([]*Session, error) {
+	bp.Method = http.MethodGet
+	path := apc.URLPathSessions.S
+	if userID != "" {
+		path = apc.URLPathSessions.Join(userID)
+	}
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = path
+	}
+	sessions := make([]*Session, 0)
+	_, err := reqParams.DoReqAny(&sessions)
+
+	less := func(i, j int) bool {
+		if sessions[i].UserID != sessions[j].UserID {
+			return sessions[i].UserID < sessions[j].UserID
+		}
+		return sessions[i].Created.Before(sessions[j].Created)
+	}
+	sort.Slice(sessions, less)
+	return sessions, err
+}
+
+// Terminate the session and revoke all its tokens
+func DeleteSession(bp api.BaseParams, sessionID string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathSessions.Join(sessionID)
+	}
+	return reqParams.DoRequest()
+}
+
+// Issue a new S3 access key for the user; the returned secret cannot be retrieved later
+func AddS3Key(bp api.BaseParams, userID string) (*S3Key, error) {
+	if userID == "" {
+		return nil, errors.New("missing user ID")
+	}
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3Keys.Join(userID)
+	}
+	key := &S3Key{}
+	_, err := reqParams.DoReqAny(key)
+	return key, err
+}
+
+// List S3 access keys (secrets omitted) of a given user or, if empty, of all users
+func GetS3Keys(bp api.BaseParams, userID string) ([]*S3Key, error) {
+	bp.Method = http.MethodGet
+	path := apc.URLPathS3Keys.S
+	if userID != "" {
+		path = apc.URLPathS3Keys.Join(userID)
+	}
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = path
+	}
+	keys := make([]*S3Key, 0)
+	_, err := reqParams.DoReqAny(&keys)
+
+	less := func(i, j int) bool {
+		if keys[i].UserID != keys[j].UserID {
+			return keys[i].UserID < keys[j].UserID
+		}
+		return keys[i].AccessKeyID < keys[j].AccessKeyID
+	}
+	sort.Slice(keys, less)
+	return keys, err
+}
+
+func DeleteS3Key(bp api.BaseParams, accessKeyID string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3Keys.Join(accessKeyID)
+	}
+	return reqParams.DoRequest()
+}
+
+// Map client certificate identity (see CertID* prefixes) to a user or roles
+func AddCertIdentity(bp api.BaseParams, ci *CertIdentity) error {
+	if err := ci.Validate(); err != nil {
+		return err
+	}
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathCerts.S
+		reqParams.Body = cos.MustMarshal(ci)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	return reqParams.DoRequest()
+}
+
+func GetCertIdentities(bp api.BaseParams) ([]*CertIdentity, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathCerts.S
+	}
+	certs := make([]*CertIdentity, 0)
+	_, err := reqParams.DoReqAny(&certs)
+	sort.Slice(certs, func(i, j int) bool { return certs[i].Identity < certs[j].Identity })
+	return certs, err
+}
+
+func DeleteCertIdentity(bp api.BaseParams, identity string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathCerts.S
+		reqParams.Body = cos.MustMarshal(&CertIdentity{Identity: identity})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	return reqParams.DoRequest()
+}
+
 func GetConfig(bp api.BaseParams) (*Config, error) {
 	bp.Method = http.MethodGet
 	reqParams := api.AllocRp()
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -26,6 +27,7 @@ const (
 	minTimeout          = cos.Duration(time.Second)
 	minLogFlushInterval = cos.Duration(10 * time.Second)
 	maxLogLevel         = 5
+	minLDAPRefresh      = cos.Duration(time.Minute)
 )
 
 // Defaults
@@ -37,6 +39,11 @@ const (
 	defaultLogFlushInterval = cos.Duration(30 * time.Second)
 	defaultTimeout          = cos.Duration(30 * time.Second)
 	defaultPort             = 52001
+
+	defaultLDAPGroupAttr = "memberOf"
+	defaultLDAPCacheTTL  = cos.Duration(5 * time.Minute)
+	defaultLDAPRefresh   = cos.Duration(10 * time.Minute)
+	defaultLDAPTimeout   = cos.Duration(10 * time.Second)
 )
 
 type (
@@ -45,6 +52,8 @@ type (
 		Log     LogConf     `json:"log"`
 		Net     NetConf     `json:"net"`
 		Timeout TimeoutConf `json:"timeout"`
+		// optional external identity provider (LDAP or Active Directory)
+		LDAP *LDAPConf `json:"ldap,omitempty"`
 	}
 	LogConf struct {
 		Dir           string       `json:"dir"`
@@ -71,23 +80,63 @@ type (
 		PubKey *string      `json:"public_key"`
 		// Size of RSA private key to generate
 		RSAKeyBits int `json:"rsa_key_bits"`
+		// When non-zero, access tokens expire after this time (or sooner), and clients
+		// use refresh tokens to get new ones; sessions still expire as per Expire
+		AccessTTL cos.Duration `json:"access_token_ttl,omitempty"`
 	}
 
 	// TimeoutConf sets the default timeout for the HTTP client used by the auth manager
 	TimeoutConf struct {
 		Default cos.Duration `json:"default_timeout"`
 	}
+	// LDAPConf configures LDAP (or Active Directory) authentication backend:
+	// users that are not found locally log in with their directory credentials,
+	// and their AuthN roles are derived from group membership (see GroupRoles)
+	LDAPConf struct {
+		// ldap://host[:port] or ldaps://host[:port]
+		URL string `json:"url"`
+		// service account to search for users and groups (empty for anonymous search)
+		BindDN       string `json:"bind_dn"`
+		BindPassword string `json:"bind_password"`
+		// where and how to find the user, e.g. "(uid=%s)" or "(sAMAccountName=%s)";
+		// the (escaped) user ID substitutes %s
+		UserBaseDN string `json:"user_base_dn"`
+		UserFilter string `json:"user_filter"`
+		// user's attribute listing group DNs (default "memberOf")
+		GroupAttr string `json:"group_attr,omitempty"`
+		// alternatively, search for groups, e.g. "(member=%s)" with user DN substituting %s
+		GroupBaseDN string `json:"group_base_dn,omitempty"`
+		GroupFilter string `json:"group_filter,omitempty"`
+		// group (DN or CN, case-insensitive) => AuthN role
+		GroupRoles map[string]string `json:"group_roles"`
+		// how long to cache user DN and groups between logins
+		CacheTTL cos.Duration `json:"cache_ttl,omitempty"`
+		// how often to re-evaluate group membership (and roles) of LDAP users
+		RefreshInterval cos.Duration `json:"refresh_interval,omitempty"`
+		Timeout         cos.Duration `json:"timeout,omitempty"`
+		// ldap:// only: upgrade the connection to TLS via StartTLS (RFC 4511, section 4.14)
+		StartTLS bool `json:"start_tls,omitempty"`
+		// allow plaintext ldap:// without StartTLS (NOTE: user passwords go over the wire in the clear)
+		Insecure bool `json:"insecure,omitempty"`
+		// ldaps:// and StartTLS
+		CACert     string `json:"ca_cert,omitempty"`
+		SkipVerify bool   `json:"skip_verify,omitempty"`
+	}
+
 	ConfigToUpdate struct {
 		Server *ServerConfToSet `json:"auth"`
 	}
 	ServerConfToSet struct {
-		Secret *string       `json:"secret,omitempty"`
-		Expire *cos.Duration `json:"expiration_time,omitempty"`
+		Secret    *string       `json:"secret,omitempty"`
+		Expire    *cos.Duration `json:"expiration_time,omitempty"`
+		AccessTTL *cos.Duration `json:"access_token_ttl,omitempty"`
 	}
-	// TokenList is a list of tokens pushed by authn
+	// TokenList is a list of (revoked) tokens pushed by authn
 	TokenList struct {
-		Tokens  []string `json:"tokens"`
-		Version int64    `json:"version,string"`
+		Tokens []string `json:"tokens"`
+		// terminated sessions: session ID => (unix) time when the session's last token expires
+		Sessions map[string]int64 `json:"sessions,omitempty"`
+		Version  int64            `json:"version,string"`
 	}
 )
 
@@ -127,6 +176,11 @@ func (c *Config) Validate() error {
 	if err := c.Net.Validate(); err != nil {
 		return err
 	}
+	if c.LDAP != nil {
+		if err := c.LDAP.Validate(); err != nil {
+			return err
+		}
+	}
 	return c.Timeout.Validate()
 }
 
@@ -143,6 +197,10 @@ func (c *ServerConf) Validate() error {
 	if c.RSAKeyBits < minRSAKeyBits {
 		return fmt.Errorf("invalid auth.rsa_key_bits=%d, (must be >= %d)", c.RSAKeyBits, minRSAKeyBits)
 	}
+	if c.AccessTTL != 0 && (c.AccessTTL < minAuthExpiration || c.AccessTTL > c.Expire) {
+		return fmt.Errorf("invalid auth.access_token_ttl=%s, (expected 0 (disabled) or between %s and auth.expiration_time=%s)",
+			c.AccessTTL, minAuthExpiration, c.Expire)
+	}
 	return nil
 }
 
@@ -197,6 +255,57 @@ func (c *TimeoutConf) Validate() error {
 	return nil
 }
 
+func (c *LDAPConf) Validate() error {
+	if c.URL == "" {
+		return errors.New("ldap.url is required")
+	}
+	if !strings.HasPrefix(c.URL, "ldap://") && !strings.HasPrefix(c.URL, "ldaps://") {
+		return fmt.Errorf("invalid ldap.url %q (expecting ldap:// or ldaps:// scheme)", c.URL)
+	}
+	if plain := strings.HasPrefix(c.URL, "ldap://"); plain {
+		if !c.StartTLS && !c.Insecure {
+			return fmt.Errorf("ldap.url %q: plaintext LDAP would send user passwords in the clear "+
+				"(use ldaps://, enable ldap.start_tls, or explicitly allow with ldap.insecure)", c.URL)
+		}
+	} else if c.StartTLS {
+		return fmt.Errorf("ldap.start_tls applies to ldap:// only (%q is TLS already)", c.URL)
+	}
+	if c.UserBaseDN == "" || c.UserFilter == "" {
+		return errors.New("ldap.user_base_dn and ldap.user_filter are required")
+	}
+	if strings.Count(c.UserFilter, "%s") != 1 {
+		return fmt.Errorf("invalid ldap.user_filter %q (expecting exactly one %%s for user ID)", c.UserFilter)
+	}
+	if (c.GroupBaseDN == "") != (c.GroupFilter == "") {
+		return errors.New("ldap.group_base_dn and ldap.group_filter must be specified together")
+	}
+	if c.GroupFilter != "" && strings.Count(c.GroupFilter, "%s") != 1 {
+		return fmt.Errorf("invalid ldap.group_filter %q (expecting exactly one %%s for user DN)", c.GroupFilter)
+	}
+	if len(c.GroupRoles) == 0 {
+		return errors.New("ldap.group_roles is empty (no LDAP user would be able to log in)")
+	}
+	if c.GroupAttr == "" {
+		c.GroupAttr = defaultLDAPGroupAttr
+	}
+	if c.CacheTTL == 0 {
+		c.CacheTTL = defaultLDAPCacheTTL
+	}
+	if c.RefreshInterval == 0 {
+		c.RefreshInterval = defaultLDAPRefresh
+	}
+	if c.RefreshInterval < minLDAPRefresh {
+		return fmt.Errorf("invalid ldap.refresh_interval=%s (expected >= %s)", c.RefreshInterval, minLDAPRefresh)
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultLDAPTimeout
+	}
+	if c.Timeout < minTimeout {
+		return fmt.Errorf("invalid ldap.timeout=%s (expected >= %s)", c.Timeout, minTimeout)
+	}
+	return nil
+}
+
 func (cu *ConfigToUpdate) Validate() error {
 	if cu.Server == nil {
 		return errors.New("configuration is empty")
@@ -1,10 +1,13 @@
 // Package authn provides AuthN API over HTTP(S)
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package authn
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -16,11 +19,28 @@ const (
 	AdminRole = "Admin"
 )
 
+// User.Source: users authenticated by external identity provider
+const (
+	UserSourceLDAP = "ldap"
+)
+
+// CertIdentity.Identity: client certificate's subject common name or one of its SANs
+// (in the order of precedence when a certificate carries more than one)
+const (
+	CertIDURI    = "uri:"   // e.g. "uri:spiffe://example.org/ns/ml/sa/trainer"
+	CertIDDNS    = "dns:"   // e.g. "dns:trainer.ml.example.org"
+	CertIDEmail  = "email:" // e.g. "email:trainer@example.org"
+	CertIDCommon = "cn:"    // e.g. "cn:trainer"
+)
+
 type (
 	User struct {
 		ID       string  `json:"id"`
 		Password string  `json:"pass,omitempty"`
 		Roles    []*Role `json:"roles"`
+		// empty for local users; otherwise, the identity provider that authenticates
+		// the user and (re)assigns the user's roles (e.g., UserSourceLDAP)
+		Source string `json:"source,omitempty"`
 	}
 
 	CluACL struct {
@@ -31,17 +51,42 @@ type (
 	}
 
 	BckACL struct {
-		Bck    cmn.Bck         `json:"bck"`
+		Bck cmn.Bck `json:"bck"`
+		// optional: when non-empty, the permissions apply only to the bucket's objects
+		// with names that start with the prefix (and are granted in addition to
+		// the user's bucket-wide and cluster-wide ones)
+		Prefix string          `json:"prefix,omitempty"`
 		Access apc.AccessAttrs `json:"perm,string"`
 	}
 
 	TokenMsg struct {
 		Token string `json:"token"`
+		// (login and refresh only) the refresh token is rotated upon each use
+		RefreshToken string `json:"refresh_token,omitempty"`
+		SessionID    string `json:"session_id,omitempty"`
+		// access token's time-to-live, in seconds
+		ExpiresIn int64 `json:"expires_in,omitempty"`
 	}
 
 	LoginMsg struct {
 		ExpiresIn *time.Duration `json:"expires_in"`
 		Password  string         `json:"password"`
+		// optional: restrict the session's tokens to the given buckets and permissions
+		// (the latter are further limited by the user's roles)
+		Scope []*BckACL `json:"scope,omitempty"`
+	}
+
+	// Login session: tracks the refresh token and the tokens issued with it
+	Session struct {
+		Created time.Time `json:"created"`
+		// when the session (and its refresh token) expires; zero if never
+		Expires   time.Time `json:"expires"`
+		Refreshed time.Time `json:"refreshed,omitempty"`
+		// expiration of the most recently issued access token
+		AccessExpires time.Time `json:"access_expires"`
+		ID            string    `json:"id"`
+		UserID        string    `json:"user_id"`
+		Scope         []*BckACL `json:"scope,omitempty"`
 	}
 
 	RegisteredClusters struct {
@@ -53,7 +98,43 @@ type (
 		Description string    `json:"desc"`
 		ClusterACLs []*CluACL `json:"clusters"`
 		BucketACLs  []*BckACL `json:"buckets"`
-		IsAdmin     bool      `json:"admin"`
+		// optional tenant (see cmn.TenantConf): users with this role see and access
+		// only the buckets in the respective namespace, e.g. ais://@#acme/bucket
+		Namespace string `json:"namespace,omitempty"`
+		IsAdmin   bool   `json:"admin"`
+	}
+
+	// S3 access key bound to a user: S3 clients sign requests (SigV4) with the
+	// secret, and AIS proxies check the signer's permissions as per user's roles
+	S3Key struct {
+		Created     time.Time `json:"created"`
+		AccessKeyID string    `json:"access_key_id"`
+		Secret      string    `json:"secret_access_key,omitempty"` // returned once, upon creation
+		UserID      string    `json:"user_id"`
+		// token on behalf of the user (AuthN => AIS clusters only)
+		Token string `json:"token,omitempty"`
+	}
+	// all S3 access keys, as pushed by AuthN to registered clusters
+	S3KeyList struct {
+		Keys    []*S3Key `json:"keys"`
+		Version int64    `json:"version,string"`
+	}
+
+	// client certificate identity (mTLS) mapped to either an existing user or a set of roles:
+	// AIS proxies verify client certificates and check permissions as per the user's (roles')
+	CertIdentity struct {
+		Created  time.Time `json:"created"`
+		Identity string    `json:"identity"` // one of the CertID* prefixes followed by the value
+		UserID   string    `json:"user_id,omitempty"`
+		Roles    []string  `json:"roles,omitempty"`
+		// token on behalf of the user or roles (AuthN => AIS clusters only)
+		Token string `json:"token,omitempty"`
+	}
+	// all client certificate identities, as pushed by AuthN to registered clusters
+	// (separately from, and versioned independently of, S3 access keys)
+	CertList struct {
+		Certs   []*CertIdentity `json:"certs"`
+		Version int64           `json:"version,string"`
 	}
 )
 
@@ -94,3 +175,32 @@ func (clu *CluACL) String() string {
 var _ jsp.Opts = (*TokenMsg)(nil)
 
 func (*TokenMsg) JspOpts() jsp.Options { return authtokJspOpts }
+
+//////////////////
+// CertIdentity //
+//////////////////
+
+func (ci *CertIdentity) Validate() error {
+	var value string
+	for _, pfx := range []string{CertIDURI, CertIDDNS, CertIDEmail, CertIDCommon} {
+		if strings.HasPrefix(ci.Identity, pfx) {
+			value = ci.Identity[len(pfx):]
+			break
+		}
+	}
+	if value == "" {
+		return fmt.Errorf("invalid certificate identity %q: expecting one of %q, %q, %q, %q followed by the value",
+			ci.Identity, CertIDURI, CertIDDNS, CertIDEmail, CertIDCommon)
+	}
+	if (ci.UserID == "") == (len(ci.Roles) == 0) {
+		return errors.New("certificate identity " + ci.Identity + " must be mapped to either a user or roles (but not both)")
+	}
+	return nil
+}
+
+func (ci *CertIdentity) String() string {
+	if ci.UserID != "" {
+		return ci.Identity + " => user " + ci.UserID
+	}
+	return ci.Identity + " => roles " + strings.Join(ci.Roles, ",")
+}
@@ -312,6 +312,50 @@ func RechunkBucket(bp BaseParams, bck cmn.Bck, msg *apc.RechunkMsg) (xid string,
 	return xid, err
 }
 
+// ScrubBucket starts target-side scrub: verify (and with `msg.Fix`, repair)
+// checksums, mirror copies, EC slices and metafiles, and chunks of a given bucket.
+// Returns xaction ID; per-target summary is reported via xaction snapshots (`apc.ScrubStats`).
+func ScrubBucket(bp BaseParams, bck cmn.Bck, msg *apc.ScrubMsg) (xid string, err error) {
+	q := qalloc()
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActScrub, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(q)
+	}
+	_, err = reqParams.doReqStr(&xid)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return xid, err
+}
+
+// ExportInventory starts writing inventory of a given ais:// bucket
+// into the (existing) destination bucket - one inventory object per target
+// named as per `apc.InventoryMsg.ObjName`.
+// Returns xaction ID.
+func ExportInventory(bp BaseParams, bck, bckTo cmn.Bck, msg *apc.InventoryMsg) (xid string, err error) {
+	q := qalloc()
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActInventory, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(q)
+		_ = bckTo.AddUnameToQuery(q, apc.QparamBckTo, "" /*objName*/)
+	}
+	_, err = reqParams.doReqStr(&xid)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return xid, err
+}
+
 // MakeNCopies starts an extended action (xaction) to bring a given bucket to a
 // certain redundancy level (num copies).
 // Returns xaction ID if successful, an error otherwise.
@@ -37,6 +37,7 @@ type (
 		Method string
 		Token  string
 		UA     string
+		Prio   string // priority class of the batch jobs this client starts (apc.PrioLow, ...); empty means normal
 	}
 
 	// ReqParams is used in constructing client-side API requests to aistore.
@@ -57,6 +58,9 @@ type (
 
 		// mem-pool (when cos.HdrContentType = cos.ContentMsgPack)
 		buf []byte
+
+		// accept trailing checksum (see apc.HdrTrailerCksumType)
+		trailers bool
 	}
 )
 
@@ -90,6 +94,9 @@ func SetAuxHeaders(r *http.Request, bp *BaseParams) {
 	if bp.UA != "" {
 		r.Header.Set(cos.HdrUserAgent, bp.UA)
 	}
+	if bp.Prio != "" {
+		r.Header.Set(apc.HdrJobPrio, bp.Prio)
+	}
 }
 
 func GetWhatRawQuery(getWhat, getProps string) string {
@@ -209,6 +216,9 @@ func (reqParams *ReqParams) do() (resp *http.Response, err error) {
 	}
 	reqParams.setRequestOptParams(req)
 	SetAuxHeaders(req, &reqParams.BaseParams)
+	if reqParams.trailers {
+		req.Header.Set(cos.HdrTE, "trailers")
+	}
 
 	var (
 		rr   = reqResp{client: reqParams.BaseParams.Client, req: req}
@@ -307,6 +317,20 @@ func (reqParams *ReqParams) rwResp(resp *http.Response, w io.Writer) (*wrappedRe
 		return nil, err
 	}
 	wresp := &wrappedResp{Response: resp}
+
+	// end-to-end protection
+	if ty := resp.Header.Get(apc.HdrTrailerCksumType); ty != "" && ty != cos.ChecksumNone {
+		n, cksum, err := cos.CopyAndChecksum(w, resp.Body, nil, ty)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkTrailer(resp, cksum); err != nil {
+			return nil, err
+		}
+		wresp.n = n
+		return wresp, nil
+	}
+
 	n, err := io.Copy(w, resp.Body)
 	if err != nil {
 		return nil, err
@@ -331,9 +355,11 @@ func (reqParams *ReqParams) readValidate(resp *http.Response, w io.Writer) (*wra
 	if err != nil {
 		return nil, err
 	}
-	if n != resp.ContentLength {
+	// (no content-length when the response carries trailing checksum)
+	if resp.ContentLength >= 0 && n != resp.ContentLength {
 		return nil, fmt.Errorf("read length (%d) != (%d) content-length", n, resp.ContentLength)
 	}
+	wresp.n = n
 	if cksum == nil {
 		if cksumType == "" {
 			return nil, errors.New(errNilCksum) // e.g., after fast-appending to a TAR
@@ -346,9 +372,27 @@ func (reqParams *ReqParams) readValidate(resp *http.Response, w io.Writer) (*wra
 	if hdrCksumValue != cksum.Val() {
 		return nil, cmn.NewErrInvalidCksum(hdrCksumValue, cksum.Val())
 	}
+	if resp.Header.Get(apc.HdrTrailerCksumType) == cksumType {
+		if err := checkTrailer(resp, cksum); err != nil {
+			return nil, err
+		}
+	}
 	return wresp, nil
 }
 
+// compare trailing checksum of the received bytes with the one computed client-side
+// (must be called after reading response body to EOF)
+func checkTrailer(resp *http.Response, cksum *cos.CksumHash) error {
+	val := resp.Trailer.Get(apc.HdrTrailerCksumVal)
+	if val == "" {
+		return fmt.Errorf("missing trailing checksum (%s) - incomplete or corrupted response", apc.HdrTrailerCksumVal)
+	}
+	if val != cksum.Val() {
+		return cmn.NewErrInvalidCksum(val, cksum.Val())
+	}
+	return nil
+}
+
 func (reqParams *ReqParams) checkResp(resp *http.Response) error {
 	if resp.StatusCode < http.StatusBadRequest {
 		return nil
@@ -183,6 +183,47 @@ func GetClusterSysInfo(bp BaseParams) (info apc.ClusterSysInfo, err error) {
 	return info, err
 }
 
+// GetHrwPreview returns, for each target, the number of objects (and bytes) that would
+// move if weighted HRW (feature flag "Weighted-HRW") were toggled - see apc.HrwPreview.
+// Walks all objects in the cluster.
+func GetHrwPreview(bp BaseParams) (out map[string]*apc.HrwPreview, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatHrwPreview)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&out)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return out, err
+}
+
+// GetTenants returns cluster-wide capacity usage (and quotas) by tenant - see cmn.TenantConf;
+// when the caller is bound to a tenant, the result includes only that tenant
+func GetTenants(bp BaseParams) (out apc.TenantsUsage, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatTenants)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&out)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return out, err
+}
+
 func GetRemoteAIS(bp BaseParams) (remais meta.RemAisVec, err error) {
 	q := qalloc()
 	q.Set(apc.QparamWhat, apc.WhatRemoteAIS)
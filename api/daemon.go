@@ -53,6 +53,48 @@ func GetDaemonLog(bp BaseParams, node *meta.Snode, args GetLogInput) (int64, err
 	return 0, err
 }
 
+// GetAuditLogArgs selects audit log records; empty fields match all
+type GetAuditLogArgs struct {
+	User   string
+	Action string
+	Bucket string // cname, e.g. "ais://abc"
+	Since  int64  // Unix time (nanoseconds)
+	Limit  int    // max number of (most recent) records; zero - server-side default
+}
+
+// GetAuditLog returns the most recent audit log records of a given node, oldest first
+// (see also: `cmn.AuditConf`)
+func GetAuditLog(bp BaseParams, node *meta.Snode, args *GetAuditLogArgs) (evs []*apc.AuditEvent, err error) {
+	q := make(url.Values, 6)
+	q.Set(apc.QparamWhat, apc.WhatAudit)
+	if args.User != "" {
+		q.Set(apc.QparamAuditUser, args.User)
+	}
+	if args.Action != "" {
+		q.Set(apc.QparamAuditAction, args.Action)
+	}
+	if args.Bucket != "" {
+		q.Set(apc.QparamAuditBck, args.Bucket)
+	}
+	if args.Since != 0 {
+		q.Set(apc.QparamAuditSince, strconv.FormatInt(args.Since, 10))
+	}
+	if args.Limit > 0 {
+		q.Set(apc.QparamAuditLimit, strconv.Itoa(args.Limit))
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = q
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	_, err = reqParams.DoReqAny(&evs)
+	FreeRp(reqParams)
+	return evs, err
+}
+
 // Returns target's mountpaths
 func GetMountpaths(bp BaseParams, node *meta.Snode) (mpl *apc.MountpathList, err error) {
 	mpl = &apc.MountpathList{}
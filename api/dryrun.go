@@ -0,0 +1,73 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Dry-run destructive (and bulk) operations: targets execute the same listing and
+// selection logic without modifying any data and return the number and total size
+// of affected objects, along with a (per-target) sample of object names.
+// See also: apc.DryRunRes, apc.CopyBckMsg.DryRun
+
+// multi-object delete (action = apc.ActDeleteObjects) or evict (apc.ActEvictObjects)
+func DryRunMultiObj(bp BaseParams, bck cmn.Bck, action string, msg *apc.EvdMsg) (apc.DryRunResults, error) {
+	q := make(url.Values, 2)
+	bck.SetQuery(q)
+	msg.DryRun = true
+	bp.Method = http.MethodDelete
+	return dryRun(bp, apc.URLPathBuckets.Join(bck.Name), apc.ActMsg{Action: action, Value: msg}, q)
+}
+
+// destroy AIS bucket (action = apc.ActDestroyBck) or evict remote bucket (apc.ActEvictRemoteBck)
+func DryRunDestroyBucket(bp BaseParams, bck cmn.Bck, action string) (apc.DryRunResults, error) {
+	q := url.Values{apc.QparamDryRun: []string{"true"}}
+	bck.SetQuery(q)
+	bp.Method = http.MethodDelete
+	return dryRun(bp, apc.URLPathBuckets.Join(bck.Name), apc.ActMsg{Action: action}, q)
+}
+
+func DryRunRenameBucket(bp BaseParams, bckFrom, bckTo cmn.Bck) (apc.DryRunResults, error) {
+	if err := bckTo.Validate(); err != nil {
+		return nil, err
+	}
+	q := url.Values{apc.QparamDryRun: []string{"true"}}
+	bckFrom.SetQuery(q)
+	_ = bckTo.AddUnameToQuery(q, apc.QparamBckTo, "" /*objName*/)
+	bp.Method = http.MethodPost
+	return dryRun(bp, apc.URLPathBuckets.Join(bckFrom.Name), apc.ActMsg{Action: apc.ActMoveBck}, q)
+}
+
+// LRU eviction: args.Kind = apc.ActLRU, optionally with args.Buckets (or args.Bck) and args.Force
+func DryRunLRU(bp BaseParams, args *xact.ArgsMsg) (apc.DryRunResults, error) {
+	args.Kind = apc.ActLRU
+	q := url.Values{apc.QparamDryRun: []string{"true"}}
+	if args.Force {
+		q.Set(apc.QparamForce, "true")
+	}
+	bp.Method = http.MethodPut
+	return dryRun(bp, apc.URLPathClu.S, apc.ActMsg{Action: apc.ActXactStart, Value: args}, q)
+}
+
+func dryRun(bp BaseParams, path string, msg apc.ActMsg, q url.Values) (res apc.DryRunResults, err error) {
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = path
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&res)
+	FreeRp(reqParams)
+	return res, err
+}
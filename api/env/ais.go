@@ -28,6 +28,10 @@ const (
 	// false or not set: IPv4 (default)
 	AisUseIPv6 = "AIS_USE_IPv6"
 
+	// sealing key (pathname) to encrypt node-local secrets at rest (AIS nodes and AuthN);
+	// default: `fname.SealKey` in the config directory - next to the secrets it seals
+	AisSealKey = "AIS_SEAL_KEY"
+
 	//
 	// HTTPS (see https://github.com/NVIDIA/aistore/blob/main/docs/environment-vars.md#https)
 	//
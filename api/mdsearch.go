@@ -0,0 +1,39 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// SearchObjects queries the bucket's metadata index (bucket property `md_index`)
+// and returns the matching objects sorted and limited as specified, e.g.:
+//
+//	size, _ := apc.ParseMDPred("size>=1MiB")
+//	color, _ := apc.ParseMDPred("custom.color=red")
+//	api.SearchObjects(bp, bck, &apc.MDQuery{Preds: []*apc.MDPred{size, color}, Sort: apc.MDFieldAtime, Desc: true, Limit: 10})
+//
+// Returns "busy" error (http.StatusServiceUnavailable) while the index is being built.
+func SearchObjects(bp BaseParams, bck cmn.Bck, query *apc.MDQuery) (entries apc.MDEntries, err error) {
+	q := make(url.Values, 4)
+	bck.SetQuery(q)
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSearchBck, Value: query})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&entries)
+	FreeRp(reqParams)
+	return entries, err
+}
@@ -51,6 +51,8 @@ type (
 		//   - `apc.QparamArchmime`
 		//   - `apc.QparamArchregx`
 		//   - `apc.QparamArchmode`
+		// - `apc.QparamSnap`: read the object as of a given (named) bucket snapshot
+		// - `apc.QparamVersionID`: read a given (current or non-current) version; see also: `apc.LsVerHistory`
 		// - TODO: add `apc.QparamValidateCksum`
 		Query url.Values
 
@@ -156,6 +158,9 @@ type (
 // otherwise, it'll `io.Discard` the latter.
 // `io.Copy` is used internally to copy response bytes from the request to the writer.
 // Returns `ObjAttrs` that can be further used to get the size and other object metadata.
+//
+// With end-to-end protection enabled (`checksum.end_to_end`), GetObject and GetObjectWithValidation
+// also validate the checksum of the received bytes against the trailing checksum (see apc.HdrTrailerCksumType).
 
 func (args *GetArgs) ret() (w io.Writer, q url.Values, hdr http.Header) {
 	w = io.Discard
@@ -203,6 +208,7 @@ func GetObject(bp BaseParams, bck cmn.Bck, objName string, args *GetArgs) (oah O
 		bck.SetQuery(qall)
 		reqParams.Query = qall
 		reqParams.Header = hdr
+		reqParams.trailers = true
 	}
 	// copy qparams over, if any
 	for k, vs := range q {
@@ -242,6 +248,7 @@ func GetObjectWithValidation(bp BaseParams, bck cmn.Bck, objName string, args *G
 		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
 		reqParams.Query = bck.AddToQuery(q)
 		reqParams.Header = hdr
+		reqParams.trailers = true
 	}
 
 	var (
@@ -593,6 +600,27 @@ func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
 	return err
 }
 
+// DeleteObjectVersion permanently removes a given (current or non-current) version
+// or delete marker of the object in ais:// bucket with version history enabled
+// (see `cmn.VersionConf.MaxHistory`)
+func DeleteObjectVersion(bp BaseParams, bck cmn.Bck, objName, version string) error {
+	q := qalloc()
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		bck.SetQuery(q)
+		q.Set(apc.QparamVersionID, version)
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
+
 // Evict(object) ======================================================================================
 
 func EvictObject(bp BaseParams, bck cmn.Bck, objName string) error {
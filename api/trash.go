@@ -0,0 +1,74 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// ListTrash returns deleted objects that can still be restored - ais:// buckets
+// with bucket property `trash.enabled` (see also: Undelete)
+func ListTrash(bp BaseParams, bck cmn.Bck, prefix string) (entries apc.TrashEntries, err error) {
+	q := qalloc()
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActListTrash, Value: &apc.TrashMsg{Prefix: prefix}})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(q)
+	}
+	_, err = reqParams.DoReqAny(&entries)
+	FreeRp(reqParams)
+	qfree(q)
+	return entries, err
+}
+
+// Undelete restores deleted object(s) and returns the restored entries, e.g.:
+//
+//	api.Undelete(bp, bck, &apc.UndeleteMsg{ObjName: "a/b/c"})  // most recently deleted copy of "a/b/c"
+//	api.Undelete(bp, bck, &apc.UndeleteMsg{Prefix: "a/"})      // all deleted objects under "a/"
+//
+// Fails with http.StatusConflict when restoring would overwrite an existing object.
+func Undelete(bp BaseParams, bck cmn.Bck, msg *apc.UndeleteMsg) (entries apc.TrashEntries, err error) {
+	q := qalloc()
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActUndelete, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(q)
+	}
+	_, err = reqParams.DoReqAny(&entries)
+	FreeRp(reqParams)
+	qfree(q)
+	return entries, err
+}
+
+// UndeleteBucket restores the most recently destroyed ais:// bucket (that had
+// `trash.enabled`) with all its content and properties
+func UndeleteBucket(bp BaseParams, bck cmn.Bck) error {
+	q := qalloc()
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActUndeleteBck})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(q)
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
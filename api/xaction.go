@@ -113,6 +113,40 @@ func AbortXaction(bp BaseParams, args *xact.ArgsMsg) (err error) {
 	return err
 }
 
+// Pause running xaction(s) - by ID, or by kind and/or bucket.
+// Only xaction kinds marked as `Pausable` (see xact.Table) can be paused;
+// paused xaction keeps its progress and continues from where it left off upon resume.
+func PauseXaction(bp BaseParams, args *xact.ArgsMsg) error {
+	return pauseXaction(bp, args, apc.ActXactPause)
+}
+
+// Resume previously paused xaction(s)
+func ResumeXaction(bp BaseParams, args *xact.ArgsMsg) error {
+	return pauseXaction(bp, args, apc.ActXactResume)
+}
+
+func pauseXaction(bp BaseParams, args *xact.ArgsMsg, action string) (err error) {
+	var (
+		q   = qalloc()
+		msg = apc.ActMsg{Action: action, Value: args}
+	)
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		args.Bck.SetQuery(q)
+		reqParams.Query = q
+	}
+	err = reqParams.DoRequest()
+
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
+
 //
 // querying and waiting
 //
@@ -454,3 +488,64 @@ func _times(args *xact.ArgsMsg) (time.Duration, time.Duration) {
 	}
 	return total, min(xact.MaxProbingFreq, cos.ProbingFrequency(total))
 }
+
+// GetJobHistory returns the most recent finished jobs recorded by the cluster (IC),
+// oldest first; the history is persistent and bounded (see ais/prxhist.go)
+func GetJobHistory(bp BaseParams, msg *xact.HistQueryMsg) (entries []*xact.HistEntry, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatJobHistory)
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&entries)
+	FreeRp(reqParams)
+	qfree(q)
+	return entries, err
+}
+
+// AddJobSchedule adds a scheduled (recurring) job that the primary will then start
+// on its cron schedule, skipping runs that'd overlap with the previous one still running
+func AddJobSchedule(bp BaseParams, job *xact.CronJob) error {
+	return jobSched(bp, apc.ActMsg{Action: apc.ActAddJobSched, Name: job.Name, Value: job})
+}
+
+func RemoveJobSchedule(bp BaseParams, name string) error {
+	return jobSched(bp, apc.ActMsg{Action: apc.ActRmJobSched, Name: name})
+}
+
+func jobSched(bp BaseParams, msg apc.ActMsg) error {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// GetJobSchedules returns all scheduled jobs along with their next and last runs
+func GetJobSchedules(bp BaseParams) (jobs []*xact.CronJobStatus, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatJobSched)
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&jobs)
+	FreeRp(reqParams)
+	qfree(q)
+	return jobs, err
+}
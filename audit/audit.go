@@ -0,0 +1,205 @@
+// Package audit records structured (JSON) events of control- and data-plane operations
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package audit
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Each node appends audit records (apc.AuditEvent), one JSON object per line,
+// to its local <log-dir>/audit.log; the latter gets rotated upon reaching
+// audit.max_size, with up to audit.max_files rotated logs kept as audit.log.<unix-nano>.
+// Optionally, the records are also batched and POST-ed to the configured HTTP sink.
+//
+// Recording is asynchronous and never blocks the caller: when the queue is full,
+// the record gets dropped (and counted).
+
+const (
+	fileName = "audit.log"
+
+	queueSize = 4096
+	sinkBatch = 256
+	sinkFlush = 2 * time.Second
+	sinkTout  = 10 * time.Second
+)
+
+type logger struct {
+	dir     string
+	ch      chan *apc.AuditEvent
+	fh      *os.File
+	client  *http.Client
+	sink    bytes.Buffer
+	size    int64
+	cnt     int // records in the sink buffer
+	dropped atomic.Int64
+}
+
+var g logger
+
+func Init(dir string) {
+	g.dir = dir
+	g.ch = make(chan *apc.AuditEvent, queueSize)
+	g.client = cmn.NewClient(cmn.TransportArgs{Timeout: sinkTout})
+	go g.run()
+}
+
+// Record enqueues the event; control-plane events are subject to audit.actions filter
+func Record(ev *apc.AuditEvent, config *cmn.AuditConf) {
+	if g.ch == nil {
+		return
+	}
+	if ev.Action != "" && len(config.Actions) > 0 && !slices.Contains(config.Actions, ev.Action) {
+		return
+	}
+	select {
+	case g.ch <- ev:
+	default:
+		if n := g.dropped.Add(1); n == 1 || n%1000 == 0 {
+			nlog.Warningln("audit: queue full, dropped", n, "record(s)")
+		}
+	}
+}
+
+func (l *logger) run() {
+	ticker := time.NewTicker(sinkFlush)
+	defer ticker.Stop()
+	for {
+		select {
+		case ev := <-l.ch:
+			config := &cmn.GCO.Get().Audit
+			l.write(ev, config)
+			if l.cnt >= sinkBatch {
+				l.flush(config.Sink)
+			}
+		case <-ticker.C:
+			if l.cnt > 0 {
+				l.flush(cmn.GCO.Get().Audit.Sink)
+			}
+		}
+	}
+}
+
+func (l *logger) write(ev *apc.AuditEvent, config *cmn.AuditConf) {
+	line, err := jsoniter.Marshal(ev)
+	if err != nil {
+		nlog.Errorln("audit: failed to marshal:", err)
+		return
+	}
+	line = append(line, '\n')
+
+	if config.Sink != "" {
+		l.sink.Write(line)
+		l.cnt++
+	}
+	if l.fh == nil {
+		if err := l.open(); err != nil {
+			nlog.Errorln("audit:", err)
+			return
+		}
+	}
+	n, err := l.fh.Write(line)
+	l.size += int64(n)
+	if err != nil {
+		nlog.Errorln("audit: failed to write:", err)
+	}
+	if l.size >= int64(cos.NonZero(config.MaxSize, cmn.DfltAuditMaxSize)) {
+		l.rotate(cos.NonZero(config.MaxFiles, cmn.DfltAuditMaxFiles))
+	}
+}
+
+func (l *logger) open() error {
+	if err := cos.CreateDir(l.dir); err != nil {
+		return err
+	}
+	fh, err := os.OpenFile(filepath.Join(l.dir, fileName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	finfo, err := fh.Stat()
+	if err != nil {
+		fh.Close()
+		return err
+	}
+	l.fh, l.size = fh, finfo.Size()
+	return nil
+}
+
+func (l *logger) rotate(maxFiles int) {
+	cos.Close(l.fh)
+	l.fh, l.size = nil, 0
+	fqn := filepath.Join(l.dir, fileName)
+	if err := os.Rename(fqn, fqn+"."+strconv.FormatInt(time.Now().UnixNano(), 10)); err != nil {
+		nlog.Errorln("audit: failed to rotate:", err)
+		return
+	}
+	rotated := listRotated(l.dir)
+	for i := maxFiles; i < len(rotated); i++ {
+		if err := cos.RemoveFile(rotated[i]); err != nil {
+			nlog.Errorln("audit:", err)
+		}
+	}
+}
+
+// POST newline-delimited JSON records; on failure, the batch is dropped
+// (the records remain in the local audit log)
+func (l *logger) flush(sink string) {
+	defer func() {
+		l.sink.Reset()
+		l.cnt = 0
+	}()
+	if sink == "" {
+		return
+	}
+	req, err := http.NewRequest(http.MethodPost, sink, bytes.NewReader(l.sink.Bytes()))
+	if err != nil {
+		nlog.Errorln("audit: sink", sink, "err:", err)
+		return
+	}
+	req.Header.Set(cos.HdrContentType, "application/x-ndjson")
+	resp, err := l.client.Do(req)
+	if err != nil {
+		nlog.Errorln("audit: failed to POST", l.cnt, "record(s) to", sink, "err:", err)
+		return
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		nlog.Errorln("audit: sink", sink, "returned", resp.Status)
+	}
+}
+
+// rotated audit logs, newest first
+func listRotated(dir string) (rotated []string) {
+	dents, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	const prefix = fileName + "."
+	for _, de := range dents {
+		if name := de.Name(); strings.HasPrefix(name, prefix) && de.Type().IsRegular() {
+			if _, err := strconv.ParseInt(name[len(prefix):], 10, 64); err == nil {
+				rotated = append(rotated, filepath.Join(dir, name))
+			}
+		}
+	}
+	// (same-length decimal suffixes)
+	slices.SortFunc(rotated, func(a, b string) int { return strings.Compare(b, a) })
+	return rotated
+}
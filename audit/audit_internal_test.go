@@ -0,0 +1,81 @@
+// Package audit records structured (JSON) events of control- and data-plane operations
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package audit
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func _events(l *logger, config *cmn.AuditConf, num int) {
+	for i := range num {
+		ev := &apc.AuditEvent{
+			Time:   int64(i + 1),
+			Node:   "t1",
+			User:   "user-" + strconv.Itoa(i%3),
+			Method: http.MethodPut,
+			Path:   "/v1/objects/abc/obj-" + strconv.Itoa(i),
+			Bucket: "ais://abc",
+			Object: "obj-" + strconv.Itoa(i),
+			Status: http.StatusOK,
+		}
+		l.write(ev, config)
+	}
+}
+
+func TestRotateAndRead(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		l      = &logger{dir: dir}
+		config = &cmn.AuditConf{MaxSize: cos.KiB, MaxFiles: 1000, Enabled: true}
+	)
+	_events(l, config, 100)
+	cos.Close(l.fh)
+
+	rotated := listRotated(dir)
+	tassert.Fatalf(t, len(rotated) > 1, "expected rotated audit logs, got %d", len(rotated))
+
+	// the most recent, oldest first
+	evs, err := read(dir, &Query{Limit: 10})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(evs) == 10, "expected 10 records, got %d", len(evs))
+	for i, ev := range evs {
+		tassert.Errorf(t, ev.Time == int64(91+i), "record %d: expected time %d, got %d", i, 91+i, ev.Time)
+	}
+
+	// filter
+	evs, err = read(dir, &Query{User: "user-1", Since: 50, Limit: 1000})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(evs) == 17, "expected 17 records, got %d", len(evs))
+	for _, ev := range evs {
+		tassert.Errorf(t, ev.User == "user-1" && ev.Time >= 50, "unexpected record %+v", ev)
+	}
+	evs, err = read(dir, &Query{Bucket: "ais://xyz", Limit: 1000})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(evs) == 0, "expected no records, got %d", len(evs))
+}
+
+func TestPruneRotated(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		l      = &logger{dir: dir}
+		config = &cmn.AuditConf{MaxSize: cos.KiB, MaxFiles: 2, Enabled: true}
+	)
+	_events(l, config, 100)
+	cos.Close(l.fh)
+
+	rotated := listRotated(dir)
+	tassert.Errorf(t, len(rotated) == 2, "expected 2 rotated audit logs, got %d", len(rotated))
+
+	evs, err := read(dir, &Query{Limit: 1000})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(evs) > 0 && evs[len(evs)-1].Time == 100, "expected the most recent record to survive pruning")
+}
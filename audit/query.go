@@ -0,0 +1,119 @@
+// Package audit records structured (JSON) events of control- and data-plane operations
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package audit
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+const dfltLimit = 1000
+
+// Query selects the most recent records; empty fields match all
+type Query struct {
+	User   string
+	Action string
+	Bucket string // cname
+	Since  int64  // Unix time (nanoseconds)
+	Limit  int
+}
+
+func NewQuery(q url.Values) (*Query, error) {
+	aq := &Query{
+		User:   q.Get(apc.QparamAuditUser),
+		Action: q.Get(apc.QparamAuditAction),
+		Bucket: q.Get(apc.QparamAuditBck),
+		Limit:  dfltLimit,
+	}
+	if s := q.Get(apc.QparamAuditSince); s != "" {
+		since, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		aq.Since = since
+	}
+	if s := q.Get(apc.QparamAuditLimit); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("invalid %s=%q", apc.QparamAuditLimit, s)
+		}
+		aq.Limit = limit
+	}
+	return aq, nil
+}
+
+func (aq *Query) match(ev *apc.AuditEvent) bool {
+	return ev.Time >= aq.Since &&
+		(aq.User == "" || ev.User == aq.User) &&
+		(aq.Action == "" || ev.Action == aq.Action) &&
+		(aq.Bucket == "" || ev.Bucket == aq.Bucket)
+}
+
+// Read returns up to `Limit` most recent matching records, oldest first
+func Read(aq *Query) ([]*apc.AuditEvent, error) {
+	return read(g.dir, aq)
+}
+
+func read(dir string, aq *Query) ([]*apc.AuditEvent, error) {
+	files := append([]string{filepath.Join(dir, fileName)}, listRotated(dir)...)
+	var out []*apc.AuditEvent
+	for _, fqn := range files {
+		evs, oldest, err := readFile(fqn, aq)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		out = append(evs, out...)
+		if len(out) >= aq.Limit || oldest < aq.Since {
+			break
+		}
+	}
+	if len(out) > aq.Limit {
+		out = out[len(out)-aq.Limit:]
+	}
+	return out, nil
+}
+
+// matching records from a single file and the time of its oldest record
+func readFile(fqn string, aq *Query) (evs []*apc.AuditEvent, oldest int64, err error) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer fh.Close()
+
+	scanner := bufio.NewScanner(fh)
+	scanner.Buffer(make([]byte, 0, 64*cos.KiB), cos.MiB)
+	for scanner.Scan() {
+		ev := &apc.AuditEvent{}
+		if jsoniter.Unmarshal(scanner.Bytes(), ev) != nil {
+			continue // (e.g., partially written last line)
+		}
+		if oldest == 0 {
+			oldest = ev.Time
+		}
+		if aq.match(ev) {
+			evs = append(evs, ev)
+			if len(evs) > aq.Limit<<1 {
+				evs = append(evs[:0], evs[len(evs)-aq.Limit:]...)
+			}
+		}
+	}
+	if len(evs) > aq.Limit {
+		evs = evs[len(evs)-aq.Limit:]
+	}
+	return evs, oldest, scanner.Err()
+}
@@ -0,0 +1,607 @@
+// Package bsnap provides point-in-time (copy-on-write) snapshots of ais:// buckets
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package bsnap
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Snapshot lifecycle (as seen by a given target):
+//
+// 1. Proxy adds cmn.SnapInfo to the bucket's props and metasyncs the new BMD.
+// 2. Upon receiving the BMD, target activates the snapshot: records the start
+//    time and begins copy-on-write (COW - see below).
+// 3. Build (x-snapshot): walk the bucket and write per-mountpath manifests
+//    listing all objects that existed at the start time.
+// 4. Snapshotted objects can be then read via GET ?snap=<name> and listed via LsoMsg.Snap.
+//
+// COW: prior to overwriting or deleting an object that belongs to a snapshot,
+// the object gets hard-linked as <mountpath>/<bucket>/%sn/<snapshot ID>/<object name>;
+// chunked objects additionally get their manifest and chunks linked (see core/laux.go).
+// No data is ever copied: space is consumed only by the preserved (since modified
+// or deleted) versions.
+//
+// Until the build completes, snapshot membership is determined by the object's
+// mtime (vs. start time); once built - by the manifests (any mountpath).
+//
+// Relocation:
+// - rebalance: prior to migrating to another target, the member object gets
+//   preserved, and the preserved version then migrates along (see core.AuxCTs);
+// - resilver: membership by name does not depend on the mountpath; the preserved
+//   versions are relocated along with objects;
+// - upon completion, manifests get rebuilt (see Relocated).
+//
+// Limitations:
+// - while rebalance is running, objects in flight may be (transiently) missing
+//   from the snapshot on their new target;
+// - rebalance migrates unmodified member objects as preserved copies that,
+//   unlike hard links, do consume space;
+// - objects with too-long names (see fs.IsFntl) are not listed;
+// - erasure-coded buckets are not supported (enforced).
+
+type (
+	snap struct {
+		bck      *meta.Bck
+		lsts     map[string][]string // mountpath => sorted object names (once built)
+		id       string
+		name     string
+		start    int64        // unix nano
+		building atomic.Int32 // x-snapshot(s) in progress
+		mu       sync.RWMutex
+		built    bool
+	}
+	stab struct {
+		m  map[string]*snap // snapshot ID => snap
+		mu sync.RWMutex
+	}
+	cow struct{}
+)
+
+// interface guard
+var _ core.SnapCOW = (*cow)(nil)
+
+var st stab
+
+const waitBuilt = time.Second // (see Range)
+
+func Init() {
+	st.m = make(map[string]*snap, 4)
+	core.Snapper = &cow{}
+	xreg.RegBckXact(&factory{})
+}
+
+// Reconcile (the current) BMD with active snapshots:
+// - activate new snapshots and return their (not yet running) build xactions;
+// - remove data of the snapshots that were destroyed.
+// When `startup` is true also remove orphaned snapshot data, if any.
+func Reconcile(bmd *meta.BMD, startup bool) (xctns []core.Xact) {
+	ids := make(map[string]struct{}, 4)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		for i := range bck.Props.Snaps {
+			si := &bck.Props.Snaps[i]
+			ids[si.ID] = struct{}{}
+			s, isNew := activate(bck, si)
+			if !isNew || s.isBuilt() {
+				continue
+			}
+			if xctn := s.renew(si.ID); xctn != nil {
+				xctns = append(xctns, xctn)
+			}
+		}
+		if startup {
+			rmOrphans(bck, ids)
+		}
+		return false
+	})
+
+	// destroyed snapshots and buckets
+	var rm []*snap
+	st.mu.Lock()
+	for id, s := range st.m {
+		if _, ok := ids[id]; !ok {
+			delete(st.m, id)
+			rm = append(rm, s)
+		}
+	}
+	st.mu.Unlock()
+	for _, s := range rm {
+		nlog.Infoln("destroying", s.String())
+		s.destroy()
+	}
+	return xctns
+}
+
+func activate(bck *meta.Bck, si *cmn.SnapInfo) (s *snap, isNew bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if s = st.m[si.ID]; s != nil {
+		return s, false
+	}
+	s = &snap{bck: meta.CloneBck(bck.Bucket()), id: si.ID, name: si.Name}
+	s.bck.Props = bck.Props
+	if err := s.load(); err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln(s.String(), "failed to load (will rebuild):", err)
+		}
+		s.start = time.Now().UnixNano()
+		s.built = false
+		if err := s.persist(); err != nil {
+			nlog.Errorln(s.String(), err)
+		}
+		nlog.Infoln("activated", s.String())
+	}
+	st.m[si.ID] = s
+	return s, true
+}
+
+// Relocated is called when rebalance or resilver completes: rebuild the manifests
+// of the bucket's (all buckets', if nil) snapshots to reflect relocated objects
+func Relocated(by string, bck *meta.Bck) {
+	var (
+		xctns []core.Xact
+		snaps = make([]*snap, 0, 4)
+	)
+	st.mu.RLock()
+	for _, s := range st.m {
+		if bck == nil || s.bck.Equal(bck, false, false) {
+			snaps = append(snaps, s)
+		}
+	}
+	st.mu.RUnlock()
+	for _, s := range snaps {
+		nlog.Infoln(by, "done: rebuilding", s.String())
+		if xctn := s.renew(cos.GenUUID()); xctn != nil {
+			xctns = append(xctns, xctn)
+		}
+	}
+	for _, xctn := range xctns {
+		xact.GoRunW(xctn)
+	}
+}
+
+// remove snapshot directories (and descriptors) that are not in the BMD
+func rmOrphans(bck *meta.Bck, ids map[string]struct{}) {
+	for _, mi := range fs.GetAvail() {
+		dir := mi.MakePathCT(bck.Bucket(), fs.SnapCT)
+		dentries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, dent := range dentries {
+			if _, ok := ids[snapID(dent.Name())]; ok {
+				continue
+			}
+			nlog.Warningln("removing orphaned snapshot data:", bck.Cname(""), mi.String(), dent.Name())
+			rmEntry(mi, dir, dent)
+		}
+	}
+}
+
+// snapshot ID given a directory entry in fs.SnapCT: descriptor, manifest,
+// and preserved objects, including manifests and chunks of the chunked ones
+func snapID(name string) string {
+	if i := strings.IndexByte(name, '.'); i > 0 {
+		return name[:i]
+	}
+	return name
+}
+
+func rmEntry(mi *fs.Mountpath, dir string, dent os.DirEntry) {
+	fqn := filepath.Join(dir, dent.Name())
+	if !dent.IsDir() {
+		os.Remove(fqn)
+		return
+	}
+	if err := mi.MoveToDeleted(fqn); err != nil {
+		if err := os.RemoveAll(fqn); err != nil {
+			nlog.Errorln(err)
+		}
+	}
+}
+
+func get(id string) (s *snap) {
+	st.mu.RLock()
+	s = st.m[id]
+	st.mu.RUnlock()
+	return s
+}
+
+func errNotFound(bck *meta.Bck, name string) error {
+	return cos.NewErrNotFound(core.T, bck.Cname("")+" snapshot \""+name+"\"")
+}
+
+//
+// COW
+//
+
+func (*cow) COW(lom *core.LOM) error { return preserve(lom, false) }
+
+// resilver: built snapshots' membership does not depend on the mountpath
+func (*cow) Relocating(lom *core.LOM, local bool) error { return preserve(lom, local) }
+
+// hard-link the object's current (on-disk) version into each snapshot it belongs to
+func preserve(lom *core.LOM, local bool) error {
+	var (
+		plom  *core.LOM
+		snaps = lom.Bprops().Snaps
+	)
+	defer func() {
+		if plom != nil {
+			core.FreeLOM(plom)
+		}
+	}()
+	for i := range snaps {
+		s := get(snaps[i].ID)
+		if s == nil || (local && s.isBuilt()) || !s.member(lom) {
+			continue
+		}
+		if err := cos.Stat(lom.GenFQN(fs.SnapCT, s.id)); err == nil {
+			continue // already preserved
+		}
+		if plom == nil {
+			// (the on-disk version - in-memory metadata may already describe the new one)
+			plom = lom.CloneTo(lom.FQN)
+			if err := plom.LoadMetaFromFS(); err != nil {
+				if cos.IsNotExist(err) {
+					return nil // nothing to preserve
+				}
+				return cmn.NewErrFailedTo(core.T, "preserve (copy-on-write)", lom.Cname(), err)
+			}
+		}
+		if err := plom.LinkPreserved(fs.SnapCT, s.id); err != nil {
+			return cmn.NewErrFailedTo(core.T, "preserve (copy-on-write)", lom.Cname(), err)
+		}
+	}
+	return nil
+}
+
+//
+// read
+//
+
+// Resolve returns the FQN of the snapshotted version of the object:
+// either the preserved one or the current one (if unchanged since the snapshot),
+// and the snapshot ID (see core.LOM.OpenPreserved).
+// The caller must rlock the object.
+func Resolve(lom *core.LOM, name string) (string, string, error) {
+	bck := lom.Bck()
+	si := bck.Props.GetSnap(name)
+	if si == nil {
+		return "", "", errNotFound(bck, name)
+	}
+	s := get(si.ID)
+	if s == nil {
+		return "", "", cmn.NewErrBusy("snapshot", si.String(), "not yet activated")
+	}
+	sfqn := lom.GenFQN(fs.SnapCT, s.id)
+	if err := cos.Stat(sfqn); err == nil {
+		return sfqn, s.id, nil
+	}
+	if s.member(lom) {
+		return lom.FQN, s.id, nil
+	}
+	return "", "", cos.NewErrNotFound(core.T, lom.Cname()+" (snapshot \""+name+"\")")
+}
+
+// List returns a page of objects that belong to the snapshot (lsmsg.Snap)
+// and are stored on this target.
+func List(bck *meta.Bck, lsmsg *apc.LsoMsg) (*cmn.LsoRes, error) {
+	si := bck.Props.GetSnap(lsmsg.Snap)
+	if si == nil {
+		return nil, errNotFound(bck, lsmsg.Snap)
+	}
+	s := get(si.ID)
+	if s == nil || !s.isBuilt() {
+		return nil, cmn.NewErrBusy("snapshot", si.String(), "is being created")
+	}
+	pageSize := int(lsmsg.PageSize)
+	if pageSize <= 0 {
+		pageSize = apc.MaxPageSizeAIS
+	}
+	after := max(lsmsg.ContinuationToken, lsmsg.StartAfter)
+
+	s.mu.RLock()
+	names := make([]string, 0, min(pageSize, 1024))
+	for _, lst := range s.lsts {
+		names = append(names, page(lst, lsmsg.Prefix, after, pageSize)...)
+	}
+	s.mu.RUnlock()
+	slices.Sort(names)
+	names = slices.Compact(names)
+	if len(names) > pageSize {
+		names = names[:pageSize]
+	}
+
+	lst := &cmn.LsoRes{UUID: lsmsg.UUID, Entries: make(cmn.LsoEntries, 0, len(names))}
+	for _, name := range names {
+		size, err := s.size(name)
+		if err != nil {
+			continue // (unlikely)
+		}
+		lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: name, Size: size, Flags: apc.EntryIsCached})
+	}
+	if len(names) == pageSize {
+		lst.ContinuationToken = names[len(names)-1]
+	}
+	return lst, nil
+}
+
+// Range calls `cb` (in name order) for each object of the snapshot stored on this target,
+// with the snapshotted version's metadata loaded; waits for the snapshot to get built,
+// if need be. Respects the xaction's abort and pause (usage: inventory).
+func Range(bck *meta.Bck, name, prefix string, xctn core.Xact, cb func(plom *core.LOM) error) error {
+	si := bck.Props.GetSnap(name)
+	if si == nil {
+		return errNotFound(bck, name)
+	}
+	s := get(si.ID)
+	if s == nil {
+		return cmn.NewErrBusy("snapshot", si.String(), "not yet activated")
+	}
+	// (tolerating a brief window between activation and x-snapshot start)
+	for idle := 0; !s.isBuilt(); {
+		if s.building.Load() > 0 {
+			idle = 0
+		} else if idle++; idle > 1 {
+			return cmn.NewErrFailedTo(core.T, "build", s.String(), errors.New("x-snapshot failed"))
+		}
+		select {
+		case err := <-xctn.ChanAbort():
+			return err
+		case <-time.After(waitBuilt):
+		}
+	}
+
+	s.mu.RLock()
+	names := make([]string, 0, 1024)
+	for _, lst := range s.lsts {
+		names = append(names, page(lst, prefix, "", len(lst))...)
+	}
+	s.mu.RUnlock()
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	for _, name := range names {
+		if xctn.WaitIfPaused() || xctn.IsAborted() {
+			return xctn.AbortErr()
+		}
+		plom, err := s.version(name)
+		if err != nil {
+			if !cos.IsNotExist(err) {
+				xctn.AddErr(err)
+			}
+			continue
+		}
+		err = cb(plom)
+		core.FreeLOM(plom)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// up to `limit` names (from a sorted list) that have the `prefix` and come after `after`
+func page(lst []string, prefix, after string, limit int) []string {
+	from := max(prefix, after)
+	i, found := slices.BinarySearch(lst, from)
+	if found && from == after {
+		i++
+	}
+	j := i
+	for j < len(lst) && j-i < limit && strings.HasPrefix(lst[j], prefix) {
+		j++
+	}
+	return lst[i:j]
+}
+
+//
+// snap
+//
+
+func (s *snap) String() string {
+	return "snapshot[" + s.bck.Cname("") + ", " + s.name + ", " + s.id + "]"
+}
+
+func (s *snap) isBuilt() (built bool) {
+	s.mu.RLock()
+	built = s.built
+	s.mu.RUnlock()
+	return built
+}
+
+// manifests, if built
+func (s *snap) manifests() (lsts map[string][]string) {
+	s.mu.RLock()
+	if s.built {
+		lsts = s.lsts
+	}
+	s.mu.RUnlock()
+	return lsts
+}
+
+// (re)build the manifests
+func (s *snap) renew(xid string) core.Xact {
+	rns := xreg.RenewBucketXact(apc.ActCreateSnap, s.bck, xreg.Args{UUID: xid, Custom: s})
+	if rns.Err != nil {
+		nlog.Errorln(s.String(), rns.Err)
+		return nil
+	}
+	if rns.IsRunning() {
+		return nil
+	}
+	return rns.Entry.Get()
+}
+
+// (with resilvering, objects may move between mountpaths - checking all manifests)
+func (s *snap) member(lom *core.LOM) bool {
+	s.mu.RLock()
+	built, found := s.built, inLsts(s.lsts, lom.ObjName)
+	s.mu.RUnlock()
+	if built {
+		return found
+	}
+	finfo, err := os.Stat(lom.FQN)
+	return err == nil && finfo.ModTime().UnixNano() <= s.start
+}
+
+func inLsts(lsts map[string][]string, name string) bool {
+	for _, lst := range lsts {
+		if _, found := slices.BinarySearch(lst, name); found {
+			return true
+		}
+	}
+	return false
+}
+
+// size of the snapshotted version
+func (s *snap) size(name string) (int64, error) {
+	plom, err := s.version(name)
+	if err != nil {
+		return 0, err
+	}
+	size := plom.Lsize(true)
+	core.FreeLOM(plom)
+	return size, nil
+}
+
+// the snapshotted version (preserved or current) with its metadata loaded;
+// the caller must free
+func (s *snap) version(name string) (*core.LOM, error) {
+	lom := core.AllocLOM(name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(s.bck); err != nil {
+		return nil, err
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	fqn := lom.GenFQN(fs.SnapCT, s.id)
+	if err := cos.Stat(fqn); err != nil {
+		fqn = lom.FQN
+	}
+	plom := lom.CloneTo(fqn)
+	if err := plom.LoadMetaFromFS(); err != nil {
+		core.FreeLOM(plom)
+		return nil, err
+	}
+	return plom, nil
+}
+
+// (upon successful build)
+func (s *snap) setBuilt(lsts map[string][]string) error {
+	s.mu.Lock()
+	s.lsts, s.built = lsts, true
+	s.mu.Unlock()
+	return s.persist()
+}
+
+func (s *snap) dir(mi *fs.Mountpath) string { return mi.MakePathCT(s.bck.Bucket(), fs.SnapCT) }
+
+func (s *snap) destroy() {
+	for _, mi := range fs.GetAvail() {
+		dir := s.dir(mi)
+		dentries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, dent := range dentries {
+			if snapID(dent.Name()) == s.id {
+				rmEntry(mi, dir, dent)
+			}
+		}
+	}
+}
+
+// descriptor: one per mountpath (redundant)
+func (s *snap) persist() (err error) {
+	var (
+		flags byte
+		cnt   int
+	)
+	s.mu.RLock()
+	if s.built {
+		flags |= descBuilt
+	}
+	s.mu.RUnlock()
+	b := packDesc(s.start, flags)
+	for _, mi := range fs.GetAvail() {
+		dir := s.dir(mi)
+		if errV := cos.CreateDir(dir); errV != nil {
+			err = errV
+			continue
+		}
+		if errV := writeFile(filepath.Join(dir, s.id+descExt), b); errV != nil {
+			err = errV
+			continue
+		}
+		cnt++
+	}
+	if cnt > 0 {
+		return nil // at least one
+	}
+	return err
+}
+
+// load descriptor and, if built, manifests
+func (s *snap) load() error {
+	var (
+		err   error
+		avail = fs.GetAvail()
+		found bool
+	)
+	for _, mi := range avail {
+		var (
+			b     []byte
+			flags byte
+		)
+		if b, err = os.ReadFile(filepath.Join(s.dir(mi), s.id+descExt)); err != nil {
+			continue
+		}
+		if s.start, flags, err = unpackDesc(b); err == nil {
+			s.built = flags&descBuilt != 0
+			found = true
+			break
+		}
+	}
+	if !found {
+		if err == nil {
+			err = os.ErrNotExist
+		}
+		return err
+	}
+	if !s.built {
+		return nil // to be (re)built
+	}
+	s.lsts = make(map[string][]string, len(avail))
+	for _, mi := range avail {
+		lst, err := readLst(filepath.Join(s.dir(mi), s.id+lstExt))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue // e.g., new mountpath
+			}
+			s.built = false
+			return nil // rebuild
+		}
+		s.lsts[mi.Path] = lst
+	}
+	return nil
+}
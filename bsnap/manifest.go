@@ -0,0 +1,129 @@
+// Package bsnap provides point-in-time (copy-on-write) snapshots of ais:// buckets
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package bsnap
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Per mountpath, in the bucket's %sn (fs.SnapCT) directory:
+//
+// <snapshot ID>.snap - descriptor: [start time: int64][flags: byte]
+// <snapshot ID>.lst  - manifest:   [count: uvarint]([name length: uvarint][name])*
+// <snapshot ID>/     - preserved (copy-on-write) objects
+// <snapshot ID>.m/, <snapshot ID>.<chunk number>/ - manifests and chunks of the
+//                      preserved chunked objects (see core/laux.go)
+
+const (
+	descExt = ".snap"
+	lstExt  = ".lst"
+
+	desclen = 8 + 1
+	maxName = 64 * cos.KiB // sanity
+)
+
+// descriptor flags
+const (
+	descBuilt = 1 << iota
+)
+
+func packDesc(start int64, flags byte) []byte {
+	b := make([]byte, desclen)
+	binary.BigEndian.PutUint64(b, uint64(start))
+	b[8] = flags
+	return b
+}
+
+func unpackDesc(b []byte) (start int64, flags byte, err error) {
+	if len(b) != desclen {
+		return 0, 0, fmt.Errorf("invalid snapshot descriptor (size %d)", len(b))
+	}
+	return int64(binary.BigEndian.Uint64(b)), b[8], nil
+}
+
+// write via temp file and rename
+func writeFile(fpath string, b []byte) error {
+	tmp := fpath + ".tmp"
+	if err := os.WriteFile(tmp, b, cos.PermRWR); err != nil {
+		return err
+	}
+	return os.Rename(tmp, fpath)
+}
+
+// names must be sorted
+func writeLst(fpath string, names []string) error {
+	tmp := fpath + ".tmp"
+	fh, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	var (
+		bw  = bufio.NewWriter(fh)
+		buf [binary.MaxVarintLen64]byte
+	)
+	n := binary.PutUvarint(buf[:], uint64(len(names)))
+	bw.Write(buf[:n])
+	for _, name := range names {
+		n = binary.PutUvarint(buf[:], uint64(len(name)))
+		bw.Write(buf[:n])
+		bw.WriteString(name)
+	}
+	err = bw.Flush()
+	if err == nil {
+		err = fh.Sync()
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, fpath)
+}
+
+func readLst(fpath string) ([]string, error) {
+	fh, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+
+	br := bufio.NewReader(fh)
+	cnt, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, min(cnt, 64*cos.KiB))
+	for range cnt {
+		l, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, _eof(err)
+		}
+		if l == 0 || l > maxName {
+			return nil, fmt.Errorf("%s: invalid name length %d", fpath, l)
+		}
+		b := make([]byte, l)
+		if _, err := io.ReadFull(br, b); err != nil {
+			return nil, _eof(err)
+		}
+		names = append(names, cos.UnsafeS(b))
+	}
+	return names, nil
+}
+
+func _eof(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
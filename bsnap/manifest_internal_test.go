@@ -0,0 +1,72 @@
+// Package bsnap provides point-in-time (copy-on-write) snapshots of ais:// buckets
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package bsnap
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestManifestDesc(t *testing.T) {
+	for _, flags := range []byte{0, descBuilt} {
+		b := packDesc(1234567890, flags)
+		start, ff, err := unpackDesc(b)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, start == 1234567890 && ff == flags, "got (%d, %d), expected (%d, %d)", start, ff, 1234567890, flags)
+	}
+	_, _, err := unpackDesc([]byte{1, 2, 3})
+	tassert.Errorf(t, err != nil, "expected error on short descriptor")
+}
+
+func TestSnapID(t *testing.T) {
+	const id = "Xy-1_z"
+	for _, name := range []string{id, id + descExt, id + lstExt, id + lstExt + ".tmp", id + ".m", id + ".0002"} {
+		tassert.Errorf(t, snapID(name) == id, "%q: got %q", name, snapID(name))
+	}
+}
+
+func TestManifestLst(t *testing.T) {
+	var (
+		fpath = filepath.Join(t.TempDir(), "x"+lstExt)
+		names = []string{"a", "a/b", "a/b/c", "dir/obj-1", "dir/obj-2", "z"}
+	)
+	tassert.CheckFatal(t, writeLst(fpath, names))
+	lst, err := readLst(fpath)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, slices.Equal(lst, names), "got %v, expected %v", lst, names)
+
+	// empty
+	tassert.CheckFatal(t, writeLst(fpath, nil))
+	lst, err = readLst(fpath)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst) == 0, "expected empty list, got %v", lst)
+}
+
+func TestPage(t *testing.T) {
+	lst := []string{"a/1", "a/2", "a/3", "b/1", "b/2", "c"}
+	tests := []struct {
+		prefix, after string
+		limit         int
+		expected      []string
+	}{
+		{"", "", 100, lst},
+		{"", "", 2, []string{"a/1", "a/2"}},
+		{"", "a/2", 2, []string{"a/3", "b/1"}},
+		{"b/", "", 100, []string{"b/1", "b/2"}},
+		{"b/", "b/1", 100, []string{"b/2"}},
+		{"b/", "a/2", 100, []string{"b/1", "b/2"}},
+		{"a/", "a/3", 100, []string{}},
+		{"d", "", 100, []string{}},
+		{"", "a/25", 1, []string{"a/3"}},
+	}
+	for _, test := range tests {
+		got := page(lst, test.prefix, test.after, test.limit)
+		tassert.Errorf(t, slices.Equal(got, test.expected), "page(%q, %q, %d): got %v, expected %v",
+			test.prefix, test.after, test.limit, got, test.expected)
+	}
+}
@@ -0,0 +1,207 @@
+// Package bsnap provides point-in-time (copy-on-write) snapshots of ais:// buckets
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package bsnap
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-snapshot: builds (target-local) snapshot manifests; rebuilds them upon
+// rebalance or resilver (see Relocated)
+
+type (
+	factory struct {
+		xreg.RenewBase
+		xctn *Xact
+	}
+	Xact struct {
+		s *snap
+		xact.Base
+	}
+	ExtSnapStats struct {
+		Name  string `json:"snap.name"`
+		Start int64  `json:"snap.start,string"` // unix nano
+		Built bool   `json:"snap.built"`
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*Xact)(nil)
+	_ xreg.Renewable = (*factory)(nil)
+)
+
+/////////////
+// factory //
+/////////////
+
+func (*factory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &factory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *factory) Start() error {
+	s, ok := p.Args.Custom.(*snap)
+	debug.Assert(ok)
+	r := &Xact{s: s}
+	r.InitBase(p.Args.UUID, p.Kind(), p.Bck)
+	s.building.Inc()
+	p.xctn = r
+	return nil
+}
+
+func (*factory) Kind() string     { return apc.ActCreateSnap }
+func (p *factory) Get() core.Xact { return p.xctn }
+
+// snapshots of the same bucket are built independently
+func (*factory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+//////////
+// Xact //
+//////////
+
+func (r *Xact) CtlMsg() string { return r.s.name }
+
+func (r *Xact) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	nlog.Infoln(r.Name(), r.s.String(), "start time", time.Unix(0, r.s.start).Format(time.RFC3339Nano))
+
+	var (
+		avail = fs.GetAvail()
+		lsts  = make(map[string][]string, len(avail))
+		old   = r.s.manifests()
+		mu    sync.Mutex
+		wgmp  sync.WaitGroup
+	)
+	for _, mi := range avail {
+		wgmp.Add(1)
+		go func(mi *fs.Mountpath) {
+			defer wgmp.Done()
+			names, err := r.walk(mi, old)
+			if err == nil {
+				err = writeLst(filepath.Join(r.s.dir(mi), r.s.id+lstExt), names)
+			}
+			if err != nil {
+				r.AddErr(err)
+				return
+			}
+			mu.Lock()
+			lsts[mi.Path] = names
+			mu.Unlock()
+		}(mi)
+	}
+	wgmp.Wait()
+
+	if r.ErrCnt() == 0 && !r.IsAborted() {
+		if err := r.s.setBuilt(lsts); err != nil {
+			r.AddErr(err)
+		}
+	}
+	r.s.building.Dec()
+	r.Finish()
+}
+
+// all objects on a given mountpath that existed at the snapshot's start time:
+// unmodified since, plus preserved (copy-on-write);
+// when rebuilding (`old` manifests), unmodified are those listed and still owned
+// by this target - mtime changes when objects get relocated
+// TODO: fntl (fs.IsFntl) names
+func (r *Xact) walk(mi *fs.Mountpath, old map[string][]string) ([]string, error) {
+	var (
+		s     = r.s
+		bck   = s.bck.Bucket()
+		smap  = core.T.Sowner().Get()
+		names = make([]string, 0, 256)
+	)
+	if err := cos.CreateDir(s.dir(mi)); err != nil {
+		return nil, err
+	}
+
+	// 1. current objects
+	err := filepath.WalkDir(mi.MakePathCT(bck, fs.ObjCT), func(fqn string, de os.DirEntry, err error) error {
+		if err != nil {
+			return _walkErr(err)
+		}
+		if r.IsAborted() {
+			return filepath.SkipAll
+		}
+		if !de.Type().IsRegular() {
+			return nil
+		}
+		finfo, err := de.Info()
+		if err != nil || (old == nil && finfo.ModTime().UnixNano() > s.start) {
+			return nil // (benign race) or new
+		}
+		lom := core.AllocLOM("")
+		if errV := lom.InitFQN(fqn, bck); errV == nil && lom.IsHRW() {
+			if old == nil || (inLsts(old, lom.ObjName) && _owned(smap, lom)) {
+				names = append(names, lom.ObjName)
+				r.ObjsAdd(1, finfo.Size())
+			}
+		}
+		core.FreeLOM(lom)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. preserved
+	pdir := filepath.Join(s.dir(mi), s.id)
+	err = filepath.WalkDir(pdir, func(fqn string, de os.DirEntry, err error) error {
+		if err != nil {
+			return _walkErr(err)
+		}
+		if !de.Type().IsRegular() {
+			return nil
+		}
+		if rel, errV := filepath.Rel(pdir, fqn); errV == nil {
+			names = append(names, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names), nil
+}
+
+// (not yet removed after having been migrated to another target)
+func _owned(smap *meta.Smap, lom *core.LOM) bool {
+	tsi, err := smap.HrwHash2T(lom.Digest())
+	return err == nil && tsi.ID() == core.T.SID()
+}
+
+func _walkErr(err error) error {
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (r *Xact) Snap() (snap *core.Snap) {
+	snap = r.Base.NewSnap(r)
+	snap.Ext = &ExtSnapStats{Name: r.s.name, Start: r.s.start, Built: r.s.isBuilt()}
+	return snap
+}
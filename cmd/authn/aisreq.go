@@ -15,6 +15,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -26,6 +27,13 @@ const (
 	retryCount = 4
 	retrySleep = 3 * time.Second
 	retry503   = time.Minute
+
+	// lifetime of the admin token that authorizes pushing S3 access keys
+	pushTokenTTL = 10 * time.Minute
+
+	// AIS endpoints to receive S3 access keys and client certificate identities
+	s3KeysPath = apc.Tokens + "/" + apc.S3Keys
+	certsPath  = apc.Tokens + "/" + apc.Certs
 )
 
 // Send request to the defined cluster to validate that the cluster will allow tokens issued by this AuthN service
@@ -46,7 +54,7 @@ func (m *mgr) validateCluster(clu *authn.CluACL) (err error) {
 	}
 
 	for _, u := range clu.URLs {
-		if err = m.call(http.MethodPost, u, apc.Tokens, body, tag); err == nil {
+		if err = m.call(http.MethodPost, u, apc.Tokens, body, nil, tag); err == nil {
 			return
 		}
 		err = fmt.Errorf("failed to %s with %s: %v", tag, clu, err)
@@ -58,12 +66,98 @@ func (m *mgr) validateCluster(clu *authn.CluACL) (err error) {
 func (m *mgr) broadcastRevoked(token string) {
 	tokenList := authn.TokenList{Tokens: []string{token}}
 	body := cos.MustMarshal(tokenList)
-	m.broadcast(http.MethodDelete, apc.Tokens, body, "broadcast-revoked")
+	m.broadcast(http.MethodDelete, apc.Tokens, body, nil, "broadcast-revoked")
+}
+
+// update list of terminated sessions on all clusters
+func (m *mgr) broadcastRevokedSessions(sessions map[string]int64) {
+	tokenList := authn.TokenList{Sessions: sessions}
+	body := cos.MustMarshal(tokenList)
+	m.broadcast(http.MethodDelete, apc.Tokens, body, nil, "broadcast-revoked-sessions")
+}
+
+// push all S3 access keys to all clusters
+func (m *mgr) broadcastS3Keys() {
+	list, err := m.genS3KeyList()
+	if err == nil {
+		err = m.broadcastList(s3KeysPath, list, "broadcast-s3keys")
+	}
+	if err != nil {
+		nlog.Errorf("failed to broadcast S3 access keys: %v", err)
+	}
+}
+
+// push all client certificate identities to all clusters
+func (m *mgr) broadcastCerts() {
+	list, err := m.genCertList()
+	if err == nil {
+		err = m.broadcastList(certsPath, list, "broadcast-certs")
+	}
+	if err != nil {
+		nlog.Errorf("failed to broadcast client certificate identities: %v", err)
+	}
+}
+
+// users' and roles' permissions are embedded in the tokens that come with both lists
+func (m *mgr) broadcastPushed() {
+	m.broadcastS3Keys()
+	m.broadcastCerts()
+}
+
+func (m *mgr) broadcastList(path string, list any, tag string) error {
+	hdr, err := m.pushHdr()
+	if err != nil {
+		return err
+	}
+	m.broadcast(http.MethodPut, path, cos.MustMarshal(list), hdr, tag)
+	return nil
+}
+
+// push all S3 access keys and client certificate identities to a (newly registered) cluster
+func (m *mgr) syncPushed(clu *authn.CluACL) {
+	keys, err := m.genS3KeyList()
+	if err == nil {
+		err = m.syncList(clu, s3KeysPath, keys, "sync-s3keys")
+	}
+	if err != nil {
+		nlog.Errorf("failed to sync S3 access keys with %s: %v", clu, err)
+	}
+	certs, err := m.genCertList()
+	if err == nil {
+		err = m.syncList(clu, certsPath, certs, "sync-certs")
+	}
+	if err != nil {
+		nlog.Errorf("failed to sync client certificate identities with %s: %v", clu, err)
+	}
+}
+
+func (m *mgr) syncList(clu *authn.CluACL, path string, list any, tag string) error {
+	hdr, err := m.pushHdr()
+	if err != nil {
+		return err
+	}
+	body := cos.MustMarshal(list)
+	for _, u := range clu.URLs {
+		if err = m.call(http.MethodPut, u, path, body, hdr, tag); err == nil {
+			break
+		}
+	}
+	return err
+}
+
+// S3 access keys and client certificate identities (along with respective tokens) are pushed by admin
+func (m *mgr) pushHdr() (http.Header, error) {
+	expires := time.Now().UTC().Add(pushTokenTTL)
+	token, err := m.createTokenWithClaims(tok.AdminClaims(expires, adminUserID, ""))
+	if err != nil {
+		return nil, err
+	}
+	return http.Header{apc.HdrAuthorization: []string{apc.AuthenticationTypeBearer + " " + token}}, nil
 }
 
 // broadcast the request to all clusters. If a cluster has a few URLS,
 // it sends to the first working one. Clusters are processed in parallel.
-func (m *mgr) broadcast(method, path string, body []byte, tag string) {
+func (m *mgr) broadcast(method, path string, body []byte, hdr http.Header, tag string) {
 	clus, code, err := m.clus()
 	if err != nil {
 		nlog.Errorf("Failed to read cluster list: %v (%d)", err, code)
@@ -75,7 +169,7 @@ func (m *mgr) broadcast(method, path string, body []byte, tag string) {
 		go func(clu *authn.CluACL) {
 			var err error
 			for _, u := range clu.URLs {
-				if err = m.call(method, u, path, body, tag); err == nil {
+				if err = m.call(method, u, path, body, hdr, tag); err == nil {
 					break
 				}
 			}
@@ -88,7 +182,7 @@ func (m *mgr) broadcast(method, path string, body []byte, tag string) {
 	wg.Wait()
 }
 
-// Send valid and non-expired revoked token list to a cluster.
+// Send valid and non-expired revoked tokens (and terminated sessions) to a cluster.
 func (m *mgr) syncTokenList(ctx context.Context, clu *authn.CluACL) {
 	const tag = "sync-tokens"
 	tokenList, code, err := m.generateRevokedTokenList(ctx)
@@ -96,12 +190,17 @@ func (m *mgr) syncTokenList(ctx context.Context, clu *authn.CluACL) {
 		nlog.Errorf("failed to sync token list with %q(%q): %v (%d)", clu.ID, clu.Alias, err, code)
 		return
 	}
-	if len(tokenList) == 0 {
+	sessions, code, err := m.revokedSessionList()
+	if err != nil {
+		nlog.Errorf("failed to sync revoked sessions with %q(%q): %v (%d)", clu.ID, clu.Alias, err, code)
 		return
 	}
-	body := cos.MustMarshal(authn.TokenList{Tokens: tokenList})
+	if len(tokenList) == 0 && len(sessions) == 0 {
+		return
+	}
+	body := cos.MustMarshal(authn.TokenList{Tokens: tokenList, Sessions: sessions})
 	for _, u := range clu.URLs {
-		if err = m.call(http.MethodDelete, u, apc.Tokens, body, tag); err == nil {
+		if err = m.call(http.MethodDelete, u, apc.Tokens, body, nil, tag); err == nil {
 			break
 		}
 		err = fmt.Errorf("failed to %s with %s: %v", tag, clu, err)
@@ -112,7 +211,7 @@ func (m *mgr) syncTokenList(ctx context.Context, clu *authn.CluACL) {
 }
 
 // TODO: reuse api/client.go reqParams.do()
-func (m *mgr) call(method, proxyURL, path string, injson []byte, tag string) error {
+func (m *mgr) call(method, proxyURL, path string, injson []byte, hdr http.Header, tag string) error {
 	var (
 		rerr    error
 		msg     []byte
@@ -132,6 +231,9 @@ func (m *mgr) call(method, proxyURL, path string, injson []byte, tag string) err
 			return nerr
 		}
 		req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+		for k, v := range hdr {
+			req.Header[k] = v
+		}
 		resp, err := client.Do(req)
 		if resp != nil && resp.Body != nil {
 			var e error
@@ -105,6 +105,9 @@ func (cm *ConfManager) UpdateConf(cu *authn.ConfigToUpdate) error {
 	if cu.Server.Expire != nil {
 		next.Server.Expire = *cu.Server.Expire
 	}
+	if cu.Server.AccessTTL != nil {
+		next.Server.AccessTTL = *cu.Server.AccessTTL
+	}
 	// Validate config after updates before storing
 	err := next.Validate()
 	if err != nil {
@@ -250,6 +253,11 @@ func (cm *ConfManager) GetExpiry() time.Duration {
 	return cm.conf.Load().Expire()
 }
 
+// zero if access tokens are not short-lived (see authn.ServerConf.AccessTTL)
+func (cm *ConfManager) GetAccessTTL() time.Duration {
+	return cm.conf.Load().Server.AccessTTL.D()
+}
+
 func (cm *ConfManager) GetDefaultTimeout() time.Duration {
 	return time.Duration(cm.conf.Load().Timeout.Default)
 }
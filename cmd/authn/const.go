@@ -4,6 +4,8 @@
  */
 package main
 
+import "time"
+
 const (
 	ClusterOwnerRole = "ClusterOwner"
 	BucketOwnerRole  = "BucketOwner"
@@ -15,6 +17,29 @@ const (
 	rolesCollection    = "role"
 	revokedCollection  = "revoked"
 	clustersCollection = "cluster"
+	s3KeysCollection   = "s3key"
+	certsCollection    = "cert"
+	sessionsCollection = "session"
+	revokedSessions    = "revsession"
 
 	adminUserID = "admin"
 )
+
+// S3 access keys
+const (
+	s3KeyPrefix = "AIS"
+	s3KeyIDLen  = 20
+	s3SecretLen = 40
+
+	// lifetime of the users' tokens that AuthN pushes along with S3 access keys
+	// and certificate identities, unless access tokens are short-lived (authn.ServerConf.AccessTTL);
+	// either way, both lists get re-pushed every half-lifetime
+	s3TokenTTL = time.Hour
+)
+
+// sessions: refresh token is "<session ID>.<secret>"
+const (
+	sessionIDLen     = 20
+	refreshSecretLen = 40
+	refreshSepa      = "."
+)
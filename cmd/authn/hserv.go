@@ -108,6 +108,9 @@ func (h *hserv) registerPublicHandlers() {
 	h.registerHandler(apc.URLPathTokens.S, h.tokenHandler)
 	h.registerHandler(apc.URLPathClusters.S, h.clusterHandler)
 	h.registerHandler(apc.URLPathRoles.S, h.roleHandler)
+	h.registerHandler(apc.URLPathS3Keys.S, h.s3KeyHandler)
+	h.registerHandler(apc.URLPathCerts.S, h.certHandler)
+	h.registerHandler(apc.URLPathSessions.S, h.sessionHandler)
 	h.registerHandler(apc.URLPathDae.S, h.configHandler)
 	h.registerHandler(apc.URLPathOIDC.S, h.oidcConfigHandler)
 	h.registerHandler(apc.URLPathJWKS.S, h.pubKeyHandler)
@@ -132,8 +135,10 @@ func (h *hserv) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodDelete:
 		h.httpRevokeToken(w, r)
+	case http.MethodPost:
+		h.httpRefreshToken(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodPost)
 	}
 }
 
@@ -167,6 +172,43 @@ func (h *hserv) roleHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *hserv) s3KeyHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.httpS3KeyPost(w, r)
+	case http.MethodDelete:
+		h.httpS3KeyDel(w, r)
+	case http.MethodGet:
+		h.httpS3KeyGet(w, r)
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
+	}
+}
+
+func (h *hserv) certHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.httpCertPost(w, r)
+	case http.MethodDelete:
+		h.httpCertDel(w, r)
+	case http.MethodGet:
+		h.httpCertGet(w, r)
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
+	}
+}
+
+func (h *hserv) sessionHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodDelete:
+		h.httpSessionDel(w, r)
+	case http.MethodGet:
+		h.httpSessionGet(w, r)
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet)
+	}
+}
+
 func (h *hserv) configHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
@@ -191,16 +233,38 @@ func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
 		cmn.WriteErrMsg(w, r, "empty token")
 		return
 	}
-	if _, err := h.mgr.tkParser.ValidateToken(r.Context(), msg.Token); err != nil {
+	claims, err := h.mgr.tkParser.ValidateToken(r.Context(), msg.Token)
+	if err != nil {
 		cmn.WriteErr(w, r, err)
 		return
 	}
-	code, err := h.mgr.revokeToken(msg.Token)
+	code, err := h.mgr.revokeToken(msg.Token, claims)
 	if err != nil {
 		h.failAction(w, r, "revoke token", msg.Token, err, code)
 	}
 }
 
+// Exchanges refresh token for a new access token (and a new refresh token)
+func (h *hserv) httpRefreshToken(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathTokens.L); err != nil {
+		return
+	}
+	msg := &authn.TokenMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	if msg.RefreshToken == "" {
+		cmn.WriteErrMsg(w, r, "empty refresh token", http.StatusUnauthorized)
+		return
+	}
+	tmsg, code, err := h.mgr.refreshToken(msg.RefreshToken)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	writeJSON(w, tmsg, "refresh token")
+}
+
 func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
 	apiItems, err := parseURL(w, r, 1, apc.URLPathUsers.L)
 	if err != nil {
@@ -367,6 +431,21 @@ func (h *hserv) validateUpdatePerms(w http.ResponseWriter, r *http.Request, user
 	return err
 }
 
+// Checks if the request header contains valid admin or the given user's credentials
+func (h *hserv) validateAdminOrSelf(w http.ResponseWriter, r *http.Request, userID string) error {
+	tk, err := h.getToken(r)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return err
+	}
+	if !tk.IsAdmin && !tk.IsUser(userID) {
+		err := errors.New("not authorized: requires admin or self")
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return err
+	}
+	return nil
+}
+
 // Generate h token for h user if provided credentials are valid.
 // If h token is already issued and it is not expired yet then the old
 // token is returned
@@ -384,18 +463,13 @@ func (h *hserv) userLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var (
-		token  string
-		code   int
-		userID = apiItems[0]
-	)
-	if token, code, err = h.mgr.issueToken(userID, msg.Password, msg); err != nil {
+	userID := apiItems[0]
+	tmsg, code, err := h.mgr.issueToken(userID, msg.Password, msg)
+	if err != nil {
 		h.failAction(w, r, "generate token for", userID, err, code)
 		return
 	}
-
-	repl := fmt.Sprintf(`{"token": %q}`, token)
-	writeBytes(w, cos.UnsafeB(repl), "login")
+	writeJSON(w, tmsg, "login")
 }
 
 func writeJSON(w http.ResponseWriter, val any, tag string) {
@@ -586,6 +660,182 @@ func (h *hserv) httpRolePut(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Issues a new S3 access key; the secret is returned only once
+func (h *hserv) httpS3KeyPost(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := parseURL(w, r, 1, apc.URLPathS3Keys.L)
+	if err != nil {
+		return
+	}
+	userID := apiItems[0]
+	if err := h.validateAdminOrSelf(w, r, userID); err != nil {
+		return
+	}
+	key, code, err := h.mgr.addS3Key(userID)
+	if err != nil {
+		h.failAction(w, r, "add S3 access key for", userID, err, code)
+		return
+	}
+	if h.mgr.cm.IsVerbose() {
+		nlog.Infof("Add S3 access key %q for user %q", key.AccessKeyID, userID)
+	}
+	writeJSON(w, key, "add S3 access key")
+}
+
+// Returns S3 access keys (without secrets) of all users (admin only) or a given user
+func (h *hserv) httpS3KeyGet(w http.ResponseWriter, r *http.Request) {
+	items, err := parseURL(w, r, 0, apc.URLPathS3Keys.L)
+	if err != nil {
+		return
+	}
+	var userID string
+	switch len(items) {
+	case 0:
+		err = h.validateAdminPerms(w, r)
+	case 1:
+		userID = items[0]
+		err = h.validateAdminOrSelf(w, r, userID)
+	default:
+		cmn.WriteErrMsg(w, r, "invalid request")
+		return
+	}
+	if err != nil {
+		return
+	}
+	keys, code, err := h.mgr.s3KeyList(userID)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	for _, key := range keys {
+		key.Secret = ""
+	}
+	writeJSON(w, keys, "list S3 access keys")
+}
+
+func (h *hserv) httpS3KeyDel(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := parseURL(w, r, 1, apc.URLPathS3Keys.L)
+	if err != nil {
+		return
+	}
+	akid := apiItems[0]
+	key, code, err := h.mgr.lookupS3Key(akid)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	if err := h.validateAdminOrSelf(w, r, key.UserID); err != nil {
+		return
+	}
+	if code, err := h.mgr.delS3Key(akid); err != nil {
+		h.failAction(w, r, "delete S3 access key", akid, err, code)
+	}
+}
+
+// Maps client certificate identity to a user or roles (admin only)
+func (h *hserv) httpCertPost(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathCerts.L); err != nil {
+		return
+	}
+	if err := h.validateAdminPerms(w, r); err != nil {
+		return
+	}
+	ci := &authn.CertIdentity{}
+	if err := cmn.ReadJSON(w, r, ci); err != nil {
+		return
+	}
+	if code, err := h.mgr.addCert(ci); err != nil {
+		h.failAction(w, r, "add certificate identity", ci.Identity, err, code)
+		return
+	}
+	if h.mgr.cm.IsVerbose() {
+		nlog.Infoln("Add certificate identity", ci.String())
+	}
+}
+
+func (h *hserv) httpCertGet(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathCerts.L); err != nil {
+		return
+	}
+	if err := h.validateAdminPerms(w, r); err != nil {
+		return
+	}
+	certs, code, err := h.mgr.certList()
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	writeJSON(w, certs, "list certificate identities")
+}
+
+// (identities may contain slashes and are, therefore, passed in the request body)
+func (h *hserv) httpCertDel(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathCerts.L); err != nil {
+		return
+	}
+	if err := h.validateAdminPerms(w, r); err != nil {
+		return
+	}
+	ci := &authn.CertIdentity{}
+	if err := cmn.ReadJSON(w, r, ci); err != nil {
+		return
+	}
+	if code, err := h.mgr.delCert(ci.Identity); err != nil {
+		h.failAction(w, r, "delete certificate identity", ci.Identity, err, code)
+	}
+}
+
+// Returns active sessions of all users (admin only) or a given user
+func (h *hserv) httpSessionGet(w http.ResponseWriter, r *http.Request) {
+	items, err := parseURL(w, r, 0, apc.URLPathSessions.L)
+	if err != nil {
+		return
+	}
+	var userID string
+	switch len(items) {
+	case 0:
+		err = h.validateAdminPerms(w, r)
+	case 1:
+		userID = items[0]
+		err = h.validateAdminOrSelf(w, r, userID)
+	default:
+		cmn.WriteErrMsg(w, r, "invalid request")
+		return
+	}
+	if err != nil {
+		return
+	}
+	list, code, err := h.mgr.sessionList(userID)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	sessions := make([]*authn.Session, 0, len(list))
+	for _, sess := range list {
+		sessions = append(sessions, &sess.Session)
+	}
+	writeJSON(w, sessions, "list sessions")
+}
+
+// Terminates the session and revokes its tokens
+func (h *hserv) httpSessionDel(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := parseURL(w, r, 1, apc.URLPathSessions.L)
+	if err != nil {
+		return
+	}
+	sid := apiItems[0]
+	sess, code, err := h.mgr.lookupSession(sid)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	if err := h.validateAdminOrSelf(w, r, sess.UserID); err != nil {
+		return
+	}
+	if code, err := h.mgr.delSession(sid); err != nil {
+		h.failAction(w, r, "terminate session", sid, err, code)
+	}
+}
+
 func (h *hserv) httpConfigGet(w http.ResponseWriter, r *http.Request) {
 	if err := h.validateAdminPerms(w, r); err != nil {
 		return
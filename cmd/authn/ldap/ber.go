@@ -0,0 +1,208 @@
+// Package ldap provides LDAP (and Active Directory) identity provider for AuthN
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ldap
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Minimal BER (X.690) encoding - only what's required by LDAPv3 (RFC 4511):
+// single-byte tags, definite lengths, integers, octet strings, booleans.
+
+const (
+	classUniversal   = 0x00
+	classApplication = 0x40
+	classContext     = 0x80
+
+	constructed = 0x20
+
+	tagBoolean     = 0x01
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagEnumerated  = 0x0a
+	tagSequence    = 0x10
+	tagSet         = 0x11
+
+	maxPacketSize = 16 * 1024 * 1024
+)
+
+type packet struct {
+	value    []byte // primitive
+	children []*packet
+	class    byte
+	tag      byte
+	cons     bool
+}
+
+var errMalformed = errors.New("malformed BER packet")
+
+func newSeq(tag byte, class byte, children ...*packet) *packet {
+	return &packet{class: class, tag: tag, cons: true, children: children}
+}
+
+func newStr(tag, class byte, s string) *packet {
+	return &packet{class: class, tag: tag, value: []byte(s)}
+}
+
+func newInt(tag byte, n int64) *packet {
+	// two's complement, minimal number of octets
+	var b []byte
+	for {
+		b = append([]byte{byte(n)}, b...)
+		if (n >= -128 && n < 128) || len(b) == 8 {
+			break
+		}
+		n >>= 8
+	}
+	return &packet{class: classUniversal, tag: tag, value: b}
+}
+
+func newBool(v bool) *packet {
+	b := byte(0)
+	if v {
+		b = 0xff
+	}
+	return &packet{class: classUniversal, tag: tagBoolean, value: []byte{b}}
+}
+
+func (p *packet) append(children ...*packet) *packet {
+	p.children = append(p.children, children...)
+	return p
+}
+
+func (p *packet) bytes() []byte {
+	var content []byte
+	if p.cons {
+		for _, c := range p.children {
+			content = append(content, c.bytes()...)
+		}
+	} else {
+		content = p.value
+	}
+	id := p.class | p.tag
+	if p.cons {
+		id |= constructed
+	}
+	out := make([]byte, 0, len(content)+6)
+	out = append(out, id)
+	out = appendLen(out, len(content))
+	return append(out, content...)
+}
+
+func appendLen(b []byte, l int) []byte {
+	if l < 0x80 {
+		return append(b, byte(l))
+	}
+	var lb []byte
+	for ; l > 0; l >>= 8 {
+		lb = append([]byte{byte(l)}, lb...)
+	}
+	b = append(b, 0x80|byte(len(lb)))
+	return append(b, lb...)
+}
+
+func (p *packet) str() string { return string(p.value) }
+
+func (p *packet) int() (n int64) {
+	if len(p.value) == 0 {
+		return 0
+	}
+	if p.value[0]&0x80 != 0 {
+		n = -1
+	}
+	for _, b := range p.value {
+		n = n<<8 | int64(b)
+	}
+	return n
+}
+
+func (p *packet) child(i int) (*packet, error) {
+	if i >= len(p.children) {
+		return nil, fmt.Errorf("%w: expected at least %d elements, got %d", errMalformed, i+1, len(p.children))
+	}
+	return p.children[i], nil
+}
+
+//
+// decoding
+//
+
+func readPacket(r *bufio.Reader) (*packet, error) {
+	id, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if id&0x1f == 0x1f {
+		return nil, fmt.Errorf("%w: multi-byte tags are not supported", errMalformed)
+	}
+	l, err := readLen(r)
+	if err != nil {
+		return nil, noEOF(err)
+	}
+	content := make([]byte, l)
+	if _, err := io.ReadFull(r, content); err != nil {
+		return nil, noEOF(err)
+	}
+	return parsePacket(id, content)
+}
+
+func readLen(r *bufio.Reader) (int, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if b < 0x80 {
+		return int(b), nil
+	}
+	n := int(b & 0x7f)
+	if n == 0 || n > 4 {
+		return 0, fmt.Errorf("%w: unsupported length encoding (%#x)", errMalformed, b)
+	}
+	var l int
+	for range n {
+		if b, err = r.ReadByte(); err != nil {
+			return 0, err
+		}
+		l = l<<8 | int(b)
+	}
+	if l > maxPacketSize {
+		return 0, fmt.Errorf("%w: packet size %d exceeds %d", errMalformed, l, maxPacketSize)
+	}
+	return l, nil
+}
+
+func parsePacket(id byte, content []byte) (*packet, error) {
+	p := &packet{class: id & 0xc0, tag: id & 0x1f, cons: id&constructed != 0}
+	if !p.cons {
+		p.value = content
+		return p, nil
+	}
+	r := bufio.NewReader(bytes.NewReader(content))
+	for {
+		c, err := readPacket(r)
+		if err == io.EOF {
+			return p, nil
+		}
+		if err != nil {
+			if err == io.ErrUnexpectedEOF {
+				err = errMalformed
+			}
+			return nil, err
+		}
+		p.children = append(p.children, c)
+	}
+}
+
+// EOF in the middle of a packet
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,316 @@
+// Package ldap provides LDAP (and Active Directory) identity provider for AuthN
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ldap
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// LDAPv3 client (RFC 4511): simple bind and search, one outstanding request at a time
+
+// protocol operations
+const (
+	opBindRequest      = 0
+	opBindResponse     = 1
+	opUnbindRequest    = 2
+	opSearchRequest    = 3
+	opSearchResEntry   = 4
+	opSearchResDone    = 5
+	opSearchResRef     = 19
+	opExtendedRequest  = 23
+	opExtendedResponse = 24 // (also, unsolicited notice of disconnection)
+)
+
+const oidStartTLS = "1.3.6.1.4.1.1466.20037"
+
+// result codes
+const (
+	ResultSuccess            = 0
+	ResultInvalidCredentials = 49
+)
+
+const (
+	scopeSubtree   = 2
+	derefNever     = 0
+	protoVersion   = 3
+	defaultPort    = "389"
+	defaultTLSPort = "636"
+)
+
+type (
+	conn struct {
+		nc      net.Conn
+		r       *bufio.Reader
+		timeout time.Duration
+		msgID   int64
+	}
+	// Entry is a single search result
+	Entry struct {
+		Attrs map[string][]string // by lowercase attribute name
+		DN    string
+	}
+	// Error is an LDAP result other than success
+	Error struct {
+		Msg  string
+		Code int64
+	}
+)
+
+func (e *Error) Error() string {
+	if e.Msg == "" {
+		return fmt.Sprintf("LDAP result code %d", e.Code)
+	}
+	return fmt.Sprintf("LDAP result code %d: %s", e.Code, e.Msg)
+}
+
+func IsInvalidCredentials(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Code == ResultInvalidCredentials
+}
+
+// dial "ldap://host[:port]" or "ldaps://host[:port]"; the former, optionally, upgraded via StartTLS
+func dial(rawURL string, tlsConf *tls.Config, startTLS bool, timeout time.Duration) (*conn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nc     net.Conn
+		host   = u.Host
+		dialer = &net.Dialer{Timeout: timeout}
+	)
+	switch u.Scheme {
+	case "ldap":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), defaultPort)
+		}
+		nc, err = dialer.Dial("tcp", host)
+	case "ldaps":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), defaultTLSPort)
+		}
+		nc, err = tls.DialWithDialer(dialer, "tcp", host, tlsConf)
+	default:
+		return nil, fmt.Errorf("invalid LDAP URL %q (expecting ldap:// or ldaps:// scheme)", rawURL)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c := &conn{nc: nc, r: bufio.NewReader(nc), timeout: timeout}
+	if startTLS && u.Scheme == "ldap" {
+		if err := c.startTLS(tlsConf); err != nil {
+			nc.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// StartTLS extended operation (RFC 4511, section 4.14) followed by TLS handshake
+// over the same connection - prior to sending any credentials
+func (c *conn) startTLS(tlsConf *tls.Config) error {
+	req := newSeq(opExtendedRequest, classApplication, newStr(0, classContext, oidStartTLS))
+	resp, err := c.roundTrip(req)
+	if err != nil {
+		return err
+	}
+	if resp.tag != opExtendedResponse {
+		return fmt.Errorf("%w: unexpected response %d to StartTLS request", errMalformed, resp.tag)
+	}
+	if err := ldapResult(resp); err != nil {
+		return fmt.Errorf("StartTLS: %w", err)
+	}
+	if c.r.Buffered() > 0 {
+		return errors.New("StartTLS: unexpected data prior to TLS handshake")
+	}
+	tc := tls.Client(c.nc, tlsConf)
+	if err := tc.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return err
+	}
+	if err := tc.Handshake(); err != nil {
+		return fmt.Errorf("StartTLS: %w", err)
+	}
+	c.nc, c.r = tc, bufio.NewReader(tc)
+	return nil
+}
+
+func (c *conn) close() {
+	// best effort
+	c.msgID++
+	unbind := newSeq(tagSequence, classUniversal,
+		newInt(tagInteger, c.msgID),
+		&packet{class: classApplication, tag: opUnbindRequest},
+	)
+	if c.nc.SetWriteDeadline(time.Now().Add(time.Second)) == nil {
+		c.nc.Write(unbind.bytes())
+	}
+	c.nc.Close()
+}
+
+// simple bind; empty password is rejected to prevent "unauthenticated" binds (RFC 4513, section 5.1.2)
+// from being mistaken for successful authentication
+func (c *conn) bind(dn, password string) error {
+	if dn != "" && password == "" {
+		return &Error{Code: ResultInvalidCredentials, Msg: "empty password"}
+	}
+	req := newSeq(opBindRequest, classApplication,
+		newInt(tagInteger, protoVersion),
+		newStr(tagOctetString, classUniversal, dn),
+		newStr(0, classContext, password), // simple
+	)
+	resp, err := c.roundTrip(req)
+	if err != nil {
+		return err
+	}
+	if resp.tag != opBindResponse {
+		return fmt.Errorf("%w: unexpected response %d to bind request", errMalformed, resp.tag)
+	}
+	return ldapResult(resp)
+}
+
+// search the subtree; return all entries
+func (c *conn) search(baseDN, filter string, attrs []string, sizeLimit int) ([]*Entry, error) {
+	f, err := compileFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	al := newSeq(tagSequence, classUniversal)
+	for _, a := range attrs {
+		al.append(newStr(tagOctetString, classUniversal, a))
+	}
+	req := newSeq(opSearchRequest, classApplication,
+		newStr(tagOctetString, classUniversal, baseDN),
+		newInt(tagEnumerated, scopeSubtree),
+		newInt(tagEnumerated, derefNever),
+		newInt(tagInteger, int64(sizeLimit)),
+		newInt(tagInteger, int64(c.timeout/time.Second)),
+		newBool(false),
+		f,
+		al,
+	)
+	if err := c.send(req); err != nil {
+		return nil, err
+	}
+	var entries []*Entry
+	for {
+		op, err := c.recv()
+		if err != nil {
+			return nil, err
+		}
+		switch op.tag {
+		case opSearchResEntry:
+			e, err := parseEntry(op)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e)
+		case opSearchResRef:
+			// referrals are not followed
+		case opSearchResDone:
+			return entries, ldapResult(op)
+		default:
+			return nil, fmt.Errorf("%w: unexpected response %d to search request", errMalformed, op.tag)
+		}
+	}
+}
+
+func (c *conn) roundTrip(req *packet) (*packet, error) {
+	if err := c.send(req); err != nil {
+		return nil, err
+	}
+	return c.recv()
+}
+
+func (c *conn) send(op *packet) error {
+	c.msgID++
+	msg := newSeq(tagSequence, classUniversal, newInt(tagInteger, c.msgID), op)
+	if err := c.nc.SetWriteDeadline(time.Now().Add(c.timeout)); err != nil {
+		return err
+	}
+	_, err := c.nc.Write(msg.bytes())
+	return err
+}
+
+// receive the response to the last request
+func (c *conn) recv() (*packet, error) {
+	if err := c.nc.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+		return nil, err
+	}
+	for {
+		msg, err := readPacket(c.r)
+		if err != nil {
+			return nil, err
+		}
+		id, err := msg.child(0)
+		if err != nil {
+			return nil, err
+		}
+		op, err := msg.child(1)
+		if err != nil {
+			return nil, err
+		}
+		if id.int() == 0 && op.tag == opExtendedResponse {
+			return nil, errors.New("LDAP server terminated the connection")
+		}
+		if id.int() != c.msgID {
+			continue // stale
+		}
+		if op.class != classApplication {
+			return nil, fmt.Errorf("%w: unexpected protocol op class %#x", errMalformed, op.class)
+		}
+		return op, nil
+	}
+}
+
+// LDAPResult ::= SEQUENCE { resultCode ENUMERATED, matchedDN, diagnosticMessage, ... }
+func ldapResult(op *packet) error {
+	code, err := op.child(0)
+	if err != nil {
+		return err
+	}
+	if code.int() == ResultSuccess {
+		return nil
+	}
+	e := &Error{Code: code.int()}
+	if msg, err := op.child(2); err == nil {
+		e.Msg = msg.str()
+	}
+	return e
+}
+
+// SearchResultEntry ::= SEQUENCE { objectName, attributes SEQUENCE OF { type, vals SET OF value } }
+func parseEntry(op *packet) (*Entry, error) {
+	dn, err := op.child(0)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := op.child(1)
+	if err != nil {
+		return nil, err
+	}
+	e := &Entry{DN: dn.str(), Attrs: make(map[string][]string, len(attrs.children))}
+	for _, attr := range attrs.children {
+		typ, err := attr.child(0)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := attr.child(1)
+		if err != nil {
+			return nil, err
+		}
+		name := strings.ToLower(typ.str())
+		for _, v := range vals.children {
+			e.Attrs[name] = append(e.Attrs[name], v.str())
+		}
+	}
+	return e, nil
+}
@@ -0,0 +1,143 @@
+// Package ldap provides LDAP (and Active Directory) identity provider for AuthN
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ldap
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Search filters (RFC 4515): supported are and (&), or (|), not (!),
+// equality (attr=value), and presence (attr=*) - enough for user and group lookups,
+// e.g. "(&(objectClass=person)(sAMAccountName=%s))"
+
+// filter choice tags (RFC 4511, section 4.5.1)
+const (
+	filterAnd      = 0
+	filterOr       = 1
+	filterNot      = 2
+	filterEquality = 3
+	filterPresent  = 7
+)
+
+// EscapeFilter escapes special characters of a value that goes into search filter
+func EscapeFilter(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i := range len(s) {
+		c := s[i]
+		switch c {
+		case '*', '(', ')', '\\', 0:
+			sb.WriteByte('\\')
+			sb.WriteString(hex.EncodeToString([]byte{c}))
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+func compileFilter(s string) (*packet, error) {
+	p, rest, err := _compile(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LDAP filter %q: %w", s, err)
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("invalid LDAP filter %q: unexpected trailing %q", s, rest)
+	}
+	return p, nil
+}
+
+// compile one parenthesized filter; return the remainder
+func _compile(s string) (*packet, string, error) {
+	if s == "" || s[0] != '(' {
+		return nil, "", fmt.Errorf("expecting '(' at %q", s)
+	}
+	s = s[1:]
+	if s == "" {
+		return nil, "", errors.New("unexpected end")
+	}
+	switch s[0] {
+	case '&', '|', '!':
+		tag := byte(filterAnd)
+		if s[0] == '|' {
+			tag = filterOr
+		} else if s[0] == '!' {
+			tag = filterNot
+		}
+		var (
+			p    = newSeq(tag, classContext)
+			rest = s[1:]
+		)
+		for rest != "" && rest[0] == '(' {
+			c, r, err := _compile(rest)
+			if err != nil {
+				return nil, "", err
+			}
+			p.append(c)
+			rest = r
+		}
+		if rest == "" || rest[0] != ')' {
+			return nil, "", fmt.Errorf("expecting ')' at %q", rest)
+		}
+		if len(p.children) == 0 || (tag == filterNot && len(p.children) != 1) {
+			return nil, "", fmt.Errorf("invalid number of operands in %q", s)
+		}
+		return p, rest[1:], nil
+	}
+
+	end := strings.IndexByte(s, ')')
+	if end < 0 {
+		return nil, "", fmt.Errorf("expecting ')' at %q", s)
+	}
+	attr, value, ok := strings.Cut(s[:end], "=")
+	if !ok || attr == "" {
+		return nil, "", fmt.Errorf("invalid item %q", s[:end])
+	}
+	switch attr[len(attr)-1] {
+	case '~', '<', '>', ':':
+		return nil, "", fmt.Errorf("unsupported filter type in %q", s[:end])
+	}
+	if value == "*" {
+		return newStr(filterPresent, classContext, attr), s[end+1:], nil
+	}
+	if strings.IndexByte(value, '*') >= 0 {
+		return nil, "", fmt.Errorf("substring filters are not supported: %q", s[:end])
+	}
+	v, err := unescapeFilter(value)
+	if err != nil {
+		return nil, "", err
+	}
+	p := newSeq(filterEquality, classContext,
+		newStr(tagOctetString, classUniversal, attr),
+		newStr(tagOctetString, classUniversal, v),
+	)
+	return p, s[end+1:], nil
+}
+
+func unescapeFilter(s string) (string, error) {
+	if strings.IndexByte(s, '\\') < 0 {
+		return s, nil
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			sb.WriteByte(s[i])
+			continue
+		}
+		if i+3 > len(s) {
+			return "", fmt.Errorf("invalid escape sequence in %q", s)
+		}
+		b, err := hex.DecodeString(s[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("invalid escape sequence in %q", s)
+		}
+		sb.WriteByte(b[0])
+		i += 2
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,413 @@
+// Package ldap provides LDAP (and Active Directory) identity provider for AuthN
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ldap
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+//
+// stub LDAP server: simple bind and (subtree) search over in-memory entries
+//
+
+type (
+	stubEntry struct {
+		attrs    map[string][]string // lowercase names
+		dn       string
+		password string
+	}
+	stubServer struct {
+		ln       net.Listener
+		tls      *tls.Config // when set, requires StartTLS prior to bind
+		entries  []*stubEntry
+		searches atomic.Int32
+		mu       sync.Mutex
+	}
+)
+
+func newStubServer(t *testing.T, entries ...*stubEntry) *stubServer {
+	return newStubServerTLS(t, nil, entries...)
+}
+
+func newStubServerTLS(t *testing.T, tlsConf *tls.Config, entries ...*stubEntry) *stubServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	tassert.CheckFatal(t, err)
+	s := &stubServer{ln: ln, tls: tlsConf, entries: entries}
+	go s.serve()
+	t.Cleanup(func() { ln.Close() })
+	return s
+}
+
+func (s *stubServer) url() string { return "ldap://" + s.ln.Addr().String() }
+
+func (s *stubServer) serve() {
+	for {
+		nc, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(nc)
+	}
+}
+
+func (s *stubServer) handle(nc net.Conn) {
+	defer nc.Close()
+	var (
+		r        = bufio.NewReader(nc)
+		upgraded bool
+	)
+	for {
+		msg, err := readPacket(r)
+		if err != nil {
+			return
+		}
+		id, op := msg.children[0], msg.children[1]
+		s.mu.Lock()
+		reply := func(resp *packet) {
+			nc.Write(newSeq(tagSequence, classUniversal, newInt(tagInteger, id.int()), resp).bytes())
+		}
+		switch op.tag {
+		case opExtendedRequest:
+			if s.tls == nil || upgraded || op.children[0].str() != oidStartTLS {
+				reply(stubResult(opExtendedResponse, resultProtocolError))
+				break
+			}
+			reply(stubResult(opExtendedResponse, ResultSuccess))
+			tc := tls.Server(nc, s.tls)
+			if tc.Handshake() != nil {
+				s.mu.Unlock()
+				return
+			}
+			nc, r, upgraded = tc, bufio.NewReader(tc), true
+		case opBindRequest:
+			if s.tls != nil && !upgraded {
+				reply(stubResult(opBindResponse, resultConfidentialityRequired))
+				break
+			}
+			dn, pwd := op.children[1].str(), op.children[2].str()
+			code := int64(ResultInvalidCredentials)
+			if dn == "" {
+				code = ResultSuccess // anonymous
+			}
+			for _, e := range s.entries {
+				if strings.EqualFold(e.dn, dn) && e.password != "" && e.password == pwd {
+					code = ResultSuccess
+				}
+			}
+			reply(stubResult(opBindResponse, code))
+		case opSearchRequest:
+			s.searches.Add(1)
+			base, filter := strings.ToLower(op.children[0].str()), op.children[6]
+			for _, e := range s.entries {
+				if !strings.HasSuffix(strings.ToLower(e.dn), base) || !stubMatch(filter, e) {
+					continue
+				}
+				attrs := newSeq(tagSequence, classUniversal)
+				for _, a := range op.children[7].children {
+					name := strings.ToLower(a.str())
+					if vals, ok := e.attrs[name]; ok {
+						set := newSeq(tagSet, classUniversal)
+						for _, v := range vals {
+							set.append(newStr(tagOctetString, classUniversal, v))
+						}
+						attrs.append(newSeq(tagSequence, classUniversal, newStr(tagOctetString, classUniversal, a.str()), set))
+					}
+				}
+				reply(newSeq(opSearchResEntry, classApplication, newStr(tagOctetString, classUniversal, e.dn), attrs))
+			}
+			reply(stubResult(opSearchResDone, ResultSuccess))
+		case opUnbindRequest:
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+	}
+}
+
+const (
+	resultProtocolError           = 2
+	resultConfidentialityRequired = 13
+)
+
+func stubResult(tag byte, code int64) *packet {
+	return newSeq(tag, classApplication,
+		newInt(tagEnumerated, code),
+		newStr(tagOctetString, classUniversal, ""),
+		newStr(tagOctetString, classUniversal, ""),
+	)
+}
+
+func stubMatch(f *packet, e *stubEntry) bool {
+	switch f.tag {
+	case filterAnd:
+		for _, c := range f.children {
+			if !stubMatch(c, e) {
+				return false
+			}
+		}
+		return true
+	case filterOr:
+		for _, c := range f.children {
+			if stubMatch(c, e) {
+				return true
+			}
+		}
+		return false
+	case filterNot:
+		return !stubMatch(f.children[0], e)
+	case filterPresent:
+		_, ok := e.attrs[strings.ToLower(f.str())]
+		return ok
+	case filterEquality:
+		for _, v := range e.attrs[strings.ToLower(f.children[0].str())] {
+			if strings.EqualFold(v, f.children[1].str()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//
+// tests
+//
+
+const (
+	svcDN    = "cn=svc,dc=example,dc=com"
+	aliceDN  = "uid=alice,ou=people,dc=example,dc=com"
+	bobDN    = "uid=bob,ou=people,dc=example,dc=com"
+	adminsDN = "cn=Admins,ou=groups,dc=example,dc=com"
+	devsDN   = "cn=devs,ou=groups,dc=example,dc=com"
+)
+
+func testEntries() []*stubEntry {
+	return []*stubEntry{
+		{dn: svcDN, password: "svc-pass"},
+		{
+			dn: aliceDN, password: "alice-pass",
+			attrs: map[string][]string{"uid": {"alice"}, "objectclass": {"person"}, "memberof": {adminsDN, devsDN}},
+		},
+		{
+			dn: bobDN, password: "bob-pass",
+			attrs: map[string][]string{"uid": {"bob"}, "objectclass": {"person"}, "memberof": {devsDN}},
+		},
+		{dn: adminsDN, attrs: map[string][]string{"objectclass": {"groupOfNames"}, "member": {aliceDN}}},
+		{dn: devsDN, attrs: map[string][]string{"objectclass": {"groupOfNames"}, "member": {aliceDN, bobDN}}},
+	}
+}
+
+func testConf(url string) *authn.LDAPConf {
+	conf := &authn.LDAPConf{
+		URL:          url,
+		BindDN:       svcDN,
+		BindPassword: "svc-pass",
+		UserBaseDN:   "ou=people,dc=example,dc=com",
+		UserFilter:   "(&(objectClass=person)(uid=%s))",
+		GroupRoles:   map[string]string{"admins": "ClusterOwner", devsDN: "BucketOwner"},
+		Insecure:     true, // (stub server)
+	}
+	if err := conf.Validate(); err != nil {
+		panic(err)
+	}
+	return conf
+}
+
+func TestAuthenticate(t *testing.T) {
+	srv := newStubServer(t, testEntries()...)
+	p, err := NewProvider(testConf(srv.url()))
+	tassert.CheckFatal(t, err)
+
+	groups, err := p.Authenticate("alice", "alice-pass")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(groups) == 2, "expected 2 groups, got %v", groups)
+	roles := p.Roles(groups)
+	tassert.Errorf(t, len(roles) == 2 && roles[0] == "BucketOwner" && roles[1] == "ClusterOwner",
+		"unexpected roles %v", roles)
+
+	groups, err = p.Authenticate("bob", "bob-pass")
+	tassert.CheckFatal(t, err)
+	roles = p.Roles(groups)
+	tassert.Errorf(t, len(roles) == 1 && roles[0] == "BucketOwner", "unexpected roles %v", roles)
+
+	_, err = p.Authenticate("alice", "bob-pass")
+	tassert.Errorf(t, IsInvalidCredentials(err), "expected invalid credentials, got %v", err)
+	_, err = p.Authenticate("alice", "")
+	tassert.Errorf(t, IsInvalidCredentials(err), "expected invalid credentials, got %v", err)
+	_, err = p.Authenticate("carol", "carol-pass")
+	tassert.Errorf(t, errors.Is(err, ErrUserNotFound), "expected user not found, got %v", err)
+
+	// filter injection
+	_, err = p.Authenticate("*", "alice-pass")
+	tassert.Errorf(t, errors.Is(err, ErrUserNotFound), "expected user not found, got %v", err)
+	_, err = p.Authenticate("alice)(uid=*", "alice-pass")
+	tassert.Errorf(t, errors.Is(err, ErrUserNotFound), "expected user not found, got %v", err)
+}
+
+func TestStartTLS(t *testing.T) {
+	cert, caFile := stubCert(t)
+	srv := newStubServerTLS(t, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, testEntries()...)
+
+	conf := testConf(srv.url())
+	conf.Insecure, conf.StartTLS, conf.CACert = false, true, caFile
+	tassert.CheckFatal(t, conf.Validate())
+	p, err := NewProvider(conf)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !p.Plaintext(), "expected TLS")
+	groups, err := p.Authenticate("alice", "alice-pass")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(groups) == 2, "expected 2 groups, got %v", groups)
+
+	// plaintext bind refused by the server
+	p, err = NewProvider(testConf(srv.url()))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, p.Plaintext(), "expected plaintext")
+	_, err = p.Authenticate("alice", "alice-pass")
+	tassert.Errorf(t, err != nil, "expected plaintext bind to fail")
+
+	// unverified server certificate
+	conf = testConf(srv.url())
+	conf.Insecure, conf.StartTLS = false, true
+	p, err = NewProvider(conf)
+	tassert.CheckFatal(t, err)
+	_, err = p.Authenticate("alice", "alice-pass")
+	tassert.Errorf(t, err != nil, "expected certificate verification to fail")
+}
+
+func TestConfTLS(t *testing.T) {
+	for _, tc := range []struct {
+		url                string
+		startTLS, insecure bool
+		ok                 bool
+	}{
+		{"ldaps://ldap.example.com", false, false, true},
+		{"ldap://ldap.example.com", true, false, true},
+		{"ldap://ldap.example.com", false, true, true},
+		{"ldap://ldap.example.com", false, false, false}, // plaintext must be explicitly allowed
+		{"ldaps://ldap.example.com", true, false, false}, // StartTLS over TLS
+	} {
+		conf := &authn.LDAPConf{
+			URL:        tc.url,
+			StartTLS:   tc.startTLS,
+			Insecure:   tc.insecure,
+			UserBaseDN: "ou=people,dc=example,dc=com",
+			UserFilter: "(uid=%s)",
+			GroupRoles: map[string]string{"admins": "ClusterOwner"},
+		}
+		err := conf.Validate()
+		tassert.Errorf(t, (err == nil) == tc.ok, "%s start_tls=%t insecure=%t: expected ok=%t, got %v",
+			tc.url, tc.startTLS, tc.insecure, tc.ok, err)
+	}
+}
+
+// self-signed server certificate for 127.0.0.1; returns the certificate and its PEM file
+func stubCert(t *testing.T) (tls.Certificate, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ldap-stub"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	tassert.CheckFatal(t, err)
+	fpath := filepath.Join(t.TempDir(), "ca.pem")
+	tassert.CheckFatal(t, os.WriteFile(fpath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, fpath
+}
+
+func TestCache(t *testing.T) {
+	srv := newStubServer(t, testEntries()...)
+	conf := testConf(srv.url())
+	conf.CacheTTL = cos.Duration(time.Hour)
+	p, err := NewProvider(conf)
+	tassert.CheckFatal(t, err)
+
+	for range 3 {
+		_, err := p.Authenticate("alice", "alice-pass")
+		tassert.CheckFatal(t, err)
+	}
+	tassert.Errorf(t, srv.searches.Load() == 1, "expected a single (cached) search, got %d", srv.searches.Load())
+
+	// wrong password is still rejected when cached
+	_, err = p.Authenticate("alice", "wrong")
+	tassert.Errorf(t, IsInvalidCredentials(err), "expected invalid credentials, got %v", err)
+
+	// refresh bypasses the cache and picks up membership changes
+	srv.mu.Lock()
+	srv.entries[1].attrs["memberof"] = []string{devsDN}
+	srv.mu.Unlock()
+	groups, err := p.Groups("alice")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(groups) == 1 && groups[0] == devsDN, "expected %q, got %v", devsDN, groups)
+	tassert.Errorf(t, srv.searches.Load() == 2, "expected 2 searches, got %d", srv.searches.Load())
+	groups, err = p.Authenticate("alice", "alice-pass")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(groups) == 1, "expected refreshed (cached) groups, got %v", groups)
+
+	srv.mu.Lock()
+	srv.entries = append(srv.entries[:1], srv.entries[2:]...) // remove alice
+	srv.mu.Unlock()
+	_, err = p.Groups("alice")
+	tassert.Errorf(t, errors.Is(err, ErrUserNotFound), "expected user not found, got %v", err)
+}
+
+func TestGroupSearch(t *testing.T) {
+	srv := newStubServer(t, testEntries()...)
+	conf := testConf(srv.url())
+	conf.GroupBaseDN = "ou=groups,dc=example,dc=com"
+	conf.GroupFilter = "(&(objectClass=groupOfNames)(member=%s))"
+	p, err := NewProvider(conf)
+	tassert.CheckFatal(t, err)
+
+	groups, err := p.Authenticate("bob", "bob-pass")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(groups) == 1 && groups[0] == devsDN, "expected %q, got %v", devsDN, groups)
+}
+
+func TestFilter(t *testing.T) {
+	valid := []string{
+		"(uid=alice)",
+		"(&(objectClass=person)(uid=a\\2ab))",
+		"(|(cn=x)(!(cn=y)))",
+		"(memberOf=*)",
+	}
+	for _, f := range valid {
+		_, err := compileFilter(f)
+		tassert.Errorf(t, err == nil, "filter %q: %v", f, err)
+	}
+	invalid := []string{"", "uid=alice", "(uid=alice", "(&)", "(!(a=b)(c=d))", "(uid=a*b)", "(uid~=a)", "(uid=a\\zz)", "(a=b))"}
+	for _, f := range invalid {
+		_, err := compileFilter(f)
+		tassert.Errorf(t, err != nil, "filter %q: expected error", f)
+	}
+	tassert.Errorf(t, EscapeFilter("a*(b)\\") == "a\\2a\\28b\\29\\5c", "unexpected %q", EscapeFilter("a*(b)\\"))
+}
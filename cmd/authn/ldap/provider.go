@@ -0,0 +1,216 @@
+// Package ldap provides LDAP (and Active Directory) identity provider for AuthN
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ldap
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+)
+
+// Provider authenticates users against LDAP directory:
+// 1. (service account bind and) search for the user's DN and groups - the result is cached
+// 2. bind as the user with the given password
+// Roles are derived from groups as per authn.LDAPConf.GroupRoles.
+
+const (
+	resultSizeLimitExceeded = 4
+	noAttrs                 = "1.1" // RFC 4511, section 4.5.1.8
+)
+
+type (
+	Provider struct {
+		conf  *authn.LDAPConf
+		tls   *tls.Config
+		roles map[string]string // lowercase group DN or CN => role
+		cache map[string]*cached
+		mu    sync.Mutex
+	}
+	cached struct {
+		ts     time.Time
+		dn     string
+		groups []string
+	}
+)
+
+var (
+	ErrUserNotFound       = errors.New("LDAP user not found")
+	errInvalidCredentials = &Error{Code: ResultInvalidCredentials, Msg: "invalid credentials"}
+)
+
+func NewProvider(conf *authn.LDAPConf) (*Provider, error) {
+	p := &Provider{
+		conf:  conf,
+		roles: make(map[string]string, len(conf.GroupRoles)),
+		cache: make(map[string]*cached, 16),
+	}
+	for group, role := range conf.GroupRoles {
+		p.roles[strings.ToLower(group)] = role
+	}
+	if strings.HasPrefix(conf.URL, "ldaps://") || conf.StartTLS {
+		u, err := url.Parse(conf.URL)
+		if err != nil {
+			return nil, err
+		}
+		p.tls = &tls.Config{ServerName: u.Hostname(), InsecureSkipVerify: conf.SkipVerify} //nolint:gosec // (configurable)
+		if conf.CACert != "" {
+			pem, err := os.ReadFile(conf.CACert)
+			if err != nil {
+				return nil, err
+			}
+			p.tls.RootCAs = x509.NewCertPool()
+			if !p.tls.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("failed to load LDAP CA certificate(s) from %q", conf.CACert)
+			}
+		}
+	}
+	return p, nil
+}
+
+func (p *Provider) String() string { return "LDAP[" + p.conf.URL + "]" }
+
+// plaintext ldap:// without StartTLS (see authn.LDAPConf.Insecure)
+func (p *Provider) Plaintext() bool { return p.tls == nil }
+
+// Authenticate verifies user's credentials and returns the user's groups
+func (p *Provider) Authenticate(uid, password string) ([]string, error) {
+	if uid == "" || password == "" {
+		return nil, errInvalidCredentials
+	}
+	c := p.lookupCache(uid)
+	if c == nil {
+		var err error
+		if c, err = p.lookup(uid); err != nil {
+			return nil, err
+		}
+	}
+	conn, err := p.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.close()
+	if err := conn.bind(c.dn, password); err != nil {
+		return nil, err
+	}
+	return c.groups, nil
+}
+
+// Groups (re)reads the user's groups from the directory, bypassing (and updating) the cache;
+// returns ErrUserNotFound if the user does not exist anymore
+func (p *Provider) Groups(uid string) ([]string, error) {
+	c, err := p.lookup(uid)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			p.mu.Lock()
+			delete(p.cache, uid)
+			p.mu.Unlock()
+		}
+		return nil, err
+	}
+	return c.groups, nil
+}
+
+// Roles maps groups to AuthN roles (sorted, no duplicates)
+func (p *Provider) Roles(groups []string) []string {
+	roles := make([]string, 0, 2)
+	for _, group := range groups {
+		group = strings.ToLower(group)
+		role, ok := p.roles[group]
+		if !ok {
+			role, ok = p.roles[groupCN(group)]
+		}
+		if ok && !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	slices.Sort(roles)
+	return roles
+}
+
+// "cn=admins,ou=groups,dc=example,dc=com" => "admins"
+func groupCN(dn string) string {
+	rdn, _, _ := strings.Cut(dn, ",")
+	if typ, val, ok := strings.Cut(rdn, "="); ok && strings.TrimSpace(typ) == "cn" {
+		return strings.TrimSpace(val)
+	}
+	return ""
+}
+
+func (p *Provider) lookupCache(uid string) *cached {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	c, ok := p.cache[uid]
+	if !ok {
+		return nil
+	}
+	if time.Since(c.ts) > time.Duration(p.conf.CacheTTL) {
+		delete(p.cache, uid)
+		return nil
+	}
+	return c
+}
+
+// search for the user and their groups
+func (p *Provider) lookup(uid string) (*cached, error) {
+	conn, err := p.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.close()
+	if p.conf.BindDN != "" {
+		if err := conn.bind(p.conf.BindDN, p.conf.BindPassword); err != nil {
+			return nil, fmt.Errorf("%s: service account bind failed: %w", p, err)
+		}
+	}
+
+	var (
+		attrs  = []string{p.conf.GroupAttr}
+		filter = strings.Replace(p.conf.UserFilter, "%s", EscapeFilter(uid), 1)
+	)
+	if p.conf.GroupFilter != "" {
+		attrs = []string{noAttrs}
+	}
+	entries, err := conn.search(p.conf.UserBaseDN, filter, attrs, 2)
+	switch {
+	case len(entries) > 1:
+		return nil, fmt.Errorf("%s: user %q is ambiguous (%q matches multiple entries)", p, uid, filter)
+	case err != nil:
+		return nil, err
+	case len(entries) == 0:
+		return nil, ErrUserNotFound
+	}
+
+	c := &cached{dn: entries[0].DN, ts: time.Now()}
+	if p.conf.GroupFilter == "" {
+		c.groups = entries[0].Attrs[strings.ToLower(p.conf.GroupAttr)]
+	} else {
+		filter = strings.Replace(p.conf.GroupFilter, "%s", EscapeFilter(c.dn), 1)
+		groups, err := conn.search(p.conf.GroupBaseDN, filter, []string{noAttrs}, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range groups {
+			c.groups = append(c.groups, g.DN)
+		}
+	}
+
+	p.mu.Lock()
+	p.cache[uid] = c
+	p.mu.Unlock()
+	return c, nil
+}
+
+func (p *Provider) dial() (*conn, error) {
+	return dial(p.conf.URL, p.tls, p.conf.StartTLS, time.Duration(p.conf.Timeout))
+}
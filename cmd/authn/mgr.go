@@ -6,17 +6,23 @@ package main
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmd/authn/config"
+	"github.com/NVIDIA/aistore/cmd/authn/ldap"
 	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -28,16 +34,38 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-type mgr struct {
-	clientH   *http.Client
-	clientTLS *http.Client
-	db        kvdb.Driver
-	cm        *config.ConfManager
-	tkParser  *tok.TokenParser
-}
+type (
+	mgr struct {
+		clientH   *http.Client
+		clientTLS *http.Client
+		db        kvdb.Driver
+		cm        *config.ConfManager
+		tkParser  *tok.TokenParser
+		idp       idp        // optional
+		seal      []byte     // to seal S3 secrets at rest (see cos.Seal)
+		sessMu    sync.Mutex // serializes all session updates (open, refresh, terminate)
+	}
+	// (persistent) S3 access key record: the secret is sealed
+	s3KeyRec struct {
+		authn.S3Key
+		Sealed []byte `json:"sealed_secret,omitempty"`
+	}
+	// (persistent) session record
+	session struct {
+		authn.Session
+		RefreshHash string `json:"refresh_hash"`
+	}
+	// external identity provider (see ldap.Provider)
+	idp interface {
+		Authenticate(uid, password string) (groups []string, err error)
+		Groups(uid string) ([]string, error)
+		Roles(groups []string) []string
+	}
+)
 
 var (
 	errInvalidCredentials = errors.New("invalid credentials")
+	errInvalidRefresh     = errors.New("invalid refresh token")
 
 	predefinedRoles = []struct {
 		prefix string
@@ -67,6 +95,25 @@ func newMgr(cm *config.ConfManager, driver kvdb.Driver) (m *mgr, code int, err e
 	}
 	// Create a limited token parser with no issuer lookup
 	m.tkParser = tok.NewTokenParser(&cmn.AuthConf{Signature: sigConf}, nil)
+
+	if m.seal, err = cos.LoadSealKey(cmn.SealKeyPath(cm.GetConfDir())); err != nil {
+		return
+	}
+	m.sealS3Keys()
+	go m.refreshPushed()
+
+	if conf := cm.GetConf().LDAP; conf != nil {
+		var provider *ldap.Provider
+		if provider, err = ldap.NewProvider(conf); err != nil {
+			return
+		}
+		m.idp = provider
+		nlog.Infoln("external identity provider:", provider.String())
+		if provider.Plaintext() {
+			nlog.Warningln("WARNING:", provider.String(), "is plaintext (ldap.insecure) - user passwords are sent in the clear")
+		}
+		go m.refreshLDAPUsers(conf.RefreshInterval.D())
+	}
 	return
 }
 
@@ -99,7 +146,13 @@ func (m *mgr) delUser(userID string) (int, error) {
 	if userID == adminUserID {
 		return http.StatusForbidden, fmt.Errorf("cannot remove built-in %q account", adminUserID)
 	}
-	return m.db.Delete(usersCollection, userID)
+	code, err := m.db.Delete(usersCollection, userID)
+	if err == nil {
+		m.delUserS3Keys(userID)
+		m.delUserCerts(userID)
+		m.delUserSessions(userID)
+	}
+	return code, err
 }
 
 // Updates an existing user. The function invalidates user tokens after
@@ -113,6 +166,10 @@ func (m *mgr) updateUser(userID string, updateReq *authn.User) (int, error) {
 	if userID == adminUserID && len(updateReq.Roles) != 0 {
 		return http.StatusForbidden, errors.New("cannot change administrator's role")
 	}
+	if uInfo.Source != "" {
+		return http.StatusForbidden, fmt.Errorf("cannot update user %q: password and roles are managed by %s",
+			userID, uInfo.Source)
+	}
 
 	if updateReq.Password != "" {
 		uInfo.Password = encryptPassword(updateReq.Password)
@@ -120,7 +177,11 @@ func (m *mgr) updateUser(userID string, updateReq *authn.User) (int, error) {
 	if len(updateReq.Roles) != 0 {
 		uInfo.Roles = updateReq.Roles
 	}
-	return m.db.Set(usersCollection, userID, uInfo)
+	code, err = m.db.Set(usersCollection, userID, uInfo)
+	if err == nil {
+		go m.broadcastPushed()
+	}
+	return code, err
 }
 
 func (m *mgr) lookupUser(userID string) (*authn.User, int, error) {
@@ -163,6 +224,14 @@ func (m *mgr) addRole(info *authn.Role) (int, error) {
 	if info.IsAdmin {
 		return http.StatusForbidden, fmt.Errorf("only built-in roles can have %q permissions", adminUserID)
 	}
+	if info.Namespace != "" {
+		if err := cos.CheckAlphaPlus(info.Namespace, "role namespace"); err != nil {
+			return http.StatusBadRequest, err
+		}
+	}
+	if err := validatePrefixACLs(info.BucketACLs); err != nil {
+		return http.StatusBadRequest, err
+	}
 	_, _, err := m.db.GetString(rolesCollection, info.Name)
 	if err == nil {
 		return http.StatusConflict, cos.NewErrAlreadyExists(m, "role "+info.Name)
@@ -175,7 +244,11 @@ func (m *mgr) delRole(role string) (int, error) {
 	if role == authn.AdminRole {
 		return http.StatusForbidden, fmt.Errorf("cannot remove built-in %q role", authn.AdminRole)
 	}
-	return m.db.Delete(rolesCollection, role)
+	code, err := m.db.Delete(rolesCollection, role)
+	if err == nil {
+		go m.broadcastPushed()
+	}
+	return code, err
 }
 
 // Updates an existing role
@@ -192,10 +265,23 @@ func (m *mgr) updateRole(role string, updateReq *authn.Role) (int, error) {
 	if updateReq.Description != "" {
 		rInfo.Description = updateReq.Description
 	}
+	if updateReq.Namespace != "" {
+		if err := cos.CheckAlphaPlus(updateReq.Namespace, "role namespace"); err != nil {
+			return http.StatusBadRequest, err
+		}
+		rInfo.Namespace = updateReq.Namespace
+	}
+	if err := validatePrefixACLs(updateReq.BucketACLs); err != nil {
+		return http.StatusBadRequest, err
+	}
 	rInfo.ClusterACLs = mergeClusterACLs(rInfo.ClusterACLs, updateReq.ClusterACLs, "")
 	rInfo.BucketACLs = mergeBckACLs(rInfo.BucketACLs, updateReq.BucketACLs, "")
 
-	return m.db.Set(rolesCollection, role, rInfo)
+	code, err = m.db.Set(rolesCollection, role, rInfo)
+	if err == nil {
+		go m.broadcastPushed()
+	}
+	return code, err
 }
 
 func (m *mgr) lookupRole(roleID string) (*authn.Role, int, error) {
@@ -327,6 +413,7 @@ func (m *mgr) addCluster(ctx context.Context, clu *authn.CluACL) (int, error) {
 	m.createRolesForCluster(clu)
 
 	go m.syncTokenList(ctx, clu)
+	go m.syncPushed(clu)
 	return http.StatusOK, nil
 }
 
@@ -374,64 +461,101 @@ func (m *mgr) delCluster(cluID string) (int, error) {
 // tokens ============================================================
 //
 
-// Generates a token for a user if user credentials are valid. If the token is
-// already generated and is not expired yet the existing token is returned.
-// AISClaims includes user ID, permissions, and token expiration time.
-// If a new token was generated then it sends the proxy a new valid token list
-func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (token string, code int, err error) {
+// Authenticates the user and opens a new login session (see newSession).
+// AISClaims includes user ID, permissions, session ID, and token expiration time.
+func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (tmsg *authn.TokenMsg, code int, err error) {
+	uInfo := &authn.User{}
+	_, err = m.db.Get(usersCollection, uid, uInfo)
+	switch {
+	case err == nil && uInfo.Source == "":
+		// local user
+		debug.Assert(uid == uInfo.ID, uid, " vs ", uInfo.ID)
+		if !isSamePassword(pwd, uInfo.Password) {
+			return nil, http.StatusUnauthorized, errInvalidCredentials
+		}
+	case m.idp != nil && uid != adminUserID && (err != nil || uInfo.Source == authn.UserSourceLDAP):
+		if uInfo, code, err = m.ldapLogin(uid, pwd); err != nil {
+			return nil, code, err
+		}
+	default:
+		if err == nil {
+			err = fmt.Errorf("user %q: identity provider %q is not configured", uid, uInfo.Source)
+		}
+		nlog.Errorln(err)
+		return nil, http.StatusUnauthorized, errInvalidCredentials
+	}
+	return m.newSession(uInfo, msg)
+}
+
+// Generates a token for an (already authenticated) user;
+// session tokens expire at sess.AccessExpires (and carry the session's ID and scope)
+func (m *mgr) userToken(uInfo *authn.User, msg *authn.LoginMsg, sess *authn.Session) (string, int, error) {
 	var (
-		uInfo   = &authn.User{}
 		cid     string
+		ns      string
 		cluACLs []*authn.CluACL
 		bckACLs []*authn.BckACL
 	)
-	_, err = m.db.Get(usersCollection, uid, uInfo)
-	if err != nil {
-		nlog.Errorln(err)
-		return "", http.StatusUnauthorized, errInvalidCredentials
-	}
-
-	debug.Assert(uid == uInfo.ID, uid, " vs ", uInfo.ID)
-
-	if !isSamePassword(pwd, uInfo.Password) {
-		return "", http.StatusUnauthorized, errInvalidCredentials
-	}
-
 	// update ACLs with roles' ones
 	for _, role := range uInfo.Roles {
 		cluACLs = mergeClusterACLs(cluACLs, role.ClusterACLs, cid)
 		bckACLs = mergeBckACLs(bckACLs, role.BucketACLs, cid)
+		if role.Namespace != "" {
+			if ns != "" && ns != role.Namespace {
+				return "", http.StatusConflict, fmt.Errorf("user %q: roles bound to different namespaces (%q, %q)",
+					uInfo.ID, ns, role.Namespace)
+			}
+			ns = role.Namespace
+		}
 	}
 
 	// generate token
-	token, err = m._token(msg, uInfo, cluACLs, bckACLs)
+	token, err := m._token(msg, uInfo, sess, ns, cluACLs, bckACLs)
 	if err != nil {
 		return "", http.StatusInternalServerError, err
 	}
 	return token, http.StatusOK, nil
 }
 
-func (m *mgr) _token(msg *authn.LoginMsg, uInfo *authn.User, cluACLs []*authn.CluACL, bckACLs []*authn.BckACL) (string, error) {
-	expDelta := m.cm.GetExpiry()
-	if msg.ExpiresIn != nil {
-		expDelta = *msg.ExpiresIn
-	}
-	if expDelta == 0 {
-		expDelta = authn.ForeverTokenTime.D()
+func (m *mgr) _token(msg *authn.LoginMsg, uInfo *authn.User, sess *authn.Session, ns string, cluACLs []*authn.CluACL,
+	bckACLs []*authn.BckACL) (string, error) {
+	var expires time.Time
+	if sess != nil {
+		expires = sess.AccessExpires
+	} else {
+		expDelta := m.cm.GetExpiry()
+		if msg.ExpiresIn != nil {
+			expDelta = *msg.ExpiresIn
+		}
+		if expDelta == 0 {
+			expDelta = authn.ForeverTokenTime.D()
+		}
+		expires = time.Now().UTC().Add(expDelta)
 	}
 
 	// put all useful info into token: who owns the token, when it was issued,
 	// when it expires and credentials to log in AWS, GCP etc.
 	// If a user is a super user, it is enough to pass only isAdmin marker
-	expires := time.Now().UTC().Add(expDelta)
-	uid := uInfo.ID
-	// TODO: parse from ACLs and/or LoginMsg
-	aud := ""
+	var (
+		claims *tok.AISClaims
+		uid    = uInfo.ID
+		aud    = "" // TODO: parse from ACLs and/or LoginMsg
+	)
 	if uInfo.IsAdmin() {
-		return m.createTokenWithClaims(tok.AdminClaims(expires, uid, aud))
+		claims = tok.AdminClaims(expires, uid, aud)
+	} else {
+		m.fixClusterIDs(cluACLs)
+		claims = tok.StandardClaims(expires, uid, aud, bckACLs, cluACLs)
+		claims.Namespace = ns
+		for _, role := range uInfo.Roles {
+			claims.Roles = append(claims.Roles, role.Name)
+		}
+	}
+	if sess != nil {
+		claims.SessionID = sess.ID
+		claims.Scope = sess.Scope
 	}
-	m.fixClusterIDs(cluACLs)
-	return m.createTokenWithClaims(tok.StandardClaims(expires, uid, aud, bckACLs, cluACLs))
+	return m.createTokenWithClaims(claims)
 }
 
 func (m *mgr) createTokenWithClaims(c *tok.AISClaims) (string, error) {
@@ -463,9 +587,16 @@ func (m *mgr) fixClusterIDs(lst []*authn.CluACL) {
 	}
 }
 
-// Delete existing token, a.k.a log out
-// If the token was removed successfully then it sends the proxy a new valid token list
-func (m *mgr) revokeToken(token string) (int, error) {
+// Delete existing token, a.k.a log out: terminate the token's session or,
+// for tokens issued without session, send the token to all clusters
+func (m *mgr) revokeToken(token string, claims *tok.AISClaims) (int, error) {
+	if claims.SessionID != "" {
+		code, err := m.delSession(claims.SessionID)
+		if cos.IsNotExist(err) {
+			return http.StatusOK, nil // already terminated or expired
+		}
+		return code, err
+	}
 	code, err := m.db.Set(revokedCollection, token, "!")
 	if err != nil {
 		return code, err
@@ -502,6 +633,679 @@ func (m *mgr) generateRevokedTokenList(ctx context.Context) ([]string, int, erro
 	return revokeList, http.StatusOK, nil
 }
 
+//
+// sessions ============================================================
+//
+
+// Opens a new login session for an (already authenticated) user: the session expires
+// as per LoginMsg.ExpiresIn (or configured auth.expiration_time), while its access tokens
+// may be short-lived (auth.access_token_ttl) and get renewed with the refresh token
+func (m *mgr) newSession(uInfo *authn.User, msg *authn.LoginMsg) (*authn.TokenMsg, int, error) {
+	if err := validateScope(msg.Scope); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	var (
+		ttl  = m.cm.GetExpiry()
+		now  = time.Now().UTC()
+		sess = &session{Session: authn.Session{
+			ID:      cos.CryptoRandS(sessionIDLen),
+			UserID:  uInfo.ID,
+			Created: now,
+			Scope:   msg.Scope,
+		}}
+	)
+	if msg.ExpiresIn != nil {
+		ttl = *msg.ExpiresIn
+	}
+	if ttl != 0 {
+		sess.Expires = now.Add(ttl)
+	}
+	m.sessMu.Lock()
+	defer m.sessMu.Unlock()
+	return m._sessionToken(uInfo, sess, now)
+}
+
+// (under sessMu) issues a new access token and (re)generates the refresh token;
+// an existing session gets persisted only if it has not been terminated in the meantime
+func (m *mgr) _sessionToken(uInfo *authn.User, sess *session, now time.Time) (*authn.TokenMsg, int, error) {
+	if sess.RefreshHash != "" {
+		if _, _, err := m.lookupSession(sess.ID); err != nil {
+			return nil, http.StatusUnauthorized, errInvalidRefresh
+		}
+	}
+	sess.AccessExpires = sess.Expires
+	if sess.AccessExpires.IsZero() {
+		sess.AccessExpires = now.Add(authn.ForeverTokenTime.D())
+	}
+	if ttl := m.cm.GetAccessTTL(); ttl > 0 && now.Add(ttl).Before(sess.AccessExpires) {
+		sess.AccessExpires = now.Add(ttl)
+	}
+	token, code, err := m.userToken(uInfo, nil, &sess.Session)
+	if err != nil {
+		return nil, code, err
+	}
+	secret := cos.CryptoRandS(refreshSecretLen)
+	sess.RefreshHash = hashRefresh(secret)
+	if code, err := m.db.Set(sessionsCollection, sess.ID, sess); err != nil {
+		return nil, code, err
+	}
+	tmsg := &authn.TokenMsg{
+		Token:        token,
+		RefreshToken: sess.ID + refreshSepa + secret,
+		SessionID:    sess.ID,
+		ExpiresIn:    int64(sess.AccessExpires.Sub(now) / time.Second),
+	}
+	return tmsg, http.StatusOK, nil
+}
+
+// Exchanges refresh token for a new access token and a new refresh token (the user's current roles apply).
+// Reusing an already exchanged refresh token terminates the session (RFC 9700, section 4.14.2).
+func (m *mgr) refreshToken(refreshToken string) (*authn.TokenMsg, int, error) {
+	sid, secret, ok := strings.Cut(refreshToken, refreshSepa)
+	if !ok || sid == "" || secret == "" {
+		return nil, http.StatusUnauthorized, errInvalidRefresh
+	}
+	m.sessMu.Lock()
+	defer m.sessMu.Unlock()
+
+	sess, _, err := m.lookupSession(sid)
+	if err != nil {
+		return nil, http.StatusUnauthorized, errInvalidRefresh
+	}
+	now := time.Now().UTC()
+	if !sess.Expires.IsZero() && now.After(sess.Expires) {
+		// (all the session's tokens have expired - nothing to revoke)
+		if _, err := m.db.Delete(sessionsCollection, sid); err != nil {
+			nlog.Errorln("failed to delete expired session", sid+":", err)
+		}
+		return nil, http.StatusUnauthorized, fmt.Errorf("session %q has expired", sid)
+	}
+	if !hmac.Equal([]byte(hashRefresh(secret)), []byte(sess.RefreshHash)) {
+		nlog.Warningln("refresh token reuse: terminating session", sid, "of user", sess.UserID)
+		m._terminateSessions(sess)
+		return nil, http.StatusUnauthorized, errInvalidRefresh
+	}
+	uInfo, _, err := m.lookupUser(sess.UserID)
+	if err != nil {
+		m._terminateSessions(sess)
+		return nil, http.StatusUnauthorized, errInvalidRefresh
+	}
+	sess.Refreshed = now
+	return m._sessionToken(uInfo, sess, now)
+}
+
+func (m *mgr) lookupSession(sid string) (*session, int, error) {
+	sess := &session{}
+	code, err := m.db.Get(sessionsCollection, sid, sess)
+	if err != nil {
+		return nil, code, err
+	}
+	return sess, http.StatusOK, nil
+}
+
+// Returns active sessions of a given user or, if empty, all users (expired sessions are removed)
+func (m *mgr) sessionList(userID string) ([]*session, int, error) {
+	recs, code, err := m.db.GetAll(sessionsCollection, "")
+	if err != nil {
+		return nil, code, err
+	}
+	var (
+		now  = time.Now()
+		list = make([]*session, 0, len(recs))
+	)
+	for sid, str := range recs {
+		sess := &session{}
+		if err := jsoniter.Unmarshal([]byte(str), sess); err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		if !sess.Expires.IsZero() && now.After(sess.Expires) {
+			if _, err := m.db.Delete(sessionsCollection, sid); err != nil {
+				nlog.Errorln("failed to delete expired session", sid+":", err)
+			}
+			continue
+		}
+		if userID == "" || sess.UserID == userID {
+			list = append(list, sess)
+		}
+	}
+	return list, http.StatusOK, nil
+}
+
+func (m *mgr) delSession(sid string) (int, error) {
+	m.sessMu.Lock()
+	defer m.sessMu.Unlock()
+	sess, code, err := m.lookupSession(sid)
+	if err != nil {
+		return code, err
+	}
+	m._terminateSessions(sess)
+	return http.StatusOK, nil
+}
+
+// Terminates all sessions of a (deleted) user
+func (m *mgr) delUserSessions(userID string) {
+	m.sessMu.Lock()
+	defer m.sessMu.Unlock()
+	list, _, err := m.sessionList(userID)
+	if err != nil {
+		nlog.Errorln("failed to list sessions of user", userID+":", err)
+		return
+	}
+	m._terminateSessions(list...)
+}
+
+// (under sessMu) removes the sessions and revokes their (not yet expired) access tokens on all clusters;
+// revoked session IDs are kept (and pushed to clusters) only until the respective tokens expire
+func (m *mgr) _terminateSessions(list ...*session) {
+	var (
+		now     = time.Now()
+		revoked = make(map[string]int64, len(list))
+	)
+	for _, sess := range list {
+		if _, err := m.db.Delete(sessionsCollection, sess.ID); err != nil {
+			nlog.Errorln("failed to delete session", sess.ID+":", err)
+			continue
+		}
+		if !now.Before(sess.AccessExpires) {
+			continue
+		}
+		exp := sess.AccessExpires.Unix()
+		if _, err := m.db.Set(revokedSessions, sess.ID, exp); err != nil {
+			nlog.Errorln("failed to revoke session", sess.ID+":", err)
+		}
+		revoked[sess.ID] = exp
+	}
+	if len(revoked) > 0 {
+		go m.broadcastRevokedSessions(revoked)
+	}
+}
+
+// Returns terminated sessions whose tokens have not expired yet (and removes the rest)
+func (m *mgr) revokedSessionList() (map[string]int64, int, error) {
+	recs, code, err := m.db.GetAll(revokedSessions, "")
+	if err != nil {
+		return nil, code, err
+	}
+	var (
+		now     = time.Now().Unix()
+		revoked = make(map[string]int64, len(recs))
+	)
+	for sid, str := range recs {
+		exp, err := strconv.ParseInt(str, 10, 64)
+		if err != nil || exp <= now {
+			if _, err := m.db.Delete(revokedSessions, sid); err != nil {
+				nlog.Errorln("failed to delete revoked session", sid+":", err)
+			}
+			continue
+		}
+		revoked[sid] = exp
+	}
+	return revoked, http.StatusOK, nil
+}
+
+// scope must list buckets with bucket-level permissions only
+func validateScope(scope []*authn.BckACL) error {
+	for _, b := range scope {
+		if b.Bck.Name == "" {
+			return errors.New("invalid token scope: missing bucket name")
+		}
+		if b.Access == 0 || b.Access&^(apc.AccessRW|apc.AccessBucketAdmin) != 0 {
+			return fmt.Errorf("invalid token scope: bucket %s: expecting bucket-level permissions, got %q",
+				b.Bck.String(), b.Access.Describe(false))
+		}
+		if b.Bck.Provider == "" {
+			b.Bck.Provider = apc.AIS
+		}
+	}
+	return nil
+}
+
+func hashRefresh(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+//
+// S3 access keys ============================================================
+//
+
+// Issues a new S3 access key for an existing user
+func (m *mgr) addS3Key(userID string) (*authn.S3Key, int, error) {
+	if _, code, err := m.lookupUser(userID); err != nil {
+		return nil, code, err
+	}
+	key := &authn.S3Key{
+		Created:     time.Now(),
+		AccessKeyID: s3KeyPrefix + strings.ToUpper(cos.CryptoRandS(s3KeyIDLen-len(s3KeyPrefix))),
+		Secret:      cos.CryptoRandS(s3SecretLen),
+		UserID:      userID,
+	}
+	if code, err := m.putS3Key(key); err != nil {
+		return nil, code, err
+	}
+	go m.broadcastS3Keys()
+	return key, http.StatusOK, nil
+}
+
+// (secret is sealed at rest)
+func (m *mgr) putS3Key(key *authn.S3Key) (int, error) {
+	sealed, err := cos.Seal(m.seal, []byte(key.Secret))
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	rec := &s3KeyRec{S3Key: *key, Sealed: sealed}
+	rec.Secret, rec.Token = "", ""
+	return m.db.Set(s3KeysCollection, key.AccessKeyID, rec)
+}
+
+func (m *mgr) unsealS3Key(rec *s3KeyRec) (*authn.S3Key, error) {
+	if len(rec.Sealed) > 0 {
+		secret, err := cos.Unseal(m.seal, rec.Sealed)
+		if err != nil {
+			return nil, fmt.Errorf("S3 access key %q: failed to unseal secret: %v", rec.AccessKeyID, err)
+		}
+		rec.Secret = string(secret)
+	}
+	return &rec.S3Key, nil
+}
+
+func (m *mgr) lookupS3Key(akid string) (*authn.S3Key, int, error) {
+	rec := &s3KeyRec{}
+	code, err := m.db.Get(s3KeysCollection, akid, rec)
+	if err != nil {
+		return nil, code, err
+	}
+	key, err := m.unsealS3Key(rec)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return key, http.StatusOK, nil
+}
+
+func (m *mgr) delS3Key(akid string) (int, error) {
+	if code, err := m.db.Delete(s3KeysCollection, akid); err != nil {
+		return code, err
+	}
+	go m.broadcastS3Keys()
+	return http.StatusOK, nil
+}
+
+// Returns S3 access keys of a given user or, if empty, all users (secrets included)
+func (m *mgr) s3KeyList(userID string) ([]*authn.S3Key, int, error) {
+	recs, code, err := m.db.GetAll(s3KeysCollection, "")
+	if err != nil {
+		return nil, code, err
+	}
+	keys := make([]*authn.S3Key, 0, len(recs))
+	for _, str := range recs {
+		rec := &s3KeyRec{}
+		if err := jsoniter.Unmarshal([]byte(str), rec); err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		if userID != "" && rec.UserID != userID {
+			continue
+		}
+		key, err := m.unsealS3Key(rec)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, http.StatusOK, nil
+}
+
+// Seals S3 secrets stored (in plaintext) by prior versions
+func (m *mgr) sealS3Keys() {
+	recs, _, err := m.db.GetAll(s3KeysCollection, "")
+	if err != nil {
+		return
+	}
+	for _, str := range recs {
+		rec := &s3KeyRec{}
+		if err := jsoniter.Unmarshal([]byte(str), rec); err != nil || len(rec.Sealed) > 0 || rec.Secret == "" {
+			continue
+		}
+		if _, err := m.putS3Key(&rec.S3Key); err != nil {
+			nlog.Errorf("failed to seal S3 access key %q: %v", rec.AccessKeyID, err)
+		}
+	}
+}
+
+// Removes all S3 access keys of a (deleted) user
+func (m *mgr) delUserS3Keys(userID string) {
+	keys, _, err := m.s3KeyList(userID)
+	if err != nil || len(keys) == 0 {
+		return
+	}
+	for _, key := range keys {
+		if _, err := m.db.Delete(s3KeysCollection, key.AccessKeyID); err != nil {
+			nlog.Errorf("failed to delete S3 access key %q (user %q): %v", key.AccessKeyID, userID, err)
+		}
+	}
+	go m.broadcastS3Keys()
+}
+
+// Generates the list of all S3 access keys for AIS clusters:
+// each key comes with an (expiring, see pushTTL) token on behalf of its user
+// so that AIS proxies can check the signer's permissions
+func (m *mgr) genS3KeyList() (*authn.S3KeyList, error) {
+	keys, _, err := m.s3KeyList("")
+	if err != nil {
+		return nil, err
+	}
+	var (
+		ttl   = m.pushTTL()
+		msg   = &authn.LoginMsg{ExpiresIn: &ttl}
+		users = make(map[string]string, len(keys)) // user => token
+		list  = &authn.S3KeyList{Keys: make([]*authn.S3Key, 0, len(keys)), Version: time.Now().UnixNano()}
+	)
+	for _, key := range keys {
+		token, ok := users[key.UserID]
+		if !ok {
+			uInfo, err := m.currentUser(key.UserID)
+			if err != nil {
+				nlog.Errorf("S3 access key %q: %v", key.AccessKeyID, err)
+				continue
+			}
+			if token, _, err = m.userToken(uInfo, msg, nil); err != nil {
+				nlog.Errorf("S3 access key %q: %v", key.AccessKeyID, err)
+				continue
+			}
+			users[key.UserID] = token
+		}
+		key.Token = token
+		list.Keys = append(list.Keys, key)
+	}
+	return list, nil
+}
+
+// lifetime of the tokens pushed along with S3 access keys and certificate identities
+func (m *mgr) pushTTL() time.Duration {
+	if ttl := m.cm.GetAccessTTL(); ttl > 0 {
+		return ttl
+	}
+	return s3TokenTTL
+}
+
+// Periodically re-pushes S3 access keys and certificate identities
+// so that the respective tokens get refreshed prior to expiration
+func (m *mgr) refreshPushed() {
+	for {
+		time.Sleep(m.pushTTL() / 2)
+		if keys, _, err := m.db.List(s3KeysCollection, ""); err == nil && len(keys) > 0 {
+			m.broadcastS3Keys()
+		}
+		if certs, _, err := m.db.List(certsCollection, ""); err == nil && len(certs) > 0 {
+			m.broadcastCerts()
+		}
+	}
+}
+
+// The user with the roles' current permissions (rather than those
+// copied into the user's record when the roles were assigned)
+func (m *mgr) currentUser(userID string) (*authn.User, error) {
+	uInfo, _, err := m.lookupUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]*authn.Role, 0, len(uInfo.Roles))
+	for _, r := range uInfo.Roles {
+		role, _, err := m.lookupRole(r.Name)
+		if err != nil {
+			nlog.Warningf("user %q: role %q: %v", userID, r.Name, err)
+			continue
+		}
+		roles = append(roles, role)
+	}
+	uInfo.Roles = roles
+	return uInfo, nil
+}
+
+//
+// client certificate (mTLS) identities ============================================================
+//
+
+// Maps client certificate identity to an existing user or existing roles
+func (m *mgr) addCert(ci *authn.CertIdentity) (int, error) {
+	if err := ci.Validate(); err != nil {
+		return http.StatusBadRequest, err
+	}
+	if ci.UserID != "" {
+		if _, code, err := m.lookupUser(ci.UserID); err != nil {
+			return code, err
+		}
+	}
+	for _, role := range ci.Roles {
+		if _, code, err := m.lookupRole(role); err != nil {
+			return code, err
+		}
+	}
+	if _, _, err := m.db.GetString(certsCollection, ci.Identity); err == nil {
+		return http.StatusConflict, cos.NewErrAlreadyExists(m, "certificate identity "+ci.Identity)
+	}
+	ci.Created = time.Now()
+	ci.Token = ""
+	if code, err := m.db.Set(certsCollection, ci.Identity, ci); err != nil {
+		return code, err
+	}
+	go m.broadcastCerts()
+	return http.StatusOK, nil
+}
+
+func (m *mgr) delCert(identity string) (int, error) {
+	if code, err := m.db.Delete(certsCollection, identity); err != nil {
+		return code, err
+	}
+	go m.broadcastCerts()
+	return http.StatusOK, nil
+}
+
+func (m *mgr) certList() ([]*authn.CertIdentity, int, error) {
+	recs, code, err := m.db.GetAll(certsCollection, "")
+	if err != nil {
+		return nil, code, err
+	}
+	certs := make([]*authn.CertIdentity, 0, len(recs))
+	for _, str := range recs {
+		ci := &authn.CertIdentity{}
+		if err := jsoniter.Unmarshal([]byte(str), ci); err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		certs = append(certs, ci)
+	}
+	return certs, http.StatusOK, nil
+}
+
+// Removes certificate identities mapped to a (deleted) user
+func (m *mgr) delUserCerts(userID string) {
+	certs, _, err := m.certList()
+	if err != nil {
+		return
+	}
+	var n int
+	for _, ci := range certs {
+		if ci.UserID != userID {
+			continue
+		}
+		if _, err := m.db.Delete(certsCollection, ci.Identity); err != nil {
+			nlog.Errorf("failed to delete certificate identity %q (user %q): %v", ci.Identity, userID, err)
+		}
+		n++
+	}
+	if n > 0 {
+		go m.broadcastCerts()
+	}
+}
+
+// Generates (expiring) tokens for all certificate identities: on behalf of the mapped user
+// or, for role-mapped identities, on behalf of the identity itself with the roles' permissions
+// (so that the identity can be used as a principal in per-prefix ACLs)
+func (m *mgr) genCertList() (*authn.CertList, error) {
+	certs, _, err := m.certList()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		ttl   = m.pushTTL()
+		msg   = &authn.LoginMsg{ExpiresIn: &ttl}
+		users = make(map[string]string, len(certs)) // user => token
+		list  = &authn.CertList{Certs: make([]*authn.CertIdentity, 0, len(certs)), Version: time.Now().UnixNano()}
+	)
+	for _, ci := range certs {
+		var (
+			token string
+			ok    bool
+		)
+		if ci.UserID != "" {
+			token, ok = users[ci.UserID]
+		}
+		if !ok {
+			uInfo, err := m.certUser(ci)
+			if err == nil {
+				token, _, err = m.userToken(uInfo, msg, nil)
+			}
+			if err != nil {
+				nlog.Errorf("certificate identity %q: %v", ci.Identity, err)
+				continue
+			}
+			if ci.UserID != "" {
+				users[ci.UserID] = token
+			}
+		}
+		ci.Token = token
+		list.Certs = append(list.Certs, ci)
+	}
+	return list, nil
+}
+
+func (m *mgr) certUser(ci *authn.CertIdentity) (*authn.User, error) {
+	if ci.UserID != "" {
+		return m.currentUser(ci.UserID)
+	}
+	uInfo := &authn.User{ID: ci.Identity, Roles: make([]*authn.Role, 0, len(ci.Roles))}
+	for _, name := range ci.Roles {
+		role, _, err := m.lookupRole(name)
+		if err != nil {
+			nlog.Warningf("certificate identity %q: role %q: %v", ci.Identity, name, err)
+			continue
+		}
+		uInfo.Roles = append(uInfo.Roles, role)
+	}
+	if len(uInfo.Roles) == 0 {
+		return nil, errors.New("none of the mapped roles exist")
+	}
+	return uInfo, nil
+}
+
+//
+// LDAP users ============================================================
+//
+
+// Authenticates the user with the identity provider and (re)creates the user's record
+// with the roles that correspond to the user's current group membership
+func (m *mgr) ldapLogin(uid, pwd string) (*authn.User, int, error) {
+	groups, err := m.idp.Authenticate(uid, pwd)
+	if err != nil {
+		if errors.Is(err, ldap.ErrUserNotFound) || ldap.IsInvalidCredentials(err) {
+			nlog.Warningln("LDAP login", uid+":", err)
+			return nil, http.StatusUnauthorized, errInvalidCredentials
+		}
+		nlog.Errorln("LDAP login", uid+":", err)
+		return nil, http.StatusServiceUnavailable, err
+	}
+	uInfo, changed, err := m.ldapUser(uid, groups)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if len(uInfo.Roles) == 0 {
+		return nil, http.StatusUnauthorized, fmt.Errorf("user %q is not a member of any LDAP group mapped to %s roles", uid, m)
+	}
+	if changed {
+		go m.broadcastPushed()
+	}
+	return uInfo, http.StatusOK, nil
+}
+
+// Updates (or creates) LDAP user's record with the roles mapped from the given groups;
+// returns true if the roles have changed
+func (m *mgr) ldapUser(uid string, groups []string) (*authn.User, bool, error) {
+	var (
+		names = m.idp.Roles(groups)
+		uInfo = &authn.User{ID: uid, Source: authn.UserSourceLDAP, Roles: make([]*authn.Role, 0, len(names))}
+		prev  = &authn.User{}
+	)
+	for _, name := range names {
+		role, _, err := m.lookupRole(name)
+		if err != nil {
+			nlog.Errorf("LDAP user %q: group-mapped role %q: %v", uid, name, err)
+			continue
+		}
+		uInfo.Roles = append(uInfo.Roles, role)
+	}
+	if _, err := m.db.Get(usersCollection, uid, prev); err == nil && sameRoles(prev.Roles, uInfo.Roles) {
+		return prev, false, nil
+	}
+	if _, err := m.db.Set(usersCollection, uid, uInfo); err != nil {
+		return nil, false, err
+	}
+	nlog.Infoln("LDAP user", uid, "roles:", names)
+	return uInfo, true, nil
+}
+
+// Periodically re-evaluates group membership of all LDAP users (that have logged in at least once):
+// updates their roles (and S3 access keys) and removes users that no longer exist in the directory
+func (m *mgr) refreshLDAPUsers(interval time.Duration) {
+	for {
+		time.Sleep(interval)
+		m.refreshLDAP()
+	}
+}
+
+func (m *mgr) refreshLDAP() (changed bool) {
+	users, _, err := m.userList()
+	if err != nil {
+		nlog.Errorln("LDAP refresh:", err)
+		return false
+	}
+	for uid, uInfo := range users {
+		if uInfo.Source != authn.UserSourceLDAP {
+			continue
+		}
+		groups, err := m.idp.Groups(uid)
+		switch {
+		case errors.Is(err, ldap.ErrUserNotFound):
+			nlog.Warningln("LDAP user", uid, "not found in the directory - removing")
+			if _, err := m.delUser(uid); err != nil {
+				nlog.Errorln("LDAP refresh:", err)
+			}
+		case err != nil:
+			nlog.Errorln("LDAP refresh", uid+":", err) // keep as is
+		default:
+			_, updated, err := m.ldapUser(uid, groups)
+			if err != nil {
+				nlog.Errorln("LDAP refresh", uid+":", err)
+			}
+			changed = changed || updated
+		}
+	}
+	if changed {
+		m.broadcastPushed()
+	}
+	return changed
+}
+
+func sameRoles(a, b []*authn.Role) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name {
+			return false
+		}
+	}
+	return true
+}
+
 //
 // private helpers ============================================================
 //
@@ -36,7 +36,15 @@ type (
 		Expires     time.Time       `json:"expires"`
 		ClusterACLs []*authn.CluACL `json:"clusters"`
 		BucketACLs  []*authn.BckACL `json:"buckets,omitempty"`
-		IsAdmin     bool            `json:"admin"`
+		Namespace   string          `json:"namespace,omitempty"` // tenant (see authn.Role)
+		// names of the user's roles - to match bucket per-prefix ACL entries (see cmn.PrefixACL)
+		Roles []string `json:"roles,omitempty"`
+		// login session (see authn.Session) - to revoke all the session's tokens at once
+		SessionID string `json:"sid,omitempty"`
+		// optional scope requested at login: when present, the token grants access
+		// only to the listed buckets, with (at most) the listed permissions
+		Scope   []*authn.BckACL `json:"ais_scope,omitempty"`
+		IsAdmin bool            `json:"admin"`
 		jwt.RegisteredClaims
 	}
 
@@ -379,19 +387,73 @@ func (c *AISClaims) IsUser(user string) bool {
 // If there are no defined ACL found at any step, any access is denied.
 
 func (c *AISClaims) CheckPermissions(clusterID string, bck *cmn.Bck, perms apc.AccessAttrs) error {
-	if c.IsAdmin {
-		return nil
+	return c.checkPermissions(clusterID, bck, 0 /*prefix ACL*/, perms)
+}
+
+// CheckObjPermissions is CheckPermissions for a given object: bucket ACLs that have
+// a prefix matching the object name grant their permissions in addition to
+// (and never restrict) the bucket-wide and cluster-wide ones.
+func (c *AISClaims) CheckObjPermissions(clusterID string, bck *cmn.Bck, objName string, perms apc.AccessAttrs) error {
+	var pfxACL apc.AccessAttrs
+	if objName != "" && bck != nil {
+		pfxACL = c.aclForPrefix(clusterID, bck, objName)
 	}
-	if perms == 0 {
+	return c.checkPermissions(clusterID, bck, pfxACL, perms)
+}
+
+// PrefixGrants is CheckPermissions that also counts a permission granted for
+// at least one prefix (e.g., list-objects: the caller then gets to see only
+// the objects it can read); expecting a single permission bit
+func (c *AISClaims) PrefixGrants(clusterID string, bck *cmn.Bck, perm apc.AccessAttrs) bool {
+	var pfxACL apc.AccessAttrs
+	for _, b := range c.BucketACLs {
+		if b.Prefix != "" && c.matchBck(b, clusterID, bck) {
+			pfxACL |= b.Access
+		}
+	}
+	return c.checkPermissions(clusterID, bck, pfxACL, perm) == nil
+}
+
+// Principals returns the user ID followed by the user's roles ("role:<name>"),
+// to match against bucket props per-prefix ACL entries (see meta.Bck.AllowObj)
+func (c *AISClaims) Principals() []string {
+	sub, _ := c.GetSubject()
+	principals := make([]string, 0, len(c.Roles)+1)
+	principals = append(principals, sub)
+	for _, role := range c.Roles {
+		principals = append(principals, cmn.PrincipalRole+role)
+	}
+	return principals
+}
+
+//
+// private
+//
+
+func (c *AISClaims) checkPermissions(clusterID string, bck *cmn.Bck, pfxACL, perms apc.AccessAttrs) error {
+	if perms == 0 && (c.Scope != nil || !c.IsAdmin) {
 		return errors.New("empty permissions requested")
 	}
 	sub, _ := c.GetSubject()
+	if c.Scope != nil {
+		if err := c.checkScope(sub, clusterID, bck, perms); err != nil {
+			return err
+		}
+	}
+	if c.IsAdmin {
+		return nil
+	}
 	cluPerms := perms & (apc.ClusterAccessRW | apc.AceAdmin)
 	objPerms := perms & (apc.AccessRW | apc.AccessBucketAdmin)
 	extra := perms &^ (cluPerms | objPerms)
 	if extra != 0 {
 		return fmt.Errorf("user `%s` has %w: invalid permissions %s", sub, ErrNoPermissions, extra.Describe(false))
 	}
+	if c.Namespace != "" {
+		if err := c.checkTenant(sub, bck, cluPerms); err != nil {
+			return err
+		}
+	}
 	cluACL, cluOk := c.aclForCluster(clusterID)
 	if cluPerms != 0 {
 		// Cluster-wide permissions requested
@@ -416,22 +478,22 @@ func (c *AISClaims) CheckPermissions(clusterID string, bck *cmn.Bck, perms apc.A
 	}
 	bckACL, bckOk := c.aclForBucket(clusterID, bck)
 	if bckOk {
-		if bckACL.Has(objPerms) {
+		if (bckACL | pfxACL).Has(objPerms) {
 			return nil
 		}
 		return fmt.Errorf("user `%s` has %v: [%s, bucket %s, granted(%s)]", sub,
-			ErrNoPermissions, c, bck.String(), bckACL.Describe(false /*include all*/))
+			ErrNoPermissions, c, bck.String(), (bckACL | pfxACL).Describe(false /*include all*/))
+	}
+	granted := pfxACL
+	if cluOk {
+		granted |= cluACL
 	}
-	if !cluOk || !cluACL.Has(objPerms) {
-		return fmt.Errorf("user `%s` has %v: [%s, granted(%s)]", sub, ErrNoPermissions, c, cluACL.Describe(false /*include all*/))
+	if !granted.Has(objPerms) {
+		return fmt.Errorf("user `%s` has %v: [%s, granted(%s)]", sub, ErrNoPermissions, c, granted.Describe(false /*include all*/))
 	}
 	return nil
 }
 
-//
-// private
-//
-
 func expiresIn(tm time.Time) string {
 	dur := time.Until(tm)
 	if dur <= 0 {
@@ -442,6 +504,43 @@ func expiresIn(tm time.Time) string {
 	return "token expires in " + dur.String()
 }
 
+// tenant-bound users: no admin permissions and no access outside their namespace
+func (c *AISClaims) checkTenant(sub string, bck *cmn.Bck, cluPerms apc.AccessAttrs) error {
+	if cluPerms&apc.AceAdmin != 0 {
+		return fmt.Errorf("user `%s` has %v: tenant %q cannot administer the cluster", sub, ErrNoPermissions, c.Namespace)
+	}
+	if bck != nil && bck.Ns != cmn.TenantNs(c.Namespace) {
+		return fmt.Errorf("user `%s` has %v: bucket %s is outside tenant %q", sub, ErrNoPermissions, bck.String(), c.Namespace)
+	}
+	return nil
+}
+
+// scoped tokens: bucket permissions only, and only those that are explicitly listed
+func (c *AISClaims) checkScope(sub, clusterID string, bck *cmn.Bck, perms apc.AccessAttrs) error {
+	if cluPerms := perms &^ (apc.AccessRW | apc.AccessBucketAdmin); cluPerms != 0 {
+		return fmt.Errorf("user `%s` has %v: %s outside token scope", sub, ErrNoPermissions, cluPerms.Describe(false))
+	}
+	if bck == nil {
+		return errors.New("requested bucket permissions without a bucket")
+	}
+	for _, b := range c.Scope {
+		// scope may or may not specify cluster ID (as Ns.UUID)
+		if b.Bck.Ns.UUID != "" && b.Bck.Ns.UUID != clusterID {
+			continue
+		}
+		scoped := cmn.Bck{Name: b.Bck.Name, Provider: b.Bck.Provider, Ns: cmn.Ns{Name: b.Bck.Ns.Name}}
+		if !scoped.Equal(bck) {
+			continue
+		}
+		if b.Access.Has(perms) {
+			return nil
+		}
+		return fmt.Errorf("user `%s` has %v: [bucket %s, token scope(%s)]", sub, ErrNoPermissions, bck.String(),
+			b.Access.Describe(false /*include all*/))
+	}
+	return fmt.Errorf("user `%s` has %v: bucket %s is outside token scope", sub, ErrNoPermissions, bck.String())
+}
+
 func (c *AISClaims) aclForCluster(clusterID string) (perms apc.AccessAttrs, ok bool) {
 	var defaultCluster *authn.CluACL
 	for _, pm := range c.ClusterACLs {
@@ -460,16 +559,30 @@ func (c *AISClaims) aclForCluster(clusterID string) (perms apc.AccessAttrs, ok b
 
 func (c *AISClaims) aclForBucket(clusterID string, bck *cmn.Bck) (perms apc.AccessAttrs, ok bool) {
 	for _, b := range c.BucketACLs {
-		tbBck := b.Bck
-		if tbBck.Ns.UUID != clusterID {
-			continue
-		}
-		// For AuthN all buckets are external: they have UUIDs of the respective AIS clusters.
-		// To correctly compare with the caller's `bck` we construct tokenBck from the token.
-		tokenBck := cmn.Bck{Name: tbBck.Name, Provider: tbBck.Provider}
-		if tokenBck.Equal(bck) {
+		if b.Prefix == "" && c.matchBck(b, clusterID, bck) {
 			return b.Access, true
 		}
 	}
 	return 0, false
 }
+
+// union of the bucket's prefix ACLs that apply to the named object
+func (c *AISClaims) aclForPrefix(clusterID string, bck *cmn.Bck, objName string) (perms apc.AccessAttrs) {
+	for _, b := range c.BucketACLs {
+		if b.Prefix != "" && strings.HasPrefix(objName, b.Prefix) && c.matchBck(b, clusterID, bck) {
+			perms |= b.Access
+		}
+	}
+	return perms
+}
+
+func (*AISClaims) matchBck(b *authn.BckACL, clusterID string, bck *cmn.Bck) bool {
+	tbBck := b.Bck
+	if tbBck.Ns.UUID != clusterID {
+		return false
+	}
+	// For AuthN all buckets are external: they have UUIDs of the respective AIS clusters.
+	// To correctly compare with the caller's `bck` we construct tokenBck from the token.
+	tokenBck := cmn.Bck{Name: tbBck.Name, Provider: tbBck.Provider, Ns: cmn.Ns{Name: tbBck.Ns.Name}}
+	return tokenBck.Equal(bck)
+}
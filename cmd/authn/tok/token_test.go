@@ -216,6 +216,87 @@ func TestStandardClaimsCluster(t *testing.T) {
 	assertClusterClaims(t, claims, testUser, cluster)
 }
 
+func TestStandardClaimsNamespace(t *testing.T) {
+	cluster := "cid1"
+
+	claims := tok.StandardClaims(futureTime, testUser, testAudience,
+		[]*authn.BckACL{{Bck: cmn.Bck{Name: "b1", Provider: "ais", Ns: cmn.Ns{UUID: cluster, Name: "acme"}}, Access: apc.AccessRW}},
+		[]*authn.CluACL{makeCluACL(apc.AccessRO|apc.AceListBuckets|apc.AceCreateBucket, cluster)})
+	claims.Namespace = "acme"
+
+	var (
+		own   = &cmn.Bck{Name: "b1", Provider: "ais", Ns: cmn.TenantNs("acme")}
+		other = &cmn.Bck{Name: "b2", Provider: "ais", Ns: cmn.TenantNs("beta")}
+		glob  = &cmn.Bck{Name: "b3", Provider: "ais"}
+	)
+	tassert.CheckError(t, claims.CheckPermissions(cluster, own, apc.AcePUT))
+	tassert.CheckError(t, claims.CheckPermissions(cluster, nil, apc.AceListBuckets))
+	tassert.Errorf(t, claims.CheckPermissions(cluster, other, apc.AceGET) != nil, "expected no access to %s", other)
+	tassert.Errorf(t, claims.CheckPermissions(cluster, glob, apc.AceGET) != nil, "expected no access to %s", glob)
+	tassert.Errorf(t, claims.CheckPermissions(cluster, nil, apc.AceAdmin) != nil, "expected no admin access")
+}
+
+func TestScopedClaims(t *testing.T) {
+	cluster := "cid1"
+
+	// user has RW access to the entire cluster while the token is scoped to RO access to b1
+	claims := tok.StandardClaims(futureTime, testUser, testAudience, nil,
+		[]*authn.CluACL{makeCluACL(apc.AccessRW|apc.ClusterAccessRW, cluster)})
+	claims.Scope = []*authn.BckACL{{Bck: cmn.Bck{Name: "b1", Provider: "ais"}, Access: apc.AccessRO}}
+
+	var (
+		bck1 = &cmn.Bck{Name: "b1", Provider: "ais"}
+		bck2 = &cmn.Bck{Name: "b2", Provider: "ais"}
+	)
+	tassert.CheckError(t, claims.CheckPermissions(cluster, bck1, apc.AceGET))
+	tassert.Errorf(t, claims.CheckPermissions(cluster, bck1, apc.AcePUT) != nil, "expected no write access to %s", bck1)
+	tassert.Errorf(t, claims.CheckPermissions(cluster, bck2, apc.AceGET) != nil, "expected no access to %s", bck2)
+	tassert.Errorf(t, claims.CheckPermissions(cluster, nil, apc.AceListBuckets) != nil, "expected no cluster access")
+
+	// scope does not extend the user's permissions
+	claims.ClusterACLs = nil
+	tassert.Errorf(t, claims.CheckPermissions(cluster, bck1, apc.AceGET) != nil, "expected no access to %s", bck1)
+
+	// and applies to admins as well
+	admin := tok.AdminClaims(futureTime, testUser, testAudience)
+	admin.Scope = claims.Scope
+	tassert.CheckError(t, admin.CheckPermissions(cluster, bck1, apc.AceGET))
+	tassert.Errorf(t, admin.CheckPermissions(cluster, bck2, apc.AceGET) != nil, "expected no access to %s", bck2)
+}
+
+func TestPrefixClaims(t *testing.T) {
+	cluster := "cid1"
+
+	// read-only access to b1 plus read-write access to its "team-a/" prefix
+	pfxACL := makeBckACL(apc.AccessRW, cluster, "b1")
+	pfxACL.Prefix = "team-a/"
+	claims := tok.StandardClaims(futureTime, testUser, testAudience,
+		[]*authn.BckACL{makeBckACL(apc.AccessRO, cluster, "b1"), pfxACL}, nil)
+	claims.Roles = []string{"team-a"}
+
+	var (
+		bck1 = &cmn.Bck{Name: "b1", Provider: "ais"}
+		bck2 = &cmn.Bck{Name: "b2", Provider: "ais"}
+	)
+	tassert.CheckError(t, claims.CheckObjPermissions(cluster, bck1, "team-a/obj", apc.AcePUT))
+	tassert.CheckError(t, claims.CheckObjPermissions(cluster, bck1, "team-b/obj", apc.AceGET))
+	tassert.Errorf(t, claims.CheckObjPermissions(cluster, bck1, "team-b/obj", apc.AcePUT) != nil, "expected no write access")
+	tassert.Errorf(t, claims.CheckPermissions(cluster, bck1, apc.AcePUT) != nil, "expected no bucket-wide write access")
+	tassert.Errorf(t, claims.CheckObjPermissions(cluster, bck2, "team-a/obj", apc.AceGET) != nil, "expected no access to %s", bck2)
+
+	// prefix-only (no bucket-wide access)
+	claims.BucketACLs = []*authn.BckACL{pfxACL}
+	tassert.CheckError(t, claims.CheckObjPermissions(cluster, bck1, "team-a/obj", apc.AceGET))
+	tassert.Errorf(t, claims.CheckObjPermissions(cluster, bck1, "obj", apc.AceGET) != nil, "expected no access outside prefix")
+	tassert.Errorf(t, claims.CheckPermissions(cluster, bck1, apc.AceObjLIST) != nil, "expected no bucket-wide list access")
+	tassert.Errorf(t, claims.PrefixGrants(cluster, bck1, apc.AceObjLIST), "expected per-prefix list access")
+	tassert.Errorf(t, !claims.PrefixGrants(cluster, bck2, apc.AceObjLIST), "expected no list access to %s", bck2)
+
+	principals := claims.Principals()
+	tassert.Errorf(t, len(principals) == 2 && principals[0] == testUser && principals[1] == cmn.PrincipalRole+"team-a",
+		"unexpected principals %v", principals)
+}
+
 func TestExtractToken(t *testing.T) {
 	// Test bearer token extraction (s3CompatEnabled=false)
 	hdr := http.Header{}
@@ -8,6 +8,9 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmd/authn/config"
+	"github.com/NVIDIA/aistore/cmd/authn/ldap"
 	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -111,7 +115,7 @@ func testUserDelete(mgr *mgr, t *testing.T) {
 
 	loginMsg := &authn.LoginMsg{}
 	token, _, err := mgr.issueToken(username, userpass, loginMsg)
-	if err != nil || token == "" {
+	if err != nil || token.Token == "" {
 		t.Errorf("Failed to generate token for %s: %v", username, err)
 	}
 
@@ -192,6 +196,7 @@ func TestToken(t *testing.T) {
 	}
 	var (
 		err   error
+		tmsg  *authn.TokenMsg
 		token string
 	)
 	driver := mock.NewDBDriver()
@@ -214,10 +219,11 @@ func TestToken(t *testing.T) {
 	// correct user creds
 	shortExpiration := 2 * time.Second
 	loginMsg := &authn.LoginMsg{ExpiresIn: &shortExpiration}
-	token, _, err = mgr.issueToken(users[1], passs[1], loginMsg)
-	if err != nil || token == "" {
-		t.Errorf("Failed to generate token for %s: %v", users[1], err)
+	tmsg, _, err = mgr.issueToken(users[1], passs[1], loginMsg)
+	if err != nil || tmsg.Token == "" {
+		t.Fatalf("Failed to generate token for %s: %v", users[1], err)
 	}
+	token = tmsg.Token
 	info, err := mgr.tkParser.ValidateToken(t.Context(), token)
 	if err != nil {
 		t.Fatalf("Failed to decrypt token %v: %v", token, err)
@@ -231,7 +237,7 @@ func TestToken(t *testing.T) {
 	// incorrect user creds
 	loginMsg = &authn.LoginMsg{}
 	tokenInval, _, err := mgr.issueToken(users[1], passs[0], loginMsg)
-	if tokenInval != "" || err == nil {
+	if tokenInval != nil || err == nil {
 		t.Errorf("Some token generated for incorrect user creds: %v", tokenInval)
 	}
 
@@ -241,6 +247,287 @@ func TestToken(t *testing.T) {
 	tassert.Fatalf(t, errors.Is(err, tok.ErrTokenExpired), "Token must be expired: %s", token)
 }
 
+func TestS3Keys(t *testing.T) {
+	driver := mock.NewDBDriver()
+	cm := createEmptyCM(t)
+	mgr, err := createManagerWithAdmin(cm, driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, true, t)
+
+	_, _, err = mgr.addS3Key("nonexisting")
+	tassert.Errorf(t, err != nil, "S3 access key issued for non-existing user")
+
+	key1, _, err := mgr.addS3Key(users[0])
+	tassert.CheckFatal(t, err)
+	key2, _, err := mgr.addS3Key(users[1])
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(key1.AccessKeyID) == s3KeyIDLen && len(key1.Secret) == s3SecretLen,
+		"invalid S3 access key %+v", key1)
+	tassert.Errorf(t, key1.AccessKeyID != key2.AccessKeyID, "duplicate access key ID %q", key1.AccessKeyID)
+
+	keys, _, err := mgr.s3KeyList(users[0])
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(keys) == 1 && keys[0].AccessKeyID == key1.AccessKeyID, "expected %q, got %+v", key1.AccessKeyID, keys)
+	tassert.Errorf(t, keys[0].Secret == key1.Secret, "S3 access key %q: secret mismatch", key1.AccessKeyID)
+
+	// secrets are sealed at rest
+	str, _, err := driver.GetString(s3KeysCollection, key1.AccessKeyID)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !strings.Contains(str, key1.Secret), "S3 secret stored in plaintext: %s", str)
+
+	// keys come with the respective users' (expiring) tokens
+	list, err := mgr.genS3KeyList()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(list.Keys) == 2, "expected 2 keys, got %d", len(list.Keys))
+	for _, key := range list.Keys {
+		claims, err := mgr.tkParser.ValidateToken(t.Context(), key.Token)
+		tassert.CheckFatal(t, err)
+		sub, err := claims.GetSubject()
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, sub == key.UserID, "S3 access key %q: token subject %q vs user %q", key.AccessKeyID, sub, key.UserID)
+		exp, err := claims.GetExpirationTime()
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, exp != nil && time.Until(exp.Time) <= s3TokenTTL, "S3 access key %q: token expires %v", key.AccessKeyID, exp)
+	}
+
+	// deleting the key or the user deletes the key
+	_, err = mgr.delS3Key(key1.AccessKeyID)
+	tassert.CheckFatal(t, err)
+	_, err = mgr.delUser(users[1])
+	tassert.CheckFatal(t, err)
+	keys, _, err = mgr.s3KeyList("")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(keys) == 0, "expected no keys, got %d", len(keys))
+}
+
+func TestSessions(t *testing.T) {
+	driver := mock.NewDBDriver()
+	accessTTL := cos.Duration(time.Minute)
+	cm := createCM(t, &authn.Config{Server: authn.ServerConf{Secret: "mytestsecret", AccessTTL: accessTTL}})
+	mgr, err := createManagerWithAdmin(cm, driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, true, t)
+
+	// login opens a session: short-lived access token and refresh token
+	tmsg, _, err := mgr.issueToken(users[0], passs[0], &authn.LoginMsg{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, tmsg.SessionID != "" && tmsg.RefreshToken != "", "expected session, got %+v", tmsg)
+	tassert.Errorf(t, tmsg.ExpiresIn > 0 && tmsg.ExpiresIn <= int64(time.Minute/time.Second),
+		"expected access token TTL <= %v, got %ds", accessTTL, tmsg.ExpiresIn)
+	claims, err := mgr.tkParser.ValidateToken(t.Context(), tmsg.Token)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, claims.SessionID == tmsg.SessionID, "session ID %q vs %q", claims.SessionID, tmsg.SessionID)
+
+	// refresh rotates the refresh token within the same session
+	rmsg, _, err := mgr.refreshToken(tmsg.RefreshToken)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, rmsg.SessionID == tmsg.SessionID, "expected the same session, got %q", rmsg.SessionID)
+	tassert.Errorf(t, rmsg.RefreshToken != tmsg.RefreshToken, "expected rotated refresh token")
+	list, _, err := mgr.sessionList(users[0])
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(list) == 1 && !list[0].Refreshed.IsZero(), "expected one refreshed session, got %d", len(list))
+
+	_, _, err = mgr.refreshToken(tmsg.SessionID + refreshSepa + "invalid")
+	tassert.Errorf(t, err != nil, "refreshed with invalid secret")
+
+	// reusing the (rotated out) refresh token terminates the session
+	_, _, err = mgr.refreshToken(tmsg.RefreshToken)
+	tassert.Errorf(t, err != nil, "refresh token reused")
+	_, _, err = mgr.refreshToken(rmsg.RefreshToken)
+	tassert.Errorf(t, err != nil, "refreshed terminated session")
+	revoked, _, err := mgr.revokedSessionList()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, revoked[tmsg.SessionID] > 0, "expected session %q to be revoked, got %v", tmsg.SessionID, revoked)
+
+	// scoped login
+	scope := []*authn.BckACL{{Bck: cmn.Bck{Name: "b1"}, Access: apc.AccessRO}}
+	smsg, _, err := mgr.issueToken(users[1], passs[1], &authn.LoginMsg{Scope: scope})
+	tassert.CheckFatal(t, err)
+	claims, err = mgr.tkParser.ValidateToken(t.Context(), smsg.Token)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(claims.Scope) == 1 && claims.Scope[0].Bck.Provider == apc.AIS, "unexpected scope %+v", claims.Scope)
+	_, _, err = mgr.issueToken(users[1], passs[1], &authn.LoginMsg{
+		Scope: []*authn.BckACL{{Bck: cmn.Bck{Name: "b1"}, Access: apc.ClusterAccessRW}},
+	})
+	tassert.Errorf(t, err != nil, "expected invalid scope error")
+
+	// deleting the user terminates all the user's sessions
+	_, err = mgr.delUser(users[1])
+	tassert.CheckFatal(t, err)
+	list, _, err = mgr.sessionList("")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(list) == 0, "expected no sessions, got %d", len(list))
+	revoked, _, err = mgr.revokedSessionList()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(revoked) == 2, "expected 2 revoked sessions, got %v", revoked)
+
+	// logout racing with refresh: the session must stay terminated
+	for range 16 {
+		lmsg, _, err := mgr.issueToken(users[0], passs[0], &authn.LoginMsg{})
+		tassert.CheckFatal(t, err)
+		wg := &sync.WaitGroup{}
+		wg.Add(2)
+		go func() {
+			mgr.refreshToken(lmsg.RefreshToken)
+			wg.Done()
+		}()
+		go func() {
+			mgr.delSession(lmsg.SessionID)
+			wg.Done()
+		}()
+		wg.Wait()
+		_, _, err = mgr.lookupSession(lmsg.SessionID)
+		tassert.Fatalf(t, err != nil, "session %q persisted by refresh after logout", lmsg.SessionID)
+	}
+}
+
+// identity provider stub: user => (password, groups); groups are mapped to the same-name roles
+type (
+	fakeUser struct {
+		pwd    string
+		groups []string
+	}
+	fakeIdP map[string]*fakeUser
+)
+
+func (f fakeIdP) Authenticate(uid, pwd string) ([]string, error) {
+	u, ok := f[uid]
+	if !ok {
+		return nil, ldap.ErrUserNotFound
+	}
+	if pwd != u.pwd {
+		return nil, &ldap.Error{Code: ldap.ResultInvalidCredentials}
+	}
+	return u.groups, nil
+}
+
+func (f fakeIdP) Groups(uid string) ([]string, error) {
+	if u, ok := f[uid]; ok {
+		return u.groups, nil
+	}
+	return nil, ldap.ErrUserNotFound
+}
+
+func (fakeIdP) Roles(groups []string) []string { return groups }
+
+func TestLDAPUsers(t *testing.T) {
+	driver := mock.NewDBDriver()
+	cm := createEmptyCM(t)
+	mgr, err := createManagerWithAdmin(cm, driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, true, t)
+	_, err = mgr.addRole(guestRole)
+	tassert.CheckFatal(t, err)
+
+	idp := fakeIdP{
+		"alice":  {"alice-pass", []string{GuestRole}},
+		"nobody": {"nobody-pass", []string{"unmapped"}},
+		users[0]: {"ldap-pass", []string{GuestRole}},
+	}
+	mgr.idp = idp
+
+	// LDAP user logs in and gets recorded with group-mapped roles
+	tmsg, _, err := mgr.issueToken("alice", "alice-pass", &authn.LoginMsg{})
+	tassert.CheckFatal(t, err)
+	_, err = mgr.tkParser.ValidateToken(t.Context(), tmsg.Token)
+	tassert.CheckFatal(t, err)
+	uInfo, _, err := mgr.lookupUser("alice")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, uInfo.Source == authn.UserSourceLDAP && len(uInfo.Roles) == 1 && uInfo.Roles[0].Name == GuestRole,
+		"unexpected LDAP user %+v", uInfo)
+
+	_, _, err = mgr.issueToken("alice", "wrong", &authn.LoginMsg{})
+	tassert.Errorf(t, err == errInvalidCredentials, "expected invalid credentials, got %v", err)
+	_, _, err = mgr.issueToken("nobody", "nobody-pass", &authn.LoginMsg{})
+	tassert.Errorf(t, err != nil, "token issued for LDAP user with no mapped roles")
+	_, err = mgr.updateUser("alice", &authn.User{Password: "new-pass"})
+	tassert.Errorf(t, err != nil, "LDAP user must not be updated locally")
+
+	// local users take precedence
+	_, _, err = mgr.issueToken(users[0], "ldap-pass", &authn.LoginMsg{})
+	tassert.Errorf(t, err == errInvalidCredentials, "expected invalid credentials, got %v", err)
+	_, _, err = mgr.issueToken(users[0], passs[0], &authn.LoginMsg{})
+	tassert.CheckError(t, err)
+
+	// group membership changes
+	idp["alice"].groups = nil
+	tassert.Errorf(t, mgr.refreshLDAP(), "expected roles to change")
+	uInfo, _, err = mgr.lookupUser("alice")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(uInfo.Roles) == 0, "expected no roles, got %d", len(uInfo.Roles))
+	tassert.Errorf(t, !mgr.refreshLDAP(), "expected no changes")
+
+	// user removed from the directory
+	delete(idp, "alice")
+	mgr.refreshLDAP()
+	_, _, err = mgr.lookupUser("alice")
+	tassert.Errorf(t, cos.IsNotExist(err), "expected LDAP user to be removed, got %v", err)
+}
+
+func TestCertIdentities(t *testing.T) {
+	driver := mock.NewDBDriver()
+	cm := createEmptyCM(t)
+	mgr, err := createManagerWithAdmin(cm, driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, true, t)
+	_, err = mgr.addRole(guestRole)
+	tassert.CheckFatal(t, err)
+
+	invalid := []*authn.CertIdentity{
+		{Identity: "trainer", UserID: users[0]},
+		{Identity: authn.CertIDCommon, UserID: users[0]},
+		{Identity: authn.CertIDCommon + "trainer"},
+		{Identity: authn.CertIDCommon + "trainer", UserID: users[0], Roles: []string{GuestRole}},
+		{Identity: authn.CertIDCommon + "trainer", UserID: "nonexisting"},
+		{Identity: authn.CertIDCommon + "trainer", Roles: []string{"nonexisting"}},
+	}
+	for _, ci := range invalid {
+		_, err := mgr.addCert(ci)
+		tassert.Errorf(t, err != nil, "expected %+v to fail", ci)
+	}
+
+	var (
+		byUser = &authn.CertIdentity{Identity: authn.CertIDDNS + "trainer.example.org", UserID: users[0]}
+		byRole = &authn.CertIdentity{Identity: authn.CertIDURI + "spiffe://example.org/ns/ml/sa/loader", Roles: []string{GuestRole}}
+	)
+	_, err = mgr.addCert(byUser)
+	tassert.CheckFatal(t, err)
+	_, err = mgr.addCert(byRole)
+	tassert.CheckFatal(t, err)
+	_, err = mgr.addCert(&authn.CertIdentity{Identity: byUser.Identity, UserID: users[1]})
+	tassert.Errorf(t, err != nil, "duplicate identity %q", byUser.Identity)
+
+	// identities come with tokens on behalf of the mapped user or the identity itself
+	list, err := mgr.genCertList()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(list.Certs) == 2, "expected 2 identities, got %d", len(list.Certs))
+	for _, ci := range list.Certs {
+		claims, err := mgr.tkParser.ValidateToken(t.Context(), ci.Token)
+		tassert.CheckFatal(t, err)
+		sub, err := claims.GetSubject()
+		tassert.CheckFatal(t, err)
+		expected := ci.UserID
+		if expected == "" {
+			expected = ci.Identity
+			tassert.Errorf(t, slices.Equal(claims.Roles, ci.Roles), "%s: roles %v vs %v", ci, claims.Roles, ci.Roles)
+		}
+		tassert.Errorf(t, sub == expected, "%s: token subject %q, expected %q", ci, sub, expected)
+	}
+
+	// deleting the identity or the user deletes the mapping
+	_, err = mgr.delCert(byRole.Identity)
+	tassert.CheckFatal(t, err)
+	_, err = mgr.delUser(users[0])
+	tassert.CheckFatal(t, err)
+	certs, _, err := mgr.certList()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(certs) == 0, "expected no identities, got %d", len(certs))
+}
+
 func TestMergeCluACLS(t *testing.T) {
 	tests := []struct {
 		title    string
@@ -5,14 +5,18 @@
 package main
 
 import (
+	"fmt"
+
 	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
 type bckACLList []*authn.BckACL
 
 func (bckList bckACLList) updated(bckACL *authn.BckACL) bool {
 	for _, acl := range bckList {
-		if acl.Bck.Equal(&bckACL.Bck) {
+		if acl.Bck.Equal(&bckACL.Bck) && acl.Prefix == bckACL.Prefix {
 			acl.Access = bckACL.Access
 			return true
 		}
@@ -32,6 +36,23 @@ func (cluList cluACLList) updated(cluACL *authn.CluACL) bool {
 	return false
 }
 
+// prefix-scoped bucket ACLs are limited to object-level permissions
+func validatePrefixACLs(acls []*authn.BckACL) error {
+	for _, acl := range acls {
+		if acl.Prefix == "" {
+			continue
+		}
+		if err := cos.ValidatePrefix("bucket ACL", acl.Prefix); err != nil {
+			return err
+		}
+		if acl.Access&^cmn.PrefixAccessMask != 0 {
+			return fmt.Errorf("bucket %s, prefix %q: expecting object-level permissions, got %q",
+				acl.Bck.String(), acl.Prefix, acl.Access.Describe(false))
+		}
+	}
+	return nil
+}
+
 // mergeBckACLs appends bucket ACLs from fromACLs which are not in toACL.
 // If a bucket ACL is already in the list, its permissions are updated.
 // If cluIDFlt is set, only ACLs for buckets of the cluster with this ID are appended.
@@ -0,0 +1,124 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles 'ais log audit' command.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/sys"
+
+	"github.com/urfave/cli"
+)
+
+const auditUsage = "Show recent audit log records from all nodes (or a selected node), e.g.:\n" +
+	indent1 + "\t- 'ais log audit'\t- show the most recent records cluster-wide;\n" +
+	indent1 + "\t- 'ais log audit --since 1h --action destroy-bck'\t- who destroyed buckets in the last hour;\n" +
+	indent1 + "\t- 'ais log audit --bucket ais://abc --user alice'\t- operations by 'alice' on ais://abc.\n" +
+	indent1 + "To enable, see 'ais config cluster audit' and (for data-plane operations) 'ais bucket props set BUCKET audit'"
+
+var (
+	auditCmdLog = cli.Command{
+		Name:         "audit",
+		Usage:        auditUsage,
+		ArgsUsage:    optionalNodeIDArgument,
+		Flags:        sortFlags([]cli.Flag{auditUserFlag, auditActionFlag, auditBckFlag, auditSinceFlag, auditLimitFlag, jsonFlag, noHeaderFlag}),
+		Action:       auditLogHandler,
+		BashComplete: suggestAllNodes,
+	}
+)
+
+func auditLogHandler(c *cli.Context) error {
+	var nodes []*meta.Snode
+	if c.NArg() > 0 {
+		node, _, err := getNode(c, c.Args().Get(0))
+		if err != nil {
+			return err
+		}
+		nodes = []*meta.Snode{node}
+	} else {
+		smap, err := getClusterMap(c)
+		if err != nil {
+			return err
+		}
+		for _, nodeMap := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
+			for _, si := range nodeMap {
+				nodes = append(nodes, si)
+			}
+		}
+	}
+
+	args := &api.GetAuditLogArgs{
+		User:   parseStrFlag(c, auditUserFlag),
+		Action: parseStrFlag(c, auditActionFlag),
+		Bucket: parseStrFlag(c, auditBckFlag),
+		Limit:  parseIntFlag(c, auditLimitFlag),
+	}
+	if flagIsSet(c, auditSinceFlag) {
+		args.Since = time.Now().Add(-parseDurationFlag(c, auditSinceFlag)).UnixNano()
+	}
+
+	var (
+		all []*apc.AuditEvent
+		mu  sync.Mutex
+		wg  = cos.NewLimitedWaitGroup(sys.NumCPU(), len(nodes))
+	)
+	for _, si := range nodes {
+		wg.Add(1)
+		go func(si *meta.Snode) {
+			defer wg.Done()
+			evs, err := api.GetAuditLog(apiBP, si, args)
+			if err != nil {
+				actionWarn(c, si.StringEx()+" returned error: "+V(err).Error())
+				return
+			}
+			mu.Lock()
+			all = append(all, evs...)
+			mu.Unlock()
+		}(si)
+	}
+	wg.Wait()
+
+	slices.SortFunc(all, func(a, b *apc.AuditEvent) int { return cmp.Compare(a.Time, b.Time) })
+	if args.Limit > 0 && len(all) > args.Limit {
+		all = all[len(all)-args.Limit:]
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+	if len(all) == 0 {
+		actionDone(c, "No audit records")
+		return nil
+	}
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "TIME\tNODE\tUSER\tREQUEST\tACTION\tBUCKET\tOBJECT\tSTATUS\tLATENCY")
+	}
+	for _, ev := range all {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			teb.FmtDateTime(time.Unix(0, ev.Time)), ev.Node, _dash(ev.User), ev.Method,
+			_dash(ev.Action), _dash(ev.Bucket), _dash(ev.Object), ev.Status, teb.FormatDuration(time.Duration(ev.Latency)))
+	}
+	return tw.Flush()
+}
+
+func _dash(s string) string {
+	if s == "" {
+		return teb.NotSetVal
+	}
+	return s
+}
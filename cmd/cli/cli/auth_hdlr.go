@@ -13,7 +13,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
-	"time"
+	"text/tabwriter"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -42,21 +42,27 @@ const (
 	flagsAuthRoleShow    = "role_show"
 	flagsAuthConfShow    = "conf_show"
 	flagsAuthOIDCShow    = "oidc_show"
+	flagsAuthS3KeyShow   = "s3key_show"
+	flagsAuthSessionShow = "session_show"
+	flagsAuthCertShow    = "cert_show"
 )
 
 const authnUnreachable = `AuthN unreachable at %s. You may need to update AIS CLI configuration or environment variable %s`
 
 var (
 	authFlags = map[string][]cli.Flag{
-		flagsAuthUserLogin:   {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag},
+		flagsAuthUserLogin:   {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag, scopeTokenFlag},
 		flagsAuthUserLogout:  {tokenFileFlag},
 		cmdAuthUser:          {passwordFlag},
-		flagsAuthRoleAddSet:  {descRoleFlag, clusterRoleFlag, bucketRoleFlag},
+		flagsAuthRoleAddSet:  {descRoleFlag, clusterRoleFlag, bucketRoleFlag, prefixRoleFlag, nsRoleFlag},
 		flagsAuthRevokeToken: {tokenFileFlag},
 		flagsAuthUserShow:    {nonverboseFlag, verboseFlag},
 		flagsAuthRoleShow:    {nonverboseFlag, verboseFlag, clusterFilterFlag},
 		flagsAuthConfShow:    {jsonFlag, noHeaderFlag},
 		flagsAuthOIDCShow:    {jsonFlag, noHeaderFlag},
+		flagsAuthS3KeyShow:   {noHeaderFlag},
+		flagsAuthSessionShow: {noHeaderFlag},
+		flagsAuthCertShow:    {noHeaderFlag},
 	}
 
 	// define separately to allow for aliasing (see alias_hdlr.go)
@@ -102,6 +108,28 @@ var (
 				Usage:  "Show AuthN public JWKS",
 				Action: wrapAuthN(showAuthJWKSHandler),
 			},
+			{
+				Name:         cmdAuthS3Key,
+				Usage:        "Show S3 access keys of all users or a given user (secrets are never shown)",
+				ArgsUsage:    showAuthS3KeyArgument,
+				Flags:        sortFlags(authFlags[flagsAuthS3KeyShow]),
+				Action:       wrapAuthN(showAuthS3KeyHandler),
+				BashComplete: oneUserCompletions,
+			},
+			{
+				Name:         cmdAuthSession,
+				Usage:        "Show active login sessions of all users or a given user",
+				ArgsUsage:    showAuthSessionArgument,
+				Flags:        sortFlags(authFlags[flagsAuthSessionShow]),
+				Action:       wrapAuthN(showAuthSessionHandler),
+				BashComplete: oneUserCompletions,
+			},
+			{
+				Name:   cmdAuthCert,
+				Usage:  "Show client certificate (mTLS) identities and the users or roles they are mapped to",
+				Flags:  sortFlags(authFlags[flagsAuthCertShow]),
+				Action: wrapAuthN(showAuthCertHandler),
+			},
 		},
 	}
 
@@ -138,6 +166,24 @@ var (
 						Action:       wrapAuthN(addAuthRoleHandler),
 						BashComplete: addRoleCompletions,
 					},
+					{
+						Name: cmdAuthS3Key,
+						Usage: "Issue a new S3 access key for the user, e.g.:\n" +
+							indent1 + "\t- 'ais auth add s3key alice'\t- S3 clients sign requests (SigV4) with the returned access key ID and secret;\n" +
+							indent1 + "\t  the secret is shown only once, and cannot be retrieved later",
+						ArgsUsage:    addAuthS3KeyArgument,
+						Action:       wrapAuthN(addAuthS3KeyHandler),
+						BashComplete: oneUserCompletions,
+					},
+					{
+						Name: cmdAuthCert,
+						Usage: "Map client certificate (mTLS) identity to a user or roles, e.g.:\n" +
+							indent1 + "\t- 'ais auth add cert cn:trainer alice'\t- certificate with subject CN 'trainer' authenticates as 'alice';\n" +
+							indent1 + "\t- 'ais auth add cert uri:spiffe://example.org/ns/ml/sa/loader role:Guest'\t- SPIFFE ID (SAN URI) with the 'Guest' role's permissions;\n" +
+							indent1 + "\tidentity prefixes: 'uri:', 'dns:', 'email:' (SANs), and 'cn:' (subject common name)",
+						ArgsUsage: addAuthCertArgument,
+						Action:    wrapAuthN(addAuthCertHandler),
+					},
 				},
 			},
 			// rm
@@ -173,6 +219,24 @@ var (
 						ArgsUsage: deleteAuthTokenArgument,
 						Action:    wrapAuthN(revokeTokenHandler),
 					},
+					{
+						Name:      cmdAuthS3Key,
+						Usage:     "Revoke S3 access key",
+						ArgsUsage: deleteAuthS3KeyArgument,
+						Action:    wrapAuthN(deleteAuthS3KeyHandler),
+					},
+					{
+						Name:      cmdAuthSession,
+						Usage:     "Terminate login session and revoke all its tokens",
+						ArgsUsage: deleteAuthSessionArgument,
+						Action:    wrapAuthN(deleteAuthSessionHandler),
+					},
+					{
+						Name:      cmdAuthCert,
+						Usage:     "Remove client certificate identity mapping",
+						ArgsUsage: deleteAuthCertArgument,
+						Action:    wrapAuthN(deleteAuthCertHandler),
+					},
 				},
 			},
 			// set
@@ -225,6 +289,12 @@ var (
 				Flags:  sortFlags(authFlags[flagsAuthUserLogout]),
 				Action: wrapAuthN(logoutUserHandler),
 			},
+			{
+				Name:   cmdAuthRefresh,
+				Usage:  "Renew access token using the refresh token from the last login (and update the token file)",
+				Flags:  sortFlags(authFlags[flagsAuthUserLogout]),
+				Action: wrapAuthN(refreshTokenHandler),
+			},
 		},
 	}
 )
@@ -369,20 +439,25 @@ func deleteRoleHandler(c *cli.Context) (err error) {
 
 func loginUserHandler(c *cli.Context) (err error) {
 	var (
-		expireIn *time.Duration
 		name     = cliAuthnUserName(c)
 		password = cliAuthnUserPassword(c, false)
 		cluID    = parseStrFlag(c, clusterTokenFlag)
+		msg      = &authn.LoginMsg{Password: password}
 	)
 	if flagIsSet(c, expireFlag) {
-		expireIn = apc.Ptr(parseDurationFlag(c, expireFlag))
+		msg.ExpiresIn = apc.Ptr(parseDurationFlag(c, expireFlag))
+	}
+	if flagIsSet(c, scopeTokenFlag) {
+		if msg.Scope, err = parseTokenScope(c, parseStrFlag(c, scopeTokenFlag)); err != nil {
+			return err
+		}
 	}
 	if cluID != "" {
 		if _, err := authn.GetRegisteredClusters(authParams, authn.CluACL{ID: cluID}); err != nil {
 			return err
 		}
 	}
-	token, err := authn.LoginUser(authParams, name, password, expireIn)
+	token, err := authn.Login(authParams, name, msg)
 	if err != nil {
 		return err
 	}
@@ -397,6 +472,58 @@ func loginUserHandler(c *cli.Context) (err error) {
 	return nil
 }
 
+// e.g. "ais://b1=ro,s3://b2=GET+HEAD-OBJECT"
+func parseTokenScope(c *cli.Context, s string) ([]*authn.BckACL, error) {
+	var scope []*authn.BckACL
+	for item := range strings.SplitSeq(s, ",") {
+		uri, perms, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok || perms == "" {
+			return nil, fmt.Errorf("invalid %s %q: expecting BUCKET=PERMISSIONS", qflprn(scopeTokenFlag), item)
+		}
+		bck, err := parseBckURI(c, uri, false)
+		if err != nil {
+			return nil, err
+		}
+		acl := &authn.BckACL{Bck: bck}
+		for perm := range strings.SplitSeq(perms, "+") {
+			p, err := apc.StrToAccess(perm)
+			if err != nil {
+				return nil, err
+			}
+			acl.Access |= p
+		}
+		scope = append(scope, acl)
+	}
+	return scope, nil
+}
+
+func refreshTokenHandler(c *cli.Context) error {
+	tokenFilePath, err := getTokenFilePath(c)
+	if err != nil {
+		return err
+	}
+	b, err := os.ReadFile(tokenFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read token %q: %v", tokenFilePath, err)
+	}
+	msg := &authn.TokenMsg{}
+	if err := jsoniter.Unmarshal(b, msg); err != nil {
+		return fmt.Errorf("invalid token %q format: %v", tokenFilePath, err)
+	}
+	if msg.RefreshToken == "" {
+		return fmt.Errorf("token file %q contains no refresh token - log in again", tokenFilePath)
+	}
+	token, err := authn.RefreshToken(authParams, msg.RefreshToken)
+	if err != nil {
+		return err
+	}
+	if err := jsp.Save(tokenFilePath, token, jsp.Plain(), nil); err != nil {
+		return fmt.Errorf("failed to write token %q: %v", tokenFilePath, err)
+	}
+	fmt.Fprintf(c.App.Writer, "Token refreshed (%s)\n", tokenFilePath)
+	return nil
+}
+
 func logoutUserHandler(c *cli.Context) (err error) {
 	tokenFilePath, err := getTokenFilePath(c)
 	if err != nil {
@@ -602,11 +729,15 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 		args    = c.Args()
 		cluster = parseStrFlag(c, clusterRoleFlag)
 		bucket  = parseStrFlag(c, bucketRoleFlag)
+		prefix  = parseStrFlag(c, prefixRoleFlag)
 		role    = args.Get(0)
 	)
 	if bucket != "" && cluster == "" {
 		return nil, fmt.Errorf("flag %s requires %s to be specified", qflprn(bucketRoleFlag), qflprn(clusterRoleFlag))
 	}
+	if prefix != "" && bucket == "" {
+		return nil, fmt.Errorf("flag %s requires %s to be specified", qflprn(prefixRoleFlag), qflprn(bucketRoleFlag))
+	}
 
 	if cluster != "" {
 		cluList, err := authn.GetRegisteredClusters(authParams, authn.CluACL{})
@@ -642,6 +773,7 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 	roleACL := &authn.Role{
 		Name:        role,
 		Description: parseStrFlag(c, descRoleFlag),
+		Namespace:   parseStrFlag(c, nsRoleFlag),
 	}
 	if bucket != "" {
 		bck, err := parseBckURI(c, bucket, false)
@@ -652,6 +784,7 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 		roleACL.BucketACLs = []*authn.BckACL{
 			{
 				Bck:    bck,
+				Prefix: prefix,
 				Access: perms,
 			},
 		}
@@ -720,6 +853,139 @@ func revokeTokenHandler(c *cli.Context) (err error) {
 	}
 	return authn.RevokeToken(authParams, msg.Token)
 }
+func addAuthS3KeyHandler(c *cli.Context) error {
+	userID := c.Args().Get(0)
+	if userID == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	key, err := authn.AddS3Key(authParams, userID)
+	if err != nil {
+		return err
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Access key ID\t%s\n", key.AccessKeyID)
+	fmt.Fprintf(tw, "Secret access key\t%s\n", key.Secret)
+	fmt.Fprintf(tw, "User\t%s\n", key.UserID)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	warn := "the secret access key cannot be retrieved later - make sure to save it now"
+	actionWarn(c, warn)
+	return nil
+}
+
+func addAuthCertHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	ci := &authn.CertIdentity{Identity: c.Args().Get(0)}
+	for _, arg := range c.Args()[1:] {
+		if role, ok := strings.CutPrefix(arg, cmn.PrincipalRole); ok {
+			ci.Roles = append(ci.Roles, role)
+			continue
+		}
+		if ci.UserID != "" {
+			return incorrectUsageMsg(c, "expecting a single user name, got %q and %q", ci.UserID, arg)
+		}
+		ci.UserID = arg
+	}
+	if err := ci.Validate(); err != nil {
+		return incorrectUsageMsg(c, "%v", err)
+	}
+	return authn.AddCertIdentity(authParams, ci)
+}
+
+func showAuthCertHandler(c *cli.Context) error {
+	certs, err := authn.GetCertIdentities(authParams)
+	if err != nil {
+		return err
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "IDENTITY\tUSER\tROLES\tCREATED")
+	}
+	for _, ci := range certs {
+		user, roles := ci.UserID, strings.Join(ci.Roles, ",")
+		if user == "" {
+			user = teb.NotSetVal
+		}
+		if roles == "" {
+			roles = teb.NotSetVal
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", ci.Identity, user, roles, teb.FmtDateTime(ci.Created))
+	}
+	return tw.Flush()
+}
+
+func deleteAuthCertHandler(c *cli.Context) error {
+	identity := c.Args().Get(0)
+	if identity == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	return authn.DeleteCertIdentity(authParams, identity)
+}
+
+func showAuthS3KeyHandler(c *cli.Context) error {
+	keys, err := authn.GetS3Keys(authParams, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "ACCESS KEY ID\tUSER\tCREATED")
+	}
+	for _, key := range keys {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", key.AccessKeyID, key.UserID, teb.FmtDateTime(key.Created))
+	}
+	return tw.Flush()
+}
+
+func deleteAuthS3KeyHandler(c *cli.Context) error {
+	akid := c.Args().Get(0)
+	if akid == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	return authn.DeleteS3Key(authParams, akid)
+}
+
+func showAuthSessionHandler(c *cli.Context) error {
+	sessions, err := authn.GetSessions(authParams, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "SESSION ID\tUSER\tCREATED\tEXPIRES\tSCOPE")
+	}
+	for _, sess := range sessions {
+		expires, scope := teb.NotSetVal, teb.NotSetVal
+		if !sess.Expires.IsZero() {
+			expires = teb.FmtDateTime(sess.Expires)
+		}
+		if len(sess.Scope) > 0 {
+			buckets := make([]string, 0, len(sess.Scope))
+			for _, b := range sess.Scope {
+				buckets = append(buckets, b.Bck.Cname(""))
+			}
+			scope = strings.Join(buckets, ", ")
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", sess.ID, sess.UserID, teb.FmtDateTime(sess.Created), expires, scope)
+	}
+	return tw.Flush()
+}
+
+func deleteAuthSessionHandler(c *cli.Context) error {
+	sid := c.Args().Get(0)
+	if sid == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	return authn.DeleteSession(authParams, sid)
+}
+
 func showAuthConfigHandler(c *cli.Context) (err error) {
 	conf, err := authn.GetConfig(authParams)
 	if err != nil {
@@ -49,6 +49,16 @@ func createBucket(c *cli.Context, bck cmn.Bck, props *cmn.BpropsToSet, dontHeadR
 func destroyBuckets(c *cli.Context, buckets []cmn.Bck) (cmn.Bck, error) {
 	for i := range buckets {
 		bck := buckets[i]
+		if flagIsSet(c, dryRunFlag) {
+			all, err := api.DryRunDestroyBucket(apiBP, bck, apc.ActDestroyBck)
+			if err != nil {
+				return bck, V(err)
+			}
+			if err := printDryRun(c, "destroy "+bck.Cname(""), all); err != nil {
+				return bck, err
+			}
+			continue
+		}
 		empty, errEmp := isBucketEmpty(bck, true /*cached*/)
 		if errEmp == nil && !empty {
 			if !flagIsSet(c, yesFlag) {
@@ -72,6 +82,13 @@ func mvBucket(c *cli.Context, bckFrom, bckTo cmn.Bck) error {
 			return err
 		}
 	}
+	if flagIsSet(c, dryRunFlag) {
+		all, err := api.DryRunRenameBucket(apiBP, bckFrom, bckTo)
+		if err != nil {
+			return V(err)
+		}
+		return printDryRun(c, "rename "+bckFrom.Cname("")+" => "+bckTo.Cname(""), all)
+	}
 	xid, err := api.RenameBucket(apiBP, bckFrom, bckTo)
 	if err != nil {
 		return V(err)
@@ -0,0 +1,148 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles per-prefix bucket permissions (bucket property 'prefix_acl').
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+
+	"github.com/urfave/cli"
+)
+
+const bucketACLUsage = "Show and update per-prefix bucket permissions (in addition to the bucket-wide 'access' property);\n" +
+	indent1 + "principal is a user ID, 'role:<role-name>', or '*' (anyone), e.g.:\n" +
+	indent1 + "\t- 'ais bucket acl set ais://abc role:team-a team-a/ rw'\t- read-write access to objects under 'team-a/' for the role;\n" +
+	indent1 + "\t- 'ais bucket acl set ais://abc '*' public/ GET HEAD-OBJECT'\t- anyone can read objects under 'public/';\n" +
+	indent1 + "\t- 'ais bucket acl rm ais://abc role:team-a team-a/'\t- remove the entry;\n" +
+	indent1 + "\t- 'ais bucket acl show ais://abc'\t- show all per-prefix entries"
+
+const (
+	bucketACLSetArgument = bucketArgument + " PRINCIPAL PREFIX PERMISSION [PERMISSION...]"
+	bucketACLRmArgument  = bucketArgument + " PRINCIPAL PREFIX"
+)
+
+var (
+	bucketCmdACL = cli.Command{
+		Name:  "acl",
+		Usage: bucketACLUsage,
+		Subcommands: []cli.Command{
+			{
+				Name:         commandShow,
+				Usage:        "Show per-prefix bucket permissions",
+				ArgsUsage:    bucketArgument,
+				Flags:        sortFlags([]cli.Flag{noHeaderFlag, jsonFlag}),
+				Action:       showBucketACLHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
+			{
+				Name:         cmdSetBprops,
+				Usage:        "Grant permissions for objects with a given prefix (replaces the principal's existing entry, if any)",
+				ArgsUsage:    bucketACLSetArgument,
+				Action:       setBucketACLHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
+			{
+				Name:         commandRemove,
+				Usage:        "Remove per-prefix permissions",
+				ArgsUsage:    bucketACLRmArgument,
+				Action:       rmBucketACLHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
+		},
+	}
+)
+
+func showBucketACLHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, bucketArgument)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	props, err := headBucket(bck, true /*don't add*/)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(props.PrefixACL, "", teb.Jopts(true))
+	}
+	if len(props.PrefixACL) == 0 {
+		actionDone(c, "No per-prefix permissions in "+bck.Cname("")+" (bucket-wide access: "+props.Access.Describe(false)+")")
+		return nil
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "PRINCIPAL\tPREFIX\tPERMISSIONS")
+	}
+	for _, e := range props.PrefixACL {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", e.Principal, e.Prefix, e.Access.Describe(true /*incl. all*/))
+	}
+	return tw.Flush()
+}
+
+func setBucketACLHandler(c *cli.Context) error {
+	if c.NArg() < 4 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	entry := cmn.PrefixACE{Principal: c.Args().Get(1), Prefix: c.Args().Get(2)}
+	for _, perm := range c.Args()[3:] {
+		p, err := apc.StrToAccess(perm)
+		if err != nil {
+			return err
+		}
+		entry.Access |= p
+	}
+	if err := (cmn.PrefixACL{entry}).ValidateAsProps(); err != nil {
+		return incorrectUsageMsg(c, "%v", err)
+	}
+	props, err := headBucket(bck, false /*don't add*/)
+	if err != nil {
+		return err
+	}
+	acl := slices.Clone(props.PrefixACL)
+	if i := _findPrefixACE(acl, entry.Principal, entry.Prefix); i >= 0 {
+		acl[i] = entry
+	} else {
+		acl = append(acl, entry)
+	}
+	return updateBckProps(c, bck, props, &cmn.BpropsToSet{PrefixACL: &acl})
+}
+
+func rmBucketACLHandler(c *cli.Context) error {
+	if c.NArg() < 3 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	principal, prefix := c.Args().Get(1), c.Args().Get(2)
+	props, err := headBucket(bck, false /*don't add*/)
+	if err != nil {
+		return err
+	}
+	i := _findPrefixACE(props.PrefixACL, principal, prefix)
+	if i < 0 {
+		return fmt.Errorf("%s: no permissions for %q on prefix %q", bck.Cname(""), principal, prefix)
+	}
+	acl := slices.Delete(slices.Clone(props.PrefixACL), i, i+1)
+	return updateBckProps(c, bck, props, &cmn.BpropsToSet{PrefixACL: &acl})
+}
+
+func _findPrefixACE(acl cmn.PrefixACL, principal, prefix string) int {
+	return slices.IndexFunc(acl, func(e cmn.PrefixACE) bool { return e.Principal == principal && e.Prefix == prefix })
+}
@@ -188,6 +188,8 @@ var (
 		templateFlag,
 		listObjPrefixFlag,
 		pageSizeFlag,
+		snapFlag,
+		allVersionsFlag,
 		pagedFlag,
 		objLimitFlag,
 		refreshFlag,
@@ -226,6 +228,8 @@ var (
 			ignoreErrorFlag,
 			yesFlag,
 			rmAllBucketsFlag,
+			dryRunFlag,
+			jsonFlag,
 		},
 		commandCopy: {
 			listFlag,
@@ -245,12 +249,15 @@ var (
 			syncFlag,
 			nonRecursFlag, // (embedded prefix dopOLTP)
 			nonverboseFlag,
+			prioFlag,
 		},
 		commandRename: {
 			waitFlag,
 			waitJobXactFinishedFlag,
 			nonverboseFlag,
 			dontHeadRemoteFlag,
+			dryRunFlag,
+			jsonFlag,
 		},
 		commandEvict: append(
 			listRangeProgressWaitFlags,
@@ -263,6 +270,7 @@ var (
 			dontHeadRemoteFlag,
 			evictAllBucketsFlag,
 			yesFlag,
+			jsonFlag, // (with --dry-run)
 		),
 		cmdSetBprops: {
 			forceFlag,
@@ -376,6 +384,11 @@ var (
 				replace:  cos.StrKVs{joinCommandWords(commandETL, commandBucket): joinCommandWords(commandBucket, commandETL)},
 			}),
 			bucketCmdRename,
+			bucketCmdSnap,
+			bucketCmdSearch,
+			bucketCmdInventory,
+			bucketCmdTrash,
+			bucketCmdACL,
 			{
 				Name:      commandRemove,
 				Usage:     "Remove AIS buckets; use '--all' to remove all AIS buckets, '--yes' to skip confirmation",
@@ -574,6 +587,10 @@ func removeAllBuckets(c *cli.Context) error {
 		fmt.Fprintln(c.App.Writer, "No AIS buckets to remove")
 		return nil
 	}
+	if flagIsSet(c, dryRunFlag) {
+		_, err := destroyBuckets(c, bcks)
+		return err
+	}
 
 	// --yes flag is ignored for safety reasons
 	if flagIsSet(c, yesFlag) {
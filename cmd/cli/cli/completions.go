@@ -64,6 +64,7 @@ var (
 		"checksum.validate_cold_get":          supportedBool,
 		"checksum.validate_warm_get":          supportedBool,
 		"checksum.validate_obj_move":          supportedBool,
+		"checksum.end_to_end":                 supportedBool,
 		"ec.enabled":                          supportedBool,
 		"fshc.enabled":                        supportedBool,
 		"lru.enabled":                         supportedBool,
@@ -83,14 +83,24 @@ const (
 	cmdLhotseGetBatch = "lhotse-get-batch"
 
 	// jobs
-	commandStart = apc.ActXactStart
-	commandStop  = apc.ActXactStop
-	commandWait  = "wait"
-
-	cmdSmap   = apc.WhatSmap
-	cmdBMD    = apc.WhatBMD
-	cmdConfig = "config" // apc.WhatNodeConfig and apc.WhatClusterConfig
-	cmdLog    = apc.WhatLog
+	commandStart  = apc.ActXactStart
+	commandStop   = apc.ActXactStop
+	commandPause  = apc.ActXactPause
+	commandResume = apc.ActXactResume
+	commandWait   = "wait"
+
+	// scheduled jobs
+	cmdJobSched    = "schedule"
+	cmdJobSchedAdd = "add"
+
+	cmdSmap        = apc.WhatSmap
+	cmdBMD         = apc.WhatBMD
+	cmdDomains     = "domains"
+	cmdHrw         = "hrw-preview"
+	cmdTenants     = "tenants"
+	cmdReplication = "replication"
+	cmdConfig      = "config" // apc.WhatNodeConfig and apc.WhatClusterConfig
+	cmdLog         = apc.WhatLog
 
 	cmdBucket = "bucket"
 	cmdObject = "object"
@@ -194,6 +204,10 @@ const (
 	cmdAuthConfig  = cmdConfig
 	cmdAuthOIDC    = "oidc"
 	cmdAuthJWKS    = "jwks"
+	cmdAuthS3Key   = "s3key"
+	cmdAuthSession = "session"
+	cmdAuthCert    = "cert"
+	cmdAuthRefresh = "refresh"
 
 	// K8s subcommans
 	cmdK8s        = "kubectl"
@@ -301,6 +315,8 @@ const (
 	optionalJobIDDaemonIDArgument = "[JOB_ID [NODE_ID]]"
 
 	jobAnyArg                = "[NAME] [JOB_ID] [NODE_ID] [BUCKET]"
+	jobSchedAddArgument      = "SCHEDULE_NAME JOB_NAME [BUCKET] [DST_BUCKET]"
+	jobSchedNameArgument     = "SCHEDULE_NAME"
 	jobShowRebalanceArgument = "[REB_ID] [NODE_ID]"
 
 	// Perf
@@ -427,6 +443,13 @@ const (
 	addSetAuthRoleArgument    = "ROLE [PERMISSION ...]"
 	deleteAuthRoleArgument    = "ROLE"
 	deleteAuthTokenArgument   = "TOKEN | TOKEN_FILE" //nolint:gosec // false positive G101
+	addAuthS3KeyArgument      = "USER_NAME"
+	showAuthS3KeyArgument     = "[USER_NAME]"
+	deleteAuthS3KeyArgument   = "ACCESS_KEY_ID"
+	showAuthSessionArgument   = "[USER_NAME]"
+	deleteAuthSessionArgument = "SESSION_ID"
+	addAuthCertArgument       = "IDENTITY {USER_NAME | role:ROLE [role:ROLE...]}"
+	deleteAuthCertArgument    = "IDENTITY"
 
 	// Alias
 	aliasURLPairArgument = "ALIAS=URL (or UUID=URL)"
@@ -674,6 +697,35 @@ var (
 		Usage: "Show top N most recent jobs (e.g., --top 5 to show the 5 most recent jobs)",
 	}
 
+	// job history
+	jobHistoryFlag = cli.BoolFlag{
+		Name: "history",
+		Usage: "Show persistent history of finished jobs (survives cluster restarts);\n" +
+			indent4 + "\tuse with job name, job ID, and/or bucket to filter, and with '--top' to limit the number of jobs",
+	}
+	jobHistUserFlag = cli.StringFlag{
+		Name:  "user",
+		Usage: "(with '--history') show only the jobs started by a given (authenticated) user",
+	}
+	jobHistSinceFlag = DurationFlag{
+		Name:  "since",
+		Usage: "(with '--history') show only the jobs finished within the specified duration back from now, e.g. '30m', '24h'",
+	}
+	jobHistErrFlag = cli.BoolFlag{
+		Name:  "errors",
+		Usage: "(with '--history') show only failed or aborted jobs",
+	}
+
+	// scheduled jobs
+	jobCronFlag = cli.StringFlag{
+		Name: "cron",
+		Usage: "Cron-style schedule (minute hour day-of-month month day-of-week, primary's local time), e.g.:\n" +
+			indent4 + "\t--cron '0 2 * * *'\t- daily at 2am;\n" +
+			indent4 + "\t--cron '*/30 * * * 1-5'\t- every 30 minutes on weekdays;\n" +
+			indent4 + "\t--cron @hourly (also: @daily, @weekly, @monthly)",
+		Required: true,
+	}
+
 	// list-objects
 	startAfterFlag = cli.StringFlag{
 		Name:  "start-after",
@@ -848,6 +900,87 @@ var (
 		EnvVar: "HF_TOKEN",
 	}
 
+	// bucket snapshots
+	snapFlag = cli.StringFlag{
+		Name:  "snap",
+		Usage: "Read or list objects as of a given (named) bucket snapshot (ais:// buckets only; see 'ais bucket snapshot')",
+	}
+
+	// audit log ('ais log audit')
+	auditUserFlag = cli.StringFlag{
+		Name:  "user",
+		Usage: "Show only the audit records of a given (authenticated) user",
+	}
+	auditActionFlag = cli.StringFlag{
+		Name:  "action",
+		Usage: "Show only the audit records of a given control-plane action, e.g. 'destroy-bck' or 'set-config'",
+	}
+	auditBckFlag = cli.StringFlag{
+		Name:  "bucket",
+		Usage: "Show only the audit records of a given bucket, e.g. 'ais://abc'",
+	}
+	auditSinceFlag = DurationFlag{
+		Name:  "since",
+		Usage: "Show only the audit records for the specified duration back from now, e.g. '30m', '24h'",
+	}
+	auditLimitFlag = cli.IntFlag{
+		Name:  "limit",
+		Usage: "The maximum number of (most recent) audit records to show",
+		Value: 100,
+	}
+
+	// metadata search (buckets with 'md_index.enabled=true')
+	mdSearchSortFlag = cli.StringFlag{
+		Name:  "sort",
+		Usage: "Sort the results by one of: name, size, atime, version",
+		Value: apc.MDFieldName,
+	}
+	mdSearchDescFlag = cli.BoolFlag{
+		Name:  "desc",
+		Usage: "Sort in descending order",
+	}
+	mdSearchLimitFlag = cli.IntFlag{
+		Name:  "limit",
+		Usage: "The maximum number of objects to show",
+		Value: apc.MDSearchDfltLimit,
+	}
+
+	// bucket inventory export
+	invFormatFlag = cli.StringFlag{
+		Name:  "format",
+		Usage: "Inventory format: " + apc.InvFormatCSV + ", " + apc.InvFormatJSONL + ", or " + apc.InvFormatParquet,
+		Value: apc.InvFormatCSV,
+	}
+	invDstPrefixFlag = cli.StringFlag{
+		Name:  "dst-prefix",
+		Usage: "Destination object name prefix (inventory objects are named PREFIX/SRC_BUCKET/JOB_ID/TARGET_ID.FORMAT)",
+		Value: apc.InvDfltPrefix,
+	}
+	invNoCustomFlag = cli.BoolFlag{
+		Name:  "no-custom",
+		Usage: "Do not include custom object metadata",
+	}
+	invSnapFlag = cli.StringFlag{
+		Name:  "snap",
+		Usage: "Export inventory as of a given (existing) bucket snapshot (default: as of job start, via temporary snapshot)",
+	}
+
+	// trash (ais:// buckets with 'trash.enabled')
+	trashIDFlag = cli.StringFlag{
+		Name:  "id",
+		Usage: "Restore a specific deleted copy of the object (see 'ais bucket trash ls'); default: the most recently deleted copy",
+	}
+
+	// object version history (ais:// buckets with 'versioning.max_history' > 0)
+	versionIDFlag = cli.StringFlag{
+		Name:  "version-id",
+		Usage: "Read or (permanently) remove a given (current or non-current) object version (ais:// buckets only)",
+	}
+	allVersionsFlag = cli.BoolFlag{
+		Name:  "all-versions",
+		Usage: "List all object versions including non-current versions and delete markers (ais:// buckets only)",
+	}
+
 	// latestVer and sync
 	latestVerFlag = cli.BoolFlag{
 		Name: "latest",
@@ -1075,6 +1208,19 @@ var (
 		Name:  "objsize-limit",
 		Usage: "Object size threshold for chunking in IEC or SI units (e.g.: 50MiB, 100mb); objects >= this size will be chunked",
 	}
+	// usage: scrub (target-side)
+	scrubChecksumFlag = cli.BoolFlag{
+		Name: "checksum",
+		Usage: "Run scrub on the cluster side (as a job) and recompute content checksums of all visited objects;\n" +
+			indent1 + "\tnote: reads the entire bucket (slow)",
+	}
+	scrubFixFlag = cli.BoolFlag{
+		Name: "fix",
+		Usage: "Run scrub on the cluster side (as a job) and repair detected inconsistencies:\n" +
+			indent1 + "\trestore corrupted objects (from a good copy, via EC, or from remote backend),\n" +
+			indent1 + "\tre-mirror, re-encode, and remove orphaned chunks",
+	}
+
 	syncRemoteFlag = cli.BoolFlag{
 		Name: "sync-remote",
 		Usage: "Write rechunked objects to remote backend via multipart upload;\n" +
@@ -1107,6 +1253,11 @@ var (
 			noWorkers +
 			indent4 + "\tany positive value will be adjusted _not_ to exceed the number of target CPUs",
 	}
+	prioFlag = cli.StringFlag{
+		Name: "prio",
+		Usage: "Priority class of the batch job(s) to start: one of \"low\", \"normal\" (default), \"high\";\n" +
+			indent4 + "\tsubject to cluster config 'xact_sched' (max concurrently running jobs per class and disk-utilization based throttling)",
+	}
 	numBlobWorkersFlag = cli.IntFlag{
 		Name:  "num-workers",
 		Usage: "Number of concurrent blob-downloading workers (readers); system default when omitted or zero",
@@ -1161,6 +1312,22 @@ var (
 		Name:  "cluster",
 		Usage: "Comma-separated list of AIS cluster IDs (type ',' for an empty cluster ID)",
 	}
+	nsRoleFlag = cli.StringFlag{
+		Name: "namespace",
+		Usage: "Bind role to a tenant (bucket namespace, e.g. 'acme' for ais://@#acme/...):\n" +
+			indent4 + "\tusers with this role will see and access only the tenant's buckets",
+	}
+	scopeTokenFlag = cli.StringFlag{
+		Name: "scope",
+		Usage: "Limit the token to the specified buckets (and, at most, the specified permissions), e.g.:\n" +
+			indent4 + "\t--scope 'ais://b1=ro,s3://b2=rw'\t- read-only access to ais://b1 and read-write access to s3://b2;\n" +
+			indent4 + "\t--scope 'ais://b1=GET+HEAD-OBJECT'\t- individual permissions joined with '+'",
+	}
+	prefixRoleFlag = cli.StringFlag{
+		Name: "prefix",
+		Usage: "Grant the role's bucket permissions only for objects with names that start with the prefix\n" +
+			indent4 + "\t(in addition to bucket-wide permissions, if any; requires '--bucket')",
+	}
 
 	// archive
 	listArchFlag = cli.BoolFlag{Name: "archive", Usage: "List archived content (see docs/archive.md for details)"}
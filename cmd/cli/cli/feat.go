@@ -49,6 +49,8 @@ var clusterFeatDesc = [...]string{
 	"resume interrupted multipart uploads from persisted partial manifests",
 	"do not delete unrecognized/invalid FQNs during space cleanup ('ais space-cleanup')",
 	"when bucket is n-way mirrored read object replica from the least-utilized mountpath",
+	"capacity-weighted HRW: place objects on targets and mountpaths proportionally to their sizes (toggling starts rebalance and resilver)",
+	"accept UNSIGNED-PAYLOAD in S3 requests signed with AuthN-issued access keys (default: require payload hash or signed chunks)",
 
 	// apc.ResetToken ("none") ===========
 }
@@ -79,6 +81,8 @@ var featTags = map[string]string{
 	"Resume-Interrupted-MPU":               "mpu,ops",
 	"Keep-Unknown-FQN":                     "integrity?,ops",
 	"Load-Balance-GET":                     "perf",
+	"Weighted-HRW":                         "placement,ops",
+	"S3-Unsigned-Payload":                  "s3,security,compat",
 }
 
 // common (cluster, bucket) feature-flags (set, show) helper
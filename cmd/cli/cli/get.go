@@ -696,6 +696,14 @@ func (a *qparamArch) getQuery(c *cli.Context, bck *cmn.Bck) (q url.Values) {
 		f()
 		q.Set(apc.QparamLatestVer, "true")
 	}
+	if flagIsSet(c, snapFlag) {
+		f()
+		q.Set(apc.QparamSnap, parseStrFlag(c, snapFlag))
+	}
+	if flagIsSet(c, versionIDFlag) {
+		f()
+		q.Set(apc.QparamVersionID, parseStrFlag(c, versionIDFlag))
+	}
 	return q
 }
 
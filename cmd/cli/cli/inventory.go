@@ -0,0 +1,83 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles bucket inventory export.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/xact"
+
+	"github.com/urfave/cli"
+)
+
+const inventoryUsage = "Export inventory of an ais:// bucket into (existing) destination bucket;\n" +
+	indent1 + "each target writes one inventory object (one record per object: name, size, checksum, version, atime, custom metadata), e.g.:\n" +
+	indent1 + "\t- 'ais bucket inventory ais://abc ais://reports'\t- write CSV inventory of ais://abc into ais://reports/.inventory/abc/JOB_ID/;\n" +
+	indent1 + "\t- 'ais bucket inventory ais://abc ais://reports --format jsonl --prefix images/ --wait'\t- JSONL inventory of 'images/*', and wait for completion;\n" +
+	indent1 + "\t- 'ais bucket inventory ais://abc ais://reports --format parquet'\t- Parquet inventory (custom metadata as MAP<string, string>);\n" +
+	indent1 + "\t- 'ais bucket inventory ais://abc ais://reports --snap daily'\t- inventory of ais://abc as of existing snapshot 'daily'.\n" +
+	indent1 + "Note: the inventory is point-in-time - unless '--snap' is given, the job creates (and then removes) a temporary bucket snapshot;\n" +
+	indent1 + "erasure-coded buckets are not supported, and Parquet requires aisnode built with 'parquet' build tag."
+
+var (
+	bucketCmdInventory = cli.Command{
+		Name:      apc.ActInventory,
+		Usage:     inventoryUsage,
+		ArgsUsage: bucketSrcArgument + " " + bucketDstArgument,
+		Flags: sortFlags([]cli.Flag{
+			invFormatFlag,
+			invDstPrefixFlag,
+			invNoCustomFlag,
+			invSnapFlag,
+			verbObjPrefixFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+		}),
+		Action:       inventoryHandler,
+		BashComplete: manyBucketsCompletions([]cli.BashCompleteFunc{}, 0),
+	}
+)
+
+func inventoryHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, bucketSrcArgument, bucketDstArgument)
+	}
+	if c.NArg() == 1 {
+		return missingArgumentsError(c, bucketDstArgument)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	bckTo, err := parseBckURI(c, c.Args().Get(1), false)
+	if err != nil {
+		return err
+	}
+	msg := &apc.InventoryMsg{
+		Prefix:    parseStrFlag(c, verbObjPrefixFlag),
+		Format:    parseStrFlag(c, invFormatFlag),
+		DstPrefix: parseStrFlag(c, invDstPrefixFlag),
+		NoCustom:  flagIsSet(c, invNoCustomFlag),
+		Snap:      parseStrFlag(c, invSnapFlag),
+	}
+	if err := msg.Validate(); err != nil {
+		return incorrectUsageMsg(c, "%v", err)
+	}
+	xid, err := api.ExportInventory(apiBP, bck, bckTo, msg)
+	if err != nil {
+		return V(err)
+	}
+
+	_, xname := xact.GetKindName(apc.ActInventory)
+	text := fmt.Sprintf("%s: %s => %s", xact.Cname(xname, xid), bck.Cname(""), bckTo.Cname(msg.DstPrefix+bck.Name+"/"+xid+"/"))
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+	return waitJob(c, xname, xid, bck)
+}
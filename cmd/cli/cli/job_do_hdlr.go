@@ -94,6 +94,17 @@ const stopUsage = "Terminate a single batch job or multiple jobs, e.g.:\n" +
 	indent1 + "\t- 'stop --all'\t- terminate all running jobs\n" +
 	indent1 + tabHelpOpt + "."
 
+const pauseUsage = "Pause a long-running job without losing its progress, e.g.:\n" +
+	indent1 + "\t- 'pause copy-bucket-XYZ'\t- pause a given job identified by its unique ID;\n" +
+	indent1 + "\t- 'pause copy-bucket'\t- pause all bucket-to-bucket copies;\n" +
+	indent1 + "\t- 'pause prefetch s3://abc'\t- pause all prefetch jobs in a given bucket.\n" +
+	indent1 + "Paused job continues from where it left off upon 'ais job resume';\n" +
+	indent1 + "only jobs that walk buckets or iterate lists and ranges of objects can be paused."
+
+const resumeUsage = "Resume previously paused job(s), e.g.:\n" +
+	indent1 + "\t- 'resume copy-bucket-XYZ'\t- resume a given job;\n" +
+	indent1 + "\t- 'resume prefetch s3://abc'\t- resume all paused prefetch jobs in a given bucket."
+
 // top-level job command
 var (
 	jobCmd = cli.Command{
@@ -105,8 +116,11 @@ var (
 	jobSub = []cli.Command{
 		jobStartSub,
 		jobStopSub,
+		jobPauseSub,
+		jobResumeSub,
 		jobWaitSub,
 		jobRemoveSub,
+		jobSchedSub,
 		makeAlias(&showCmdJob, &mkaliasOpts{newName: commandShow}),
 	}
 )
@@ -117,6 +131,7 @@ var (
 		waitFlag,
 		waitJobXactFinishedFlag,
 		nonverboseFlag,
+		prioFlag,
 	}
 	startSpecialFlags = map[string][]cli.Flag{
 		commandRebalance: {
@@ -158,6 +173,7 @@ var (
 			yesFlag,
 			numWorkersFlag,
 			dontHeadRemoteFlag,
+			prioFlag,
 		),
 		cmdBlobDownload: {
 			refreshFlag,
@@ -175,6 +191,9 @@ var (
 			lruBucketsFlag,
 			forceFlag,
 			nonverboseFlag,
+			prioFlag,
+			dryRunFlag,
+			jsonFlag,
 		},
 	}
 
@@ -270,6 +289,24 @@ var (
 	}
 )
 
+// ais job pause | resume
+var (
+	jobPauseSub = cli.Command{
+		Name:         commandPause,
+		Usage:        pauseUsage,
+		ArgsUsage:    jobAnyArg,
+		Action:       pauseJobHandler,
+		BashComplete: runningJobCompletions,
+	}
+	jobResumeSub = cli.Command{
+		Name:         commandResume,
+		Usage:        resumeUsage,
+		ArgsUsage:    jobAnyArg,
+		Action:       pauseJobHandler,
+		BashComplete: runningJobCompletions,
+	}
+)
+
 // ais wait
 var (
 	waitCmdsFlags = []cli.Flag{
@@ -447,6 +484,9 @@ func startResilverHandler(c *cli.Context) error {
 }
 
 func startXaction(c *cli.Context, xargs *xact.ArgsMsg, extra string) error {
+	if err := setJobPrio(c); err != nil {
+		return err
+	}
 	if !xargs.Bck.IsQuery() {
 		if _, err := headBucket(xargs.Bck, false /* don't add */); err != nil {
 			return err
@@ -1103,11 +1143,12 @@ func bgDownload(c *cli.Context, id string) (err error) {
 }
 
 func startLRUHandler(c *cli.Context) error {
-	if !flagIsSet(c, lruBucketsFlag) {
+	dryRun := flagIsSet(c, dryRunFlag)
+	if !flagIsSet(c, lruBucketsFlag) && !dryRun {
 		return startXactionHandler(c)
 	}
 
-	if flagIsSet(c, forceFlag) {
+	if flagIsSet(c, forceFlag) && !dryRun {
 		warn := fmt.Sprintf("LRU eviction with %s option will evict buckets _ignoring_ their respective `lru.enabled` properties.",
 			qflprn(forceFlag))
 		if !confirm(c, "Would you like to continue?", warn) {
@@ -1115,17 +1156,29 @@ func startLRUHandler(c *cli.Context) error {
 		}
 	}
 
-	s := parseStrFlag(c, lruBucketsFlag)
-	bckArgs := splitCsv(s)
-	buckets := make([]cmn.Bck, len(bckArgs))
-	for idx, bckArg := range bckArgs {
-		bck, err := parseBckURI(c, bckArg, false)
+	var buckets []cmn.Bck
+	if flagIsSet(c, lruBucketsFlag) {
+		bckArgs := splitCsv(parseStrFlag(c, lruBucketsFlag))
+		buckets = make([]cmn.Bck, len(bckArgs))
+		for idx, bckArg := range bckArgs {
+			bck, err := parseBckURI(c, bckArg, false)
+			if err != nil {
+				return err
+			}
+			buckets[idx] = bck
+		}
+	}
+	if dryRun {
+		all, err := api.DryRunLRU(apiBP, &xact.ArgsMsg{Buckets: buckets, Force: flagIsSet(c, forceFlag)})
 		if err != nil {
-			return err
+			return V(err)
 		}
-		buckets[idx] = bck
+		return printDryRun(c, "LRU eviction", all)
 	}
 
+	if err := setJobPrio(c); err != nil {
+		return err
+	}
 	xargs := xact.ArgsMsg{Kind: apc.ActLRU, Buckets: buckets, Force: flagIsSet(c, forceFlag)}
 	xid, err := xstart(&xargs, "")
 	if err != nil {
@@ -1136,6 +1189,19 @@ func startLRUHandler(c *cli.Context) error {
 	return nil
 }
 
+// --prio: applies to all batch jobs started by the command (see api/apc/prio.go)
+func setJobPrio(c *cli.Context) error {
+	if !flagIsSet(c, prioFlag) {
+		return nil
+	}
+	prio := parseStrFlag(c, prioFlag)
+	if err := apc.ValidatePrio(prio); err != nil {
+		return err
+	}
+	apiBP.Prio = prio
+	return nil
+}
+
 //
 // job stop
 //
@@ -1293,6 +1359,49 @@ func stopJobHandler(c *cli.Context) error {
 	return nil
 }
 
+// pause or resume (see xact.Table: "Pausable")
+func pauseJobHandler(c *cli.Context) error {
+	resume := c.Command.Name == commandResume
+	name, xid, daemonID, bck, err := jobArgs(c, 0, true /*ignore daemonID*/)
+	if err != nil {
+		return err
+	}
+	if name == "" && xid == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if daemonID != "" {
+		actionWarn(c, fmt.Sprintf("node ID %q will be ignored (pausing job on a given node not supported)\n", daemonID))
+	}
+
+	var xactKind, xname string
+	if name != "" {
+		xactKind, xname = xact.GetKindName(name)
+		if xactKind == "" {
+			return incorrectUsageMsg(c, "unrecognized or misplaced option '%s'", name)
+		}
+		if !xact.Table[xactKind].Pausable {
+			return fmt.Errorf("%s job cannot be paused", xname)
+		}
+	}
+
+	args := xact.ArgsMsg{ID: xid, Kind: xactKind, Bck: bck}
+	if resume {
+		err = api.ResumeXaction(apiBP, &args)
+	} else {
+		err = api.PauseXaction(apiBP, &args)
+	}
+	if err != nil {
+		return V(err)
+	}
+	msg := formatXactMsg(xid, xname, bck)
+	if resume {
+		actionDone(c, "Resumed "+msg)
+	} else {
+		actionDone(c, "Paused "+msg+" (to continue, run 'ais job resume')")
+	}
+	return nil
+}
+
 // NOTE: the '--all' case when both (xactKind == "" && xname == "") - is also handled here
 // TODO: aistore supports `bck` for additional filtering (NIY)
 func stopXactionKindOrAll(c *cli.Context, xactKind, xname string, bck cmn.Bck) error {
@@ -0,0 +1,83 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles persistent job history: `ais show job --history`
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+
+	"github.com/urfave/cli"
+)
+
+func showJobHistory(c *cli.Context, name, xid string, bck cmn.Bck) error {
+	msg := &xact.HistQueryMsg{
+		ID:      xid,
+		Kind:    name,
+		Bck:     bck,
+		User:    parseStrFlag(c, jobHistUserFlag),
+		Limit:   parseIntFlag(c, topFlag),
+		OnlyErr: flagIsSet(c, jobHistErrFlag),
+	}
+	if flagIsSet(c, jobHistSinceFlag) {
+		msg.Since = time.Now().Add(-parseDurationFlag(c, jobHistSinceFlag))
+	}
+	entries, err := api.GetJobHistory(apiBP, msg)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(entries, "", teb.Jopts(true))
+	}
+	if len(entries) == 0 {
+		actionDone(c, "No matching jobs in the history")
+		return nil
+	}
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "JOB\tBUCKET\tUSER\tOBJECTS\tBYTES\tSTART\tEND\tSTATE")
+	}
+	for _, e := range entries {
+		objs, size := e.Stats.Objs+e.Stats.InObjs, e.Stats.Bytes+e.Stats.InBytes
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			xact.Cname(e.Kind, e.ID), _histBcks(e.Buckets), _dash(e.User),
+			strconv.FormatInt(objs, 10), cos.ToSizeIEC(size, 2),
+			teb.FmtDateTime(e.StartTime), teb.FmtDateTime(e.EndTime), _histState(e))
+	}
+	return tw.Flush()
+}
+
+func _histBcks(bcks []cmn.Bck) string {
+	if len(bcks) == 0 {
+		return teb.NotSetVal
+	}
+	names := make([]string, len(bcks))
+	for i := range bcks {
+		names[i] = bcks[i].Cname("")
+	}
+	return strings.Join(names, " => ")
+}
+
+func _histState(e *xact.HistEntry) string {
+	switch {
+	case e.Aborted:
+		return "Aborted"
+	case e.Err != "":
+		return "Finished with errors: " + strconv.Quote(e.Err)
+	default:
+		return "Finished"
+	}
+}
@@ -0,0 +1,219 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles scheduled (recurring) jobs: `ais job schedule add|ls|rm`
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+
+	"github.com/urfave/cli"
+)
+
+var (
+	jobSchedAddFlags = []cli.Flag{
+		jobCronFlag,
+		verbObjPrefixFlag,
+		listFlag,
+		templateFlag,
+		latestVerFlag,
+		prioFlag,
+	}
+	jobSchedSub = cli.Command{
+		Name:  cmdJobSched,
+		Usage: "Manage scheduled (recurring) jobs started by the cluster on a cron schedule",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdJobSchedAdd,
+				Usage: "Add scheduled job, e.g.:\n" +
+					indent1 + "\t- 'ais job schedule add nightly-lru lru --cron \"0 2 * * *\"'\t- run LRU eviction daily at 2am;\n" +
+					indent1 + "\t- 'ais job schedule add sync-abc copy-bucket s3://abc ais://abc --cron @hourly --latest'\t- hourly copy (sync) remote bucket;\n" +
+					indent1 + "\t- 'ais job schedule add pf prefetch s3://abc --prefix images/ --cron \"30 1 * * 1-5\"'\t- prefetch on weekdays.\n" +
+					indent1 + "\tThe run is skipped when the previous job of the same kind (on the same bucket) is still running.",
+				ArgsUsage:    jobSchedAddArgument,
+				Flags:        sortFlags(jobSchedAddFlags),
+				Action:       addJobSchedHandler,
+				BashComplete: jobSchedAddCompletions,
+			},
+			{
+				Name:   commandList,
+				Usage:  "List scheduled jobs along with their next and last runs",
+				Flags:  sortFlags([]cli.Flag{jsonFlag, noHeaderFlag}),
+				Action: lsJobSchedHandler,
+			},
+			{
+				Name:         commandRemove,
+				Usage:        "Remove scheduled job",
+				ArgsUsage:    jobSchedNameArgument,
+				Action:       rmJobSchedHandler,
+				BashComplete: jobSchedCompletions,
+			},
+		},
+	}
+)
+
+func addJobSchedHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	var (
+		name  = c.Args().Get(0)
+		jname = c.Args().Get(1)
+		job   = &xact.CronJob{Name: name, Cron: parseStrFlag(c, jobCronFlag)}
+	)
+	kind, _ := xact.GetKindName(jname)
+	if kind == "" {
+		if kind, _ = xact.GetSimilar(jname); kind == "" {
+			return fmt.Errorf("unknown job %q (see 'ais job start --help' for supported names)", jname)
+		}
+	}
+	if c.NArg() > 2 {
+		bck, err := parseBckURI(c, c.Args().Get(2), false)
+		if err != nil {
+			return err
+		}
+		job.Bck = bck
+	}
+	switch kind {
+	case apc.ActCopyBck:
+		if c.NArg() < 4 {
+			return missingArgumentsError(c, bucketDstArgument)
+		}
+		bckTo, err := parseBckURI(c, c.Args().Get(3), false)
+		if err != nil {
+			return err
+		}
+		job.BckTo = bckTo
+		msg := &apc.TCBMsg{}
+		msg.Prefix = parseStrFlag(c, verbObjPrefixFlag)
+		msg.LatestVer = flagIsSet(c, latestVerFlag)
+		job.Msg = apc.ActMsg{Action: kind, Value: msg}
+	case apc.ActPrefetchObjects, apc.ActEvictObjects, apc.ActDeleteObjects:
+		lr, err := _schedListRange(c)
+		if err != nil {
+			return err
+		}
+		if kind == apc.ActPrefetchObjects {
+			job.Msg = apc.ActMsg{Action: kind, Value: &apc.PrefetchMsg{ListRange: lr, LatestVer: flagIsSet(c, latestVerFlag)}}
+		} else {
+			job.Msg = apc.ActMsg{Action: kind, Value: &apc.EvdMsg{ListRange: lr}}
+		}
+	default:
+		job.Msg = apc.ActMsg{Action: apc.ActXactStart, Value: &xact.ArgsMsg{Kind: kind, Bck: job.Bck}}
+		job.Bck = cmn.Bck{}
+	}
+	job.Msg.Prio = parseStrFlag(c, prioFlag)
+	if _, _, err := job.Validate(); err != nil {
+		return err
+	}
+	if err := api.AddJobSchedule(apiBP, job); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Added scheduled job %q (%s)", name, job.Cron))
+	return nil
+}
+
+func _schedListRange(c *cli.Context) (lr apc.ListRange, err error) {
+	switch {
+	case flagIsSet(c, listFlag) && flagIsSet(c, templateFlag):
+		err = incorrectUsageMsg(c, "%s and %s options are mutually exclusive", qflprn(listFlag), qflprn(templateFlag))
+	case flagIsSet(c, listFlag):
+		lr.ObjNames = splitCsv(parseStrFlag(c, listFlag))
+	case flagIsSet(c, templateFlag):
+		lr.Template = parseStrFlag(c, templateFlag)
+	default:
+		lr.Template = parseStrFlag(c, verbObjPrefixFlag) // (prefix is a template with no ranges)
+	}
+	return lr, err
+}
+
+func lsJobSchedHandler(c *cli.Context) error {
+	jobs, err := api.GetJobSchedules(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(jobs, "", teb.Jopts(true))
+	}
+	if len(jobs) == 0 {
+		actionDone(c, "No scheduled jobs")
+		return nil
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "NAME\tSCHEDULE\tJOB\tBUCKET\tNEXT RUN\tLAST RUN\tLAST JOB\tSKIPPED")
+	}
+	for _, j := range jobs {
+		kind, bck, _ := j.Validate()
+		_, jname := xact.GetKindName(kind)
+		bname := teb.NotSetVal
+		if !bck.IsEmpty() {
+			bname = bck.Cname("")
+			if !j.BckTo.IsEmpty() {
+				bname += " => " + j.BckTo.Cname("")
+			}
+		}
+		last := teb.NotSetVal
+		switch {
+		case j.LastErr != "":
+			last = "Failed: " + strconv.Quote(j.LastErr)
+		case j.LastID != "":
+			last = xact.Cname(kind, j.LastID)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+			j.Name, j.Cron, cos.Left(jname, kind), bname, teb.FmtDateTime(j.NextRun), teb.FmtDateTime(j.LastRun), last, j.Skipped)
+	}
+	return tw.Flush()
+}
+
+func rmJobSchedHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	name := c.Args().Get(0)
+	if err := api.RemoveJobSchedule(apiBP, name); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Removed scheduled job %q", name))
+	return nil
+}
+
+// complete to: SCHEDULE_NAME JOB_NAME [BUCKET] [DST_BUCKET]
+func jobSchedAddCompletions(c *cli.Context) {
+	switch c.NArg() {
+	case 1:
+		names := xact.ListDisplayNames(true /*only-startable*/)
+		for _, kind := range xact.CronBckActions {
+			_, name := xact.GetKindName(kind)
+			names = append(names, name)
+		}
+		fmt.Println(strings.Join(names, " "))
+	case 2, 3:
+		bucketCompletions(bcmplop{})(c)
+	}
+}
+
+func jobSchedCompletions(c *cli.Context) {
+	if c.NArg() > 0 {
+		return
+	}
+	jobs, err := api.GetJobSchedules(apiBP)
+	if err != nil {
+		return
+	}
+	for _, j := range jobs {
+		fmt.Println(j.Name)
+	}
+}
@@ -48,7 +48,8 @@ var showJobUsage = "Show running and/or finished jobs:\n" +
 	indent1 + "\t- show job ls --refresh 10 --count 4\t- same as above but only for the first four 10-seconds intervals;\n" +
 	indent1 + "\t- show job prefetch --refresh 1m\t- show all running prefetch jobs at 1 minute intervals (until Ctrl-C);\n" +
 	indent1 + "\t- show job evict\t- all running bucket and/or data evicting jobs;\n" +
-	indent1 + "\t- show job --all\t- show absolutely all jobs, running and finished."
+	indent1 + "\t- show job --all\t- show absolutely all jobs, running and finished;\n" +
+	indent1 + "\t- show job copy-bucket --history --since 24h\t- show bucket-to-bucket copies finished in the last 24 hours (persistent history)."
 
 var showJobFlags = append(
 	longRunFlags,
@@ -61,6 +62,10 @@ var showJobFlags = append(
 	unitsFlag,
 	dateTimeFlag,
 	topFlag,
+	jobHistoryFlag,
+	jobHistUserFlag,
+	jobHistSinceFlag,
+	jobHistErrFlag,
 	// download and dsort only
 	progressFlag,
 	dsortLogFlag,
@@ -109,6 +114,9 @@ func showJobsHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, jobHistoryFlag) {
+		return showJobHistory(c, name, xid, bck)
+	}
 	if name == cmdRebalance {
 		return showRebalanceHandler(c)
 	}
@@ -82,6 +82,7 @@ var (
 		Subcommands: []cli.Command{
 			makeAlias(&showCmdLog, &mkaliasOpts{newName: commandShow}),
 			getCmdLog,
+			auditCmdLog,
 		},
 	}
 )
@@ -281,6 +281,15 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 		addCachedCol = true           // preliminary; may change below
 		msg.SetFlag(apc.LsBckPresent) // default
 	}
+	if flagIsSet(c, snapFlag) {
+		msg.Snap = parseStrFlag(c, snapFlag)
+	}
+	if flagIsSet(c, allVersionsFlag) {
+		if flagIsSet(c, snapFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(allVersionsFlag), qflprn(snapFlag))
+		}
+		msg.SetFlag(apc.LsVerHistory)
+	}
 	if listArch {
 		msg.SetFlag(apc.LsArchDir)
 
@@ -406,6 +415,10 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 		}
 	}
 
+	if msg.IsFlagSet(apc.LsVerHistory) && !msg.IsFlagSet(apc.LsNameOnly) {
+		msg.AddProps(apc.GetPropsVersion)
+	}
+
 	// addCachedCol: correction #2
 	if addCachedCol && (msg.IsFlagSet(apc.LsNameOnly) || msg.IsFlagSet(apc.LsNameSize)) {
 		addCachedCol = false
@@ -0,0 +1,93 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles bucket metadata search.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+
+	"github.com/urfave/cli"
+)
+
+const mdSearchUsage = "Search objects by metadata (requires bucket property 'md_index.enabled=true'); all predicates must match, e.g.:\n" +
+	indent1 + "\t- 'ais bucket search ais://abc \"size>=1MiB\" \"custom.color=red\"'\t- large red objects;\n" +
+	indent1 + "\t- 'ais bucket search ais://abc \"atime<720h\" --sort size --desc --limit 10'\t- ten largest objects not accessed in 30 days;\n" +
+	indent1 + "\t- 'ais bucket search ais://abc \"name^=images/\" custom.label'\t- objects with prefix 'images/' that have custom 'label'.\n" +
+	indent1 + "Fields: name, size, atime, version, custom.KEY; operators: =, !=, <, <=, >, >=, ^= (prefix), ~= (contains)"
+
+const mdSearchArgument = bucketArgument + " [PREDICATE ...]"
+
+var (
+	bucketCmdSearch = cli.Command{
+		Name:         "search",
+		Usage:        mdSearchUsage,
+		ArgsUsage:    mdSearchArgument,
+		Flags:        sortFlags([]cli.Flag{mdSearchSortFlag, mdSearchDescFlag, mdSearchLimitFlag, unitsFlag, noHeaderFlag, jsonFlag}),
+		Action:       mdSearchHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
+)
+
+func mdSearchHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, bucketArgument)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	query := &apc.MDQuery{
+		Sort:  parseStrFlag(c, mdSearchSortFlag),
+		Desc:  flagIsSet(c, mdSearchDescFlag),
+		Limit: parseIntFlag(c, mdSearchLimitFlag),
+	}
+	for _, s := range c.Args().Tail() {
+		pred, err := apc.ParseMDPred(s)
+		if err != nil {
+			return incorrectUsageMsg(c, "%v", err)
+		}
+		query.Preds = append(query.Preds, pred)
+	}
+	if err := query.Validate(); err != nil {
+		return incorrectUsageMsg(c, "%v", err)
+	}
+
+	entries, err := api.SearchObjects(apiBP, bck, query)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(entries, "", teb.Jopts(true))
+	}
+	if len(entries) == 0 {
+		actionDone(c, "No matching objects in "+bck.Cname(""))
+		return nil
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "NAME\tSIZE\tATIME\tVERSION\tCUSTOM")
+	}
+	for _, e := range entries {
+		var atime string
+		if e.Atime != 0 {
+			atime = teb.FmtDateTime(time.Unix(0, e.Atime))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", e.Name, teb.FmtSize(e.Size, units, 2),
+			_dash(atime), _dash(e.Version), _dash(cmn.CustomMD2S(e.Custom)))
+	}
+	return tw.Flush()
+}
@@ -225,8 +225,11 @@ func _evictOne(c *cli.Context, shift int) error {
 // Evict remote bucket
 func evictBucket(c *cli.Context, bck cmn.Bck) error {
 	if flagIsSet(c, dryRunFlag) {
-		fmt.Fprintf(c.App.Writer, "%s %s\n", dryRunHeader(), bck.Cname(""))
-		return nil
+		all, err := api.DryRunDestroyBucket(apiBP, bck, apc.ActEvictRemoteBck)
+		if err != nil {
+			return V(err)
+		}
+		return printDryRun(c, "evict "+bck.Cname(""), all)
 	}
 
 	keepMD := flagIsSet(c, keepMDFlag)
@@ -404,7 +407,18 @@ func _rmOne(c *cli.Context, shift int, warned *bool) error {
 		return err
 	}
 
+	if flagIsSet(c, versionIDFlag) && (oltp.objName == "" || oltp.list != "" || oltp.tmpl != "") {
+		return incorrectUsageMsg(c, "flag %s requires a single object name", qflprn(versionIDFlag))
+	}
+
 	switch {
+	case flagIsSet(c, dryRunFlag): // 0. nothing gets deleted (see lrCtx.dryRun)
+		if oltp.objName == "" && oltp.list == "" && oltp.tmpl == "" && !flagIsSet(c, rmrfFlag) {
+			return incorrectUsageMsg(c, "to select objects to be removed use one of: (%s or %s or %s)",
+				qflprn(listFlag), qflprn(templateFlag), qflprn(rmrfFlag))
+		}
+		lrCtx := &lrCtx{cos.Left(oltp.list, oltp.objName), oltp.tmpl, bck}
+		return lrCtx.do(c)
 	case oltp.list != "" || oltp.tmpl != "": // 1. multi-obj
 		// TODO: warnEscapeObjName()
 		lrCtx := &lrCtx{oltp.list, oltp.tmpl, bck}
@@ -423,6 +437,16 @@ func _rmOne(c *cli.Context, shift int, warned *bool) error {
 			qflprn(listFlag), qflprn(templateFlag), qflprn(rmrfFlag))
 	default: // 3. one obj
 		encObjName := warnEscapeObjName(c, oltp.objName, warned)
+		if flagIsSet(c, versionIDFlag) {
+			ver := parseStrFlag(c, versionIDFlag)
+			if err := api.DeleteObjectVersion(apiBP, bck, encObjName, ver); err != nil {
+				return V(err)
+			}
+			if !flagIsSet(c, nonverboseFlag) {
+				fmt.Fprintf(c.App.Writer, "deleted %q version %s from %s\n", oltp.objName, ver, bck.Cname(""))
+			}
+			return nil
+		}
 		err := api.DeleteObject(apiBP, bck, encObjName)
 		if err == nil && bck.IsCloud() && oltp.notFound {
 			// [NOTE]
@@ -450,6 +474,9 @@ func _rmOne(c *cli.Context, shift int, warned *bool) error {
 }
 
 func startPrefetchHandler(c *cli.Context) error {
+	if err := setJobPrio(c); err != nil {
+		return err
+	}
 	if flagIsSet(c, dryRunFlag) {
 		dryRunCptn(c)
 	}
@@ -530,6 +557,9 @@ func (lr *lrCtx) do(c *cli.Context) error {
 
 	// 2. [DRY-RUN]
 	if flagIsSet(c, dryRunFlag) {
+		if verb := lr.verb(c); verb == commandRemove || verb == commandEvict {
+			return lr.dryRun(c, verb, fileList)
+		}
 		lr.dry(c, fileList, &pt)
 		return nil
 	}
@@ -641,11 +671,36 @@ func (lr *lrCtx) dry(c *cli.Context, fileList []string, pt *cos.ParsedTemplate)
 	}
 }
 
-func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action string, err error) {
-	verb := c.Command.Name
+// [DRY-RUN] evict and rm: run the selection on the server side (see apc.DryRunRes)
+func (lr *lrCtx) dryRun(c *cli.Context, verb string, fileList []string) error {
+	var (
+		action = cos.Ternary(verb == commandRemove, apc.ActDeleteObjects, apc.ActEvictObjects)
+		msg    = &apc.EvdMsg{
+			ListRange: apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs},
+			NonRecurs: flagIsSet(c, nonRecursFlag),
+		}
+	)
+	if verb == commandEvict {
+		if err := ensureRemoteProvider(lr.bck); err != nil {
+			return err
+		}
+	}
+	all, err := api.DryRunMultiObj(apiBP, lr.bck, action, msg)
+	if err != nil {
+		return V(err)
+	}
+	return printDryRun(c, verb+" "+lr.bck.Cname(lr.tmplObjs), all)
+}
+
+func (*lrCtx) verb(c *cli.Context) string {
 	if isAlias(c) {
-		verb = lastAliasedWord(c)
+		return lastAliasedWord(c)
 	}
+	return c.Command.Name
+}
+
+func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action string, err error) {
+	verb := lr.verb(c)
 
 	switch verb {
 	case commandRemove:
@@ -112,12 +112,14 @@ var (
 			listRangeProgressWaitFlags,
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
 			rmrfFlag,
+			dryRunFlag,
 			verboseFlag, // rm -rf
 			nonverboseFlag,
 			nonRecursFlag, // (embedded prefix dopOLTP dop)
 			yesFlag,
 			dontHeadRemoteFlag,
 			encodeObjnameFlag,
+			versionIDFlag,
 		),
 		commandRename: {
 			encodeObjnameFlag,
@@ -129,6 +131,8 @@ var (
 			yesFlag,
 			headObjPresentFlag,
 			latestVerFlag,
+			snapFlag,
+			versionIDFlag,
 			refreshFlag,
 			progressFlag,
 			// blob-downloader
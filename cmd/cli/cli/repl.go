@@ -0,0 +1,96 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles continuous bucket replication commands.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+)
+
+type replRow struct {
+	Bck    string         `json:"bucket"`
+	Target string         `json:"target"`
+	Stats  *apc.ReplStats `json:"stats"`
+}
+
+var showCmdRepl = cli.Command{
+	Name: cmdReplication,
+	Usage: "Show continuous bucket replication (see bucket property 'replication'): per-target backlog\n" +
+		indent1 + "(journaled and not yet shipped changes), replication lag (age of the oldest such change),\n" +
+		indent1 + "and the numbers of replicated, retried, and dead-lettered (permanently failed) changes",
+	ArgsUsage:    optionalBucketArgument,
+	Flags:        sortFlags([]cli.Flag{noHeaderFlag, jsonFlag, unitsFlag}),
+	Action:       showReplHandler,
+	BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+}
+
+func showReplHandler(c *cli.Context) error {
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	xargs := &xact.ArgsMsg{Kind: apc.ActReplicate, OnlyRunning: true}
+	if c.NArg() > 0 {
+		bck, err := parseBckURI(c, c.Args().Get(0), false)
+		if err != nil {
+			return err
+		}
+		xargs.Bck = bck
+	}
+	xs, _, err := queryXactions(xargs, false /*summarize*/)
+	if err != nil {
+		return err
+	}
+	rows := make([]*replRow, 0, len(xs))
+	for tid, snaps := range xs {
+		for _, snap := range snaps {
+			st := &apc.ReplStats{}
+			if err := jsoniter.Unmarshal(cos.MustMarshal(snap.Ext), st); err != nil {
+				return fmt.Errorf("%s[%s]: invalid replication stats: %v", snap.Kind, snap.ID, err)
+			}
+			rows = append(rows, &replRow{Bck: snap.Bck.Cname(""), Target: meta.Tname(tid), Stats: st})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Bck != rows[j].Bck {
+			return rows[i].Bck < rows[j].Bck
+		}
+		return rows[i].Target < rows[j].Target
+	})
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(rows, "", teb.Jopts(true))
+	}
+	if len(rows) == 0 {
+		what := "any bucket"
+		if !xargs.Bck.IsEmpty() {
+			what = xargs.Bck.Cname("")
+		}
+		actionDone(c, "No replication in progress for "+what)
+		return nil
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "BUCKET\tTARGET\tDESTINATION\tBACKLOG\tBACKLOG SIZE\tLAG\tPUT\tDELETE\tRETRY\tDEAD")
+	}
+	for _, row := range rows {
+		st := row.Stats
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\t%s\t%d\t%d\t%d\t%d\n",
+			row.Bck, row.Target, st.Dst, st.BacklogCnt, teb.FmtSize(st.BacklogSize, units, 2), st.Lag,
+			st.PutCount, st.DelCount, st.RetryCount, st.DeadCount)
+	}
+	return tw.Flush()
+}
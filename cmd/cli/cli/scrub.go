@@ -24,13 +24,12 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/sys"
+	"github.com/NVIDIA/aistore/xact"
 
 	"github.com/urfave/cli"
 )
 
 // [TODO]
-// - '--checksum' option (slow)
-// - '--fix' option (***)
 // - multiple buckets vs one-log-per-scrub-metric - a problem
 // - async execution with '--wait' option
 // - speed-up `ls` via multiple workers
@@ -99,6 +98,10 @@ var (
 		largeSizeFlag,
 		scrubObjCachedFlag,
 		allColumnsFlag,
+		scrubChecksumFlag,
+		scrubFixFlag,
+		waitFlag,
+		waitJobXactFinishedFlag,
 	)
 )
 
@@ -129,6 +132,10 @@ func scrubHandler(c *cli.Context) (err error) {
 		ctx.pref = prefix
 	}
 
+	if flagIsSet(c, scrubChecksumFlag) || flagIsSet(c, scrubFixFlag) {
+		return ctx.server()
+	}
+
 	now := mono.NanoTime()
 
 	// setup progress updates
@@ -200,6 +207,33 @@ func scrubHandler(c *cli.Context) (err error) {
 // scrCtx //
 ////////////
 
+// target-side scrub (apc.ActScrub)
+func (ctx *scrCtx) server() error {
+	c := ctx.c
+	if !ctx.qbck.IsBucket() {
+		return incorrectUsageMsg(c, "%s and %s options require a bucket name", qflprn(scrubChecksumFlag), qflprn(scrubFixFlag))
+	}
+	var (
+		bck = cmn.Bck(ctx.qbck)
+		msg = &apc.ScrubMsg{
+			Prefix:   ctx.pref,
+			Checksum: flagIsSet(c, scrubChecksumFlag),
+			Fix:      flagIsSet(c, scrubFixFlag),
+		}
+	)
+	xid, err := api.ScrubBucket(apiBP, bck, msg)
+	if err != nil {
+		return V(err)
+	}
+	_, xname := xact.GetKindName(apc.ActScrub)
+	text := fmt.Sprintf("%s: %s", xact.Cname(xname, xid), bck.Cname(ctx.pref))
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+	return waitJob(c, xname, xid, bck)
+}
+
 func (ctx *scrCtx) iniLogs() {
 	for i := range ctx.logs {
 		// default
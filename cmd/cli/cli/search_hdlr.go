@@ -44,6 +44,8 @@ var (
 		commandECEncode: {"protect", "encode", "replicate", "erasure-code", "backup", "redundancy"},
 		commandStart:    {"do", "run", "execute"},
 		commandStop:     {"abort", "terminate"},
+		commandPause:    {"suspend", "hold"},
+		commandResume:   {"continue", "unpause"},
 		commandPut:      {"update", "write", "promote", "modify", "upload"},
 		commandCreate:   {"add", "new"},
 		commandObject:   {"file"},
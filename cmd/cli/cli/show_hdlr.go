@@ -8,6 +8,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
@@ -93,6 +94,7 @@ var (
 			makeAlias(&showCmdRemoteCluster, &mkaliasOpts{newName: cmdShowRemoteAIS}),
 			showCmdRemote,
 			showCmdJob,
+			showCmdRepl,
 			showCmdLog,
 			showTLS,
 			makeAlias(&showCmdETL, &mkaliasOpts{newName: commandETL}),
@@ -144,6 +146,26 @@ var (
 				Action:       showBMDHandler,
 				BashComplete: suggestAllNodes,
 			},
+			{
+				Name: cmdDomains,
+				Usage: "Show targets' failure domains (local config 'failure_domain') and buckets\n" +
+					indent1 + "that cannot spread their EC slices and replicas across distinct domains",
+				Flags:  sortFlags([]cli.Flag{noHeaderFlag}),
+				Action: showDomainsHandler,
+			},
+			{
+				Name: cmdHrw,
+				Usage: "Show targets' weights (capacities) and preview data movement (number of objects and bytes)\n" +
+					indent1 + "that would follow toggling weighted HRW (feature flag 'Weighted-HRW'); walks all objects in the cluster",
+				Flags:  sortFlags([]cli.Flag{noHeaderFlag, jsonFlag, unitsFlag}),
+				Action: showHrwPreviewHandler,
+			},
+			{
+				Name:   cmdTenants,
+				Usage:  "Show tenants (see config 'tenant'): number of buckets, used capacity, and quotas",
+				Flags:  sortFlags([]cli.Flag{noHeaderFlag, jsonFlag, unitsFlag}),
+				Action: showTenantsHandler,
+			},
 			{
 				Name:      cmdConfig,
 				Usage:     "Show cluster and node configuration",
@@ -398,6 +420,155 @@ func showBMDHandler(c *cli.Context) error {
 	return nil
 }
 
+func showHrwPreviewHandler(c *cli.Context) error {
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	out, err := api.GetHrwPreview(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(out, "", teb.Jopts(true))
+	}
+	var (
+		tids = make([]string, 0, len(out))
+		tot  apc.HrwPreview
+	)
+	for tid, res := range out {
+		tids = append(tids, tid)
+		tot.Weighted = res.Weighted
+		tot.Objects += res.Objects
+		tot.Size += res.Size
+		tot.ToTargets += res.ToTargets
+		tot.ToTargetsSz += res.ToTargetsSz
+		tot.ToMpaths += res.ToMpaths
+		tot.ToMpathsSz += res.ToMpathsSz
+	}
+	sort.Strings(tids)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "TARGET\tWEIGHT (GiB)\tOBJECTS\tSIZE\tTO OTHER TARGETS\tTO OTHER MOUNTPATHS")
+	}
+	for _, tid := range tids {
+		res := out[tid]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%d (%s)\t%d (%s)\n", tid, res.Weight, res.Objects, teb.FmtSize(res.Size, units, 2),
+			res.ToTargets, teb.FmtSize(res.ToTargetsSz, units, 2), res.ToMpaths, teb.FmtSize(res.ToMpathsSz, units, 2))
+	}
+	tw.Flush()
+
+	fmt.Fprintln(c.App.Writer)
+	state, toggle := "disabled", "enabling"
+	if tot.Weighted {
+		state, toggle = "enabled", "disabling"
+	}
+	fmt.Fprintf(c.App.Writer, "Weighted HRW is currently %s; %s it would migrate %d objects (%s) between targets "+
+		"and %d objects (%s) between mountpaths (total: %d objects, %s)\n",
+		state, toggle, tot.ToTargets, teb.FmtSize(tot.ToTargetsSz, units, 2),
+		tot.ToMpaths, teb.FmtSize(tot.ToMpathsSz, units, 2), tot.Objects, teb.FmtSize(tot.Size, units, 2))
+	return nil
+}
+
+func showTenantsHandler(c *cli.Context) error {
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	out, err := api.GetTenants(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(out, "", teb.Jopts(true))
+	}
+	names := make([]string, 0, len(out))
+	for name := range out {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "TENANT\tBUCKETS\tUSED\tQUOTA")
+	}
+	for _, name := range names {
+		tu := out[name]
+		quota := teb.NotSetVal
+		if tu.Quota > 0 {
+			quota = teb.FmtSize(tu.Quota, units, 2)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", name, tu.Buckets, teb.FmtSize(tu.Size, units, 2), quota)
+	}
+	return tw.Flush()
+}
+
+func showDomainsHandler(c *cli.Context) error {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	if !smap.HasDomains() {
+		actionDone(c, "No failure domains configured (see targets' local config 'failure_domain')")
+		return nil
+	}
+	bmd, err := api.GetBMD(apiBP)
+	if err != nil {
+		return V(err)
+	}
+
+	// domains
+	var (
+		domains = make(map[string][]string, 8)
+		names   = make([]string, 0, 8)
+	)
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		d := tsi.FailureDomain()
+		if _, ok := domains[d]; !ok {
+			names = append(names, d)
+		}
+		domains[d] = append(domains[d], tsi.StringEx())
+	}
+	sort.Strings(names)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "DOMAIN\tTARGETS")
+	}
+	for _, d := range names {
+		tids := domains[d]
+		sort.Strings(tids)
+		fmt.Fprintf(tw, "%s\t%s\n", d, strings.Join(tids, ", "))
+	}
+	tw.Flush()
+
+	// buckets
+	var violations []string
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if reason := meta.CheckDomains(bck.Props, len(domains)); reason != "" {
+			violations = append(violations, bck.Cname("")+"\t"+reason)
+		}
+		return false
+	})
+	fmt.Fprintln(c.App.Writer)
+	if len(violations) == 0 {
+		actionDone(c, "All buckets comply with the failure-domain placement policy")
+		return nil
+	}
+	sort.Strings(violations)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "BUCKET\tVIOLATION")
+	}
+	for _, v := range violations {
+		fmt.Fprintln(tw, v)
+	}
+	return tw.Flush()
+}
+
 func showClusterConfigHandler(c *cli.Context) error {
 	return showClusterConfig(c, c.Args().Get(0))
 }
@@ -0,0 +1,144 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles bucket snapshot commands.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/xact"
+
+	"github.com/urfave/cli"
+)
+
+const snapUsage = "Create, list, and remove point-in-time (copy-on-write) snapshots of ais:// buckets.\n" +
+	indent1 + "To read or list snapshotted objects, use '--snap' option, e.g.:\n" +
+	indent1 + "\t- 'ais ls ais://abc --snap mysnap'\t- list objects as of snapshot 'mysnap';\n" +
+	indent1 + "\t- 'ais get ais://abc/obj --snap mysnap -'\t- read the object as of snapshot 'mysnap'.\n" +
+	indent1 + "Note: chunked objects are not snapshotted; rebalance and resilver invalidate existing snapshots"
+
+const snapArgument = bucketArgument + " SNAPSHOT_NAME"
+
+var (
+	bucketCmdSnap = cli.Command{
+		Name:  "snapshot",
+		Usage: snapUsage,
+		Subcommands: []cli.Command{
+			{
+				Name:         commandCreate,
+				Usage:        "Create named bucket snapshot",
+				ArgsUsage:    snapArgument,
+				Flags:        sortFlags([]cli.Flag{waitFlag, waitJobXactFinishedFlag, nonverboseFlag}),
+				Action:       createSnapHandler,
+				BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+			},
+			{
+				Name:         commandList,
+				Usage:        "List bucket snapshots",
+				ArgsUsage:    bucketArgument,
+				Flags:        sortFlags([]cli.Flag{noHeaderFlag}),
+				Action:       listSnapsHandler,
+				BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+			},
+			{
+				Name:         commandRemove,
+				Usage:        "Remove named bucket snapshot (and all its preserved data)",
+				ArgsUsage:    snapArgument,
+				Action:       rmSnapHandler,
+				BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+			},
+		},
+	}
+)
+
+func _snapArgs(c *cli.Context) (bck cmn.Bck, name string, err error) {
+	if c.NArg() == 0 {
+		return bck, "", missingArgumentsError(c, bucketArgument, "SNAPSHOT_NAME")
+	}
+	if c.NArg() == 1 {
+		return bck, "", missingArgumentsError(c, "SNAPSHOT_NAME")
+	}
+	bck, err = parseBckURI(c, c.Args().Get(0), false)
+	return bck, c.Args().Get(1), err
+}
+
+func createSnapHandler(c *cli.Context) error {
+	bck, name, err := _snapArgs(c)
+	if err != nil {
+		return err
+	}
+	xid, err := api.CreateSnapshot(apiBP, bck, name)
+	if err != nil {
+		return V(err)
+	}
+	_, xname := xact.GetKindName(apc.ActCreateSnap)
+	text := fmt.Sprintf("%s %s snapshot %q", xact.Cname(xname, xid), bck.Cname(""), name)
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		if flagIsSet(c, nonverboseFlag) {
+			fmt.Fprintln(c.App.Writer, xid)
+		} else {
+			actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+		}
+		return nil
+	}
+
+	// wait
+	var timeout time.Duration
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintln(c.App.Writer, text+" ...")
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActCreateSnap, Timeout: timeout}
+	if err := waitXact(&xargs); err != nil {
+		return err
+	}
+	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	return nil
+}
+
+func listSnapsHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, bucketArgument)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	snaps, err := api.ListSnapshots(apiBP, bck)
+	if err != nil {
+		return V(err)
+	}
+	if len(snaps) == 0 {
+		actionDone(c, bck.Cname("")+" has no snapshots")
+		return nil
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "NAME\tID\tCREATED")
+	}
+	for _, si := range snaps {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", si.Name, si.ID, teb.FmtDateTime(time.Unix(0, si.Created)))
+	}
+	return tw.Flush()
+}
+
+func rmSnapHandler(c *cli.Context) error {
+	bck, name, err := _snapArgs(c)
+	if err != nil {
+		return err
+	}
+	if err := api.DestroySnapshot(apiBP, bck, name); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Removed %s snapshot %q", bck.Cname(""), name))
+	return nil
+}
@@ -58,7 +58,9 @@ const scrubUsage = "Validate in-cluster content for inconsistencies and data pla
 	indent1 + "\t- 'ais scrub'\t- same as above;\n" +
 	indent1 + "\t- 'ais scrub ais://bucket'\t- validate a specific AIS bucket;\n" +
 	indent1 + "\t- 'ais scrub gs://abc/images/'\t- validate part of the GCP bucket under \"images/\";\n" +
-	indent1 + "\t- 'ais scrub gs://abc --prefix images/'\t- same as above using an explicit prefix."
+	indent1 + "\t- 'ais scrub gs://abc --prefix images/'\t- same as above using an explicit prefix;\n" +
+	indent1 + "\t- 'ais scrub ais://abc --checksum'\t- run cluster-side job that also recomputes and validates checksums;\n" +
+	indent1 + "\t- 'ais scrub ais://abc --checksum --fix --wait'\t- same as above, and repair (wait for the job to finish)."
 
 // {verb}-mountpath usage:
 const (
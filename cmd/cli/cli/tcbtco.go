@@ -49,6 +49,9 @@ func copyBucketHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	if err := setJobPrio(c); err != nil {
+		return err
+	}
 
 	// [CONVENTIONS]
 	// 1. '--sync' and '--latest' both require aistore to reach out for remote metadata and, therefore,
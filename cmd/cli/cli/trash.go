@@ -0,0 +1,140 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles listing and restoring deleted objects and buckets (trash).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	"github.com/urfave/cli"
+)
+
+const trashUsage = "List and restore deleted objects and destroyed buckets (requires bucket property 'trash.enabled=true');\n" +
+	indent1 + "deleted content is kept for 'trash.retention' and then purged by storage cleanup ('ais storage cleanup'), e.g.:\n" +
+	indent1 + "\t- 'ais bucket trash ls ais://abc --prefix images/'\t- list deleted objects that can still be restored;\n" +
+	indent1 + "\t- 'ais bucket trash restore ais://abc/images/1.jpg'\t- restore the most recently deleted copy of the object;\n" +
+	indent1 + "\t- 'ais bucket trash restore ais://abc --prefix images/'\t- restore all deleted objects with a given prefix;\n" +
+	indent1 + "\t- 'ais bucket trash restore-bck ais://abc'\t- restore destroyed bucket (with all its content and properties)"
+
+var (
+	bucketCmdTrash = cli.Command{
+		Name:  "trash",
+		Usage: trashUsage,
+		Subcommands: []cli.Command{
+			{
+				Name:         commandList,
+				Usage:        "List deleted objects that can still be restored",
+				ArgsUsage:    bucketArgument,
+				Flags:        sortFlags([]cli.Flag{verbObjPrefixFlag, unitsFlag, noHeaderFlag, jsonFlag}),
+				Action:       listTrashHandler,
+				BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+			},
+			{
+				Name:         "restore",
+				Usage:        "Restore deleted object (the most recently deleted copy, unless '--id' is specified) or all deleted objects with a given prefix",
+				ArgsUsage:    "BUCKET[/OBJECT_NAME]",
+				Flags:        sortFlags([]cli.Flag{verbObjPrefixFlag, trashIDFlag}),
+				Action:       undeleteHandler,
+				BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+			},
+			{
+				Name:         "restore-bck",
+				Usage:        "Restore the most recently destroyed bucket with all its content and properties",
+				ArgsUsage:    bucketArgument,
+				Action:       undeleteBucketHandler,
+				BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+			},
+		},
+	}
+)
+
+func listTrashHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, bucketArgument)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	entries, err := api.ListTrash(apiBP, bck, parseStrFlag(c, verbObjPrefixFlag))
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(entries, "", teb.Jopts(true))
+	}
+	if len(entries) == 0 {
+		actionDone(c, "No deleted objects in "+bck.Cname(""))
+		return nil
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "NAME\tSIZE\tVERSION\tDELETED\tEXPIRES\tID")
+	}
+	for _, e := range entries {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", e.Name, teb.FmtSize(e.Size, units, 2), _dash(e.Version),
+			teb.FmtDateTime(time.Unix(0, e.Deleted)), teb.FmtDateTime(time.Unix(0, e.Expires)), e.ID())
+	}
+	return tw.Flush()
+}
+
+func undeleteHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, objName, err := parseBckObjURI(c, c.Args().Get(0), true /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	msg := &apc.UndeleteMsg{
+		ObjName: objName,
+		ID:      parseStrFlag(c, trashIDFlag),
+		Prefix:  parseStrFlag(c, verbObjPrefixFlag),
+	}
+	if objName == "" && msg.Prefix == "" {
+		return incorrectUsageMsg(c, "expecting object name or '--%s' (to restore all deleted objects with a given prefix)",
+			verbObjPrefixFlag.Name)
+	}
+	if err := msg.Validate(); err != nil {
+		return incorrectUsageMsg(c, "%v", err)
+	}
+	restored, err := api.Undelete(apiBP, bck, msg)
+	if err != nil {
+		return V(err)
+	}
+	if n := len(restored); n == 1 {
+		actionDone(c, "Restored "+bck.Cname(restored[0].Name))
+	} else {
+		actionDone(c, fmt.Sprintf("Restored %d object%s in %s", n, cos.Plural(n), bck.Cname("")))
+	}
+	return nil
+}
+
+func undeleteBucketHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, bucketArgument)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	if err := api.UndeleteBucket(apiBP, bck); err != nil {
+		return V(err)
+	}
+	actionDone(c, "Restored bucket "+bck.Cname(""))
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -536,6 +537,12 @@ func bckPropList(props *cmn.Bprops, verbose bool) (propList nvpairList) {
 			{"chunks", props.Chunks.String()},
 			{"lru", props.LRU.String()},
 			{"versioning", props.Versioning.String()},
+			{"replication", props.Repl.String()},
+			{"audit", props.Audit.String()},
+			{"proxy_cache", props.ProxyCache.String()},
+			{"md_index", props.MDIndex.String()},
+			{"trash", props.Trash.String()},
+			{"tier", props.Tier.String()},
 		}
 		if props.Provider == apc.HT {
 			origURL := props.Extra.HTTP.OrigURLBck
@@ -973,6 +980,38 @@ func dryRunCptn(c *cli.Context) {
 	fmt.Fprintln(c.App.Writer, dryRunHeader()+" with no modifications to the cluster")
 }
 
+// server-side dry-run (see api/dryrun.go): show per-target totals and sample object names
+func printDryRun(c *cli.Context, what string, all apc.DryRunResults) error {
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+	objs, size := all.Totals()
+	fmt.Fprintf(c.App.Writer, "%s %s: %d object%s, %s total\n", dryRunHeader(), what, objs, cos.Plural(int(objs)), cos.ToSizeIEC(size, 2))
+	if objs == 0 {
+		return nil
+	}
+	tids := make([]string, 0, len(all))
+	for tid := range all {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TARGET\tOBJECTS\tSIZE\tSAMPLE")
+	for _, tid := range tids {
+		var (
+			res    = all[tid]
+			sample = strings.Join(res.Sample, ", ")
+		)
+		if res.Objs > int64(len(res.Sample)) {
+			sample += ", ..."
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", meta.Tname(tid), res.Objs, cos.ToSizeIEC(res.Bytes, 2), sample)
+	}
+	return tw.Flush()
+}
+
 //////////////////////////
 // HuggingFace wrappers //
 //////////////////////////
@@ -31,5 +31,7 @@ const (
 	xfinishedErrs = "Finished with errors"
 	xrunning      = "Running"
 	xidle         = "Idle"
+	xpaused       = "Paused"
+	xqueued       = "Queued"
 	xaborted      = "Aborted"
 )
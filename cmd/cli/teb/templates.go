@@ -354,12 +354,13 @@ See '--help' and docs/cli for details.`
 		"{{ if ne (len $role.BucketACLs) 0 }}" +
 		"BUCKET\tPERMISSIONS\n" +
 		"{{ range $bck := $role.BucketACLs }}" +
-		"{{ FormatBckName $bck.Bck }}\t{{ FormatACL $bck.Access }}\n" +
+		"{{ FormatBckName $bck.Bck }}{{ if $bck.Prefix }}/{{ $bck.Prefix }}*{{ end }}\t{{ FormatACL $bck.Access }}\n" +
 		"{{end}}{{end}}" +
 		"{{ end }}"
 
 	AuthNRoleVerboseTmpl = "Role\t{{ .Name }}\n" +
 		"Description\t{{ .Description }}\n" +
+		"{{ if .Namespace }}Namespace\t{{ .Namespace }}\n{{ end }}" +
 		"{{ if ne (len .ClusterACLs) 0 }}" +
 		"CLUSTER ID\tALIAS\tPERMISSIONS\n" +
 		"{{ range $clu := .ClusterACLs }}" +
@@ -368,7 +369,7 @@ See '--help' and docs/cli for details.`
 		"{{ if ne (len .BucketACLs) 0 }}" +
 		"BUCKET\tPERMISSIONS\n" +
 		"{{ range $bck := .BucketACLs }}" +
-		"{{ FormatBckName $bck.Bck }}\t{{ FormatACL $bck.Access }}\n" +
+		"{{ FormatBckName $bck.Bck }}{{ if $bck.Prefix }}/{{ $bck.Prefix }}*{{ end }}\t{{ FormatACL $bck.Access }}\n" +
 		"{{end}}{{end}}"
 
 	// `search`
@@ -554,6 +554,11 @@ func FmtXactRunFinAbrt(snap *core.Snap) (s string) {
 			return xfinished
 		}
 		return fmt.Sprintf("%s: %q", xfinishedErrs, snap.Err)
+	case snap.IsPaused():
+		s = xpaused + " since " + cos.FormatTime(snap.PauseTime, cos.StampSec)
+	case snap.IsQueued():
+		prio := cos.Left(snap.Prio, apc.PrioNormal)
+		s = xqueued + " (" + prio + " priority)"
 	case snap.IsIdle():
 		s = xidle
 	default:
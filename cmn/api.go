@@ -10,8 +10,11 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -59,7 +62,15 @@ type (
 		Chunks      ChunksConf      `json:"chunks"`                           // chunks and chunk manifests; multipart upload
 		Mirror      MirrorConf      `json:"mirror"`                           // n-way mirroring
 		LRU         LRUConf         `json:"lru"`                              // LRU watermarks and enable/disable
+		Repl        ReplConf        `json:"replication"`                      // continuous replication to remote AIS or cloud bucket
+		Audit       AuditBckConf    `json:"audit"`                            // audit data-plane (object) operations
+		ProxyCache  ProxyCacheConf  `json:"proxy_cache"`                      // serve small hot objects, HEAD, and list-objects from proxies
+		MDIndex     MDIndexConf     `json:"md_index"`                         // per-target secondary index of object metadata (see api.SearchObjects)
+		Trash       TrashConf       `json:"trash"`                            // soft delete: keep deleted objects (and destroyed bucket) for a while
+		Tier        TierConf        `json:"tier"`                             // storage classes (mountpath labels): pin to a class; demote and promote
+		Snaps       []SnapInfo      `json:"snapshots,omitempty" list:"omit"`  // point-in-time snapshots (ais:// buckets only)
 		Access      apc.AccessAttrs `json:"access,string"`                    // access permissions
+		PrefixACL   PrefixACL       `json:"prefix_acl,omitempty" list:"omit"` // per-prefix permissions (extend `access` for the listed principals)
 		Features    feat.Flags      `json:"features,string"`                  // to flip assorted enumerated defaults (e.g. "S3-Use-Path-Style"; see cmn/feat)
 		BID         uint64          `json:"bid,string" list:"omit"`           // unique ID
 		Created     int64           `json:"created,string" list:"readonly"`   // creation timestamp
@@ -136,9 +147,16 @@ type (
 		Cksum       *CksumConfToSet       `json:"checksum,omitempty"`
 		LRU         *LRUConfToSet         `json:"lru,omitempty"`
 		Mirror      *MirrorConfToSet      `json:"mirror,omitempty"`
+		Repl        *ReplConfToSet        `json:"replication,omitempty"`
+		Audit       *AuditBckConfToSet    `json:"audit,omitempty"`
+		ProxyCache  *ProxyCacheConfToSet  `json:"proxy_cache,omitempty"`
+		MDIndex     *MDIndexConfToSet     `json:"md_index,omitempty"`
+		Trash       *TrashConfToSet       `json:"trash,omitempty"`
+		Tier        *TierConfToSet        `json:"tier,omitempty"`
 		Chunks      *ChunksConfToSet      `json:"chunks,omitempty"`
 		EC          *ECConfToSet          `json:"ec,omitempty"`
 		Access      *apc.AccessAttrs      `json:"access,string,omitempty"`
+		PrefixACL   *PrefixACL            `json:"prefix_acl,omitempty" list:"omit"`
 		RateLimit   *RateLimitConfToSet   `json:"rate_limit,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
@@ -150,6 +168,112 @@ type (
 		Name     *string `json:"name"`
 		Provider *string `json:"provider"`
 	}
+
+	// Bucket-only (non-inheritable) continuous replication: targets journal
+	// PUT, DELETE, and rename of the objects they store and asynchronously ship
+	// the changes to the destination (see package repl)
+	ReplConf struct {
+		Dst         string `json:"dst"`          // destination bucket, e.g. "s3://abc" or "ais://@remais/abc"
+		Prefix      string `json:"prefix"`       // replicate only the objects with names that have this prefix
+		SyncDeletes bool   `json:"sync_deletes"` // propagate deletions (including the "delete" part of rename)
+		Enabled     bool   `json:"enabled"`
+	}
+	ReplConfToSet struct {
+		Dst         *string `json:"dst,omitempty"`
+		Prefix      *string `json:"prefix,omitempty"`
+		SyncDeletes *bool   `json:"sync_deletes,omitempty"`
+		Enabled     *bool   `json:"enabled,omitempty"`
+	}
+
+	// Bucket-only (non-inheritable) audit of data-plane operations;
+	// takes effect only when cluster-wide audit is enabled (see AuditConf)
+	AuditBckConf struct {
+		Prefix  string `json:"prefix,omitempty"` // audit only the objects with names that have this prefix
+		Reads   bool   `json:"reads"`            // in addition to writes and deletions, audit GET and HEAD
+		Enabled bool   `json:"enabled"`
+	}
+	AuditBckConfToSet struct {
+		Prefix  *string `json:"prefix,omitempty"`
+		Reads   *bool   `json:"reads,omitempty"`
+		Enabled *bool   `json:"enabled,omitempty"`
+	}
+
+	// Bucket-only (non-inheritable) proxy-side cache: small hot objects,
+	// HEAD(object) results, and first pages of list-objects get served directly
+	// by the proxies, without redirecting (see ais/prxcache.go)
+	ProxyCacheConf struct {
+		MaxObjSize cos.SizeIEC  `json:"max_obj_size"` // cache objects of up to this size (zero: DfltPxcMaxObjSize)
+		TTL        cos.Duration `json:"ttl"`          // revalidate cached objects and refresh cached HEAD and listings (zero: DfltPxcTTL)
+		Enabled    bool         `json:"enabled"`
+	}
+	ProxyCacheConfToSet struct {
+		MaxObjSize *cos.SizeIEC  `json:"max_obj_size,omitempty"`
+		TTL        *cos.Duration `json:"ttl,omitempty"`
+		Enabled    *bool         `json:"enabled,omitempty"`
+	}
+
+	// Bucket-only (non-inheritable) secondary index of object metadata: name, size,
+	// atime, version, and custom metadata; targets build it (once) in the background,
+	// persist it, and keep it current on PUT and DELETE (see ais/tgtmdidx.go)
+	MDIndexConf struct {
+		Enabled bool `json:"enabled"`
+	}
+	MDIndexConfToSet struct {
+		Enabled *bool `json:"enabled,omitempty"`
+	}
+
+	// Bucket-only (non-inheritable) soft delete for ais:// buckets: deleted objects
+	// (and the bucket itself, when destroyed) are moved to trash on the same mountpath
+	// and can be restored within the retention window; `space` cleanup purges
+	// what's expired (see core/ltrash.go and fs/trash.go)
+	TrashConf struct {
+		Retention cos.Duration `json:"retention"` // keep deleted content for at least this long (zero: DfltTrashRetention)
+		Enabled   bool         `json:"enabled"`
+	}
+	TrashConfToSet struct {
+		Retention *cos.Duration `json:"retention,omitempty"`
+		Enabled   *bool         `json:"enabled,omitempty"`
+	}
+
+	// Bucket-only (non-inheritable) storage classes: pins the bucket (or the objects
+	// with a given prefix) to the mountpaths labeled `hot` (see cos.MountpathLabel);
+	// with `cold` specified, the tiering xaction (apc.ActTier) demotes objects not accessed
+	// for `demote_after` and promotes those that get read `promote_hits` times
+	// (see core/ltier.go and xact/xs/tier.go)
+	TierConf struct {
+		Hot         string       `json:"hot"`          // storage class (mountpath label) for new and frequently accessed objects
+		Cold        string       `json:"cold"`         // storage class for objects that are not being accessed (empty: pin only)
+		Prefix      string       `json:"prefix"`       // applies only to the objects with names that have this prefix
+		DemoteAfter cos.Duration `json:"demote_after"` // (zero: DfltTierDemoteAfter)
+		PromoteHits int          `json:"promote_hits"` // number of GETs of a cold object (zero: DfltTierPromoteHits)
+		Enabled     bool         `json:"enabled"`
+	}
+	TierConfToSet struct {
+		Hot         *string       `json:"hot,omitempty"`
+		Cold        *string       `json:"cold,omitempty"`
+		Prefix      *string       `json:"prefix,omitempty"`
+		DemoteAfter *cos.Duration `json:"demote_after,omitempty"`
+		PromoteHits *int          `json:"promote_hits,omitempty"`
+		Enabled     *bool         `json:"enabled,omitempty"`
+	}
+
+	// Bucket-only (non-inheritable) per-prefix permissions: for objects with names that have
+	// the given prefix, the principal gets `access` in addition to the bucket-wide permissions
+	// (see meta.Bck.AllowObj); principal is a user ID, "role:<role-name>", or "*" (anyone)
+	PrefixACE struct {
+		Principal string          `json:"principal"`
+		Prefix    string          `json:"prefix"`
+		Access    apc.AccessAttrs `json:"access,string"`
+	}
+	PrefixACL []PrefixACE
+
+	// bucket snapshot: created and destroyed via api.CreateSnapshot and api.DestroySnapshot
+	// (not settable via bucket props; see package bsnap)
+	SnapInfo struct {
+		Name    string `json:"name"`
+		ID      string `json:"id"`
+		Created int64  `json:"created,string"` // unix nano
+	}
 )
 
 //
@@ -239,13 +363,15 @@ func (bp *Bprops) Validate(targetCnt int) error {
 
 	// run assorted props validators
 	var softErr error
-	for _, pv := range []propsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Chunks, &bp.LRU, &bp.Features} {
+	for _, pv := range []propsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Chunks, &bp.LRU, &bp.Repl, &bp.ProxyCache, &bp.MDIndex, &bp.Trash, &bp.Tier, &bp.PrefixACL, &bp.Features} {
 		var err error
 		switch {
 		case pv == &bp.EC:
 			err = bp.EC.ValidateAsProps(targetCnt)
 		case pv == &bp.Extra:
 			err = bp.Extra.ValidateAsProps(bp.Provider)
+		case pv == &bp.Trash:
+			err = bp.Trash.ValidateAsProps(bp.Provider)
 		default:
 			err = pv.ValidateAsProps()
 		}
@@ -261,9 +387,26 @@ func (bp *Bprops) Validate(targetCnt int) error {
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		nlog.Warningln("n-way mirroring and EC are both enabled at the same time on the same bucket")
 	}
+	if bp.Tier.Enabled && bp.EC.Enabled {
+		return errors.New("storage classes (tiering) and EC cannot be enabled at the same time on the same bucket")
+	}
+	if bp.Tier.Enabled && bp.Mirror.Enabled {
+		return errors.New("storage classes (tiering) and n-way mirroring cannot be enabled at the same time on the same bucket")
+	}
 	if bp.Mirror.Enabled && bp.Chunks.AutoEnabled() {
 		return errors.New("n-way mirroring and chunking cannot be enabled at the same time on the same bucket (MPU chunking is still allowed)")
 	}
+	if bp.Versioning.MaxHistory > 0 {
+		switch {
+		case bp.Provider != apc.AIS:
+			bp.Versioning.MaxHistory = 0 // (version history: ais:// buckets only - not inheriting)
+		case bp.EC.Enabled:
+			return errors.New("version history (versioning.max_history) and EC cannot be enabled at the same time on the same bucket")
+		}
+	}
+	if len(bp.Snaps) > 0 && bp.EC.Enabled {
+		return errors.New("bucket snapshots and EC cannot be enabled at the same time on the same bucket")
+	}
 
 	// not inheriting cluster-scope features
 	names := bp.Features.Names()
@@ -325,6 +468,280 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 	return nil
 }
 
+//
+// ReplConf
+//
+
+func (c *ReplConf) DstBck() (bck Bck, err error) {
+	var objName string
+	bck, objName, err = ParseBckObjectURI(c.Dst, ParseURIOpts{})
+	if err != nil {
+		return bck, fmt.Errorf("invalid replication.dst %q: %v", c.Dst, err)
+	}
+	if bck.Name == "" || objName != "" {
+		return bck, fmt.Errorf("invalid replication.dst %q: expecting destination bucket, e.g. \"s3://abc\"", c.Dst)
+	}
+	return bck, nil
+}
+
+func (c *ReplConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	bck, err := c.DstBck()
+	if err != nil {
+		return err
+	}
+	if !bck.IsRemote() {
+		return fmt.Errorf("invalid replication.dst %q: destination must be a remote AIS or cloud bucket", c.Dst)
+	}
+	return nil
+}
+
+func (c *ReplConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	s := "to " + c.Dst
+	if c.Prefix != "" {
+		s += ", prefix " + strconv.Quote(c.Prefix)
+	}
+	if c.SyncDeletes {
+		s += ", sync-deletes"
+	}
+	return s
+}
+
+//
+// SnapInfo
+//
+
+func (bp *Bprops) GetSnap(name string) *SnapInfo {
+	for i := range bp.Snaps {
+		if bp.Snaps[i].Name == name {
+			return &bp.Snaps[i]
+		}
+	}
+	return nil
+}
+
+func (si *SnapInfo) String() string { return "snapshot[" + si.Name + ", " + si.ID + "]" }
+
+//
+// AuditBckConf
+//
+
+func (c *AuditBckConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	s := "writes"
+	if c.Reads {
+		s = "reads and writes"
+	}
+	if c.Prefix != "" {
+		s += ", prefix " + strconv.Quote(c.Prefix)
+	}
+	return s
+}
+
+//
+// ProxyCacheConf
+//
+
+const (
+	DfltPxcMaxObjSize = 64 * cos.KiB
+	MaxPxcObjSize     = 16 * cos.MiB
+	DfltPxcTTL        = 10 * time.Second
+)
+
+func (c *ProxyCacheConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxObjSize < 0 || c.MaxObjSize > MaxPxcObjSize {
+		return fmt.Errorf("invalid proxy_cache.max_obj_size=%s (expected range [0, %s])", c.MaxObjSize, cos.ToSizeIEC(MaxPxcObjSize, 0))
+	}
+	if c.TTL < 0 {
+		return fmt.Errorf("invalid proxy_cache.ttl=%s (expecting non-negative duration)", c.TTL)
+	}
+	return nil
+}
+
+func (c *ProxyCacheConf) MaxSize() int64 { return int64(cos.NonZero(c.MaxObjSize, DfltPxcMaxObjSize)) }
+func (c *ProxyCacheConf) TTLNano() int64 { return int64(cos.NonZero(c.TTL, cos.Duration(DfltPxcTTL))) }
+
+func (c *ProxyCacheConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	return "max_obj_size " + cos.ToSizeIEC(c.MaxSize(), 0) + ", ttl " + time.Duration(c.TTLNano()).String()
+}
+
+//
+// MDIndexConf
+//
+
+func (*MDIndexConf) ValidateAsProps(...any) error { return nil }
+
+func (c *MDIndexConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	return "enabled"
+}
+
+//
+// TrashConf
+//
+
+const (
+	DfltTrashRetention = 24 * time.Hour
+	MinTrashRetention  = time.Minute
+)
+
+func (c *TrashConf) ValidateAsProps(args ...any) error {
+	if provider, ok := args[0].(string); ok && c.Enabled && provider != apc.AIS {
+		return fmt.Errorf("trash (soft delete) is supported only for ais:// buckets, got %q", provider)
+	}
+	if c.Retention != 0 && c.Retention.D() < MinTrashRetention {
+		return fmt.Errorf("invalid trash.retention=%s (expecting zero (default) or at least %v)", c.Retention, MinTrashRetention)
+	}
+	return nil
+}
+
+func (c *TrashConf) RetentionD() time.Duration {
+	return cos.NonZero(c.Retention, cos.Duration(DfltTrashRetention)).D()
+}
+
+func (c *TrashConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	return "retention " + c.RetentionD().String()
+}
+
+//
+// TierConf
+//
+
+const (
+	DfltTierDemoteAfter = 30 * 24 * time.Hour
+	MinTierDemoteAfter  = time.Minute
+	DfltTierPromoteHits = 3
+)
+
+func (c *TierConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Hot == "" {
+		return errors.New("tier.hot (storage class, i.e. mountpath label) must be specified")
+	}
+	if c.Cold == c.Hot {
+		return fmt.Errorf("tier.hot and tier.cold must be different storage classes, got %q", c.Hot)
+	}
+	if c.DemoteAfter != 0 && c.DemoteAfter.D() < MinTierDemoteAfter {
+		return fmt.Errorf("invalid tier.demote_after=%s (expecting zero (default) or at least %v)", c.DemoteAfter, MinTierDemoteAfter)
+	}
+	if c.PromoteHits < 0 {
+		return fmt.Errorf("invalid tier.promote_hits=%d (expecting zero (default) or positive)", c.PromoteHits)
+	}
+	return nil
+}
+
+// whether a given object belongs to the storage class ("tier") defined by this config
+func (c *TierConf) Applies(objName string) bool {
+	return c.Enabled && strings.HasPrefix(objName, c.Prefix)
+}
+
+// whether objects are placed the same way (changing placement requires resilver)
+func (c *TierConf) SamePlacement(other *TierConf) bool {
+	if !c.Enabled || !other.Enabled {
+		return c.Enabled == other.Enabled
+	}
+	return c.Hot == other.Hot && c.Cold == other.Cold && c.Prefix == other.Prefix
+}
+
+func (c *TierConf) DemoteAfterD() time.Duration {
+	return cos.NonZero(c.DemoteAfter, cos.Duration(DfltTierDemoteAfter)).D()
+}
+
+func (c *TierConf) PromoteHitsN() int {
+	return cos.NonZero(c.PromoteHits, DfltTierPromoteHits)
+}
+
+func (c *TierConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	s := c.Hot
+	if c.Cold != "" {
+		s += " <=> " + c.Cold + " (demote after " + c.DemoteAfterD().String() + ", promote after " + strconv.Itoa(c.PromoteHitsN()) + " reads)"
+	}
+	if c.Prefix != "" {
+		s += ", prefix " + c.Prefix
+	}
+	return s
+}
+
+//
+// PrefixACL
+//
+
+const (
+	PrincipalAll  = "*"
+	PrincipalRole = "role:"
+)
+
+// object-level permissions, including list-objects
+const PrefixAccessMask = apc.AccessRW | apc.AceObjUpdate
+
+func (acl PrefixACL) ValidateAsProps(...any) error {
+	for i := range acl {
+		e := &acl[i]
+		switch {
+		case e.Principal == "" || e.Principal == PrincipalRole:
+			return fmt.Errorf("prefix_acl[%d]: missing principal (user ID, %q<role-name>, or %q)", i, PrincipalRole, PrincipalAll)
+		case e.Prefix == "":
+			return fmt.Errorf("prefix_acl[%d]: missing prefix (use bucket-wide access instead)", i)
+		case e.Access == 0:
+			return fmt.Errorf("prefix_acl[%d]: missing permissions", i)
+		case e.Access&^PrefixAccessMask != 0:
+			return fmt.Errorf("prefix_acl[%d]: expecting object-level permissions, got %q", i, e.Access.Describe(false))
+		}
+		if err := cos.ValidatePrefix("prefix_acl", e.Prefix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// the union of permissions granted to any of the principals for a given object
+func (acl PrefixACL) Access(objName string, principals []string) (access apc.AccessAttrs) {
+	for i := range acl {
+		e := &acl[i]
+		if strings.HasPrefix(objName, e.Prefix) && e.applies(principals) {
+			access |= e.Access
+		}
+	}
+	return access
+}
+
+// whether any of the principals is granted `perms` for at least one prefix
+func (acl PrefixACL) Grants(perms apc.AccessAttrs, principals []string) bool {
+	for i := range acl {
+		if e := &acl[i]; e.Access.Has(perms) && e.applies(principals) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *PrefixACE) applies(principals []string) bool {
+	return e.Principal == PrincipalAll || slices.Contains(principals, e.Principal)
+}
+
 //
 // Bucket Summary - result for a given bucket, and all results -------------------------------------------------
 //
@@ -362,6 +779,7 @@ func aggr(from, to *BsummResult) {
 	to.ObjCount.Present += from.ObjCount.Present
 	to.ObjCount.Remote += from.ObjCount.Remote
 	to.TotalSize.OnDisk += from.TotalSize.OnDisk
+	to.TotalSize.Snapshots += from.TotalSize.Snapshots
 	to.TotalSize.PresentObjs += from.TotalSize.PresentObjs
 	to.TotalSize.RemoteObjs += from.TotalSize.RemoteObjs
 }
@@ -393,6 +393,11 @@ func (n Ns) IsGlobal() bool    { return n == NsGlobal }
 func (n Ns) IsAnyRemote() bool { return n == NsAnyRemote }
 func (n Ns) IsRemote() bool    { return n.UUID != "" }
 
+// tenant: named namespace of *this* cluster (see TenantConf)
+func (n Ns) IsTenant() bool { return n.UUID == "" && n.Name != "" }
+
+func TenantNs(tenant string) Ns { return Ns{Name: tenant} }
+
 func (b *Bck) Backend() *Bck {
 	bprops := b.Props
 	if bprops == nil {
@@ -20,4 +20,6 @@ const (
 	CtxReadWrapper contextID = "readWrapper" // context key for ReadWrapperFunc
 	CtxSetSize     contextID = "setSize"     // context key for SetSizeFunc
 	CtxOriginalURL contextID = "origURL"     // context key for OriginalURL for HTTP cloud
+	CtxS3Signer    contextID = "s3Signer"    // context key for claims of the verified SigV4 signer (AuthN-issued S3 access key)
+	CtxClientConn  contextID = "clientConn"  // context key for client's *tls.Conn (to get client certificates - mTLS)
 )
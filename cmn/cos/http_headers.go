@@ -43,6 +43,10 @@ const (
 	HdrServer    = "Server"
 	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
 
+	// trailer fields: https://www.rfc-editor.org/rfc/rfc9110#section-6.5
+	HdrTE      = "TE" // (client willing to accept trailer fields when includes "trailers")
+	HdrTrailer = "Trailer"
+
 	HdrHSTS = "Strict-Transport-Security"
 
 	// RFC1123GMT or, same, http.TimeFormat ("Mon, 02 Jan 2006 15:04:05 GMT")
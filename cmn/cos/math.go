@@ -4,7 +4,11 @@
  */
 package cos
 
-import "github.com/NVIDIA/aistore/cmn/debug"
+import (
+	"math"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
 
 func DivCeil(a, b int64) int64 {
 	d, r := a/b, a%b
@@ -47,3 +51,14 @@ func ClampInt(i, mini, maxi int) int {
 	}
 	return min(i, maxi)
 }
+
+// Weighted rendezvous hashing (Schindelhauer & Schomaker): given uniformly distributed
+// 64-bit hash `h` and (relative) `weight`, returns weight / -ln(u), with u in (0, 1)
+// derived from the hash. The node with the highest score wins and does so with
+// probability proportional to its weight. With equal weights the order is the
+// same as the order of (unweighted) hashes.
+// Note that positive float64 values compare as their IEEE-754 bits (uint64).
+func WeightedHrw(h, weight uint64) uint64 {
+	u := (float64(h>>11) + 0.5) / (1 << 53)
+	return math.Float64bits(float64(max(weight, 1)) / -math.Log(u))
+}
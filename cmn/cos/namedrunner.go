@@ -19,4 +19,8 @@ type (
 		Name() string
 		ChanAbort() <-chan error
 	}
+	// optional: runner that can be paused and resumed
+	Pauser interface {
+		WaitIfPaused() (aborted bool)
+	}
 )
@@ -0,0 +1,94 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	cryptorand "crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Node-local secrets at rest: a random (AES-256) sealing key is generated on first use
+// and stored in a separate owner-only file; the secrets themselves (e.g., cluster key)
+// are sealed with AES-GCM before getting persisted.
+
+const (
+	PermRW os.FileMode = 0o600 // owner-only (secrets)
+
+	SealKeyLen = 32
+)
+
+// load or create (owner-only) sealing key
+func LoadSealKey(fpath string) ([]byte, error) {
+	key, err := os.ReadFile(fpath)
+	if err == nil {
+		if len(key) != SealKeyLen {
+			return nil, fmt.Errorf("invalid sealing key %q (len %d)", fpath, len(key))
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err := CreateDir(filepath.Dir(fpath)); err != nil {
+		return nil, err
+	}
+	key = CryptoRandB(SealKeyLen)
+	fh, err := os.OpenFile(fpath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, PermRW)
+	if err != nil {
+		if os.IsExist(err) { // racing with another caller
+			return LoadSealKey(fpath)
+		}
+		return nil, err
+	}
+	if _, err = fh.Write(key); err == nil {
+		err = fh.Sync()
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		RemoveFile(fpath)
+		return nil, err
+	}
+	return key, nil
+}
+
+// layout: [nonce | ciphertext+tag]
+func Seal(key, plain []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(plain)+gcm.Overhead())
+	if _, err := cryptorand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plain, nil), nil
+}
+
+func Unseal(key, sealed []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	ns := gcm.NonceSize()
+	if len(sealed) < ns+gcm.Overhead() {
+		return nil, errors.New("sealed data is too short")
+	}
+	return gcm.Open(nil, sealed[:ns], sealed[ns:], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
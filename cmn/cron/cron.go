@@ -0,0 +1,201 @@
+// Package cron parses and evaluates cron-style schedules.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Standard 5-field expression: "minute hour day-of-month month day-of-week"
+// (local time). Each field is '*', a value, a range 'a-b', or a step ('*/n', 'a-b/n'),
+// or a comma-separated list of the above. Day-of-week is 0-7 (both 0 and 7 are Sunday).
+// When both day-of-month and day-of-week are restricted, either one matching is enough
+// (the traditional cron semantics).
+//
+// Also supported: @hourly, @daily (@midnight), @weekly, @monthly, @yearly (@annually).
+
+type (
+	Expr struct {
+		src    string
+		min    uint64
+		hour   uint64
+		dom    uint64
+		month  uint64
+		dow    uint64
+		domAny bool
+		dowAny bool
+	}
+	field struct {
+		name     string
+		min, max int
+	}
+)
+
+var fields = [5]field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day-of-month", 1, 31},
+	{"month", 1, 12},
+	{"day-of-week", 0, 7},
+}
+
+var aliases = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// no match within this horizon means the expression never fires (e.g., "0 0 31 2 *")
+const maxLookahead = 5 * 366 * 24 * time.Hour
+
+func Parse(s string) (*Expr, error) {
+	src := strings.TrimSpace(s)
+	if src == "" {
+		return nil, errors.New("empty cron expression")
+	}
+	spec := src
+	if spec[0] == '@' {
+		a, ok := aliases[spec]
+		if !ok {
+			return nil, fmt.Errorf("invalid cron expression %q: unknown alias", src)
+		}
+		spec = a
+	}
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("invalid cron expression %q: expecting %d fields (minute hour day-of-month month day-of-week), got %d",
+			src, len(fields), len(parts))
+	}
+	var (
+		e    = &Expr{src: src}
+		bits [5]uint64
+	)
+	for i, part := range parts {
+		b, err := parseField(part, &fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", src, err)
+		}
+		bits[i] = b
+	}
+	e.min, e.hour, e.dom, e.month, e.dow = bits[0], bits[1], bits[2], bits[3], bits[4]
+	if e.dow&(1<<7) != 0 {
+		e.dow |= 1 // 7 => Sunday
+	}
+	e.domAny = strings.HasPrefix(parts[2], "*")
+	e.dowAny = strings.HasPrefix(parts[4], "*")
+	return e, nil
+}
+
+func parseField(s string, f *field) (bits uint64, _ error) {
+	for item := range strings.SplitSeq(s, ",") {
+		var (
+			rng, step = item, ""
+			lo, hi    = f.min, f.max
+			n         = 1
+			err       error
+		)
+		if i := strings.IndexByte(item, '/'); i >= 0 {
+			rng, step = item[:i], item[i+1:]
+			if n, err = strconv.Atoi(step); err != nil || n <= 0 {
+				return 0, fmt.Errorf("%s: invalid step %q", f.name, step)
+			}
+		}
+		switch {
+		case rng == "*":
+		case strings.IndexByte(rng, '-') > 0:
+			i := strings.IndexByte(rng, '-')
+			if lo, err = f.atoi(rng[:i]); err != nil {
+				return 0, err
+			}
+			if hi, err = f.atoi(rng[i+1:]); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("%s: invalid range %q", f.name, rng)
+			}
+		default:
+			if lo, err = f.atoi(rng); err != nil {
+				return 0, err
+			}
+			if step == "" {
+				hi = lo
+			}
+		}
+		for v := lo; v <= hi; v += n {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func (f *field) atoi(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid value %q", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s: value %d is out of range [%d, %d]", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+func (e *Expr) String() string { return e.src }
+
+// whether the schedule fires at the given time (truncated to the minute)
+func (e *Expr) Match(t time.Time) bool {
+	if e.min&(1<<t.Minute()) == 0 || e.hour&(1<<t.Hour()) == 0 || e.month&(1<<int(t.Month())) == 0 {
+		return false
+	}
+	return e.matchDay(t)
+}
+
+func (e *Expr) matchDay(t time.Time) bool {
+	var (
+		dom = e.dom&(1<<t.Day()) != 0
+		dow = e.dow&(1<<int(t.Weekday())) != 0
+	)
+	switch {
+	case e.domAny && e.dowAny:
+		return true
+	case e.domAny:
+		return dow
+	case e.dowAny:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// the next time (strictly after t) the schedule fires; zero time if never
+func (e *Expr) Next(t time.Time) time.Time {
+	var (
+		end = t.Add(maxLookahead)
+		loc = t.Location()
+	)
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(end) {
+		switch {
+		case e.month&(1<<int(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !e.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case e.hour&(1<<t.Hour()) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case e.min&(1<<t.Minute()) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
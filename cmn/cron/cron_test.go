@@ -0,0 +1,62 @@
+// Package cron_test: unit tests for the package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cron"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParse(t *testing.T) {
+	valid := []string{
+		"* * * * *", "0 2 * * *", "*/15 * * * *", "0 0-6/2 * * 1-5", "5,10,20-25 * 1 1,6 7", "@daily", "@hourly", " @weekly ",
+	}
+	for _, s := range valid {
+		_, err := cron.Parse(s)
+		tassert.Errorf(t, err == nil, "%q: unexpected error: %v", s, err)
+	}
+	invalid := []string{
+		"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8",
+		"*/0 * * * *", "5-1 * * * *", "a * * * *", "@sometimes",
+	}
+	for _, s := range invalid {
+		_, err := cron.Parse(s)
+		tassert.Errorf(t, err != nil, "%q: expected error", s)
+	}
+}
+
+func TestMatchNext(t *testing.T) {
+	// Monday, 2026-03-02 10:07
+	now := time.Date(2026, 3, 2, 10, 7, 30, 0, time.UTC)
+	tests := []struct {
+		expr string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2026, 3, 2, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 3, 2, 10, 15, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2026, 3, 3, 2, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 3, 2, 11, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"30 4 29 2 *", time.Date(2028, 2, 29, 4, 30, 0, 0, time.UTC)},
+		// day-of-month OR day-of-week
+		{"0 0 15 * 3", time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", time.Time{}},
+	}
+	for _, test := range tests {
+		e, err := cron.Parse(test.expr)
+		tassert.CheckFatal(t, err)
+		next := e.Next(now)
+		tassert.Errorf(t, next.Equal(test.next), "%q: expected next %v, got %v", test.expr, test.next, next)
+		if !next.IsZero() {
+			tassert.Errorf(t, e.Match(next), "%q: expected to match %v", test.expr, next)
+			tassert.Errorf(t, !e.Match(next.Add(-time.Minute)) || test.expr == "* * * * *", "%q: unexpected match", test.expr)
+		}
+	}
+}
@@ -49,6 +49,8 @@ const (
 	ResumeInterruptedMPU      // resume interrupted multipart uploads from persisted partial manifests
 	KeepUnknownFQN            // do not delete unrecognized/invalid FQNs during space cleanup ('ais space-cleanup')
 	LoadBalanceGET            // when bucket is n-way mirrored read object replica from the least-utilized mountpath
+	WeightedHRW               // capacity-weighted HRW: place objects on targets and mountpaths proportionally to their sizes (toggling starts rebalance and resilver)
+	S3UnsignedPayload         // accept UNSIGNED-PAYLOAD in S3 requests signed with AuthN-issued access keys (default: require payload hash or signed chunks)
 )
 
 var Cluster = [...]string{
@@ -76,6 +78,8 @@ var Cluster = [...]string{
 	"Resume-Interrupted-MPU",
 	"Keep-Unknown-FQN",
 	"Load-Balance-GET",
+	"Weighted-HRW",
+	"S3-Unsigned-Payload",
 
 	// apc.ResetToken ("none") ===========
 }
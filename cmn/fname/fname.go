@@ -32,6 +32,11 @@ const (
 	BmdPrevious = Bmd + ".prev" // bmd previous version
 	Vmd         = ".ais.vmd"    // vmd persistent file basename
 	Emd         = ".ais.emd"    // emd persistent file basename
+	JobSched    = ".ais.jsched" // scheduled jobs (proxies only)
+	S3Keys      = ".ais.s3keys" // S3 access keys issued by AuthN (proxies only)
+	Certs       = ".ais.certs"  // client certificate identities mapped by AuthN (proxies only)
+	CSK         = ".ais.csk"    // cluster key (see auth.cluster_key)
+	SealKey     = ".ais.seal"   // node-local key to encrypt secrets at rest (see cos.Seal)
 
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
@@ -60,4 +65,10 @@ const (
 	RebalanceMarker     = "rebalance"
 	NodeRestartedMarker = "node_restarted"
 	NodeRestartedPrev   = "node_restarted.prev"
+
+	// Bucket replication journals: per mountpath (see package repl)
+	ReplDir = ".ais.repl"
+
+	// Checkpoints of paused xactions: per mountpath (see fs/mpather/ckpt.go)
+	XactDir = ".ais.xact"
 )
@@ -156,7 +156,7 @@ func (bd *BuntDriver) DeleteCollection(collection string) (int, error) {
 	}
 	err = bd.driver.Update(func(tx *buntdb.Tx) error {
 		for _, k := range keys {
-			_, err := tx.Delete(k)
+			_, err := tx.Delete(makePath(collection, k))
 			if err != nil && err != buntdb.ErrNotFound {
 				return err
 			}
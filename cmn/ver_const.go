@@ -41,6 +41,10 @@ const (
 	MetaverVMD   = 2 // Volume MD (jsp)
 	MetaverEtlMD = 2 // ETL MD (jsp)
 
+	MetaverJobSched = 1 // scheduled jobs (jsp)
+	MetaverS3Keys   = 1 // S3 access keys and client certificate identities (jsp)
+	MetaverCSK      = 1 // cluster key (jsp)
+
 	MetaverConfig      = 4 // Global Configuration (jsp)
 	MetaverAuthNConfig = 1 // Authn config (jsp) // ditto
 	MetaverAuthTokens  = 1 // Authn tokens (jsp) // ditto
@@ -173,7 +173,17 @@ func NewCTFromBO(bck *meta.Bck, objName, ctType string, extras ...string) (ct *C
 }
 
 func (ct *CT) init(extras ...string) error {
-	mi, digest, err := fs.Hrw(ct.bck.MakeUname(ct.objName))
+	var (
+		mi     *fs.Mountpath
+		digest uint64
+		err    error
+		uname  = ct.bck.MakeUname(ct.objName)
+	)
+	if conf := tierConf(ct.bck, ct.objName); conf != nil && ct.contentType == fs.ObjCT {
+		mi, digest, err = tierLocate(ct.bck, ct.objName, uname, conf)
+	} else {
+		mi, digest, err = fs.Hrw(uname)
+	}
 	if err != nil {
 		return err
 	}
@@ -9,7 +9,7 @@ import (
 )
 
 // 1. parse fqn
-// 2. compute hrw fqn (may differ)
+// 2. compute hrw fqn (may differ; storage classes - see ltier.go)
 // 3. compute and set digest
 func ResolveFQN(fqn string, parsed *fs.ParsedFQN) (hrwFQN string, _ error) {
 	if err := parsed.Init(fqn); err != nil {
@@ -19,6 +19,9 @@ func ResolveFQN(fqn string, parsed *fs.ParsedFQN) (hrwFQN string, _ error) {
 	uname := parsed.Bck.MakeUname(parsed.ObjName)
 	mi, digest, err := fs.Hrw(uname)
 	if err == nil {
+		if tmi := tierResolve(parsed, uname); tmi != nil {
+			mi = tmi
+		}
 		// may differ from fqn if object is misplaced (see lom.IsHRW)
 		hrwFQN = mi.MakePathFQN(&parsed.Bck, parsed.ContentType, parsed.ObjName)
 	}
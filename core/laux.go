@@ -0,0 +1,293 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+//
+// auxiliary per-object content that follows the object (see AuxCTs): trash
+// (see ltrash.go), version history (see lver.go), and bucket snapshots (see bsnap);
+// rebalance and resilver migrate it along with objects (see reb and res)
+//
+// preserved copies (trash, snapshots) are hard links - no data copying:
+// - chunk #1 (the object's own file that also carries its metadata) is linked as
+//   the "main" preserved file, e.g. fs.TrashCT/<object-name>/<deletion time>
+// - a chunked object additionally gets its completed manifest linked alongside
+//   (<extra>.m), and each of the remaining chunks - on the chunk's own mountpath
+//   (<extra>.<chunk number>)
+// - manifest and chunk links are found by searching all available mountpaths
+//   (resilver leaves them in place unless the mountpath is going away)
+//
+
+var AuxCTs = []string{fs.TrashCT, fs.VersionCT, fs.SnapCT}
+
+const psvManifest = "m"
+
+func psvExtra(extra, sfx string) string { return extra + "." + sfx }
+
+// IsPsvCT returns true for content types that keep preserved (hard-linked) copies
+func IsPsvCT(ct string) bool { return ct == fs.TrashCT || ct == fs.SnapCT }
+
+// ParsePsv splits the given extra into the main preserved file's extra and
+// the suffix (empty for the main file itself)
+func ParsePsv(extra string) (string, string) {
+	if i := strings.LastIndexByte(extra, '.'); i > 0 {
+		return extra[:i], extra[i+1:]
+	}
+	return extra, ""
+}
+
+// LinkPreserved hard-links the object (and, if chunked, its manifest and chunks)
+// as fs.CSM.Gen(<object>, ct, extra); must be called under lock with the object loaded
+func (lom *LOM) LinkPreserved(ct, extra string) error {
+	links := make([]string, 0, 2)
+	err := lom._linkPsv(ct, extra, &links)
+	if err != nil {
+		for _, fqn := range links {
+			if nested := cos.RemoveFile(fqn); nested != nil {
+				nlog.Errorln("nested err:", nested)
+			}
+		}
+	}
+	return err
+}
+
+func (lom *LOM) _linkPsv(ct, extra string, links *[]string) error {
+	if err := _link(lom.FQN, lom.GenFQN(ct, extra), links); err != nil {
+		return err
+	}
+	if !lom.IsChunked() {
+		return nil
+	}
+	u, err := NewUfest("", lom, true)
+	if err != nil {
+		return err
+	}
+	if err := u.LoadCompleted(lom); err != nil {
+		return err
+	}
+	if err := _link(u._fqns(lom, true), lom.GenFQN(ct, psvExtra(extra, psvManifest)), links); err != nil {
+		return err
+	}
+	for i := 1; i < len(u.chunks); i++ {
+		c := &u.chunks[i]
+		mi, _, err := fs.FQN2Mpath(c.path)
+		if err != nil {
+			return err
+		}
+		dst := fs.CSM.Gen(lom.ObjName, ct, lom.Bucket(), mi, psvExtra(extra, formatCnum(int(c.num))))
+		if err := _link(c.path, dst, links); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _link(src, dst string, links *[]string) error {
+	if err := cos.CreateDir(filepath.Dir(dst)); err != nil {
+		return err
+	}
+	if err := os.Link(src, dst); err != nil {
+		return err
+	}
+	*links = append(*links, dst)
+	return nil
+}
+
+// find preserved file (manifest or chunk link): the given mountpath first
+func (lom *LOM) findPsv(ct, extra string, first *fs.Mountpath) string {
+	if first != nil {
+		if fqn := fs.CSM.Gen(lom.ObjName, ct, lom.Bucket(), first, extra); cos.Stat(fqn) == nil {
+			return fqn
+		}
+	}
+	for _, mi := range fs.GetAvail() {
+		if first != nil && mi.Path == first.Path {
+			continue
+		}
+		if fqn := fs.CSM.Gen(lom.ObjName, ct, lom.Bucket(), mi, extra); cos.Stat(fqn) == nil {
+			return fqn
+		}
+	}
+	return ""
+}
+
+// PsvFQNs returns the manifest and chunk links of the preserved chunked object,
+// given its main file (plom, with metadata loaded); manifest first
+func (lom *LOM) PsvFQNs(plom *LOM, ct, extra string) ([]string, error) {
+	u, err := lom.loadPsv(plom, ct, extra)
+	if err != nil {
+		return nil, err
+	}
+	mi, _, _ := fs.FQN2Mpath(plom.FQN)
+	fqns := make([]string, 0, len(u.chunks))
+	fqns = append(fqns, lom.findPsv(ct, psvExtra(extra, psvManifest), mi))
+	for i := 1; i < len(u.chunks); i++ {
+		fqns = append(fqns, u.chunks[i].path)
+	}
+	return fqns, nil
+}
+
+// load the preserved manifest and resolve the locations of the preserved chunks
+func (lom *LOM) loadPsv(plom *LOM, ct, extra string) (*Ufest, error) {
+	mi, _, err := fs.FQN2Mpath(plom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	mfqn := lom.findPsv(ct, psvExtra(extra, psvManifest), mi)
+	if mfqn == "" {
+		return nil, cos.NewErrNotFound(T, lom.Cname()+" (preserved chunk manifest)")
+	}
+	u, err := NewUfest("", lom, true)
+	if err != nil {
+		return nil, err
+	}
+	csgl := g.pmm.NewSGL(sizeLoad)
+	defer csgl.Free()
+	fh, err := os.Open(mfqn)
+	if err != nil {
+		return nil, err
+	}
+	_, err = io.Copy(csgl, fh)
+	cos.Close(fh)
+	if err != nil {
+		return nil, err
+	}
+	if err := u._load(csgl); err != nil {
+		return nil, u._errLoad(tagCompleted, lom.Cname(), err)
+	}
+	if err := u.Check(true); err != nil {
+		return nil, u._errLoad(tagCompleted, lom.Cname(), cmn.NewErrLmetaCorrupted(err))
+	}
+	if size := plom.Lsize(true); size != u.size {
+		return nil, fmt.Errorf("%s: preserved manifest size %d vs object size %d", u._itag(lom.Cname()), u.size, size)
+	}
+	u.completed.Store(true)
+
+	u.chunks[0].path = plom.FQN
+	for i := 1; i < len(u.chunks); i++ {
+		c := &u.chunks[i]
+		cfqn := lom.findPsv(ct, psvExtra(extra, formatCnum(int(c.num))), nil)
+		if cfqn == "" {
+			return nil, cos.NewErrNotFound(T, fmt.Sprintf("%s (preserved chunk %d/%d)", lom.Cname(), c.num, u.count))
+		}
+		c.path = cfqn
+	}
+	return u, nil
+}
+
+// reader of a preserved chunked object (see OpenPreserved)
+type psvReader struct {
+	*UfestReader
+}
+
+func (r *psvReader) Open() (cos.ReadOpenCloser, error) {
+	ur, err := r.u.NewReader()
+	if err != nil {
+		return nil, err
+	}
+	return &psvReader{ur}, nil
+}
+
+// OpenPreserved opens the preserved copy given its main file (plom, with metadata loaded)
+func (lom *LOM) OpenPreserved(plom *LOM, ct, extra string) (cos.ReadOpenCloser, error) {
+	if !plom.IsChunked() {
+		return cos.NewFileHandle(plom.FQN)
+	}
+	u, err := lom.loadPsv(plom, ct, extra)
+	if err != nil {
+		return nil, err
+	}
+	ur, err := u.NewReader()
+	if err != nil {
+		return nil, err
+	}
+	return &psvReader{ur}, nil
+}
+
+// RemovePreserved removes the preserved copy: the main file and, if chunked,
+// its manifest and chunk links
+func (lom *LOM) RemovePreserved(fqn, ct, extra string) error {
+	var (
+		plom = lom.CloneTo(fqn)
+		err  error
+	)
+	defer FreeLOM(plom)
+	if plom.LoadMetaFromFS() == nil && plom.IsChunked() {
+		var fqns []string
+		if fqns, err = lom.PsvFQNs(plom, ct, extra); err == nil {
+			for _, f := range fqns {
+				if f == "" {
+					continue
+				}
+				if errV := cos.RemoveFile(f); errV != nil {
+					err = errors.Join(err, errV)
+				}
+			}
+		}
+	}
+	if errV := cos.RemoveFile(fqn); errV != nil {
+		err = errors.Join(err, errV)
+	}
+	return err
+}
+
+//
+// rebalance and resilver
+//
+
+// AuxMeta returns the raw on-disk metadata of the auxiliary file, if any
+// (delete markers, manifests, and chunk links carry none)
+func AuxMeta(fqn string) []byte {
+	b, err := fs.GetXattr(fqn, xattrLOM)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// WriteAux stores auxiliary content received from another target (rebalance)
+// or relocated from another mountpath (resilver);
+// the caller must wlock the object
+func (lom *LOM) WriteAux(mi *fs.Mountpath, ct, extra string, md []byte, r io.Reader, buf []byte) error {
+	var (
+		fqn  = fs.CSM.Gen(lom.ObjName, ct, lom.Bucket(), mi, extra)
+		wfqn = fs.CSM.Gen(lom.ObjName, fs.WorkCT, lom.Bucket(), mi, fs.WorkfilePut)
+	)
+	fh, err := cos.CreateFile(wfqn)
+	if err != nil {
+		return err
+	}
+	if r != nil {
+		_, err = cos.CopyBuffer(fh, r, buf)
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil && len(md) > 0 {
+		err = fs.SetXattr(wfqn, xattrLOM, md)
+	}
+	if err == nil {
+		err = cos.Rename(wfqn, fqn)
+	}
+	if err != nil {
+		if nested := cos.RemoveFile(wfqn); nested != nil {
+			nlog.Errorln("nested err:", nested)
+		}
+	}
+	return err
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"slices"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -421,6 +422,12 @@ func (lom *LOM) _copy2fqn(dst *LOM, buf []byte, sameBucket bool) (err, nested er
 		locked = dst.TryLock(true)
 	}
 
+	if !lom.isMirror(dst) {
+		if err = dst.cow(); err != nil {
+			nested = cos.RemoveFile(workFQN)
+			return err, nested, locked
+		}
+	}
 	if err = cos.Rename(workFQN, dstFQN); err != nil {
 		nested = cos.RemoveFile(workFQN)
 		return err, nested, locked
@@ -495,25 +502,61 @@ func (lom *LOM) OpenCopy() (cos.LomReader, string) {
 	return nil, ""
 }
 
-// returns the least-utilized mountpath that does _not_ have a copy of this `lom` yet
+// returns the least-utilized mountpath that does _not_ have a copy of this `lom` yet;
+// mountpaths that share disks with the existing copies (and would therefore fail
+// together) are selected only when there's no other choice
 // (compare with leastUtilCopy())
 func (lom *LOM) LeastUtilNoCopy() (mi *fs.Mountpath) {
 	var (
 		avail      = fs.GetAvail()
 		mpathUtils = fs.GetAllMpathUtils()
+		shared     *fs.Mountpath
 		minUtil    = int64(101) // to motivate the first assignment
+		minShared  = int64(101)
 	)
 	for mpath, mpathInfo := range avail {
 		if lom.haveMpath(mpath) || mpathInfo.IsAnySet(fs.FlagWaitingDD) {
 			continue
 		}
-		if util := mpathUtils.Get(mpath); util < minUtil {
+		util := mpathUtils.Get(mpath)
+		if lom.shareDisks(mpathInfo) {
+			if util < minShared {
+				minShared, shared = util, mpathInfo
+			}
+			continue
+		}
+		if util < minUtil {
 			minUtil, mi = util, mpathInfo
 		}
 	}
+	if mi == nil {
+		mi = shared
+	}
 	return
 }
 
+// whether a given mountpath shares disks with any of the existing copies
+func (lom *LOM) shareDisks(mi *fs.Mountpath) bool {
+	if len(lom.md.copies) == 0 {
+		return _shareDisks(lom.mi, mi)
+	}
+	for _, cmi := range lom.md.copies {
+		if _shareDisks(cmi, mi) {
+			return true
+		}
+	}
+	return false
+}
+
+func _shareDisks(a, b *fs.Mountpath) bool {
+	for _, disk := range a.Disks {
+		if slices.Contains(b.Disks, disk) {
+			return true
+		}
+	}
+	return false
+}
+
 func (lom *LOM) haveMpath(mpath string) bool {
 	if len(lom.md.copies) == 0 {
 		return lom.mi.Path == mpath
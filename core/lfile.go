@@ -205,6 +205,9 @@ func (lom *LOM) RemoveObj(force ...bool) (err error) {
 		// NOTE: making "rlock" exception to be able to forcefully rm corrupted object in the GET path
 		return len(force) > 0 && force[0] && locked == apc.LockRead
 	})
+	if err = lom.cow(); err != nil {
+		return err
+	}
 	err = lom.RemoveMain()
 	for copyFQN := range lom.md.copies {
 		if erc := cos.RemoveFile(copyFQN); erc != nil && !cos.IsNotExist(erc) && err == nil {
@@ -232,6 +235,9 @@ func (lom *LOM) RenameMainTo(wfqn string) error {
 }
 
 func (lom *LOM) RenameToMain(wfqn string) error {
+	if err := lom.cow(); err != nil {
+		return err
+	}
 	err := cos.Rename(wfqn, lom.FQN)
 	if err == nil {
 		return nil
@@ -93,7 +93,11 @@ func (lom *LOM) InitBck(bck *meta.Bck) (err error) {
 	}
 	uname := lom.bck.MakeUname(lom.ObjName)
 	lom.md.uname = cos.UnsafeSptr(uname)
-	lom.mi, lom.digest, err = fs.Hrw(uname)
+	if conf := lom.TierConf(); conf != nil {
+		lom.mi, lom.digest, err = tierLocate(&lom.bck, lom.ObjName, uname, conf)
+	} else {
+		lom.mi, lom.digest, err = fs.Hrw(uname)
+	}
 	if err != nil {
 		return
 	}
@@ -414,11 +414,25 @@ func (lom *LOM) CksumType() string {
 	}
 	return c.Type
 }
+
+// (end-to-end implies warm GET validation of monolithic objects - chunked ones get validated
+// on the fly, chunk by chunk; see UfestVerifier)
 func (lom *LOM) ValidateWarmGet() bool {
-	return lom.CksumType() != cos.ChecksumNone && lom.CksumConf().ValidateWarmGet
+	if lom.CksumType() == cos.ChecksumNone {
+		return false
+	}
+	c := lom.CksumConf()
+	return c.ValidateWarmGet || (c.EndToEnd && !lom.IsChunked())
 }
 func (lom *LOM) ValidateColdGet() bool {
-	return lom.CksumType() != cos.ChecksumNone && lom.CksumConf().ValidateColdGet
+	if lom.CksumType() == cos.ChecksumNone {
+		return false
+	}
+	c := lom.CksumConf()
+	return c.ValidateColdGet || c.EndToEnd
+}
+func (lom *LOM) ValidateEndToEnd() bool {
+	return lom.CksumType() != cos.ChecksumNone && lom.CksumConf().EndToEnd
 }
 
 // to report via list-objects and HEAD()
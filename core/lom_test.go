@@ -38,6 +38,10 @@ var _ = Describe("LOM", func() {
 		bucketCloudB = "LOM_TEST_Cloud_B"
 
 		sameBucketName = "LOM_TEST_Local_and_Cloud"
+
+		bucketTier = "LOM_TEST_Tier"
+
+		bucketTrash = "LOM_TEST_Trash"
 	)
 
 	var (
@@ -91,6 +95,14 @@ var _ = Describe("LOM", func() {
 		meta.NewBck(bucketCloudA, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 5}),
 		meta.NewBck(bucketCloudB, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 6}),
 		meta.NewBck(sameBucketName, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 7}),
+		meta.NewBck(
+			bucketTier, apc.AIS, cmn.NsGlobal,
+			&cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}, Tier: cmn.TierConf{Enabled: true, Hot: "nvme", Cold: "hdd"}, BID: 8},
+		),
+		meta.NewBck(
+			bucketTrash, apc.AIS, cmn.NsGlobal,
+			&cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}, Trash: cmn.TrashConf{Enabled: true}, BID: 9},
+		),
 	)
 
 	BeforeEach(func() {
@@ -766,6 +778,37 @@ var _ = Describe("LOM", func() {
 				Expect(copyObjHash).To(BeEquivalentTo(expectedHash))
 			})
 
+			It("should prefer mountpaths that do not share disks with existing copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				var (
+					avail  = fs.GetAvail()
+					others []*fs.Mountpath
+					saved  = make(map[string][]string, len(avail))
+				)
+				for mpath, mi := range avail {
+					saved[mpath] = mi.Disks
+					if mpath != lom.Mountpath().Path {
+						others = append(others, mi)
+					}
+				}
+				defer func() {
+					for mpath, mi := range avail {
+						mi.Disks = saved[mpath]
+					}
+				}()
+				Expect(others).To(HaveLen(numMpaths - 1))
+
+				lom.Mountpath().Disks = []string{"sda"}
+				others[0].Disks, others[1].Disks = []string{"sda"}, []string{"sdb"}
+				Expect(lom.LeastUtilNoCopy().Path).To(Equal(others[1].Path))
+				others[0].Disks, others[1].Disks = []string{"sdc"}, []string{"sda", "sdd"}
+				Expect(lom.LeastUtilNoCopy().Path).To(Equal(others[0].Path))
+
+				// no choice
+				others[0].Disks, others[1].Disks = []string{"sda"}, []string{"sda"}
+				Expect(lom.LeastUtilNoCopy()).NotTo(BeNil())
+			})
+
 			It("should successfully copy the object in case it is mirror copy", func() {
 				lom := prepareLOM(mirrorFQNs[0])
 				copyLOM := prepareCopy(lom, mirrorFQNs[1])
@@ -1099,6 +1142,184 @@ var _ = Describe("LOM", func() {
 		})
 	})
 
+	Describe("storage classes", func() {
+		tierBck := cmn.Bck{Name: bucketTier, Provider: apc.AIS, Ns: cmn.NsGlobal}
+
+		It("should place objects within their storage class", func() {
+			avail := fs.GetAvail()
+			avail[mpaths[0]].Label = "nvme"
+			avail[mpaths[1]].Label = "hdd"
+			avail[mpaths[2]].Label = "hdd"
+			defer func() {
+				for _, mi := range avail {
+					mi.Label = cos.TestMpathLabel
+				}
+			}()
+
+			for i := range 20 {
+				objName := "tier/obj-" + strconv.Itoa(i)
+
+				lom := &core.LOM{ObjName: objName}
+				Expect(lom.InitCmnBck(&tierBck)).NotTo(HaveOccurred())
+				Expect(lom.Mountpath().Path).To(Equal(mpaths[0]))
+				Expect(lom.IsHRW()).To(BeTrue())
+
+				// demoted: found in the cold class
+				cold := lom.TierMpath(avail, "hdd")
+				Expect(cold).NotTo(BeNil())
+				coldFQN := cold.MakePathFQN(&tierBck, fs.ObjCT, objName)
+				createTestFile(coldFQN, 0)
+
+				lom = &core.LOM{ObjName: objName}
+				Expect(lom.InitCmnBck(&tierBck)).NotTo(HaveOccurred())
+				Expect(lom.FQN).To(Equal(coldFQN))
+				Expect(lom.IsCold(lom.TierConf())).To(BeTrue())
+
+				lom = newBasicLom(coldFQN)
+				Expect(lom.IsHRW()).To(BeTrue())
+				var parsed fs.ParsedFQN
+				hrwFQN, err := core.ResolveFQN(coldFQN, &parsed)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(hrwFQN).To(Equal(coldFQN))
+
+				// but not on any other cold mountpath
+				for _, mi := range avail {
+					if mi.Path != cold.Path && mi.Label == "hdd" {
+						lom = newBasicLom(mi.MakePathFQN(&tierBck, fs.ObjCT, objName))
+						Expect(lom.IsHRW()).To(BeFalse())
+					}
+				}
+			}
+		})
+
+		It("should move chunked objects together with their chunks", func() {
+			avail := fs.GetAvail()
+			avail[mpaths[0]].Label = "nvme"
+			avail[mpaths[1]].Label = "hdd"
+			avail[mpaths[2]].Label = "hdd"
+			defer func() {
+				for _, mi := range avail {
+					mi.Label = cos.TestMpathLabel
+				}
+			}()
+
+			chunkPaths := func(lom *core.LOM) []string {
+				u, err := core.NewUfest("", lom, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(u.LoadCompleted(lom)).NotTo(HaveOccurred())
+				paths := make([]string, 0, u.Count())
+				for i := 1; i <= u.Count(); i++ {
+					c, err := u.GetChunk(i)
+					Expect(err).NotTo(HaveOccurred())
+					paths = append(paths, c.Path())
+				}
+				return paths
+			}
+			expectClass := func(paths []string, label string) {
+				for _, path := range paths {
+					var parsed fs.ParsedFQN
+					Expect(parsed.Init(path)).NotTo(HaveOccurred())
+					Expect(string(parsed.Mountpath.Label)).To(Equal(label), path)
+				}
+			}
+
+			lom := &core.LOM{ObjName: "tier/chunked"}
+			Expect(lom.InitCmnBck(&tierBck)).NotTo(HaveOccurred())
+			Expect(lom.Mountpath().Path).To(Equal(mpaths[0]))
+			lom = prepareLOMChunked(lom.FQN, 6)
+			hot := chunkPaths(lom)
+			expectClass(hot, "nvme")
+
+			// demote
+			buf := make([]byte, cos.KiB)
+			lom.Lock(true)
+			cold, err := lom.MoveTier(lom.TierMpath(avail, "hdd"), buf)
+			lom.Unlock(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cold.IsCold(cold.TierConf())).To(BeTrue())
+			for _, path := range hot {
+				Expect(path).NotTo(BeAnExistingFile())
+			}
+			Expect(lom.GenFQN(fs.ChunkMetaCT)).NotTo(BeAnExistingFile())
+			expectClass(chunkPaths(cold), "hdd")
+
+			cold.Lock(true)
+			_, ok := cold.HrwWithChunks(avail)
+			cold.Unlock(true)
+			Expect(ok).To(BeTrue())
+
+			// promote
+			cold.Lock(true)
+			promoted, err := cold.MoveTier(cold.TierMpath(avail, "nvme"), buf)
+			cold.Unlock(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(promoted.Mountpath().Path).To(Equal(mpaths[0]))
+			expectClass(chunkPaths(promoted), "nvme")
+		})
+	})
+
+	Describe("trash", func() {
+		trashBck := cmn.Bck{Name: bucketTrash, Provider: apc.AIS, Ns: cmn.NsGlobal}
+
+		It("should preserve and restore chunked objects", func() {
+			lom := &core.LOM{ObjName: "trash/chunked"}
+			Expect(lom.InitCmnBck(&trashBck)).NotTo(HaveOccurred())
+			lom = prepareLOMChunked(lom.FQN, 5)
+
+			readAll := func(r io.ReadCloser) []byte {
+				b, err := io.ReadAll(r)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r.Close()).NotTo(HaveOccurred())
+				return b
+			}
+			lom.Lock(false)
+			r, err := lom.Open()
+			Expect(err).NotTo(HaveOccurred())
+			orig := readAll(r)
+			lom.Unlock(false)
+
+			lom.Lock(true)
+			Expect(lom.MoveToTrash()).NotTo(HaveOccurred())
+			Expect(lom.RemoveObj()).NotTo(HaveOccurred())
+			lom.Unlock(true)
+
+			// the main file, the manifest, and the links to chunks 2..5
+			var tfqn, deleted string
+			cnt := 0
+			for _, mi := range fs.GetAvail() {
+				dir := filepath.Dir(fs.CSM.Gen(lom.ObjName, fs.TrashCT, &trashBck, mi, "0"))
+				dents, _ := os.ReadDir(dir)
+				for _, de := range dents {
+					_, main, err := core.ParseTrashed(de.Name())
+					Expect(err).NotTo(HaveOccurred())
+					if main {
+						tfqn, deleted = filepath.Join(dir, de.Name()), de.Name()
+					}
+					cnt++
+				}
+			}
+			Expect(cnt).To(Equal(6))
+			Expect(tfqn).NotTo(BeEmpty())
+
+			tlom := lom.CloneTo(tfqn)
+			Expect(tlom.LoadMetaFromFS()).NotTo(HaveOccurred())
+			Expect(tlom.IsChunked()).To(BeTrue())
+			roc, err := lom.OpenPreserved(tlom, fs.TrashCT, deleted)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readAll(roc)).To(Equal(orig))
+			roc, err = roc.Open()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readAll(roc)).To(Equal(orig))
+
+			Expect(lom.RemovePreserved(tfqn, fs.TrashCT, deleted)).NotTo(HaveOccurred())
+			for _, mi := range fs.GetAvail() {
+				dir := filepath.Dir(fs.CSM.Gen(lom.ObjName, fs.TrashCT, &trashBck, mi, "0"))
+				dents, _ := os.ReadDir(dir)
+				Expect(dents).To(BeEmpty())
+			}
+		})
+	})
+
 	Describe("local and cloud bucket with the same name", func() {
 		It("should have different fqn", func() {
 			testObject := "foldr/test-obj.ext"
@@ -17,10 +17,11 @@ import (
 //
 
 // return expected (HRW) mountpath and whether the object is properly located (ie, not misplaced)
+// (for tiered buckets, HRW within the object's current storage class - see ltier.go)
 func (lom *LOM) Hrw(avail fs.MPI) (*fs.Mountpath, bool /*ok*/) {
 	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname(), "expecting w-locked")
 
-	hrwMi, _, err := avail.Hrw(cos.UnsafeB(*lom.md.uname))
+	hrwMi, err := lom.hrwMi(avail)
 	if err != nil {
 		nlog.Warningln(err)
 		return nil, false
@@ -0,0 +1,45 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+//
+// bucket snapshots: copy-on-write hooks
+//
+
+// implemented by package `bsnap`
+type SnapCOW interface {
+	// called (under wlock) prior to overwriting or removing the object
+	// that may belong to one or more bucket snapshots
+	COW(lom *LOM) error
+	// called (under lock, with the object loaded) prior to relocating the object:
+	// to another target (rebalance) or another mountpath (resilver, local == true)
+	Relocating(lom *LOM, local bool) error
+}
+
+var Snapper SnapCOW
+
+func (lom *LOM) cow() error {
+	if !lom.hasSnaps() {
+		return nil
+	}
+	return Snapper.COW(lom)
+}
+
+// SnapRelocating preserves the object's snapshotted version (if need be) so that the
+// latter migrates along with the object (see AuxCTs)
+func (lom *LOM) SnapRelocating(local bool) error {
+	if !lom.hasSnaps() {
+		return nil
+	}
+	return Snapper.Relocating(lom, local)
+}
+
+func (lom *LOM) hasSnaps() bool {
+	if Snapper == nil {
+		return false
+	}
+	bprops := lom.Bprops()
+	return bprops != nil && len(bprops.Snaps) > 0
+}
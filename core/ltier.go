@@ -0,0 +1,230 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+//
+// storage classes and tiering: bucket property `tier` (see cmn.TierConf)
+//
+// - objects of the bucket (or prefix) are placed on the mountpaths labeled `tier.hot`
+//   (HRW within the class - see fs.MPI.HrwLabel); demoted objects reside on their
+//   HRW mountpath within `tier.cold`
+// - tier placement is the single source of truth for the object's expected location
+//   (ResolveFQN, InitBck, NewCTFromBO, and resilver): both locations are "properly located"
+//   (lom.IsHRW) - storage cleanup leaves them alone, while resilver keeps (or restores)
+//   HRW placement within the current class
+// - changing the placement (`tier.enabled`, `hot`, `cold`, or `prefix`) triggers resilver
+//   on all targets (see cmn.TierConf.SamePlacement)
+// - when the object is not cached and not found in the hot class, InitBck looks it up
+//   in the cold one, which costs one extra stat() - tiered buckets only
+// - GETs of cold objects are counted (in memory, see TierHit); demotion and promotion
+//   are carried out by the tiering xaction (xact/xs/tier.go)
+// - chunks of a chunked object (other than chunk #1, which is the object itself) reside
+//   on their HRW mountpaths within the object's class; demotion and promotion move
+//   the manifest and all chunks together (see Ufest.Relocate)
+// - tiering cannot be enabled on n-way mirrored buckets (see cmn.Bprops.Validate)
+// - limitation: objects with extra-long names (fs.IsFntl) are pinned but not tiered
+//
+
+const maxTierHits = 64 * 1024 // max tracked cold objects (per target)
+
+var tierHits struct {
+	m  map[string]int
+	mu sync.Mutex
+}
+
+func (lom *LOM) TierConf() *cmn.TierConf { return tierConf(lom.Bck(), lom.ObjName) }
+
+func tierConf(bck *meta.Bck, objName string) *cmn.TierConf {
+	if bck.Props == nil || !bck.Props.Tier.Applies(objName) {
+		return nil
+	}
+	return &bck.Props.Tier
+}
+
+// whether the object resides in the cold storage class
+func (lom *LOM) IsCold(conf *cmn.TierConf) bool {
+	return conf.Cold != "" && lom.mi.Label == cos.MountpathLabel(conf.Cold)
+}
+
+// hot and cold (HRW) mountpaths of a given object; cold is nil when not configured
+func tierHrw(avail fs.MPI, uname []byte, conf *cmn.TierConf) (hot, cold *fs.Mountpath, digest uint64, err error) {
+	hot, digest, err = avail.HrwLabel(uname, cos.MountpathLabel(conf.Hot))
+	if err != nil || conf.Cold == "" {
+		return hot, nil, digest, err
+	}
+	cold, _, err = avail.HrwLabel(uname, cos.MountpathLabel(conf.Cold))
+	return hot, cold, digest, err
+}
+
+// (InitBck, NewCTFromBO) hot location, unless the object is not there but is in the cold one;
+// LOM cache (when hit) tells where the object is without stat-ing
+func tierLocate(bck *meta.Bck, objName string, uname []byte, conf *cmn.TierConf) (*fs.Mountpath, uint64, error) {
+	hot, cold, digest, err := tierHrw(fs.GetAvail(), uname, conf)
+	if err != nil {
+		return nil, 0, err
+	}
+	if cold == nil || cold.Path == hot.Path || fs.IsFntl(objName) || lcached(hot, digest, uname) {
+		return hot, digest, nil
+	}
+	if lcached(cold, digest, uname) {
+		return cold, digest, nil
+	}
+	if cos.Stat(hot.MakePathFQN(bck.Bucket(), fs.ObjCT, objName)) != nil &&
+		cos.Stat(cold.MakePathFQN(bck.Bucket(), fs.ObjCT, objName)) == nil {
+		return cold, digest, nil
+	}
+	return hot, digest, nil
+}
+
+func lcached(mi *fs.Mountpath, digest uint64, uname []byte) bool {
+	md, ok := mi.LomCaches.Get(lcacheIdx(digest)).Load(digest)
+	return ok && *md.(*lmeta).uname == string(uname)
+}
+
+// (ResolveFQN) expected location of a given object in a tiered bucket: the object's own mountpath
+// if it is HRW within either storage class, HRW within the hot one otherwise;
+// nil if the bucket is not tiered (or not present)
+func tierResolve(parsed *fs.ParsedFQN, uname []byte) *fs.Mountpath {
+	if parsed.ContentType != fs.ObjCT || T == nil || T.Bowner() == nil {
+		return nil
+	}
+	bprops, present := T.Bowner().Get().Get((*meta.Bck)(&parsed.Bck))
+	if !present || !bprops.Tier.Applies(parsed.ObjName) {
+		return nil
+	}
+	hot, cold, _, err := tierHrw(fs.GetAvail(), uname, &bprops.Tier)
+	if err != nil {
+		return nil
+	}
+	if cold != nil && parsed.Mountpath.Path == cold.Path {
+		return cold
+	}
+	return hot
+}
+
+// expected mountpath: HRW or, for tiered buckets, HRW within the object's current class
+func (lom *LOM) hrwMi(avail fs.MPI) (mi *fs.Mountpath, err error) {
+	uname := cos.UnsafeB(*lom.md.uname)
+	conf := lom.TierConf()
+	if conf == nil {
+		mi, _, err = avail.Hrw(uname)
+		return mi, err
+	}
+	label := conf.Hot
+	if lom.IsCold(conf) {
+		label = conf.Cold
+	}
+	mi, _, err = avail.HrwLabel(uname, cos.MountpathLabel(label))
+	return mi, err
+}
+
+// (chunks 2..N) HRW mountpath within the object's current class; plain HRW when not tiered
+func (lom *LOM) chunkHrw(avail fs.MPI, hrwkey string) (*fs.Mountpath, error) {
+	var label string
+	if conf := lom.TierConf(); conf != nil {
+		label = conf.Hot
+		if lom.IsCold(conf) {
+			label = conf.Cold
+		}
+	}
+	mi, _, err := avail.HrwLabel(cos.UnsafeB(hrwkey), cos.MountpathLabel(label))
+	return mi, err
+}
+
+// TierMpath returns destination mountpath to demote (or promote) the object to -
+// nil if there's no mountpath labeled with the respective storage class
+func (lom *LOM) TierMpath(avail fs.MPI, label string) *fs.Mountpath {
+	mi, _, err := avail.HrwLabel(cos.UnsafeB(*lom.md.uname), cos.MountpathLabel(label))
+	if err != nil || mi.Label != cos.MountpathLabel(label) {
+		return nil
+	}
+	return mi
+}
+
+// TierHit is called upon successful GET to count reads of cold objects
+func (lom *LOM) TierHit() {
+	conf := lom.TierConf()
+	if conf == nil || !lom.IsCold(conf) {
+		return
+	}
+	uname := *lom.md.uname
+	tierHits.mu.Lock()
+	if tierHits.m == nil || len(tierHits.m) >= maxTierHits {
+		tierHits.m = make(map[string]int, 64) // (forgetting is ok)
+	}
+	tierHits.m[uname]++
+	tierHits.mu.Unlock()
+}
+
+func (lom *LOM) TierHits() (n int) {
+	tierHits.mu.Lock()
+	n = tierHits.m[*lom.md.uname]
+	tierHits.mu.Unlock()
+	return n
+}
+
+// MoveTier relocates the object to a given mountpath (in another storage class);
+// chunked object: the manifest and all its chunks move to the destination class;
+// must be called under w-lock; returns the relocated LOM (caller must free)
+func (lom *LOM) MoveTier(mi *fs.Mountpath, buf []byte) (*LOM, error) {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname(), " is not w-locked")
+	debug.Assert(!lom.HasCopies(), lom.Cname())
+	debug.Assert(lom.mi.Path != mi.Path, lom.Cname(), " ", mi.String())
+
+	var (
+		dst *LOM
+		err error
+	)
+	if lom.IsChunked() {
+		dst, err = lom.moveTierChunks(mi, buf)
+	} else {
+		dst, err = lom.Copy2FQN(mi.MakePathFQN(lom.Bucket(), fs.ObjCT, lom.ObjName), buf)
+	}
+	if err != nil {
+		return nil, err
+	}
+	lom.UncacheDel()
+	if !lom.IsChunked() {
+		if err := lom.RemoveMain(); err != nil && !cos.IsNotExist(err) {
+			nlog.Warningln("tier: failed to remove", lom.FQN, "after moving", lom.Cname(), "to", mi.String(), "err:", err)
+		}
+	}
+	tierHits.mu.Lock()
+	delete(tierHits.m, *lom.md.uname)
+	tierHits.mu.Unlock()
+	return dst, nil
+}
+
+// (Ufest.Relocate removes the source manifest and chunks)
+func (lom *LOM) moveTierChunks(mi *fs.Mountpath, buf []byte) (*LOM, error) {
+	u, err := NewUfest("", lom, true /*must-exist*/)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.LoadCompleted(lom); err != nil {
+		return nil, err
+	}
+	dst, err := u.Relocate(mi, buf)
+	if err != nil {
+		return nil, err
+	}
+	if dst == lom {
+		return nil, fmt.Errorf("tier: %s not relocated to %s", lom.Cname(), mi)
+	}
+	return dst, nil
+}
@@ -0,0 +1,74 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+//
+// trash (soft delete): ais:// buckets with trash.enabled
+//
+// - deleting an object keeps it in the trash: a hard link (no data copying)
+//   fs.TrashCT/<object-name>/<deletion time> that also retains the object's metadata
+// - trashed objects can be listed and restored (see ais/tgttrash.go) until `space`
+//   cleanup purges them upon expiration of trash.retention
+// - chunked objects: the manifest and chunks get linked as well (see core/laux.go);
+//   extra-long names (fs.IsFntl) are trashed under their shortened names - listing
+//   reports the original name (cmn.OrigFntl)
+// - destroying the bucket moves the bucket as a whole - see fs/trash.go
+// - rebalance and resilver migrate trashed objects (see AuxCTs)
+//
+
+func (lom *LOM) TrashEnabled() bool {
+	bck := lom.Bck()
+	return bck.IsAIS() && bck.Props != nil && bck.Props.Trash.Enabled
+}
+
+func (lom *LOM) TrashFQN(deleted string) string {
+	return lom.GenFQN(fs.TrashCT, deleted)
+}
+
+// MoveToTrash is called under wlock prior to deleting the object
+func (lom *LOM) MoveToTrash() error {
+	if !lom.TrashEnabled() {
+		return nil
+	}
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname())
+	if err := lom.LinkPreserved(fs.TrashCT, strconv.FormatInt(time.Now().UnixNano(), 10)); err != nil {
+		return cmn.NewErrFailedTo(T, "move to trash", lom.Cname(), err)
+	}
+	return nil
+}
+
+// ParseTrashed returns the deletion time of the trashed object given its (parsed)
+// trash extra, and whether the file is the main one - not a manifest or chunk link
+func ParseTrashed(extra string) (deleted int64, main bool, err error) {
+	d, sfx := ParsePsv(extra)
+	deleted, err = strconv.ParseInt(d, 10, 64)
+	return deleted, sfx == "", err
+}
+
+// FindTrashed returns FQN of the trashed object deleted at a given time
+// (searching all mountpaths - in case the latter have changed)
+func (lom *LOM) FindTrashed(deleted string) (string, error) {
+	if fqn := lom.TrashFQN(deleted); cos.Stat(fqn) == nil {
+		return fqn, nil
+	}
+	for _, mi := range fs.GetAvail() {
+		fqn := fs.CSM.Gen(lom.ObjName, fs.TrashCT, lom.Bucket(), mi, deleted)
+		if cos.Stat(fqn) == nil {
+			return fqn, nil
+		}
+	}
+	return "", cos.NewErrNotFound(T, lom.Cname()+" (deleted at "+deleted+") in trash")
+}
@@ -0,0 +1,325 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+//
+// version history: ais:// buckets with versioning.max_history > 0
+//
+// - overwriting or deleting an object keeps its current version as non-current:
+//   a hard link (no data copying) fs.VersionCT/<object-name>/~/~<version>
+//   that also retains the version's metadata; a chunked object gets copied
+//   into a single (non-chunked) file
+// - deletion additionally leaves a zero-size delete marker ~<version+1>.del
+// - an object (re)created after deletion continues the numbering
+// - the oldest entries get pruned beyond versioning.max_history
+// - global rebalance and resilver migrate the history along with the object - including
+//   the history of deleted objects (delete markers); rebalance acknowledges (and
+//   retransmits, if need be) each entry - see AuxCTs and reb/aux.go
+// - EC: not supported - the bucket cannot have both erasure coding and max_history > 0
+//   (see cmn.Bprops.Validate)
+// - limitations (TODO): extra-long names (fs.IsFntl) are not preserved
+//
+
+const (
+	verPrefix    = "~"
+	verDelSuffix = ".del"
+)
+
+// non-current version or delete marker
+type VerEntry struct {
+	FQN       string
+	Version   string
+	Name      string // on-disk name, e.g. "~3" or "~4.del"
+	n         uint64
+	DelMarker bool
+}
+
+func (lom *LOM) MaxVerHistory() int {
+	bck := lom.Bck()
+	if !bck.IsAIS() || bck.Props == nil || !bck.Props.Versioning.Enabled {
+		return 0
+	}
+	return bck.Props.Versioning.MaxHistory
+}
+
+func verName(ver string, delMarker bool) string {
+	if delMarker {
+		return verPrefix + ver + verDelSuffix
+	}
+	return verPrefix + ver
+}
+
+func _parseVerName(name string) (ver string, n uint64, delMarker, ok bool) {
+	if !strings.HasPrefix(name, verPrefix) {
+		return "", 0, false, false
+	}
+	ver = name[len(verPrefix):]
+	if strings.HasSuffix(ver, verDelSuffix) {
+		ver = ver[:len(ver)-len(verDelSuffix)]
+		delMarker = true
+	}
+	n, err := strconv.ParseUint(ver, 10, 64)
+	return ver, n, delMarker, err == nil
+}
+
+func (lom *LOM) VerFQN(ver string, delMarker bool) string {
+	return lom.GenFQN(fs.VersionCT, verName(ver, delMarker))
+}
+
+// non-current versions and delete markers, newest first
+func (lom *LOM) VerHistory() ([]VerEntry, error) {
+	dir := filepath.Dir(lom.VerFQN(lomInitialVersion, false))
+	dents, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var hist []VerEntry
+	for _, de := range dents {
+		if !de.Type().IsRegular() {
+			continue
+		}
+		name := de.Name()
+		ver, n, del, ok := _parseVerName(name)
+		if !ok {
+			continue
+		}
+		hist = append(hist, VerEntry{FQN: filepath.Join(dir, name), Version: ver, Name: name, n: n, DelMarker: del})
+	}
+	slices.SortFunc(hist, func(a, b VerEntry) int { return cmp.Compare(b.n, a.n) })
+	return hist, nil
+}
+
+// KeepVersion is called under wlock prior to overwriting (PUT) or deleting the object.
+// When overwriting, it also makes sure that the new version (see IncVersion)
+// continues the numbering.
+func (lom *LOM) KeepVersion(del bool) error {
+	limit := lom.MaxVerHistory()
+	if limit == 0 {
+		return nil
+	}
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname())
+
+	cur := lom.CloneTo(lom.FQN)
+	defer FreeLOM(cur)
+	err := cur.LoadMetaFromFS()
+	switch {
+	case err == nil:
+		ver := cur.md.Version()
+		n, errV := strconv.ParseUint(ver, 10, 64)
+		if errV != nil {
+			return nil // (unversioned)
+		}
+		if cur.IsChunked() {
+			err = lom.copyChunked(cur, ver)
+		} else {
+			err = lom.link(ver)
+		}
+		if err != nil {
+			return err
+		}
+		if del {
+			if err := lom.delMarker(strconv.FormatUint(n+1, 10)); err != nil {
+				return err
+			}
+		} else {
+			lom.SetVersion(ver)
+		}
+	case cos.IsNotExist(err):
+		if del {
+			return nil
+		}
+		hist, err := lom.VerHistory()
+		if err != nil {
+			return err
+		}
+		if len(hist) > 0 {
+			lom.SetVersion(hist[0].Version) // (re)creating
+		}
+		return nil
+	default:
+		return err
+	}
+	return lom.pruneVersions(limit)
+}
+
+func (lom *LOM) link(ver string) error {
+	vfqn := lom.VerFQN(ver, false)
+	if err := cos.CreateDir(filepath.Dir(vfqn)); err != nil {
+		return err
+	}
+	if err := os.Link(lom.FQN, vfqn); err != nil && !os.IsExist(err) {
+		return cmn.NewErrFailedTo(T, "keep version", lom.Cname()+" v"+ver, err)
+	}
+	return nil
+}
+
+// chunks (and the manifest) get replaced or removed along with the current
+// version - copying
+func (lom *LOM) copyChunked(cur *LOM, ver string) error {
+	r, err := cur.Open()
+	if err != nil {
+		return err
+	}
+	buf, slab := g.pmm.Alloc()
+	err = lom.WriteVersion(verName(ver, false), cur.ObjAttrs(), r, buf)
+	slab.Free(buf)
+	cos.Close(r)
+	if err != nil {
+		return cmn.NewErrFailedTo(T, "keep version", lom.Cname()+" v"+ver+" (chunked)", err)
+	}
+	return nil
+}
+
+func (lom *LOM) delMarker(ver string) error {
+	fh, err := cos.CreateFile(lom.VerFQN(ver, true))
+	if err != nil {
+		return err
+	}
+	return fh.Close()
+}
+
+func (lom *LOM) pruneVersions(limit int) error {
+	hist, err := lom.VerHistory()
+	if err != nil || len(hist) <= limit {
+		return err
+	}
+	for i := limit; i < len(hist); i++ {
+		if err := cos.RemoveFile(hist[i].FQN); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResolveVersion returns the FQN of the given (current or non-current) version.
+// The caller must rlock the object.
+func (lom *LOM) ResolveVersion(ver string) (string, error) {
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	switch {
+	case err == nil:
+		if lom.Version() == ver {
+			return lom.FQN, nil
+		}
+	case !cmn.IsErrObjNought(err):
+		return "", err
+	}
+	vfqn := lom.VerFQN(ver, false)
+	if err := cos.Stat(vfqn); err == nil {
+		return vfqn, nil
+	}
+	if err := cos.Stat(lom.VerFQN(ver, true)); err == nil {
+		return "", cos.NewErrNotFound(T, lom.Cname()+" v"+ver+" (delete marker)")
+	}
+	return "", cos.NewErrNotFound(T, lom.Cname()+" v"+ver)
+}
+
+// DelVersion permanently removes the given version: current, non-current, or delete marker.
+// Removing the current version or the latest delete marker makes the newest
+// remaining version (if any) current.
+// The caller must wlock the object.
+func (lom *LOM) DelVersion(ver string) error {
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	switch {
+	case err == nil:
+		if lom.Version() == ver {
+			if err := lom.RemoveObj(); err != nil {
+				return err
+			}
+			return lom.promote()
+		}
+	case !cmn.IsErrObjNought(err):
+		return err
+	}
+
+	// non-current
+	fqn := lom.VerFQN(ver, false)
+	if errV := cos.Stat(fqn); errV != nil {
+		fqn = lom.VerFQN(ver, true)
+		if errV = cos.Stat(fqn); errV != nil {
+			return cos.NewErrNotFound(T, lom.Cname()+" v"+ver)
+		}
+	}
+	if errV := cos.RemoveFile(fqn); errV != nil {
+		return errV
+	}
+	if err == nil {
+		return nil // current version remains current
+	}
+	return lom.promote()
+}
+
+func (lom *LOM) promote() error {
+	hist, err := lom.VerHistory()
+	if err != nil || len(hist) == 0 || hist[0].DelMarker {
+		return err
+	}
+	if err := cos.Rename(hist[0].FQN, lom.FQN); err != nil {
+		return err
+	}
+	lom.UncacheDel()
+	return nil
+}
+
+// WriteVersion stores a non-current version or a delete marker
+func (lom *LOM) WriteVersion(vname string, oah cos.OAH, r io.Reader, buf []byte) error {
+	ver, _, del, ok := _parseVerName(vname)
+	if !ok {
+		return fmt.Errorf("%s: invalid version name %q", lom.Cname(), vname)
+	}
+	if del {
+		return lom.delMarker(ver)
+	}
+	wfqn := lom.GenFQN(fs.WorkCT, fs.WorkfilePut)
+	fh, err := cos.CreateFile(wfqn)
+	if err != nil {
+		return err
+	}
+	if r != nil { // (nil when zero-size)
+		_, err = cos.CopyBuffer(fh, r, buf)
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		vlom := lom.CloneTo(wfqn)
+		vlom.md = lmeta{}
+		vlom.CopyAttrs(oah, false /*skip cksum*/)
+		vlom.setbid(lom.Bprops().BID)
+		mdbuf := vlom.pack()
+		err = fs.SetXattr(wfqn, xattrLOM, mdbuf)
+		g.smm.Free(mdbuf)
+		FreeLOM(vlom)
+	}
+	if err == nil {
+		err = cos.Rename(wfqn, lom.VerFQN(ver, false))
+	}
+	if err != nil {
+		if nested := cos.RemoveFile(wfqn); nested != nil {
+			nlog.Errorln("nested err:", nested)
+		}
+	}
+	return err
+}
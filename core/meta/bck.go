@@ -230,12 +230,25 @@ func (b *Bck) init(bmd *BMD) error {
 // to support s3 clients:
 // find an already existing bucket by name (and nothing else)
 // returns an error when name cannot be unambiguously resolved to a single bucket
-func InitByNameOnly(bckName string, bowner Bowner) (bck *Bck, ecode int, err error) {
+func InitByNameOnly(bckName string, bowner Bowner) (*Bck, int, error) {
+	return initByName(bckName, nil, bowner)
+}
+
+// same as above, limited to a given namespace (e.g., tenant - see cmn.TenantConf)
+func InitByNameNs(bckName string, ns cmn.Ns, bowner Bowner) (*Bck, int, error) {
+	return initByName(bckName, &ns, bowner)
+}
+
+func initByName(bckName string, ns *cmn.Ns, bowner Bowner) (bck *Bck, ecode int, err error) {
 	bmd := bowner.Get()
-	all := bmd.getAllByName(bckName)
+	all := bmd.getAllByName(bckName, ns)
 	switch {
 	case all == nil:
-		err = cmn.NewErrAisBckNotFound(&cmn.Bck{Name: bckName})
+		nbck := &cmn.Bck{Name: bckName}
+		if ns != nil {
+			nbck.Ns = *ns
+		}
+		err = cmn.NewErrAisBckNotFound(nbck)
 		ecode = http.StatusNotFound
 	case len(all) == 1:
 		bck = &all[0]
@@ -279,6 +292,20 @@ func (b *Bck) checkAccess(bit apc.AccessAttrs) (err error) {
 	return
 }
 
+// AllowObj checks bucket-wide permissions extended by the bucket's per-prefix ACL
+// entries that apply to the named object and any of the given principals
+// (user ID and "role:<name>"; entries with principal "*" apply to everyone)
+func (b *Bck) AllowObj(bit apc.AccessAttrs, objName string, principals ...string) error {
+	if b.Props.Access.Has(bit) {
+		return nil
+	}
+	access := b.Props.Access | b.Props.PrefixACL.Access(objName, principals)
+	if access.Has(bit) {
+		return nil
+	}
+	return cmn.NewObjectAccessDenied(b.Cname(objName), apc.AccessOp(bit), access)
+}
+
 func (b *Bck) MaxPageSize() int64 {
 	switch b.Provider {
 	case apc.AIS:
@@ -5,10 +5,14 @@
 package meta_test
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -122,4 +126,73 @@ var _ = Describe("Bck", func() {
 			),
 		)
 	})
+
+	Describe("InitByNameNs", func() {
+		var (
+			acme   = cmn.TenantNs("acme")
+			bowner = mock.NewBaseBownerMock(
+				meta.NewBck("data", apc.AIS, cmn.NsGlobal, &cmn.Bprops{}),
+				meta.NewBck("data", apc.AIS, acme, &cmn.Bprops{}),
+				meta.NewBck("logs", apc.AIS, acme, &cmn.Bprops{}),
+			)
+		)
+
+		It("should resolve bucket name within a given namespace", func() {
+			bck, _, err := meta.InitByNameNs("data", acme, bowner)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bck.Ns).To(Equal(acme))
+
+			bck, _, err = meta.InitByNameNs("data", cmn.NsGlobal, bowner)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bck.Ns.IsGlobal()).To(BeTrue())
+		})
+
+		It("should not see buckets in other namespaces", func() {
+			_, ecode, err := meta.InitByNameNs("logs", cmn.TenantNs("beta"), bowner)
+			Expect(err).To(HaveOccurred())
+			Expect(ecode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should fail to resolve ambiguous name without namespace", func() {
+			_, ecode, err := meta.InitByNameOnly("data", bowner)
+			Expect(err).To(HaveOccurred())
+			Expect(ecode).To(Equal(http.StatusUnprocessableEntity))
+		})
+	})
+
+	Describe("AllowObj", func() {
+		props := &cmn.Bprops{
+			Access: apc.AccessRO,
+			PrefixACL: cmn.PrefixACL{
+				{Principal: "alice", Prefix: "team-a/", Access: apc.AccessRW},
+				{Principal: cmn.PrincipalRole + "ingest", Prefix: "raw/", Access: apc.AcePUT},
+				{Principal: cmn.PrincipalAll, Prefix: "public/", Access: apc.AceObjDELETE},
+			},
+		}
+		bck := meta.NewBck("shared", apc.AIS, cmn.NsGlobal, props)
+
+		It("should apply bucket-wide permissions to all objects", func() {
+			Expect(bck.AllowObj(apc.AceGET, "any/obj")).NotTo(HaveOccurred())
+			Expect(bck.AllowObj(apc.AcePUT, "any/obj", "alice")).To(HaveOccurred())
+		})
+
+		It("should extend permissions for matching principals and prefixes", func() {
+			Expect(bck.AllowObj(apc.AcePUT, "team-a/obj", "alice")).NotTo(HaveOccurred())
+			Expect(bck.AllowObj(apc.AcePUT, "team-a/obj", "bob")).To(HaveOccurred())
+			Expect(bck.AllowObj(apc.AcePUT, "raw/obj", "bob", cmn.PrincipalRole+"ingest")).NotTo(HaveOccurred())
+			Expect(bck.AllowObj(apc.AceObjDELETE, "raw/obj", "bob", cmn.PrincipalRole+"ingest")).To(HaveOccurred())
+			Expect(bck.AllowObj(apc.AceObjDELETE, "public/obj")).NotTo(HaveOccurred())
+		})
+
+		It("should not extend bucket-level permissions", func() {
+			Expect(bck.Allow(apc.AcePUT)).To(HaveOccurred())
+			Expect(bck.AllowObj(apc.AcePUT, "", "alice")).To(HaveOccurred())
+		})
+
+		It("should return object access error", func() {
+			err := bck.AllowObj(apc.AcePUT, "team-b/obj", "alice")
+			var errObj *cmn.ErrObjectAccessDenied
+			Expect(errors.As(err, &errObj)).To(BeTrue())
+		})
+	})
 })
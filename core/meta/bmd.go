@@ -231,12 +231,15 @@ func (m *BMD) initBckGlob(bck *Bck) bool {
 	return present
 }
 
-func (m *BMD) getAllByName(bckName string) (all []Bck) {
+func (m *BMD) getAllByName(bckName string, ns *cmn.Ns) (all []Bck) {
 	for provider, namespace := range m.Providers {
 		for nsUname, buckets := range namespace {
 			if props, present := buckets[bckName]; present {
 				bck := Bck{Name: bckName, Provider: provider, Props: props}
 				bck.Ns = cmn.ParseNsUname(nsUname)
+				if ns != nil && bck.Ns != *ns {
+					continue
+				}
 				all = append(all, bck)
 			}
 		}
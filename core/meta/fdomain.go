@@ -0,0 +1,81 @@
+// Package meta: cluster-level metadata
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Failure domains (rack, zone, host) are assigned to targets via local config
+// (cmn.LocalConfig.FailureDomain) and used to spread EC slices and replicas - see HrwTargetList.
+// An unlabeled target is a failure domain of its own.
+// Objects that do not Spread (e.g., written prior to labeling, or relocated by rebalance)
+// get re-encoded by EC rebalance and scrub (with Fix).
+
+func (d *Snode) FailureDomain() string {
+	if d.Domain != "" {
+		return d.Domain
+	}
+	return d.DaeID
+}
+
+// whether any of the targets is labeled
+func (m *Smap) HasDomains() bool {
+	for _, tsi := range m.Tmap {
+		if tsi.Domain != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// failure domain => number of active targets
+func (m *Smap) Domains() map[string]int {
+	domains := make(map[string]int, len(m.Tmap))
+	for _, tsi := range m.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		domains[tsi.FailureDomain()]++
+	}
+	return domains
+}
+
+// whether the given targets (e.g., holders of EC slices and replicas) occupy
+// as many distinct failure domains as possible (numDomains: see Domains)
+func (m *Smap) Spread(tids cos.MapStrUint16, numDomains int) bool {
+	domains := make(cos.StrSet, len(tids))
+	for tid := range tids {
+		if tsi := m.GetTarget(tid); tsi != nil {
+			domains.Add(tsi.FailureDomain())
+		}
+	}
+	return len(domains) >= min(len(tids), numDomains)
+}
+
+// CheckDomains returns a non-empty reason when a bucket with given props cannot (fully)
+// spread its redundant content across distinct failure domains
+func CheckDomains(props *cmn.Bprops, numDomains int) string {
+	switch {
+	case props.EC.Enabled:
+		// (compare with ec/putjogger: main target + data and parity slices)
+		need := props.EC.DataSlices + props.EC.ParitySlices + 1
+		if props.EC.ObjSizeLimit == cmn.ObjSizeToAlwaysReplicate {
+			need = props.EC.ParitySlices + 1
+		}
+		if need > numDomains {
+			return fmt.Sprintf("EC (d=%d, p=%d) requires %d failure domains, have %d",
+				props.EC.DataSlices, props.EC.ParitySlices, need, numDomains)
+		}
+	case props.Mirror.Enabled && props.Mirror.Copies > 1:
+		// n-way mirror is local: all copies reside on the same target (different mountpaths
+		// that, if possible, do not share disks - see core.LOM.LeastUtilNoCopy)
+		return fmt.Sprintf("%d-way mirror: all copies share the same target's failure domain (consider EC)", props.Mirror.Copies)
+	}
+	return ""
+}
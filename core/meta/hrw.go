@@ -1,6 +1,6 @@
 // Package meta: cluster-level metadata
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package meta
 
@@ -11,6 +11,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/xoshiro256"
 
 	onexxh "github.com/OneOfOne/xxhash"
@@ -19,6 +20,21 @@ import (
 // A variant of consistent hash based on rendezvous algorithm by Thaler and Ravishankar,
 // aka highest random weight (HRW)
 // See also: fs/hrw.go
+//
+// With feature flag `Weighted-HRW` (feat.WeightedHRW) objects are placed on targets
+// proportionally to their respective capacities (Snode.Weight) - see cos.WeightedHrw.
+// The same applies to HrwTargetList (EC slices, mirrors) but not to proxies and tasks.
+
+// whether to use weighted (capacity-proportional) HRW
+func HrwWeighted() bool { return cmn.Rom.Features().IsSet(feat.WeightedHRW) }
+
+func hrwScore(tsi *Snode, digest uint64, weighted bool) uint64 {
+	cs := xoshiro256.Hash(tsi.digest() ^ digest)
+	if weighted {
+		cs = cos.WeightedHrw(cs, tsi.Weight)
+	}
+	return cs
+}
 
 func (smap *Smap) HrwName2T(uname []byte) (*Snode, error) {
 	digest := onexxh.Checksum64S(uname, cos.MLCG32)
@@ -38,13 +54,18 @@ func (smap *Smap) HrwMultiHome(uname []byte) (si *Snode, netName string, err err
 	return si, si.nmr.name(), nil
 }
 
-func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
+func (smap *Smap) HrwHash2T(digest uint64) (*Snode, error) {
+	return smap.HrwHash2Tw(digest, HrwWeighted())
+}
+
+// (weighted or not, regardless of the current configuration - e.g., to preview placement)
+func (smap *Smap) HrwHash2Tw(digest uint64, weighted bool) (si *Snode, err error) {
 	var maxH uint64
 	for _, tsi := range smap.Tmap {
 		if tsi.InMaintOrDecomm() { // always skipping targets 'in maintenance mode'
 			continue
 		}
-		cs := xoshiro256.Hash(tsi.digest() ^ digest)
+		cs := hrwScore(tsi, digest, weighted)
 		if cs >= maxH {
 			maxH = cs
 			si = tsi
@@ -58,9 +79,12 @@ func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
 
 // NOTE: including targets 'in maintenance mode', if any
 func (smap *Smap) HrwHash2Tall(digest uint64) (si *Snode, err error) {
-	var maxH uint64
+	var (
+		maxH     uint64
+		weighted = HrwWeighted()
+	)
 	for _, tsi := range smap.Tmap {
-		cs := xoshiro256.Hash(tsi.digest() ^ digest)
+		cs := hrwScore(tsi, digest, weighted)
 		if cs >= maxH {
 			maxH = cs
 			si = tsi
@@ -153,6 +177,12 @@ type hrwList struct {
 // returns resulting subset (aka slice) that has the requested length = count.
 // Returns error if the cluster does not have enough targets.
 // If count == length of Smap.Tmap, the function returns as many targets as possible.
+//
+// When targets are labeled with failure domains (Snode.Domain), the list spreads
+// across distinct domains: HRW order is preserved among targets from not-yet-used
+// domains, while the rest (if needed) follow in their respective HRW order.
+// Either way, the first target is always the HRW owner, and a shorter list
+// is always a prefix of a longer one (for the same name and Smap).
 
 func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error) {
 	const fmterr = "%v: required %d, available %d, %s"
@@ -163,16 +193,25 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 	}
 	b := cos.UnsafeBptr(uname)
 	digest := onexxh.Checksum64S(*b, cos.MLCG32)
-	hlist := newHrwList(count)
-
+	hasDomains := smap.HasDomains()
+	n := count
+	if hasDomains {
+		n = cnt // need all of them sorted
+	}
+	var (
+		hlist    = newHrwList(n)
+		weighted = HrwWeighted()
+	)
 	for _, tsi := range smap.Tmap {
-		cs := xoshiro256.Hash(tsi.digest() ^ digest)
 		if tsi.InMaintOrDecomm() {
 			continue
 		}
-		hlist.add(cs, tsi)
+		hlist.add(hrwScore(tsi, digest, weighted), tsi)
 	}
 	sis = hlist.get()
+	if hasDomains {
+		sis = spreadDomains(sis, count)
+	}
 	if count != cnt && len(sis) < count {
 		err = fmt.Errorf(fmterr, cmn.ErrNotEnoughTargets, count, len(sis), smap)
 		return nil, err
@@ -180,6 +219,30 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 	return sis, nil
 }
 
+// (see above)
+func spreadDomains(sorted Nodes, count int) Nodes {
+	var (
+		sis  = make(Nodes, 0, count)
+		rest = make(Nodes, 0, len(sorted))
+		used = make(cos.StrSet, count)
+	)
+	for _, tsi := range sorted {
+		if len(sis) == count {
+			break
+		}
+		if d := tsi.FailureDomain(); !used.Contains(d) {
+			used.Add(d)
+			sis = append(sis, tsi)
+		} else {
+			rest = append(rest, tsi)
+		}
+	}
+	for i := 0; len(sis) < count && i < len(rest); i++ {
+		sis = append(sis, rest[i])
+	}
+	return sis
+}
+
 func newHrwList(count int) *hrwList {
 	return &hrwList{hs: make([]uint64, 0, count), sis: make(Nodes, 0, count), n: count}
 }
@@ -0,0 +1,186 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	onexxh "github.com/OneOfOne/xxhash"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HRW", func() {
+	const numTargets = 12
+
+	newSmap := func(domain func(i int) string) *meta.Smap {
+		smap := &meta.Smap{Tmap: make(meta.NodeMap, numTargets), Pmap: make(meta.NodeMap)}
+		for i := range numTargets {
+			tsi := &meta.Snode{Domain: domain(i)}
+			tsi.Init("t"+strconv.Itoa(i), apc.Target)
+			smap.Tmap[tsi.ID()] = tsi
+		}
+		return smap
+	}
+
+	Describe("HrwTargetList", func() {
+		It("should not change placement when no failure domains", func() {
+			var (
+				plain   = newSmap(func(int) string { return "" })
+				labeled = newSmap(func(i int) string { return "rack" + strconv.Itoa(i) }) // one target per domain
+			)
+			Expect(plain.HasDomains()).To(BeFalse())
+			Expect(labeled.HasDomains()).To(BeTrue())
+			for i := range 100 {
+				uname := "bucket/obj-" + strconv.Itoa(i)
+				a, err := plain.HrwTargetList(&uname, 5)
+				Expect(err).NotTo(HaveOccurred())
+				b, err := labeled.HrwTargetList(&uname, 5)
+				Expect(err).NotTo(HaveOccurred())
+				for j := range a {
+					Expect(a[j].ID()).To(Equal(b[j].ID()))
+				}
+			}
+		})
+
+		It("should spread across distinct failure domains", func() {
+			smap := newSmap(func(i int) string { return "rack" + strconv.Itoa(i%4) })
+			Expect(smap.Domains()).To(HaveLen(4))
+			for i := range 100 {
+				uname := "bucket/obj-" + strconv.Itoa(i)
+				owner, err := smap.HrwName2T([]byte(uname))
+				Expect(err).NotTo(HaveOccurred())
+
+				sis, err := smap.HrwTargetList(&uname, 6)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sis).To(HaveLen(6))
+				Expect(sis[0].ID()).To(Equal(owner.ID()))
+
+				domains := cos.NewStrSet()
+				for _, tsi := range sis[:4] {
+					domains.Add(tsi.FailureDomain())
+				}
+				Expect(domains).To(HaveLen(4))
+
+				// shorter list is a prefix of the longer one
+				short, err := smap.HrwTargetList(&uname, 3)
+				Expect(err).NotTo(HaveOccurred())
+				for j := range short {
+					Expect(short[j].ID()).To(Equal(sis[j].ID()))
+				}
+
+				tids := make(cos.MapStrUint16, len(sis))
+				for j, tsi := range sis {
+					tids[tsi.ID()] = uint16(j)
+				}
+				Expect(smap.Spread(tids, 4)).To(BeTrue())
+			}
+		})
+
+		It("should detect targets that share failure domains", func() {
+			smap := newSmap(func(i int) string { return "rack" + strconv.Itoa(i%4) })
+			// t0, t4, and t8 are all in rack0
+			Expect(smap.Spread(cos.MapStrUint16{"t0": 0, "t4": 1, "t8": 2}, 4)).To(BeFalse())
+			Expect(smap.Spread(cos.MapStrUint16{"t0": 0, "t1": 1, "t4": 2}, 4)).To(BeFalse())
+			Expect(smap.Spread(cos.MapStrUint16{"t0": 0, "t1": 1, "t2": 2}, 4)).To(BeTrue())
+			// more holders than domains
+			Expect(smap.Spread(cos.MapStrUint16{"t0": 0, "t1": 1, "t2": 2, "t3": 3, "t4": 4}, 4)).To(BeTrue())
+			Expect(smap.Spread(cos.MapStrUint16{"t0": 0, "t1": 1, "t2": 2, "t4": 3, "t8": 4}, 4)).To(BeFalse())
+		})
+	})
+
+	Describe("weighted", func() {
+		digests := func(n int) []uint64 {
+			ds := make([]uint64, n)
+			for i := range n {
+				ds[i] = onexxh.Checksum64S([]byte("bucket/obj-"+strconv.Itoa(i)), cos.MLCG32)
+			}
+			return ds
+		}
+
+		It("should not change placement when weights are equal", func() {
+			smap := newSmap(func(int) string { return "" })
+			for _, tsi := range smap.Tmap {
+				tsi.Weight = 8192
+			}
+			for _, digest := range digests(1000) {
+				a, err := smap.HrwHash2Tw(digest, false)
+				Expect(err).NotTo(HaveOccurred())
+				b, err := smap.HrwHash2Tw(digest, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(a.ID()).To(Equal(b.ID()))
+			}
+		})
+
+		It("should place proportionally to weights", func() {
+			const num = 60000
+			var (
+				smap   = newSmap(func(int) string { return "" })
+				counts = make(map[string]int, numTargets)
+				total  uint64
+			)
+			for i := range numTargets {
+				w := uint64(8 * 1024)
+				if i%2 == 0 {
+					w = 20 * 1024
+				}
+				smap.Tmap["t"+strconv.Itoa(i)].Weight = w
+				total += w
+			}
+			for _, digest := range digests(num) {
+				tsi, err := smap.HrwHash2Tw(digest, true)
+				Expect(err).NotTo(HaveOccurred())
+				counts[tsi.ID()]++
+			}
+			for tid, tsi := range smap.Tmap {
+				expected := float64(num) * float64(tsi.Weight) / float64(total)
+				Expect(float64(counts[tid])).To(BeNumerically("~", expected, expected*0.1), tid)
+			}
+		})
+
+		It("should only move objects to the target that got bigger", func() {
+			smap := newSmap(func(int) string { return "" })
+			for _, tsi := range smap.Tmap {
+				tsi.Weight = 8 * 1024
+			}
+			ds := digests(10000)
+			before := make([]string, len(ds))
+			for i, digest := range ds {
+				tsi, _ := smap.HrwHash2Tw(digest, true)
+				before[i] = tsi.ID()
+			}
+			smap.Tmap["t3"].Weight = 20 * 1024
+			var moved int
+			for i, digest := range ds {
+				tsi, _ := smap.HrwHash2Tw(digest, true)
+				if tsi.ID() != before[i] {
+					Expect(tsi.ID()).To(Equal("t3"))
+					moved++
+				}
+			}
+			Expect(moved).To(BeNumerically(">", 0))
+		})
+	})
+
+	Describe("CheckDomains", func() {
+		It("should report buckets that cannot spread", func() {
+			props := &cmn.Bprops{}
+			Expect(meta.CheckDomains(props, 3)).To(BeEmpty())
+
+			props.EC = cmn.ECConf{Enabled: true, DataSlices: 2, ParitySlices: 1}
+			Expect(meta.CheckDomains(props, 4)).To(BeEmpty())
+			Expect(meta.CheckDomains(props, 3)).NotTo(BeEmpty())
+
+			props.EC = cmn.ECConf{}
+			props.Mirror = cmn.MirrorConf{Enabled: true, Copies: 2}
+			Expect(meta.CheckDomains(props, 4)).NotTo(BeEmpty())
+		})
+	})
+})
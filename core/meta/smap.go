@@ -85,6 +85,8 @@ type (
 		PubExtra   []NetInfo    `json:"pub_extra,omitempty"`
 		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
 		IDDigest   uint64       `json:"id_digest"`
+		Domain     string       `json:"domain,omitempty"` // failure domain (rack, zone, host) - see FailureDomain()
+		Weight     uint64       `json:"weight,omitempty"` // target capacity in GiB (weighted HRW - see hrw.go)
 	}
 
 	Nodes   []*Snode          // slice of Snodes
@@ -191,6 +193,12 @@ func (d *Snode) Eq(o *Snode) (eq bool) {
 		if err := d.NetEq(o); err != nil {
 			nlog.Warningln(err)
 			eq = false
+		} else if d.Domain != o.Domain {
+			nlog.Warningf("%s: failure domain changed %q => %q", d.StringEx(), d.Domain, o.Domain)
+			eq = false
+		} else if d.Weight != o.Weight {
+			nlog.Warningf("%s: weight (capacity) changed %dGiB => %dGiB", d.StringEx(), d.Weight, o.Weight)
+			eq = false
 		}
 	}
 	return eq
@@ -350,6 +350,88 @@ var _ = Describe("MPU-UfestRead", func() {
 			By(fmt.Sprintf("Successfully read %d chunks totaling %d bytes",
 				numChunks, totalFileSize))
 		})
+		It("should validate chunk checksums when reading ranges", func() {
+			const (
+				numChunks = 4
+				chunkSize = 64 * cos.KiB
+				totalSize = numChunks * chunkSize
+			)
+			testObject := "chunked/verified-ranges.bin"
+			localFQN := mis[0].MakePathFQN(&localBckB, fs.ObjCT, testObject)
+
+			createTestFile(localFQN, 0)
+			lom := newBasicLom(localFQN, totalSize)
+			ufest, err := core.NewUfest("verify-test-"+cos.GenTie(), lom, false /*must-exist*/)
+			Expect(err).NotTo(HaveOccurred())
+
+			var paths []string
+			for chunkNum := 1; chunkNum <= numChunks; chunkNum++ {
+				chunk, err := ufest.NewChunk(chunkNum, lom)
+				Expect(err).NotTo(HaveOccurred())
+
+				cksumH := cos.NewCksumHash(cos.ChecksumCRC32C)
+				createTestChunk(chunk.Path(), chunkSize, cksumH.H)
+				cksumH.Finalize()
+				chunk.SetCksum(cksumH.Clone())
+
+				Expect(ufest.Add(chunk, chunkSize, int64(chunkNum))).NotTo(HaveOccurred())
+				paths = append(paths, chunk.Path())
+			}
+			Expect(lom.CompleteUfest(ufest, false)).NotTo(HaveOccurred())
+
+			reader, err := ufest.NewReader()
+			Expect(err).NotTo(HaveOccurred())
+			defer reader.Close()
+
+			ranges := [][2]int64{
+				{0, totalSize},
+				{0, 1},
+				{chunkSize - 1, 2},
+				{chunkSize, chunkSize},
+				{chunkSize/2 + 7, 2*chunkSize + 13},
+				{totalSize - 1, 1},
+				{totalSize / 3, 0},
+			}
+			for _, rng := range ranges {
+				expected := make([]byte, rng[1])
+				_, err := reader.ReadAt(expected, rng[0])
+				if rng[1] > 0 {
+					Expect(err).NotTo(HaveOccurred())
+				}
+				v := reader.Verifier(rng[0], rng[1])
+				actual, err := io.ReadAll(v)
+				Expect(err).NotTo(HaveOccurred(), "range %v", rng)
+				Expect(v.Close()).NotTo(HaveOccurred())
+				Expect(bytes.Equal(actual, expected)).To(BeTrue(), "range %v", rng)
+			}
+
+			By("corrupting the third chunk")
+			fh, err := os.OpenFile(paths[2], os.O_RDWR, 0)
+			Expect(err).NotTo(HaveOccurred())
+			b := make([]byte, 1)
+			_, err = fh.ReadAt(b, chunkSize-1)
+			Expect(err).NotTo(HaveOccurred())
+			b[0]++
+			_, err = fh.WriteAt(b, chunkSize-1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fh.Close()).NotTo(HaveOccurred())
+
+			// reading the very first byte of the corrupted chunk still requires validating the entire chunk
+			v := reader.Verifier(2*chunkSize, 1)
+			_, err = io.ReadAll(v)
+			v.Close()
+			Expect(cos.IsErrBadCksum(err)).To(BeTrue(), "expecting bad checksum, got %v", err)
+
+			v = reader.Verifier(0, 2*chunkSize)
+			_, err = io.ReadAll(v)
+			v.Close()
+			Expect(err).NotTo(HaveOccurred())
+
+			v = reader.Verifier(chunkSize, totalSize-chunkSize)
+			_, err = io.ReadAll(v)
+			v.Close()
+			Expect(cos.IsErrBadCksum(err)).To(BeTrue(), "expecting bad checksum, got %v", err)
+		})
 	})
 
 	Describe("MPU Complete -> GET scenario", func() {
@@ -1,6 +1,6 @@
 // Package core provides core metadata and in-cluster API
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package core
 
@@ -36,6 +36,19 @@ type (
 		// Memory allocators
 		PageMM() *memsys.MMSA
 		ByteMM() *memsys.MMSA
+
+		// signature of the intra-cluster request (apc.HdrSenderSig); empty if not configured
+		IntraSig(ireq *IntraReq) string
+	}
+
+	// intra-cluster request to sign (see ais/csk.go)
+	IntraReq struct {
+		Method   string
+		Path     string
+		RawQuery string
+		SessID   string // transport stream session (apc.HdrSessID), if any
+		Body     []byte // request body to digest (when not streamed)
+		Streamed bool   // body is streamed and won't be digested
 	}
 )
 
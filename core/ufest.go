@@ -327,7 +327,7 @@ func (u *Ufest) IsHRW(avail fs.MPI) (bool, error) {
 			}
 		default:
 			hrwkey := u.id + formatCnum(int(c.num))
-			mi, _ /*digest*/, err := avail.Hrw(cos.UnsafeB(hrwkey))
+			mi, err := u.lom.chunkHrw(avail, hrwkey)
 			if err != nil {
 				return false, err
 			}
@@ -339,11 +339,11 @@ func (u *Ufest) IsHRW(avail fs.MPI) (bool, error) {
 	return true, nil
 }
 
-// (note fs.Hrw => fs.GetAvail())
+// (for tiered buckets, HRW within the object's storage class - see ltier.go)
 func (u *Ufest) newChunk2N(num int, lom *LOM) (*Uchunk, error) {
 	snum := formatCnum(num)
 	hrwkey := u.id + snum
-	mi, _ /*digest*/, err := fs.Hrw(cos.UnsafeB(hrwkey))
+	mi, err := lom.chunkHrw(fs.GetAvail(), hrwkey)
 	if err != nil {
 		return nil, err
 	}
@@ -1290,7 +1290,15 @@ func (lom *LOM) CompleteUfest(u *Ufest, locked bool) (err error) {
 		prevLom   = lom.Clone()
 		prevUfest *Ufest
 	)
-	if prevLom.Load(false /*cache it*/, true /*locked*/) == nil && prevLom.IsChunked() {
+	errPrev := prevLom.Load(false /*cache it*/, true /*locked*/)
+	if errPrev == nil {
+		// bucket snapshots: preserve the version that's about to be replaced
+		if err := prevLom.cow(); err != nil {
+			u.Abort(lom)
+			return err
+		}
+	}
+	if errPrev == nil && prevLom.IsChunked() {
 		prevUfest, err = NewUfest("", prevLom, true /*must-exist*/)
 		if err == nil {
 			// Load old ufest for cleaning up old chunks after successful completion
@@ -1552,6 +1560,160 @@ func (r *UfestReader) ReadAt(p []byte, off int64) (n int, err error) {
 	return n, err
 }
 
+///////////////////
+// UfestVerifier //
+///////////////////
+
+// - reads [off, off+size) range of a chunked object while validating each
+//   overlapping chunk against its checksum stored in the manifest
+// - chunk bytes outside the range are read (and hashed) but not returned
+// - validation happens at chunk boundaries: by the time a corrupted chunk
+//   fails with cos.ErrBadCksum, some of its bytes may have been already returned
+// - chunks that carry no checksum are read as is
+// - same usage pattern as UfestReader (above)
+
+type (
+	UfestVerifier struct {
+		u      *Ufest
+		cfh    *os.File
+		cksumH *cos.CksumHash
+		slab   *memsys.Slab
+		buf    []byte // to read (and discard) out-of-range bytes
+		cidx   int    // current chunk
+		cbeg   int64  // current chunk's offset in the object
+		coff   int64  // offset within current chunk
+		off    int64  // next offset to return
+		end    int64  // range end (exclusive)
+	}
+)
+
+var (
+	_ io.ReadCloser = (*UfestVerifier)(nil)
+)
+
+func (r *UfestReader) Verifier(off, size int64) *UfestVerifier {
+	debug.Assert(off >= 0 && size >= 0 && off+size <= r.u.size, off, " ", size, " vs ", r.u.size)
+	return &UfestVerifier{u: r.u, off: off, end: off + size}
+}
+
+func (v *UfestVerifier) Read(p []byte) (n int, err error) {
+	u := v.u
+	for {
+		if v.cfh == nil {
+			if v.off >= v.end {
+				return n, io.EOF
+			}
+			if err := v.open(); err != nil {
+				return n, err
+			}
+		}
+		var (
+			c   = &u.chunks[v.cidx]
+			pos = v.cbeg + v.coff
+		)
+		switch {
+		case v.coff >= c.size:
+			if err := v.done(c); err != nil {
+				return n, err
+			}
+			continue
+		case pos < v.off: // leading out-of-range bytes
+			err = v.discard(c, v.off-pos)
+		case v.off < v.end: // in range
+			if len(p) == 0 {
+				return n, nil
+			}
+			var m int
+			m, err = v.cfh.Read(p[:min(int64(len(p)), v.end-v.off, c.size-v.coff)])
+			if m > 0 && v.cksumH != nil {
+				v.cksumH.H.Write(p[:m])
+			}
+			n += m
+			p = p[m:]
+			v.coff += int64(m)
+			v.off += int64(m)
+		default: // trailing
+			err = v.discard(c, c.size-v.coff)
+		}
+		if err != nil {
+			return n, v.rerr(c, err)
+		}
+	}
+}
+
+func (v *UfestVerifier) open() error {
+	u := v.u
+	for v.cidx < int(u.count) && v.cbeg+u.chunks[v.cidx].size <= v.off {
+		v.cbeg += u.chunks[v.cidx].size
+		v.cidx++
+	}
+	if v.cidx >= int(u.count) {
+		return fmt.Errorf("%s: offset %d is out of bounds", u._rtag(), v.off)
+	}
+	c := &u.chunks[v.cidx]
+	fh, err := os.Open(c.path)
+	if err != nil {
+		return fmt.Errorf("%s: failed to open chunk (%d/%d): %w", u._rtag(), v.cidx+1, u.count, err)
+	}
+	v.cfh, v.coff = fh, 0
+	v.cksumH = nil
+	if !cos.NoneC(c.cksum) {
+		v.cksumH = cos.NewCksumHash(c.cksum.Ty())
+	}
+	return nil
+}
+
+func (v *UfestVerifier) discard(c *Uchunk, size int64) error {
+	if v.buf == nil {
+		v.buf, v.slab = g.pmm.AllocSize(min(c.size, memsys.DefaultBufSize))
+	}
+	m, err := v.cfh.Read(v.buf[:min(size, int64(len(v.buf)))])
+	if m > 0 && v.cksumH != nil {
+		v.cksumH.H.Write(v.buf[:m])
+	}
+	v.coff += int64(m)
+	return err
+}
+
+func (v *UfestVerifier) rerr(c *Uchunk, err error) error {
+	if err == io.EOF {
+		if v.coff < c.size {
+			return fmt.Errorf("%s: chunk (%d/%d) appears to be truncated (%d/%d): %v",
+				v.u._rtag(), v.cidx+1, v.u.count, v.coff, c.size, io.ErrUnexpectedEOF)
+		}
+		return nil
+	}
+	return err
+}
+
+// the entire chunk's been read: validate and move on
+func (v *UfestVerifier) done(c *Uchunk) (err error) {
+	cos.Close(v.cfh)
+	v.cfh = nil
+	if v.cksumH != nil {
+		v.cksumH.Finalize()
+		if !v.cksumH.Equal(c.cksum) {
+			err = cos.NewErrDataCksum(&v.cksumH.Cksum, c.cksum, v.u._rtag()+" chunk "+strconv.Itoa(int(c.num)))
+		}
+		v.cksumH = nil
+	}
+	v.cbeg += c.size
+	v.cidx++
+	return err
+}
+
+func (v *UfestVerifier) Close() error {
+	if v.cfh != nil {
+		cos.Close(v.cfh)
+		v.cfh = nil
+	}
+	if v.slab != nil {
+		v.slab.Free(v.buf)
+		v.buf, v.slab = nil, nil
+	}
+	return nil
+}
+
 // Relocate a chunked object to its canonical location(s) without making extra copies;
 // must be called under LOM write-lock.
 // Relocate is a single-threaded operation: the caller must NOT share this Ufest instance
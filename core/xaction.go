@@ -57,6 +57,13 @@ type (
 		AbortErr() error
 		AbortedAfter(time.Duration) error
 		ChanAbort() <-chan error
+		// pause/resume (see xact.Table: "Pausable")
+		Pause() error
+		Resume() error
+		IsPaused() bool
+		WaitIfPaused() (aborted bool)
+		// priority class (see xact/sched.go)
+		SetPrio(prio string)
 		// err (info)
 		AddErr(error, ...int)
 		ErrCnt() int // used by sentinel and quiesce
@@ -107,6 +114,10 @@ type (
 		ID        string    `json:"id"`
 		Kind      string    `json:"kind"`
 		CtlMsg    string    `json:"ctlmsg,omitempty"` // initiating control msg (a.k.a. "run options"; added v3.26)
+		Prio      string    `json:"prio,omitempty"`   // priority class (apc.PrioLow, ...); empty when normal
+
+		// time paused (zero when not paused)
+		PauseTime time.Time `json:"pause-time"`
 
 		// extended error info
 		AbortErr string `json:"abort-err"`
@@ -119,6 +130,8 @@ type (
 		Stats    Stats `json:"stats"`
 		AbortedX bool  `json:"aborted"`
 		IdleX    bool  `json:"is_idle"`
+		PausedX  bool  `json:"paused,omitempty"`
+		QueuedX  bool  `json:"queued,omitempty"` // waiting for admission (see xact/sched.go)
 	}
 	AllRunningInOut struct {
 		Kind    string
@@ -133,6 +146,8 @@ type (
 
 func (xsnap *Snap) IsAborted() bool { return xsnap.AbortedX }
 func (xsnap *Snap) IsIdle() bool    { return xsnap.IdleX }
+func (xsnap *Snap) IsPaused() bool  { return xsnap.PausedX && xsnap.IsRunning() }
+func (xsnap *Snap) IsQueued() bool  { return xsnap.QueuedX && xsnap.IsRunning() }
 func (xsnap *Snap) Started() bool   { return !xsnap.StartTime.IsZero() }
 
 func (xsnap *Snap) IsRunning() bool {
@@ -64,7 +64,8 @@ cat > $AIS_CONF_FILE <<EOL
 		"primary_url":   "${AIS_PRIMARY_URL}",
 		"original_url":  "${AIS_PRIMARY_URL}",
 		"discovery_url": "${AIS_DISCOVERY_URL}",
-		"non_electable": ${AIS_NON_ELECTABLE:-false}
+		"non_electable": ${AIS_NON_ELECTABLE:-false},
+		"cache_size":    "256mb"
 	},
 	"space": {
 		"cleanupwm":         65,
@@ -98,12 +99,27 @@ cat > $AIS_CONF_FILE <<EOL
 	"resilver": {
 		"enabled": true
 	},
+	"xact_sched": {
+		"enabled":	false,
+		"max_high":	0,
+		"max_normal":	0,
+		"max_low":	2
+	},
+	"audit": {
+		"enabled":	false,
+		"max_size":	"16mb",
+		"max_files":	8
+	},
+	"tenant": {
+		"enabled":	false
+	},
 	"checksum": {
 		"type":			"xxhash2",
 		"validate_cold_get":	false,
 		"validate_warm_get":	false,
 		"validate_obj_move":	false,
-		"enable_read_range":	false
+		"enable_read_range":	false,
+		"end_to_end":		false
 	},
 	"transport": {
 		"max_header":		4096,
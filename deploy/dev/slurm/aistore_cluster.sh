@@ -153,7 +153,7 @@ cat > ${NODE_CONFIG_DIR}/proxy/ais.json << PROXYEOF
     "disk": {"iostat_time_long": "2s", "iostat_time_short": "100ms", "iostat_time_smooth": "8s", "disk_util_low_wm": 20, "disk_util_high_wm": 80, "disk_util_max_wm": 95},
     "rebalance": {"dest_retry_time": "2m", "compression": "never", "bundle_multiplier": 2, "burst_buffer": 1024, "enabled": true},
     "resilver": {"enabled": true},
-    "checksum": {"type": "xxhash2", "validate_cold_get": false, "validate_warm_get": false, "validate_obj_move": false, "enable_read_range": false},
+    "checksum": {"type": "xxhash2", "validate_cold_get": false, "validate_warm_get": false, "validate_obj_move": false, "enable_read_range": false, "end_to_end": false},
     "transport": {"max_header": 4096, "burst_buffer": 512, "idle_teardown": "4s", "quiescent": "10s", "lz4_block": "256kb", "lz4_frame_checksum": false},
     "memsys": {"min_free": "2gb", "default_buf": "32kb", "to_gc": "4gb", "hk_time": "3m", "min_pct_total": 0, "min_pct_free": 0},
     "versioning": {"enabled": true, "validate_warm_get": false},
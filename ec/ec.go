@@ -394,6 +394,11 @@ func RequestECMeta(bck *cmn.Bck, objName string, si *meta.Snode, client *http.Cl
 		return nil, err
 	}
 	rq.URL.RawQuery = query.Encode()
+	rq.Header.Set(apc.HdrSenderID, core.T.SID())
+	ireq := core.IntraReq{Method: rq.Method, Path: rq.URL.Path, RawQuery: rq.URL.RawQuery}
+	if sig := core.T.IntraSig(&ireq); sig != "" {
+		rq.Header.Set(apc.HdrSenderSig, sig)
+	}
 	resp, err := client.Do(rq) //nolint:bodyclose // closed inside cos.Close
 	if err != nil {
 		return nil, err
@@ -239,6 +239,9 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		generation            = mono.NanoTime()
 		cksumType, cksumValue = lom.Checksum().Get()
 	)
+	// when re-encoding: previous placement (see rmStale below)
+	prev, _ := LoadMetadata(ctMeta.FQN())
+
 	md := &Metadata{
 		MDVersion:   MDVersionLast,
 		Generation:  generation,
@@ -290,9 +293,29 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		}
 		return fmt.Errorf("%s metafile saved while bucket %s was being destroyed", ctMeta.ObjectName(), ctMeta.Bucket())
 	}
+	if prev != nil {
+		c.rmStale(lom, prev, md)
+	}
 	return nil
 }
 
+// remove slices and replicas left on the previous holders that are no longer in the list
+// (e.g., when re-encoding to spread across failure domains - see meta.Smap.Spread)
+func (c *putJogger) rmStale(lom *core.LOM, prev, md *Metadata) {
+	var stale meta.Nodes
+	for _, tsi := range prev.RemoteTargets() {
+		if _, ok := md.Daemons[tsi.ID()]; !ok {
+			stale = append(stale, tsi)
+		}
+	}
+	if len(stale) == 0 {
+		return
+	}
+	if err := c.sendDel(lom, stale, prev); err != nil {
+		nlog.Errorln("failed to remove stale slices of", lom.Cname(), "[", err, "]")
+	}
+}
+
 func (*putJogger) newCtx(lom *core.LOM, md *Metadata) (ctx *encodeCtx, err error) {
 	ctx = allocCtx()
 	ctx.lom = lom
@@ -339,8 +362,13 @@ func (c *putJogger) cleanup(lom *core.LOM) error {
 	if err := cos.RemoveFile(ctMeta.FQN()); err != nil {
 		return err
 	}
+	return c.sendDel(lom, nodes, nil)
+}
 
-	request := newIntraReq(reqDel, nil, lom.Bck()).NewPack(g.smm)
+// given previous metadata, the receivers keep slices and replicas of a newer generation
+// (see XactRespond.removeObjAndMeta)
+func (c *putJogger) sendDel(lom *core.LOM, nodes []*meta.Snode, prev *Metadata) error {
+	request := newIntraReq(reqDel, prev, lom.Bck()).NewPack(g.smm)
 	o := transport.AllocSend()
 	o.Hdr = transport.ObjHdr{ObjName: lom.ObjName, Opaque: request, Opcode: reqDel}
 	o.Hdr.Bck.Copy(lom.Bucket())
@@ -106,7 +106,9 @@ func (r *XactRespond) Run(gowg *sync.WaitGroup) {
 
 // Utility function to cleanup both object/slice and its meta on the local node
 // Used when processing object deletion request
-func (*XactRespond) removeObjAndMeta(bck *meta.Bck, objName string) error {
+// gen > 0: remove only if the local metadata is not newer (e.g., when re-encoding
+// removes stale slices from previous holders that may have since received a new one)
+func (*XactRespond) removeObjAndMeta(bck *meta.Bck, objName string, gen int64) error {
 	if cmn.Rom.V(4, cos.ModEC) {
 		nlog.Infof("Delete request for %s", bck.Cname(objName))
 	}
@@ -118,6 +120,15 @@ func (*XactRespond) removeObjAndMeta(bck *meta.Bck, objName string) error {
 	ct.Lock(true)
 	defer ct.Unlock(true)
 
+	if gen > 0 {
+		if md, err := LoadMetadata(ct.GenFQN(fs.ECMetaCT)); err == nil && md.Generation > gen {
+			if cmn.Rom.V(4, cos.ModEC) {
+				nlog.Infoln("Keeping", bck.Cname(objName), "generation", md.Generation, ">", gen)
+			}
+			return nil
+		}
+	}
+
 	// to be consistent with PUT, object's files are deleted in a reversed
 	// order: first Metafile is removed, then Replica/Slice
 	// Why: the main object is gone already, so we do not want any target
@@ -168,7 +179,11 @@ func (r *XactRespond) dispatchReq(iReq intraReq, hdr *transport.ObjHdr, bck *met
 	switch hdr.Opcode {
 	case reqDel:
 		// object cleanup request: delete replicas, slices and metafiles
-		if err := r.removeObjAndMeta(bck, hdr.ObjName); err != nil {
+		var gen int64
+		if iReq.meta != nil {
+			gen = iReq.meta.Generation
+		}
+		if err := r.removeObjAndMeta(bck, hdr.ObjName, gen); err != nil {
 			err = cmn.NewErrFailedTo(core.T, "delete", bck.Cname(hdr.ObjName), err)
 			r.AddErr(err, 0)
 		}
@@ -11,8 +11,10 @@ import (
 	"net/url"
 	"sync"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 )
 
@@ -63,6 +65,7 @@ func call(reqArgs *cmn.HreqArgs) response {
 	if err != nil {
 		return response{err: err, statusCode: http.StatusInternalServerError}
 	}
+	signReq(req, reqArgs.Body, false /*streamed*/)
 
 	resp, err := bcastClient.Do(req) //nolint:bodyclose // Closed inside `cos.Close`.
 
@@ -74,3 +77,23 @@ func call(reqArgs *cmn.HreqArgs) response {
 	cos.Close(resp.Body)
 	return response{res: out, err: err, statusCode: resp.StatusCode}
 }
+
+// identify and sign intra-cluster request on behalf of this node - proxy or target
+// (see core.IntraReq)
+func signReq(req *http.Request, body []byte, streamed bool) {
+	var node core.Node = core.T
+	if psi != nil {
+		node = psi
+	}
+	ireq := core.IntraReq{
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		RawQuery: req.URL.RawQuery,
+		Body:     body,
+		Streamed: streamed,
+	}
+	req.Header.Set(apc.HdrSenderID, node.SID())
+	if sig := node.IntraSig(&ireq); sig != "" {
+		req.Header.Set(apc.HdrSenderSig, sig)
+	}
+}
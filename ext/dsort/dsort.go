@@ -264,7 +264,8 @@ func (m *Manager) createShard(s *shard.Shard, lom *core.LOM) error {
 	}
 	lom.SetAtimeUnix(time.Now().UnixNano())
 
-	if m.aborted() {
+	// priority-based admission and throttling (see xact/sched.go)
+	if m.xctn.WaitIfPaused() || m.aborted() {
 		return m.newErrAborted()
 	}
 
@@ -612,6 +613,10 @@ func (m *Manager) parseEKMFile() (shard.ExternalKeyMap, error) {
 	tsi := core.T.Snode()
 	req.Header.Set(apc.HdrSenderID, tsi.ID())
 	req.Header.Set(apc.HdrSenderName, tsi.String())
+	// sign only when the (user-provided) URL points to a cluster node
+	if smap := core.T.Sowner().Get(); smap.PubNet2Node(parsedURL.Host) != nil {
+		signReq(req, nil, false /*streamed*/)
+	}
 
 	resp, err := m.client.Do(req) //nolint:bodyclose // closed by cos.Close below
 	if err != nil {
@@ -891,6 +896,7 @@ func (m *Manager) _do(reqArgs *cmn.HreqArgs, tsi *meta.Snode, act string) error
 	if errV != nil {
 		return errV
 	}
+	signReq(req, reqArgs.Body, reqArgs.BodyR != nil)
 	resp, err := m.client.Do(req) //nolint:bodyclose // cos.Close below
 
 	cmn.HreqFree(req)
@@ -924,6 +930,9 @@ type extractShard struct {
 
 func (es *extractShard) do() (err error) {
 	m := es.m
+	if m.xctn.WaitIfPaused() { // ditto
+		return m.newErrAborted()
+	}
 	shardName := es.name
 	if es.isRange && m.Pars.InputExtension != "" {
 		ext, errV := archive.Mime("", es.name) // from filename
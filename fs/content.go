@@ -26,6 +26,9 @@ const (
 	ECMetaCT    = "mt"
 	ChunkCT     = "ch"
 	ChunkMetaCT = "ut"
+	SnapCT      = "sn" // bucket snapshots: preserved (copy-on-write) objects and per-snapshot manifests
+	VersionCT   = "vr" // version history: non-current object versions and delete markers
+	TrashCT     = "tr" // soft delete: deleted objects retained until trash.retention expires
 
 	// ext
 	DsortFileCT = "ds"
@@ -66,6 +69,9 @@ type (
 	ecMetaCR    struct{}
 	objChunkCR  struct{}
 	chunkMetaCR struct{}
+	snapCR      struct{}
+	versionCR   struct{}
+	trashCR     struct{}
 	dsortCR     struct{}
 )
 
@@ -91,6 +97,9 @@ var (
 	_ contentRes = (*ecMetaCR)(nil)
 	_ contentRes = (*objChunkCR)(nil)
 	_ contentRes = (*chunkMetaCR)(nil)
+	_ contentRes = (*snapCR)(nil)
+	_ contentRes = (*versionCR)(nil)
+	_ contentRes = (*trashCR)(nil)
 )
 
 // register all content types
@@ -110,6 +119,9 @@ func (csm *contentSpecMgr) regAll() {
 	csm._reg(ECMetaCT, &ecMetaCR{})
 	csm._reg(ChunkCT, &objChunkCR{})
 	csm._reg(ChunkMetaCT, &chunkMetaCR{})
+	csm._reg(SnapCT, &snapCR{})
+	csm._reg(VersionCT, &versionCR{})
+	csm._reg(TrashCT, &trashCR{})
 
 	csm._reg(DsortFileCT, &dsortCR{})
 	csm._reg(DsortWorkCT, &dsortCR{})
@@ -290,6 +302,52 @@ func (*ecMetaCR) parseUbase(base string) ContentInfo {
 	return ContentInfo{Base: base, Ok: true}
 }
 
+// <snapshot ID>/<object name>
+func (*snapCR) makeUbase(base string, extras ...string) string {
+	debug.Assert(len(extras) == 1 && extras[0] != "", extras)
+	return extras[0] + "/" + base
+}
+
+func (*snapCR) parseUbase(base string) ContentInfo {
+	i := strings.IndexByte(base, '/')
+	if i <= 0 {
+		return ContentInfo{}
+	}
+	return ContentInfo{Base: base[i+1:], Extras: []string{base[:i]}, Ok: true}
+}
+
+// <object-name>/~/<version-name>
+// (object names cannot contain "~/" - see cos.ValidOname - and so the "~" directory
+// does not collide with any object name and its (sorted) walk follows object names)
+const VerDir = "/~/"
+
+func (*versionCR) makeUbase(base string, extras ...string) string {
+	debug.Assert(len(extras) == 1 && extras[0] != "", extras)
+	return base + VerDir + extras[0]
+}
+
+func (*versionCR) parseUbase(base string) ContentInfo {
+	i := strings.LastIndex(base, VerDir)
+	if i <= 0 || i+len(VerDir) == len(base) {
+		return ContentInfo{}
+	}
+	return ContentInfo{Base: base[:i], Extras: []string{base[i+len(VerDir):]}, Ok: true}
+}
+
+// <object-name>/<deletion-time>
+func (*trashCR) makeUbase(base string, extras ...string) string {
+	debug.Assert(len(extras) == 1 && extras[0] != "", extras)
+	return base + "/" + extras[0]
+}
+
+func (*trashCR) parseUbase(base string) ContentInfo {
+	i := strings.LastIndexByte(base, '/')
+	if i <= 0 || i == len(base)-1 {
+		return ContentInfo{}
+	}
+	return ContentInfo{Base: base[:i], Extras: []string{base[i+1:]}, Ok: true}
+}
+
 func (*dsortCR) makeUbase(base string, _ ...string) string { return base }
 
 func (*dsortCR) parseUbase(base string) ContentInfo {
@@ -20,7 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 )
 
-// TODO: undelete (feature)
+// staging area for asynchronous removal (for undelete, see trash.go)
 
 const (
 	deletedRoot = ".$deleted"
@@ -36,6 +36,8 @@ const (
 	WorkfileAppend       = "append"         // APPEND to object (as file)
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
+	WorkfileInventory    = "inventory"      // export bucket inventory
+	WorkfileScrub        = "scrub"          // corrupted object set aside while being restored
 )
 
 type ParsedFQN struct {
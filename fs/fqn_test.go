@@ -407,3 +407,38 @@ func BenchmarkParseFQN(b *testing.B) {
 		parsed.Init(fqn)
 	}
 }
+
+// version history: "<object-name>/~/<version-name>" must not collide with
+// (and must parse back into) any valid object name
+func TestVersionUbase(t *testing.T) {
+	const mpath = "/tmp/vubase"
+	mios := mock.NewIOS()
+	fs.TestNew(mios)
+	cos.CreateDir(mpath)
+	defer os.RemoveAll(mpath)
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+
+	var (
+		mi    = fs.GetAvail()[mpath]
+		bck   = cmn.Bck{Name: "bucket", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		names = []string{"a", "a/b", "a/~", "~", "~a/b", "a~", "a/b~"}
+		seen  = make(map[string]string, len(names))
+	)
+	for _, name := range names {
+		tassert.CheckFatal(t, cos.ValidateOname(name))
+		fqn := fs.CSM.Gen(name, fs.VersionCT, &bck, mi, "~1")
+		var parsed fs.ParsedFQN
+		tassert.CheckFatal(t, parsed.Init(fqn))
+		ci := fs.CSM.ParseUbase(parsed.ObjName, fs.VersionCT)
+		tassert.Fatalf(t, ci.Ok && ci.Base == name && ci.Extras[0] == "~1", "%q: got %+v", name, ci)
+
+		// version directory vs all other names' version files
+		dir := strings.TrimSuffix(fqn, "/~1")
+		for other, ofqn := range seen {
+			odir := strings.TrimSuffix(ofqn, "/~1")
+			tassert.Errorf(t, ofqn != fqn && ofqn != dir && fqn != odir, "%q and %q collide", name, other)
+		}
+		seen[name] = fqn
+	}
+}
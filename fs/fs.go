@@ -66,6 +66,7 @@ type (
 		Disks      []string           // owned disks (ios.FsDisks map => slice)
 		flags      uint64             // bit flags (set/get atomic)
 		PathDigest uint64             // (HRW logic)
+		Weight     uint64             // filesystem size in GiB (weighted HRW - see hrw.go)
 		capacity   Capacity
 	}
 	MPI map[string]*Mountpath
@@ -123,8 +124,13 @@ func NewMountpath(mpath string, label cos.MountpathLabel) (*Mountpath, error) {
 		Label:      label,
 		PathDigest: onexxh.Checksum64S(cos.UnsafeB(cleanMpath), cos.MLCG32),
 	}
-	err = mi.resolveFS()
-	return mi, err
+	if err = mi.resolveFS(); err != nil {
+		return mi, err
+	}
+	if blocks, _, bsize, e := ios.GetFSStats(cleanMpath); e == nil {
+		mi.Weight = max((blocks*uint64(bsize))>>30, 1)
+	}
+	return mi, nil
 }
 
 func (mi *Mountpath) CheckFS() (err error) {
@@ -1172,6 +1178,37 @@ func OnDiskSize(bck *cmn.Bck, prefix string) (size uint64) {
 	return
 }
 
+// total size of a given content type (e.g., SnapCT) across available mountpaths;
+// hard-linked files are counted once
+func OnDiskSizeCT(bck *cmn.Bck, ct string) (size uint64) {
+	avail := GetAvail()
+	for _, mi := range avail {
+		inodes := make(map[uint64]struct{}, 64)
+		dir := mi.MakePathCT(bck, ct)
+		err := filepath.WalkDir(dir, func(_ string, de os.DirEntry, err error) error {
+			if err != nil || !de.Type().IsRegular() {
+				return nil // (benign races)
+			}
+			finfo, errV := de.Info()
+			if errV != nil {
+				return nil
+			}
+			if st, ok := finfo.Sys().(*syscall.Stat_t); ok {
+				if _, dup := inodes[st.Ino]; dup {
+					return nil
+				}
+				inodes[st.Ino] = struct{}{}
+			}
+			size += uint64(finfo.Size())
+			return nil
+		})
+		if err != nil && !os.IsNotExist(err) && cmn.Rom.V(4, cos.ModFS) {
+			nlog.Warningln("failed to calculate", ct, "size:", err, "["+mi.String(), bck.String()+"]")
+		}
+	}
+	return size
+}
+
 // via (`apc.WhatDiskStats`, target_stats)
 func DiskStats(allds cos.AllDiskStats, tcdf *Tcdf, config *cmn.Config, refreshCap bool) {
 	// iops and bw
@@ -7,6 +7,7 @@ package fs
 import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/xoshiro256"
 
 	onexxh "github.com/OneOfOne/xxhash"
@@ -15,6 +16,9 @@ import (
 // A variant of consistent hash based on rendezvous algorithm by Thaler and Ravishankar,
 // aka highest random weight (HRW)
 // See also: core/meta/hrw.go
+//
+// With feature flag `Weighted-HRW` (feat.WeightedHRW) objects are placed on mountpaths
+// proportionally to their respective sizes (Mountpath.Weight) - see cos.WeightedHrw.
 
 func Hrw(uname []byte) (mi *Mountpath, digest uint64, err error) {
 	avail := GetAvail()
@@ -22,15 +26,37 @@ func Hrw(uname []byte) (mi *Mountpath, digest uint64, err error) {
 }
 
 func (avail MPI) Hrw(uname []byte) (mi *Mountpath, digest uint64, err error) {
-	var (
-		maxH uint64
-	)
 	digest = onexxh.Checksum64S(uname, cos.MLCG32)
+	mi, err = avail.HrwDigest(digest, "", weightedHrw())
+	return mi, digest, err
+}
+
+// HRW within a given storage class, i.e. mountpaths labeled with `label`;
+// falls back to all available mountpaths when there are none
+// (digest is the same as the one computed by (regular) Hrw - see cmn.TierConf)
+func (avail MPI) HrwLabel(uname []byte, label cos.MountpathLabel) (mi *Mountpath, digest uint64, err error) {
+	digest = onexxh.Checksum64S(uname, cos.MLCG32)
+	weighted := weightedHrw()
+	mi, err = avail.HrwDigest(digest, label, weighted)
+	if mi == nil && label != "" {
+		mi, err = avail.HrwDigest(digest, "", weighted)
+	}
+	return mi, digest, err
+}
+
+// HrwDigest selects HRW mountpath for a given digest, weighted or not
+// (regardless of the current configuration - e.g., to preview placement);
+// non-empty `label` restricts selection to the mountpaths labeled with it
+func (avail MPI) HrwDigest(digest uint64, label cos.MountpathLabel, weighted bool) (mi *Mountpath, err error) {
+	var maxH uint64
 	for _, mpathInfo := range avail {
-		if mpathInfo.IsAnySet(FlagWaitingDD) {
+		if (label != "" && mpathInfo.Label != label) || mpathInfo.IsAnySet(FlagWaitingDD) {
 			continue
 		}
 		cs := xoshiro256.Hash(mpathInfo.PathDigest ^ digest)
+		if weighted {
+			cs = cos.WeightedHrw(cs, mpathInfo.Weight)
+		}
 		if cs >= maxH {
 			maxH = cs
 			mi = mpathInfo
@@ -39,5 +65,7 @@ func (avail MPI) Hrw(uname []byte) (mi *Mountpath, digest uint64, err error) {
 	if mi == nil {
 		err = cmn.ErrNoMountpaths
 	}
-	return
+	return mi, err
 }
+
+func weightedHrw() bool { return cmn.Rom.Features().IsSet(feat.WeightedHRW) }
@@ -5,6 +5,8 @@
 package fs_test
 
 import (
+	"math"
+	"strconv"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -16,6 +18,8 @@ import (
 	"github.com/NVIDIA/aistore/tools"
 	"github.com/NVIDIA/aistore/tools/tassert"
 	"github.com/NVIDIA/aistore/tools/trand"
+
+	"github.com/OneOfOne/xxhash"
 )
 
 func TestMountpathAddNonExisting(t *testing.T) {
@@ -305,6 +309,73 @@ func TestMoveMarkers(t *testing.T) {
 	}
 }
 
+func TestHrwLabel(t *testing.T) {
+	avail := make(fs.MPI, 6)
+	for i := range 6 {
+		mpath := "/tmp/mp" + strconv.Itoa(i)
+		label := cos.MountpathLabel("hdd")
+		if i < 2 {
+			label = "nvme"
+		}
+		avail[mpath] = &fs.Mountpath{Path: mpath, Label: label, PathDigest: xxhash.Checksum64S([]byte(mpath), cos.MLCG32)}
+	}
+	for i := range 100 {
+		uname := []byte("bck/obj-" + strconv.Itoa(i))
+		_, digest, err := avail.Hrw(uname)
+		tassert.CheckFatal(t, err)
+		for _, label := range []cos.MountpathLabel{"nvme", "hdd"} {
+			mi, d, err := avail.HrwLabel(uname, label)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, mi.Label == label, "%s: expected %q, got %q", uname, label, mi.Label)
+			tassert.Errorf(t, d == digest, "%s: digest mismatch", uname)
+		}
+		// no such class: same as regular HRW
+		mi, _, err := avail.HrwLabel(uname, "tape")
+		tassert.CheckFatal(t, err)
+		hmi, _, _ := avail.Hrw(uname)
+		tassert.Errorf(t, mi.Path == hmi.Path, "%s: expected fallback to %s, got %s", uname, hmi.Path, mi.Path)
+	}
+}
+
+func TestHrwWeighted(t *testing.T) {
+	const num = 40000
+	var (
+		avail  = make(fs.MPI, 4)
+		counts = make(map[string]int, 4)
+		total  uint64
+	)
+	for i := range 4 {
+		mpath := "/tmp/mp" + strconv.Itoa(i)
+		avail[mpath] = &fs.Mountpath{Path: mpath, Weight: 8 * 1024, PathDigest: xxhash.Checksum64S([]byte(mpath), cos.MLCG32)}
+	}
+	// equal weights: same as unweighted
+	for i := range 1000 {
+		digest := xxhash.Checksum64S([]byte("bck/obj-"+strconv.Itoa(i)), cos.MLCG32)
+		a, err := avail.HrwDigest(digest, "", false)
+		tassert.CheckFatal(t, err)
+		b, err := avail.HrwDigest(digest, "", true)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, a.Path == b.Path, "digest %x: %s vs %s", digest, a.Path, b.Path)
+	}
+	// 8TB and 20TB drives
+	avail["/tmp/mp0"].Weight = 20 * 1024
+	avail["/tmp/mp1"].Weight = 20 * 1024
+	for _, mi := range avail {
+		total += mi.Weight
+	}
+	for i := range num {
+		digest := xxhash.Checksum64S([]byte("bck/obj-"+strconv.Itoa(i)), cos.MLCG32)
+		mi, err := avail.HrwDigest(digest, "", true)
+		tassert.CheckFatal(t, err)
+		counts[mi.Path]++
+	}
+	for mpath, mi := range avail {
+		expected := float64(num) * float64(mi.Weight) / float64(total)
+		tassert.Errorf(t, math.Abs(float64(counts[mpath])-expected) < expected*0.1,
+			"%s: expected ~%.0f objects, got %d", mpath, expected, counts[mpath])
+	}
+}
+
 func initFS() {
 	fs.TestNew(mock.NewIOS())
 }
@@ -0,0 +1,202 @@
+// Package mpather provides per-mountpath concepts.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package mpather
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Pause checkpoint
+//
+// A jogger group created with JgroupOpts.Ckpt walks its bucket in sorted order
+// and can, therefore, persist where each jogger is: the last visited FQN or, if
+// the mountpath is fully traversed, "done". One checkpoint file per mountpath:
+// join(mountpath, fname.XactDir, xaction ID).
+//
+// Joggers restored from the checkpoint skip everything up to and including the
+// last visited FQN (at-least-once: an object that was being visited at the time
+// of the checkpoint will be visited again).
+
+const ckptVer = 1
+
+type Ckpt struct {
+	Args   any     `json:"args,omitempty"` // xaction-specific, to restart the xaction
+	ID     string  `json:"id"`
+	Kind   string  `json:"kind"`
+	Pos    string  `json:"pos,omitempty"` // last visited FQN
+	Bck    cmn.Bck `json:"bck"`
+	Paused int64   `json:"paused"` // Unix nanoseconds
+	Done   bool    `json:"done,omitempty"`
+}
+
+func ckptPath(mi *fs.Mountpath, xid string) string {
+	return filepath.Join(mi.Path, fname.XactDir, xid)
+}
+
+// persist checkpoint on all mountpaths of this (checkpointable) jogger group
+func (jg *Jgroup) SaveCkpt(ckpt *Ckpt) (err error) {
+	for _, j := range jg.joggers {
+		c := *ckpt
+		c.Pos, c.Done = j.lastPos(), j.done.Load()
+		if erc := jsp.Save(ckptPath(j.mi, c.ID), &c, jsp.CCSign(ckptVer), nil); erc != nil {
+			nlog.Errorln(j.String(), "failed to checkpoint:", erc)
+			err = erc
+		}
+	}
+	return err
+}
+
+// load persisted checkpoint (if exists) into each jogger;
+// return true if restored
+func (jg *Jgroup) LoadCkpt(xid string) (restored bool) {
+	for _, j := range jg.joggers {
+		ckpt := &Ckpt{}
+		if _, err := jsp.Load(ckptPath(j.mi, xid), ckpt, jsp.CCSign(ckptVer)); err != nil {
+			if !cos.IsNotExist(err) {
+				nlog.Errorln(j.String(), "failed to load checkpoint:", err)
+			}
+			continue
+		}
+		restored = true
+		if ckpt.Done {
+			j.done.Store(true)
+			continue
+		}
+		j.setFrom(ckpt.Pos)
+	}
+	return restored
+}
+
+func (jg *Jgroup) RemoveCkpt(xid string) {
+	for _, j := range jg.joggers {
+		if err := cos.RemoveFile(ckptPath(j.mi, xid)); err != nil {
+			nlog.Errorln(j.String(), "failed to remove checkpoint:", err)
+		}
+	}
+}
+
+// all persisted checkpoints on all available mountpaths (one per xaction ID)
+func LoadCkpts() map[string]*Ckpt {
+	ckpts := make(map[string]*Ckpt, 2)
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		dir := filepath.Join(mi.Path, fname.XactDir)
+		dentries, err := os.ReadDir(dir)
+		if err != nil {
+			if !cos.IsNotExist(err) {
+				nlog.Errorln("failed to read", dir+":", err)
+			}
+			continue
+		}
+		for _, de := range dentries {
+			xid := de.Name()
+			if de.IsDir() || strings.Contains(xid, ".tmp.") {
+				continue
+			}
+			if _, ok := ckpts[xid]; ok {
+				continue
+			}
+			ckpt := &Ckpt{}
+			if _, err := jsp.Load(filepath.Join(dir, xid), ckpt, jsp.CCSign(ckptVer)); err != nil {
+				nlog.Errorln("failed to load checkpoint", xid, "from", mi.String()+":", err)
+				continue
+			}
+			ckpts[xid] = ckpt
+		}
+	}
+	return ckpts
+}
+
+// remove all checkpoints of a given xaction (e.g., when it cannot be restarted)
+func RemoveCkpts(xid string) {
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		if err := cos.RemoveFile(ckptPath(mi, xid)); err != nil {
+			nlog.Errorln("failed to remove checkpoint", xid, "from", mi.String()+":", err)
+		}
+	}
+}
+
+////////////
+// jogger //
+////////////
+
+func (j *jogger) setFrom(pos string) {
+	if pos == "" {
+		return
+	}
+	for i, root := range j.roots {
+		if under(root, pos) {
+			j.from, j.fromIdx = pos, i
+			j.pos.Store(&pos)
+			return
+		}
+	}
+	nlog.Warningln(j.String(), "checkpoint", pos, "does not belong - walking from the start")
+}
+
+func (j *jogger) lastPos() string {
+	if p := j.pos.Load(); p != nil {
+		return *p
+	}
+	return ""
+}
+
+// resuming from checkpoint: skip the part of the (sorted) walk that
+// precedes (and includes) the last visited FQN
+func (j *jogger) skip(fqn string) bool {
+	idx := -1
+	for i, root := range j.roots {
+		if under(root, fqn) {
+			idx = i
+			break
+		}
+	}
+	switch {
+	case idx < j.fromIdx:
+		return true
+	case idx > j.fromIdx:
+		j.from = ""
+		return false
+	case fqn == j.from:
+		j.from = ""
+		return true
+	case under(fqn, j.from):
+		return false // (descend)
+	case cmpPath(fqn, j.from) < 0:
+		return true
+	default:
+		j.from = ""
+		return false
+	}
+}
+
+func under(dir, fqn string) bool {
+	return fqn == dir || (strings.HasPrefix(fqn, dir) && fqn[len(dir)] == filepath.Separator)
+}
+
+// compare the way sorted walk orders: component by component
+func cmpPath(a, b string) int {
+	for {
+		ea, ra, _ := strings.Cut(a, cos.PathSeparator)
+		eb, rb, _ := strings.Cut(b, cos.PathSeparator)
+		if c := strings.Compare(ea, eb); c != 0 {
+			return c
+		}
+		if ra == "" || rb == "" {
+			return strings.Compare(ra, rb)
+		}
+		a, b = ra, rb
+	}
+}
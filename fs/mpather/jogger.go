@@ -10,6 +10,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	ratomic "sync/atomic"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -36,7 +37,7 @@ const (
 type (
 	JgroupOpts struct {
 		onFinish    func()
-		Parent      cos.Stopper // optional: stop walk when parent xaction aborts
+		Parent      cos.Stopper // optional: stop walk when parent xaction aborts (and pause when it implements cos.Pauser)
 		VisitObj    func(lom *core.LOM, buf []byte) error
 		VisitCT     func(ct *core.CT, buf []byte) error
 		Slab        *memsys.Slab
@@ -45,6 +46,7 @@ type (
 		Prefix      string
 		CTs         []string
 		DoLoad      LoadType // if specified, lom.Load(lock type)
+		Ckpt        bool     // sorted walk that can be checkpointed and resumed (see ckpt.go); single bucket only
 		IncludeCopy bool     // visit copies (aka replicas)
 		PerBucket   bool     // num joggers = (num mountpaths) x (num buckets)
 		RW          bool     // true when performs data IO
@@ -69,12 +71,18 @@ type (
 		opts      *JgroupOpts
 		mi        *fs.Mountpath
 		config    *cmn.Config
-		stopCh    *cos.StopCh  // shared group stop
-		bdir      string       // mi.MakePath(bck)
-		objPrefix string       // fully-qualified prefix, as in: join(bdir, opts.Prefix)
-		buf       []byte       // for visit*() callbacks
-		adv       load.Advice  // throttle
-		numvis    atomic.Int64 // counter: num visited objects
+		stopCh    *cos.StopCh             // shared group stop
+		bdir      string                  // mi.MakePath(bck)
+		objPrefix string                  // fully-qualified prefix, as in: join(bdir, opts.Prefix)
+		buf       []byte                  // for visit*() callbacks
+		adv       load.Advice             // throttle
+		pauser    cos.Pauser              // parent xaction (optional)
+		from      string                  // resuming from checkpoint: skip up to and including
+		roots     []string                // walk roots, one per content type (Ckpt only)
+		fromIdx   int                     // index of the root that contains 'from'
+		pos       ratomic.Pointer[string] // last visited (Ckpt only)
+		numvis    atomic.Int64            // counter: num visited objects
+		done      atomic.Bool             // fully traversed (Ckpt only)
 	}
 )
 
@@ -86,6 +94,7 @@ func NewJgroup(opts *JgroupOpts, config *cmn.Config, smi *fs.Mountpath) *Jgroup
 		jg      = &Jgroup{}
 	)
 	debug.Assert(!opts.IncludeCopy || (opts.IncludeCopy && opts.DoLoad > noLoad))
+	debug.Assert(!opts.Ckpt || (len(opts.Buckets) == 0 && !opts.Bck.IsQuery()))
 
 	opts.onFinish = jg.markFinished
 
@@ -187,10 +196,19 @@ func newJogger(opts *JgroupOpts, mi *fs.Mountpath, config *cmn.Config, stopCh *c
 		config: config,
 		stopCh: stopCh,
 	}
+	if opts.Parent != nil {
+		j.pauser, _ = opts.Parent.(cos.Pauser)
+	}
 	if opts.Prefix != "" {
 		j.bdir = mi.MakePathCT(&j.opts.Bck, fs.ObjCT) // this mountpath's bucket dir that contains objects
 		j.objPrefix = filepath.Join(j.bdir, opts.Prefix)
 	}
+	if opts.Ckpt {
+		j.roots = make([]string, len(opts.CTs))
+		for i, ct := range opts.CTs {
+			j.roots[i] = mi.MakePathCT(&opts.Bck, ct)
+		}
+	}
 	// throttling context
 	j.adv.Init(load.FlMem|load.FlDsk, &load.Extra{Mi: j.mi, Cfg: &j.config.Disk, RW: j.opts.RW})
 	return
@@ -210,6 +228,10 @@ func (j *jogger) run() error {
 		return err
 	}
 
+	if j.done.Load() {
+		j.opts.onFinish() // (restored from checkpoint)
+		return nil
+	}
 	if j.opts.Slab != nil {
 		j.buf = j.opts.Slab.Alloc()
 	}
@@ -223,7 +245,11 @@ func (j *jogger) run() error {
 	case j.opts.Bck.IsQuery():
 		err = j.runQbck(cmn.QueryBcks(j.opts.Bck))
 	default:
-		_, err = j.runBck(&j.opts.Bck)
+		var aborted bool
+		aborted, err = j.runBck(&j.opts.Bck)
+		if j.opts.Ckpt && !aborted && err == nil && !j.stopped() {
+			j.done.Store(true)
+		}
 	}
 
 	if j.buf != nil {
@@ -279,7 +305,7 @@ func (j *jogger) runBck(bck *cmn.Bck) (aborted bool, err error) {
 		Mi:       j.mi,
 		CTs:      j.opts.CTs,
 		Callback: j.jog,
-		Sorted:   false,
+		Sorted:   j.opts.Ckpt,
 	}
 	opts.Bck.Copy(bck)
 
@@ -305,6 +331,12 @@ func (j *jogger) jog(fqn string, de fs.DirEntry) error {
 			return nil
 		}
 	}
+	if j.from != "" && j.skip(fqn) {
+		if de.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	}
 	if de.IsDir() {
 		return nil
 	}
@@ -312,9 +344,15 @@ func (j *jogger) jog(fqn string, de fs.DirEntry) error {
 	if j.stopped() {
 		return fs.ErrWalkStopped
 	}
+	if j.pauser != nil && j.pauser.WaitIfPaused() {
+		return fs.ErrWalkStopped
+	}
 	if err := j.visitFQN(fqn, j.buf); err != nil {
 		return err
 	}
+	if j.opts.Ckpt {
+		j.pos.Store(&fqn)
+	}
 
 	n := j.numvis.Inc()
 	if j.opts.RW && j.adv.ShouldCheck(n) {
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"os"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -243,3 +245,113 @@ func TestJoggerGroupMultiContentTypes(t *testing.T) {
 	err := jg.Stop()
 	tassert.CheckFatal(t, err)
 }
+
+// parent xaction that stays paused until resumed (see cos.Pauser)
+type pausedParent struct {
+	resumeCh chan struct{}
+	abortCh  chan error
+}
+
+func (*pausedParent) Abort(error) bool               { return false }
+func (*pausedParent) IsAborted() bool                { return false }
+func (*pausedParent) IsDone() bool                   { return false }
+func (*pausedParent) Name() string                   { return "paused-parent" }
+func (p *pausedParent) ChanAbort() <-chan error      { return p.abortCh }
+func (p *pausedParent) WaitIfPaused() (aborted bool) { <-p.resumeCh; return false }
+
+func TestJoggerGroupPause(t *testing.T) {
+	var (
+		desc = tools.ObjectsDesc{
+			CTs: []tools.ContentTypeDesc{
+				{Type: fs.ObjCT, ContentCnt: 100},
+			},
+			MountpathsCnt: 4,
+			ObjectSize:    cos.KiB,
+		}
+		out     = tools.PrepareObjects(t, desc)
+		counter = atomic.NewInt32(0)
+		parent  = &pausedParent{resumeCh: make(chan struct{}), abortCh: make(chan error)}
+	)
+	defer os.RemoveAll(out.Dir)
+
+	opts := &mpather.JgroupOpts{
+		Bck:    *out.Bck,
+		CTs:    []string{fs.ObjCT},
+		Parent: parent,
+		VisitObj: func(*core.LOM, []byte) error {
+			counter.Inc()
+			return nil
+		},
+	}
+	jg := mpather.NewJgroup(opts, cmn.GCO.Get(), nil)
+	jg.Run()
+
+	time.Sleep(100 * time.Millisecond)
+	tassert.Errorf(t, counter.Load() == 0, "paused joggers visited %d objects", counter.Load())
+
+	close(parent.resumeCh)
+	<-jg.ListenFinished()
+	tassert.Errorf(
+		t, int(counter.Load()) == len(out.FQNs[fs.ObjCT]),
+		"invalid number of objects visited after resume (%d vs %d)", counter.Load(), len(out.FQNs[fs.ObjCT]),
+	)
+	tassert.CheckFatal(t, jg.Stop())
+}
+
+func TestJoggerGroupCkpt(t *testing.T) {
+	const xid = "ckpt-test-xid"
+	var (
+		desc = tools.ObjectsDesc{
+			CTs: []tools.ContentTypeDesc{
+				{Type: fs.ObjCT, ContentCnt: 500},
+			},
+			MountpathsCnt: 4,
+			ObjectSize:    cos.KiB,
+		}
+		out     = tools.PrepareObjects(t, desc)
+		mu      sync.Mutex
+		visited = make(map[string]int, 500)
+		counter = atomic.NewInt32(0)
+	)
+	defer os.RemoveAll(out.Dir)
+
+	visit := func(lom *core.LOM, _ []byte) error {
+		if counter.Inc() > 100 {
+			return errors.New("interrupted")
+		}
+		mu.Lock()
+		visited[lom.FQN]++
+		mu.Unlock()
+		return nil
+	}
+	opts := &mpather.JgroupOpts{
+		Bck:      *out.Bck,
+		CTs:      []string{fs.ObjCT},
+		VisitObj: visit,
+		Ckpt:     true,
+	}
+
+	// 1. interrupt and checkpoint
+	jg := mpather.NewJgroup(opts, cmn.GCO.Get(), nil)
+	jg.Run()
+	<-jg.ListenFinished()
+	jg.Stop()
+	tassert.CheckFatal(t, jg.SaveCkpt(&mpather.Ckpt{ID: xid}))
+	tassert.Fatalf(t, len(visited) > 0 && len(visited) < len(out.FQNs[fs.ObjCT]), "expected partial walk, got %d", len(visited))
+
+	// 2. restore and walk the rest
+	counter.Store(-1 << 20)
+	jg = mpather.NewJgroup(opts, cmn.GCO.Get(), nil)
+	tassert.Fatalf(t, jg.LoadCkpt(xid), "expected to restore from checkpoint")
+	jg.Run()
+	<-jg.ListenFinished()
+	tassert.CheckFatal(t, jg.Stop())
+
+	for _, fqn := range out.FQNs[fs.ObjCT] {
+		n := visited[fqn]
+		tassert.Errorf(t, n == 1, "%s: visited %d times", fqn, n)
+	}
+
+	jg.RemoveCkpt(xid)
+	tassert.Errorf(t, len(mpather.LoadCkpts()) == 0, "expected no checkpoints")
+}
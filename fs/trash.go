@@ -0,0 +1,234 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Destroyed buckets with trash enabled (see cmn.TrashConf):
+// - on each mountpath, the bucket directory (with all its content types) is moved to
+//   <mountpath>/.$trash/<bucket path>/<deletion time>/
+//   along with the bucket's props - the latter to restore the bucket as it was,
+//   including its BID (that the objects' metadata refers to)
+// - `space` cleanup purges trashed buckets upon expiration of their trash.retention
+// Deleted objects, on the other hand, are kept in the bucket itself - see TrashCT and core/ltrash.go
+
+const (
+	trashRoot   = ".$trash"
+	trashBprops = ".bprops"
+)
+
+type TrashedBck struct {
+	Props   *cmn.Bprops
+	Deleted int64 // unix nano
+}
+
+func (mi *Mountpath) trashPathBck(bck *cmn.Bck) string {
+	rel := strings.TrimPrefix(mi.MakePathBck(bck), mi.Path)
+	return filepath.Join(mi.Path, trashRoot, rel)
+}
+
+// instead of DestroyBucket
+func TrashBucket(op string, bck *cmn.Bck, props *cmn.Bprops) error {
+	var (
+		avail   = GetAvail()
+		deleted = strconv.FormatInt(time.Now().UnixNano(), 10)
+		bprops  = cos.MustMarshal(props)
+		n       int
+	)
+	for _, mi := range avail {
+		if err := mi.trashBck(bck, deleted, bprops); err != nil {
+			nlog.Errorf("%s %q: failed to move %s to trash (removing instead): %v", op, bck.String(), mi, err)
+			if errMv := mi.MoveToDeleted(mi.MakePathBck(bck)); errMv != nil {
+				mfs.hc.FSHC(errMv, mi, "")
+				continue
+			}
+		}
+		n++
+	}
+	if count := len(avail); n < count {
+		return fmt.Errorf("%s %q: failed to destroy %d out of %d dirs", op, bck.String(), count-n, count)
+	}
+	return nil
+}
+
+func (mi *Mountpath) trashBck(bck *cmn.Bck, deleted string, bprops []byte) error {
+	dir := mi.MakePathBck(bck)
+	if err := cos.Stat(dir); err != nil {
+		if cos.IsNotExist(err) {
+			err = nil
+		}
+		return err
+	}
+	tdir := filepath.Join(mi.trashPathBck(bck), deleted)
+	if err := cos.CreateDir(filepath.Dir(tdir)); err != nil {
+		return err
+	}
+	if err := os.Rename(dir, tdir); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(tdir, trashBprops), bprops, cos.PermRWR)
+}
+
+// trashed incarnations of a given bucket, newest first
+func ListTrashedBcks(bck *cmn.Bck) (res []TrashedBck, err error) {
+	for _, mi := range GetAvail() {
+		tbcks, errT := mi.trashedBcks(bck)
+		if errT != nil {
+			err = errors.Join(err, errT)
+			continue
+		}
+	outer:
+		for _, tb := range tbcks {
+			for i := range res {
+				if res[i].Deleted == tb.Deleted {
+					continue outer
+				}
+			}
+			res = append(res, tb)
+		}
+	}
+	sortTrashedBcks(res)
+	return res, err
+}
+
+func sortTrashedBcks(tbcks []TrashedBck) {
+	slices.SortFunc(tbcks, func(a, b TrashedBck) int { return cmp.Compare(b.Deleted, a.Deleted) })
+}
+
+func (mi *Mountpath) trashedBcks(bck *cmn.Bck) ([]TrashedBck, error) {
+	tpath := mi.trashPathBck(bck)
+	dents, err := os.ReadDir(tpath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	tbcks := make([]TrashedBck, 0, len(dents))
+	for _, de := range dents {
+		if !de.IsDir() {
+			continue
+		}
+		if tb, ok := readTrashedBck(filepath.Join(tpath, de.Name())); ok {
+			tbcks = append(tbcks, tb)
+		}
+	}
+	return tbcks, nil
+}
+
+func readTrashedBck(tdir string) (tb TrashedBck, ok bool) {
+	deleted, err := strconv.ParseInt(filepath.Base(tdir), 10, 64)
+	if err != nil {
+		return tb, false
+	}
+	b, err := os.ReadFile(filepath.Join(tdir, trashBprops))
+	if err != nil {
+		return tb, false
+	}
+	props := &cmn.Bprops{}
+	if err := jsoniter.Unmarshal(b, props); err != nil {
+		nlog.Errorln("failed to unmarshal trashed bucket props", tdir, err)
+		return tb, false
+	}
+	return TrashedBck{Props: props, Deleted: deleted}, true
+}
+
+// UntrashBucket restores the trashed bucket with a given BID on all mountpaths
+// (instead of creating a new and empty one - compare with CreateBucket)
+func UntrashBucket(bck *cmn.Bck, bid uint64) (errs []error) {
+	for _, mi := range GetAvail() {
+		if err := mi.untrashBck(bck, bid); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (mi *Mountpath) untrashBck(bck *cmn.Bck, bid uint64) error {
+	tbcks, err := mi.trashedBcks(bck)
+	if err != nil {
+		return err
+	}
+	sortTrashedBcks(tbcks)
+	for _, tb := range tbcks {
+		if tb.Props.BID != bid {
+			continue
+		}
+		dir := mi.MakePathBck(bck)
+		if err := cos.Stat(dir); err == nil {
+			return fmt.Errorf("cannot restore bucket %s: directory %s already exists", bck.String(), dir)
+		}
+		tdir := filepath.Join(mi.trashPathBck(bck), strconv.FormatInt(tb.Deleted, 10))
+		if err := cos.CreateDir(filepath.Dir(dir)); err != nil {
+			return err
+		}
+		if err := os.Rename(tdir, dir); err != nil {
+			return err
+		}
+		if err := cos.RemoveFile(filepath.Join(dir, trashBprops)); err != nil {
+			nlog.Warningln(err)
+		}
+		// content types that may not have existed at deletion time
+		for contentType := range CSM.m {
+			if err := cos.CreateDir(mi.MakePathCT(bck, contentType)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// nothing to restore on this mountpath
+	_, err = mi.createBckDirs(bck, false /*nilbmd*/)
+	return err
+}
+
+// RemoveExpiredTrash permanently removes trashed buckets past their retention
+func (mi *Mountpath) RemoveExpiredTrash(now time.Time) (n int, rerr error) {
+	root := filepath.Join(mi.Path, trashRoot)
+	err := filepath.WalkDir(root, func(path string, de fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !de.IsDir() || path == root {
+			return nil
+		}
+		if cos.Stat(filepath.Join(path, trashBprops)) != nil {
+			return nil // keep descending
+		}
+		tb, ok := readTrashedBck(path)
+		if ok && now.Sub(time.Unix(0, tb.Deleted)) < tb.Props.Trash.RetentionD() {
+			return filepath.SkipDir
+		}
+		if err := RemoveAll(path); err != nil {
+			rerr = err
+		} else {
+			n++
+		}
+		return filepath.SkipDir
+	})
+	if err != nil && rerr == nil {
+		rerr = err
+	}
+	return n, rerr
+}
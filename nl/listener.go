@@ -48,6 +48,8 @@ type Listener interface {
 	String() string
 	GetOwner() string
 	SetOwner(string)
+	User() string
+	SetUser(string)
 	LastUpdated(*meta.Snode) int64
 	ProgressInterval() time.Duration
 
@@ -81,6 +83,7 @@ type (
 			Kind  string // async operation kind (see api/apc/actmsg.go)
 			Cause string // causal action (e.g. decommission => rebalance)
 			Owned string // "": not owned | equalIC: IC | otherwise, pid + IC
+			User  string `json:",omitempty"` // initiating (authenticated) user, if any
 			Bck   []*cmn.Bck
 		}
 
@@ -139,6 +142,8 @@ func (nlb *ListenerBase) ProgressInterval() time.Duration { return nlb.progress
 func (nlb *ListenerBase) NodeStats() *NodeStats           { return nlb.Stats }
 func (nlb *ListenerBase) GetOwner() string                { return nlb.Common.Owned }
 func (nlb *ListenerBase) SetOwner(o string)               { nlb.Common.Owned = o }
+func (nlb *ListenerBase) User() string                    { return nlb.Common.User }
+func (nlb *ListenerBase) SetUser(u string)                { nlb.Common.User = u }
 func (nlb *ListenerBase) Kind() string                    { return nlb.Common.Kind }
 func (nlb *ListenerBase) Cause() string                   { return nlb.Common.Cause }
 func (nlb *ListenerBase) Bcks() []*cmn.Bck                { return nlb.Common.Bck }
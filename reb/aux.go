@@ -0,0 +1,379 @@
+// Package reb provides global cluster-wide rebalance upon adding/removing storage nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package reb
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/transport"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// auxiliary per-object content (see core.AuxCTs):
+// - migrates to the object's new owner after the bucket's objects (EC buckets including)
+// - a preserved chunked object (see core/laux.go) migrates as a unit: the main file
+//   (that carries the object's metadata and the original name) followed by its manifest
+//   and chunk links
+// - each file is acknowledged (rebMsgAux) and then removed at the source
+//   (unless feat.DontDeleteWhenRebalancing); unacknowledged ones get retransmitted
+
+type (
+	auxEntry struct {
+		bck     cmn.Bck
+		objName string
+		fqn     string
+		ct      string
+		extra   string
+	}
+	auxAcks struct {
+		q  map[string]*auxEntry // on the wire, waiting for ACK
+		mu sync.Mutex
+	}
+)
+
+func auxKey(bck *cmn.Bck, objName, ct, extra string) string {
+	return string(bck.MakeUname(objName)) + "\x00" + ct + "\x00" + extra
+}
+
+func (a *auxAcks) init() {
+	a.mu.Lock()
+	a.q = make(map[string]*auxEntry, initCapLomAcks)
+	a.mu.Unlock()
+}
+
+func (a *auxAcks) add(e *auxEntry) {
+	a.mu.Lock()
+	a.q[auxKey(&e.bck, e.objName, e.ct, e.extra)] = e
+	a.mu.Unlock()
+}
+
+func (a *auxAcks) del(key string) (e *auxEntry) {
+	a.mu.Lock()
+	if e = a.q[key]; e != nil {
+		delete(a.q, key)
+	}
+	a.mu.Unlock()
+	return e
+}
+
+func (a *auxAcks) pending() (cnt int) {
+	a.mu.Lock()
+	cnt = len(a.q)
+	a.mu.Unlock()
+	return cnt
+}
+
+func (a *auxAcks) all() []*auxEntry {
+	a.mu.Lock()
+	ents := make([]*auxEntry, 0, len(a.q))
+	for _, e := range a.q {
+		ents = append(ents, e)
+	}
+	a.mu.Unlock()
+	return ents
+}
+
+//
+// send
+//
+
+func (rj *rebJogger) visitAux(fqn string, de fs.DirEntry) error {
+	if err := rj.xreb.AbortErr(); err != nil {
+		nlog.Infoln(rj.xreb.Name(), "rj-walk-visit aborted", err)
+		return err
+	}
+	if de.IsDir() {
+		return nil
+	}
+	var parsed fs.ParsedFQN
+	if err := parsed.Init(fqn); err != nil {
+		return nil
+	}
+	ci := fs.CSM.ParseUbase(parsed.ObjName, parsed.ContentType)
+	if !ci.Ok {
+		return nil
+	}
+	extra := ci.Extras[0]
+	psv := core.IsPsvCT(parsed.ContentType)
+	if psv {
+		if _, sfx := core.ParsePsv(extra); sfx != "" {
+			return nil // goes with its main file
+		}
+	}
+
+	// the owner - by the original name (cmn.OrigFntl), if any
+	lom, plom, err := auxLOM(&rj.opts.Bck, ci.Base, fqn)
+	if err != nil {
+		return nil
+	}
+	defer func() {
+		if plom != nil {
+			core.FreeLOM(plom)
+		}
+		core.FreeLOM(lom)
+	}()
+	if rj.rargs.prefix != "" && !cmn.ObjHasPrefix(lom.ObjName, rj.rargs.prefix) {
+		return nil
+	}
+	tsi, err := rj.rargs.smap.HrwHash2T(lom.Digest())
+	if err != nil {
+		return err
+	}
+	if tsi.ID() == core.T.SID() {
+		return nil
+	}
+
+	if err := rj.sendAux(lom, tsi, fqn, parsed.ContentType, extra); err != nil {
+		return rj._auxErr(err)
+	}
+	if !psv || plom == nil || !plom.IsChunked() {
+		return nil
+	}
+	fqns, err := lom.PsvFQNs(plom, parsed.ContentType, extra)
+	if err != nil {
+		rj.xreb.AddErr(err)
+		return nil
+	}
+	for _, f := range fqns {
+		if err := rj.sendAuxFQN(lom, tsi, f); err != nil {
+			return rj._auxErr(err)
+		}
+	}
+	return nil
+}
+
+// returns the object (by its original name) and, when the file carries metadata, the latter
+func auxLOM(bck *cmn.Bck, base, fqn string) (lom, plom *core.LOM, _ error) {
+	lom = core.AllocLOM(base)
+	if err := lom.InitCmnBck(bck); err != nil {
+		core.FreeLOM(lom)
+		return nil, nil, err
+	}
+	plom = lom.CloneTo(fqn)
+	if plom.LoadMetaFromFS() != nil {
+		core.FreeLOM(plom)
+		return lom, nil, nil
+	}
+	if !plom.IsFntl() {
+		return lom, plom, nil
+	}
+	orig := plom.OrigFntl()
+	if orig == nil {
+		return lom, plom, nil
+	}
+	olom := core.AllocLOM(orig[1])
+	if err := olom.InitCmnBck(bck); err != nil {
+		core.FreeLOM(olom)
+		return lom, plom, nil
+	}
+	core.FreeLOM(lom)
+	return olom, plom, nil
+}
+
+func (rj *rebJogger) _auxErr(err error) error {
+	if cmn.IsErrStreamTerminated(err) {
+		rj.xreb.Abort(err)
+		return err
+	}
+	rj.xreb.AddErr(err)
+	return nil
+}
+
+func (rj *rebJogger) sendAuxFQN(lom *core.LOM, tsi *meta.Snode, fqn string) error {
+	var parsed fs.ParsedFQN
+	if err := parsed.Init(fqn); err != nil {
+		return err
+	}
+	ci := fs.CSM.ParseUbase(parsed.ObjName, parsed.ContentType)
+	if !ci.Ok {
+		return fmt.Errorf("%s: invalid auxiliary %q", lom.Cname(), fqn)
+	}
+	return rj.sendAux(lom, tsi, fqn, parsed.ContentType, ci.Extras[0])
+}
+
+func (rj *rebJogger) sendAux(lom *core.LOM, tsi *meta.Snode, fqn, ct, extra string) error {
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return err
+	}
+	var roc cos.ReadOpenCloser
+	if finfo.Size() > 0 {
+		if roc, err = cos.NewFileHandle(fqn); err != nil {
+			return err
+		}
+	}
+	var (
+		ack = regularAck{rebID: rj.m.rebID(), daemonID: core.T.SID()}
+		e   = &auxEntry{objName: lom.ObjName, fqn: fqn, ct: ct, extra: extra}
+		o   = transport.AllocSend()
+	)
+	e.bck.Copy(lom.Bucket())
+	o.Hdr.Bck.Copy(lom.Bucket())
+	o.Hdr.ObjName = lom.ObjName
+	o.Hdr.Opaque = ack.NewPackAux(ct, extra, core.AuxMeta(fqn))
+	o.Hdr.ObjAttrs.Size = finfo.Size()
+	o.SentCB = rj.auxSentCallback
+
+	rj.m.auxacks.add(e)
+	if err := rj.m.dm.Send(o, roc, tsi); err != nil {
+		rj.m.auxacks.del(auxKey(&e.bck, e.objName, ct, extra))
+		return err
+	}
+	return nil
+}
+
+func (rj *rebJogger) auxSentCallback(hdr *transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+	if err == nil {
+		return
+	}
+	switch {
+	case cmn.IsErrStreamTerminated(err):
+		rj.xreb.Abort(err)
+		nlog.Errorln("stream term-ed: [", err, rj.xreb.Name(), "]")
+	case cmn.Rom.V(4, cos.ModReb) || !cos.IsErrRetriableConn(err):
+		nlog.Errorf("%s: %s failed to send %s (auxiliary): %v", core.T, rj.xreb.Name(), hdr.Cname(), err)
+	}
+}
+
+func (reb *Reb) retransmitAux(rj *rebJogger) (cnt int) {
+	for _, e := range reb.auxacks.all() {
+		if rj.xreb.IsAborted() {
+			return 0
+		}
+		key := auxKey(&e.bck, e.objName, e.ct, e.extra)
+		if cos.Stat(e.fqn) != nil {
+			reb.auxacks.del(key)
+			continue
+		}
+		lom := core.AllocLOM(e.objName)
+		if err := lom.InitCmnBck(&e.bck); err != nil {
+			core.FreeLOM(lom)
+			reb.auxacks.del(key)
+			continue
+		}
+		tsi, err := rj.rargs.smap.HrwHash2T(lom.Digest())
+		if err == nil {
+			err = rj.sendAux(lom, tsi, e.fqn, e.ct, e.extra)
+		}
+		core.FreeLOM(lom)
+		if err == nil {
+			cnt++
+			continue
+		}
+		if cmn.IsErrStreamTerminated(err) {
+			rj.xreb.Abort(err)
+			nlog.Errorln(rj.rargs.logHdr, "stream term-ed:", err)
+			return 0
+		}
+		rj.xreb.AddErr(fmt.Errorf("%s: failed to retransmit %s (auxiliary %s): %w", rj.rargs.logHdr, e.objName, e.ct, err))
+	}
+	return cnt
+}
+
+//
+// receive
+//
+
+func (reb *Reb) recvAux(hdr *transport.ObjHdr, smap *meta.Smap, unpacker *cos.ByteUnpack, objReader io.Reader, xreb *xs.Rebalance) error {
+	ack := &regularAck{}
+	if err := unpacker.ReadAny(ack); err != nil {
+		nlog.Errorf("g[%d]: failed to parse ACK: %v", reb.rebID(), err)
+		return err
+	}
+	if ack.rebID != reb.rebID() {
+		nlog.Warningln("received", hdr.Cname(), reb.warnID(ack.rebID, ack.daemonID))
+		return nil
+	}
+	ct, extra, md, err := _unpackAux(unpacker)
+	if err != nil {
+		return fmt.Errorf("g[%d]: failed to unpack auxiliary %s: %v", reb.rebID(), hdr.Cname(), err)
+	}
+	lom := core.AllocLOM(hdr.ObjName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitCmnBck(&hdr.Bck); err != nil {
+		nlog.Errorln(err)
+		return nil
+	}
+	if hdr.ObjAttrs.Size == 0 {
+		objReader = nil
+	}
+	buf, slab := core.T.PageMM().AllocSize(hdr.ObjAttrs.Size)
+	lom.Lock(true)
+	err = lom.WriteAux(lom.Mountpath(), ct, extra, md, objReader, buf)
+	lom.Unlock(true)
+	slab.Free(buf)
+	if err != nil {
+		// not acknowledging - the sender will retransmit
+		nlog.Errorln(core.T.String(), "g["+xreb.ID()+"]:", "failed to receive", lom.Cname(), "auxiliary", ct, extra, "from", meta.Tname(ack.daemonID), "err:", err)
+		return nil
+	}
+	return reb.auxACK(smap, hdr, ack.daemonID, ct, extra)
+}
+
+func _unpackAux(unpacker *cos.ByteUnpack) (ct, extra string, md []byte, err error) {
+	if ct, err = unpacker.ReadString(); err != nil {
+		return
+	}
+	if extra, err = unpacker.ReadString(); err != nil {
+		return
+	}
+	md, err = unpacker.ReadBytes()
+	return
+}
+
+func (reb *Reb) auxACK(smap *meta.Smap, hdr *transport.ObjHdr, tsid, ct, extra string) error {
+	tsi := smap.GetTarget(tsid)
+	if tsi == nil {
+		err := fmt.Errorf("g[%d]: %s is not in the %s", reb.rebID(), meta.Tname(tsid), smap)
+		nlog.Errorln(err)
+		return err
+	}
+	if stage := reb.stages.stage.Load(); stage < rebStageFinStreams && stage != rebStageInactive {
+		ack := &regularAck{rebID: reb.rebID(), daemonID: core.T.SID()}
+		hdr.Opaque = ack.NewPackAux(ct, extra, nil)
+		hdr.ObjAttrs.Size = 0
+		if err := reb.dm.ACK(hdr, nil, tsi); err != nil {
+			nlog.Errorln(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (reb *Reb) recvAuxAck(hdr *transport.ObjHdr, unpacker *cos.ByteUnpack) error {
+	var (
+		rebID = reb.rebID()
+		ack   = &regularAck{}
+	)
+	if err := unpacker.ReadAny(ack); err != nil {
+		return fmt.Errorf("g[%d]: failed to unpack auxiliary ACK: %v", rebID, err)
+	}
+	if ack.rebID != rebID {
+		nlog.Warningln("ACK from", ack.daemonID, "[", reb.warnID(ack.rebID, ack.daemonID), "]")
+		return nil
+	}
+	ct, extra, _, err := _unpackAux(unpacker)
+	if err != nil {
+		return fmt.Errorf("g[%d]: failed to unpack auxiliary ACK: %v", rebID, err)
+	}
+	e := reb.auxacks.del(auxKey(&hdr.Bck, hdr.ObjName, ct, extra))
+	if e == nil || cmn.Rom.Features().IsSet(feat.DontDeleteWhenRebalancing) {
+		return nil
+	}
+	if err := cos.RemoveFile(e.fqn); err != nil {
+		nlog.Warningln("failed to remove migrated", e.fqn, "[", err, "]")
+	}
+	return nil
+}
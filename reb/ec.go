@@ -200,7 +200,8 @@ func (*Reb) renameAsWorkFile(ct *core.CT) (string, error) {
 // Find a target that has either an obsolete slice or no slice of the object.
 // Used to resolve the conflict: this target is the "main" one (has a full
 // replica) but it also stores a slice of the object. So, the existing slice
-// goes to any other _free_ target.
+// goes to any other _free_ target - preferably, from a failure domain that
+// does not yet hold any of the object's slices (see meta.Smap.HrwTargetList).
 func (reb *Reb) findEmptyTarget(md *ec.Metadata, ct *core.CT, sender string) (*meta.Snode, error) {
 	var (
 		sliceCnt     = md.Data + md.Parity + 2
@@ -211,6 +212,9 @@ func (reb *Reb) findEmptyTarget(md *ec.Metadata, ct *core.CT, sender string) (*m
 	if err != nil {
 		return nil, err
 	}
+	if smap.HasDomains() {
+		hrwList = freeDomainsFirst(smap, hrwList, md.Daemons, sender)
+	}
 	for _, tsi := range hrwList {
 		if tsi.ID() == sender || tsi.ID() == core.T.SID() {
 			continue
@@ -236,6 +240,31 @@ func (reb *Reb) findEmptyTarget(md *ec.Metadata, ct *core.CT, sender string) (*m
 	return nil, errors.New("no _free_ targets")
 }
 
+// (stable) reorder: targets from the failure domains not used by the current holders go first
+func freeDomainsFirst(smap *meta.Smap, hrwList meta.Nodes, holders cos.MapStrUint16, sender string) meta.Nodes {
+	used := make(cos.StrSet, len(holders))
+	for tid := range holders {
+		if tid == sender {
+			continue // (the sender hands over its full replica to this target)
+		}
+		if tsi := smap.GetTarget(tid); tsi != nil {
+			used.Add(tsi.FailureDomain())
+		}
+	}
+	sorted := make(meta.Nodes, 0, len(hrwList))
+	for _, tsi := range hrwList {
+		if !used.Contains(tsi.FailureDomain()) {
+			sorted = append(sorted, tsi)
+		}
+	}
+	for _, tsi := range hrwList {
+		if used.Contains(tsi.FailureDomain()) {
+			sorted = append(sorted, tsi)
+		}
+	}
+	return sorted
+}
+
 // Check if this target has a metadata for the received CT
 func detectLocalCT(req *stageNtfn, ct *core.CT) (*ec.Metadata, error) {
 	if req.action == ecActMoveCT {
@@ -66,6 +66,7 @@ type (
 		ecClient  *http.Client
 		stages    *nodeStages
 		lomacks   [cos.MultiHashMapCount]*lomAcks
+		auxacks   auxAcks
 		awaiting  struct {
 			targets meta.Nodes // targets for which we are waiting for
 			ts      int64      // last time we have recomputed
@@ -450,6 +451,7 @@ func (reb *Reb) _renew(rargs *rebArgs, xreb *xs.Rebalance, haveStreams bool) err
 	for i := range len(acks) { // init lom acks
 		acks[i] = &lomAcks{mu: &sync.Mutex{}, q: make(map[string]*core.LOM, initCapLomAcks)}
 	}
+	reb.auxacks.init()
 
 	// 4. create persistent mark
 	fatalErr, warnErr := fs.PersistMarker(fname.RebalanceMarker, true /*quiet*/)
@@ -585,6 +587,7 @@ func (reb *Reb) rebWaitAck(rargs *rebArgs) (errCnt int) {
 					return 0
 				}
 			}
+			cnt += reb.auxacks.pending()
 			if cnt == 0 {
 				nlog.Infoln(rargs.logHdr, "received all ACKs")
 				break
@@ -699,7 +702,7 @@ func (reb *Reb) retransmit(rargs *rebArgs) (cnt int) {
 		}
 	}
 
-	return cnt
+	return cnt + reb.retransmitAux(rj)
 }
 
 func (reb *Reb) fini(rargs *rebArgs, err error, tstats cos.StatsUpdater) {
@@ -783,8 +786,6 @@ func (rj *rebJogger) jog(mi *fs.Mountpath) {
 	defer rj.wg.Done()
 	{
 		rj.opts.Mi = mi
-		rj.opts.CTs = []string{fs.ObjCT}
-		rj.opts.Callback = rj.visitObj
 		rj.opts.Sorted = false
 	}
 	// limited scope
@@ -797,9 +798,15 @@ func (rj *rebJogger) jog(mi *fs.Mountpath) {
 	bmd.Range(nil, nil, rj.walkBck)
 }
 
+// objects first, auxiliary content (see aux.go) second
 func (rj *rebJogger) walkBck(bck *meta.Bck) bool {
 	rj.opts.Bck.Copy(bck.Bucket())
+	rj.opts.CTs, rj.opts.Callback = []string{fs.ObjCT}, rj.visitObj
 	err := fs.Walk(&rj.opts)
+	if err == nil && !rj.xreb.IsAborted() {
+		rj.opts.CTs, rj.opts.Callback = core.AuxCTs, rj.visitAux
+		err = fs.Walk(&rj.opts)
+	}
 	if err == nil {
 		return rj.xreb.IsAborted()
 	}
@@ -904,6 +911,11 @@ func (rj *rebJogger) _lwalk(lom *core.LOM, fqn string) error {
 	if roc, err = _getReader(lom); err != nil {
 		return err
 	}
+	// bucket snapshots: the preserved version migrates along (see core.AuxCTs)
+	if err := lom.SnapRelocating(false /*local*/); err != nil {
+		cos.Close(roc)
+		return err
+	}
 
 	// transmit (unlock via transport completion => roc.Close)
 	rj.m.addLomAck(lom)
@@ -14,6 +14,7 @@ const (
 	rebMsgRegular = iota // regular rebalance: acknowledge/Object
 	rebMsgEC             // EC rebalance: acknowledge/CT/Namespace
 	rebMsgNtfn           // stage transition notification (via DM's ack stream) _or_ EC md update (via data stream)
+	rebMsgAux            // auxiliary per-object content (see core.AuxCTs): acknowledge/content type/extra
 )
 
 const rebMsgKindSize = 1
@@ -79,6 +80,18 @@ func (rack *regularAck) NewPack() []byte {
 	return packer.Bytes()
 }
 
+// md: raw on-disk metadata, if any (none in ACKs)
+func (rack *regularAck) NewPackAux(ct, extra string, md []byte) []byte {
+	l := rebMsgKindSize + rack.PackedSize() + cos.PackedStrLen(ct) + cos.PackedStrLen(extra) + cos.PackedBytesLen(md)
+	packer := cos.NewPacker(nil, l)
+	packer.WriteUint8(rebMsgAux)
+	packer.WriteAny(rack)
+	packer.WriteString(ct)
+	packer.WriteString(extra)
+	packer.WriteBytes(md)
+	return packer.Bytes()
+}
+
 // rebID + len(DaemonID) + DaemonID
 func (rack *regularAck) PackedSize() int {
 	return cos.SizeofI64 + cos.SizeofLen + len(rack.daemonID)
@@ -65,6 +65,12 @@ func (reb *Reb) recvObj(hdr *transport.ObjHdr, objReader io.Reader, err error) e
 		}
 		return nil
 	}
+	if act == rebMsgAux {
+		if err := reb.recvAux(hdr, smap, unpacker, objReader, xreb); err != nil {
+			return reb._recvAbrt(err, xreb)
+		}
+		return nil
+	}
 	debug.Assertf(act == rebMsgEC, "act=%d", act)
 	if err := reb.recvECData(hdr, unpacker, objReader, xreb); err != nil {
 		return reb._recvAbrt(err, xreb)
@@ -103,6 +109,8 @@ func (reb *Reb) recvAckNtfn(hdr *transport.ObjHdr, _ io.Reader, err error) error
 		err = reb.recvECAck(hdr, unpacker)
 	case rebMsgRegular:
 		err = reb.recvRegularAck(hdr, unpacker, xreb)
+	case rebMsgAux:
+		err = reb.recvAuxAck(hdr, unpacker)
 	case rebMsgNtfn:
 		var ntfn stageNtfn
 		err = unpacker.ReadAny(&ntfn)
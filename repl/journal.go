@@ -0,0 +1,307 @@
+// Package repl provides continuous (asynchronous) bucket replication to remote AIS clusters and clouds
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package repl
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Journal: per (mountpath, bucket), append-only, and durable
+//
+// <mountpath>/.ais.repl/<BID>.jrnl - sequence of records (below)
+// <mountpath>/.ais.repl/<BID>.off  - offset of the first not-yet-shipped record
+// <mountpath>/.ais.repl/<BID>.dead - dead letters: records that failed permanently (same layout)
+//
+// Record layout: [payload size: uint32][op: byte][unix-nano: int64][object name]
+//
+// Records and offsets are fsync-ed prior to returning. Once all records are shipped
+// the journal gets truncated, and the offset reset. Shipping is at-least-once:
+// a crash between shipping and committing the offset results in re-shipping
+// (idempotent PUT or DELETE) of the same records.
+//
+// A destroyed journal (replication disabled, bucket destroyed) stays destroyed:
+// no further appends or commits, and no files get recreated.
+
+const (
+	OpPut = byte('p')
+	OpDel = byte('d')
+)
+
+const (
+	jrnlExt = ".jrnl"
+	offExt  = ".off"
+	deadExt = ".dead"
+
+	szlen  = 4            // payload size
+	hdrlen = 1 + 8        // op + unix-nano
+	maxrec = 64 * cos.KiB // sanity
+)
+
+type (
+	Rec struct {
+		Name string
+		Time int64 // when journaled (unix nano)
+		Op   byte
+	}
+	journal struct {
+		fh    *os.File // O_APPEND
+		path  string
+		mu    sync.Mutex
+		off   int64 // offset of the first not-yet-shipped record
+		size  int64 // journal size
+		cnt   int64 // number of not-yet-shipped records
+		first int64 // timestamp of the first not-yet-shipped record
+		gone  bool  // destroyed
+	}
+)
+
+var errDestroyed = errors.New("replication journal destroyed")
+
+func jname(dir string, bid uint64) string {
+	return filepath.Join(dir, strconv.FormatUint(bid, 16)+jrnlExt)
+}
+
+func openJournal(dir string, bid uint64) (*journal, error) {
+	if err := cos.CreateDir(dir); err != nil {
+		return nil, err
+	}
+	var (
+		j   = &journal{path: jname(dir, bid)}
+		err error
+	)
+	j.fh, err = os.OpenFile(j.path, os.O_CREATE|os.O_RDWR|os.O_APPEND, cos.PermRWR)
+	if err != nil {
+		return nil, err
+	}
+	finfo, err := j.fh.Stat()
+	if err != nil {
+		j.fh.Close()
+		return nil, err
+	}
+	j.size = finfo.Size()
+	j.off = j.loadOff()
+	if j.off > j.size {
+		j.off = 0 // (unlikely)
+	}
+	if err := j.scan(); err != nil {
+		j.fh.Close()
+		return nil, err
+	}
+	return j, nil
+}
+
+// count not-yet-shipped records; truncate torn tail (if any)
+func (j *journal) scan() error {
+	var (
+		off = j.off
+		hdr [szlen + hdrlen]byte
+	)
+	for off < j.size {
+		if j.size-off < int64(len(hdr)) {
+			break
+		}
+		if _, err := j.fh.ReadAt(hdr[:], off); err != nil {
+			return err
+		}
+		n := int64(binary.BigEndian.Uint32(hdr[:szlen]))
+		if n < hdrlen || n > maxrec || off+szlen+n > j.size {
+			break
+		}
+		if j.cnt == 0 {
+			j.first = int64(binary.BigEndian.Uint64(hdr[szlen+1:]))
+		}
+		j.cnt++
+		off += szlen + n
+	}
+	if off < j.size {
+		if err := j.fh.Truncate(off); err != nil {
+			return err
+		}
+		j.size = off
+	}
+	return nil
+}
+
+func (j *journal) loadOff() int64 {
+	b, err := os.ReadFile(j.offPath())
+	if err != nil || len(b) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func (j *journal) offPath() string  { return j.path[:len(j.path)-len(jrnlExt)] + offExt }
+func (j *journal) deadPath() string { return j.path[:len(j.path)-len(jrnlExt)] + deadExt }
+
+func packRec(op byte, ts int64, name string) ([]byte, error) {
+	l := hdrlen + len(name)
+	if l > maxrec {
+		return nil, fmt.Errorf("object name too long (%d)", len(name))
+	}
+	buf := make([]byte, szlen+l)
+	binary.BigEndian.PutUint32(buf, uint32(l))
+	buf[szlen] = op
+	binary.BigEndian.PutUint64(buf[szlen+1:], uint64(ts))
+	copy(buf[szlen+hdrlen:], name)
+	return buf, nil
+}
+
+func (j *journal) append(op byte, name string) error {
+	now := time.Now().UnixNano()
+	buf, err := packRec(op, now, name)
+	if err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	if j.gone {
+		j.mu.Unlock()
+		return errDestroyed
+	}
+	_, err = j.fh.Write(buf)
+	if err == nil {
+		err = j.fh.Sync()
+	}
+	if err == nil {
+		j.size += int64(len(buf))
+		if j.cnt == 0 {
+			j.first = now
+		}
+		j.cnt++
+	}
+	j.mu.Unlock()
+	return err
+}
+
+// read up to `limit` not-yet-shipped records; return the offset past the last one read
+func (j *journal) read(limit int) (recs []Rec, end int64, err error) {
+	j.mu.Lock()
+	off, size, gone := j.off, j.size, j.gone
+	j.mu.Unlock()
+	if gone {
+		return nil, off, errDestroyed
+	}
+
+	var hdr [szlen + hdrlen]byte
+	for off < size && len(recs) < limit {
+		if _, err = j.fh.ReadAt(hdr[:], off); err != nil {
+			return recs, off, err
+		}
+		n := int64(binary.BigEndian.Uint32(hdr[:szlen]))
+		debug.Assert(n >= hdrlen && n <= maxrec, n)
+		name := make([]byte, n-hdrlen)
+		if _, err = j.fh.ReadAt(name, off+szlen+hdrlen); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return recs, off, err
+		}
+		recs = append(recs, Rec{
+			Op:   hdr[szlen],
+			Time: int64(binary.BigEndian.Uint64(hdr[szlen+1:])),
+			Name: cos.UnsafeS(name),
+		})
+		off += szlen + n
+	}
+	return recs, off, nil
+}
+
+// advance past `n` shipped records; truncate when fully caught up
+func (j *journal) commit(end int64, n int) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.gone {
+		return errDestroyed
+	}
+	debug.Assert(end > j.off && end <= j.size, end, " vs ", j.off, ", ", j.size)
+	j.off = end
+	j.cnt -= int64(n)
+	if j.off == j.size {
+		debug.Assert(j.cnt == 0, j.cnt)
+		j.off, j.size, j.cnt, j.first = 0, 0, 0, 0
+		if err := j.fh.Truncate(0); err != nil {
+			return err
+		}
+	} else {
+		var ts [8]byte
+		if _, err := j.fh.ReadAt(ts[:], j.off+szlen+1); err == nil {
+			j.first = int64(binary.BigEndian.Uint64(ts[:]))
+		}
+	}
+	return j.persistOff()
+}
+
+func (j *journal) persistOff() error {
+	var (
+		b   [8]byte
+		tmp = j.offPath() + ".tmp"
+	)
+	binary.BigEndian.PutUint64(b[:], uint64(j.off))
+	if err := writeSync(tmp, os.O_TRUNC, b[:]); err != nil {
+		return err
+	}
+	return os.Rename(tmp, j.offPath())
+}
+
+// record that failed permanently (and won't be retried)
+func (j *journal) deadLetter(rec *Rec) error {
+	buf, err := packRec(rec.Op, rec.Time, rec.Name)
+	if err != nil {
+		return err
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.gone {
+		return errDestroyed
+	}
+	return writeSync(j.deadPath(), os.O_APPEND, buf)
+}
+
+// (never creates parent directory)
+func writeSync(fpath string, flag int, b []byte) error {
+	fh, err := os.OpenFile(fpath, os.O_CREATE|os.O_WRONLY|flag, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	if _, err = fh.Write(b); err == nil {
+		err = fh.Sync()
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	return err
+}
+
+// backlog: number of records, size (bytes), and the timestamp of the oldest record
+func (j *journal) backlog() (cnt, size, first int64) {
+	j.mu.Lock()
+	cnt, size, first = j.cnt, j.size-j.off, j.first
+	j.mu.Unlock()
+	return cnt, size, first
+}
+
+func (j *journal) close() error { return j.fh.Close() }
+
+func (j *journal) destroy() {
+	j.mu.Lock()
+	j.gone = true
+	j.off, j.size, j.cnt, j.first = 0, 0, 0, 0
+	j.close()
+	os.Remove(j.path)
+	os.Remove(j.offPath())
+	os.Remove(j.deadPath())
+	j.mu.Unlock()
+}
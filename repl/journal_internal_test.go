@@ -0,0 +1,131 @@
+// Package repl provides continuous (asynchronous) bucket replication to remote AIS clusters and clouds
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package repl
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const testBID = 0x1234
+
+func TestJournalAppendReadCommit(t *testing.T) {
+	dir := t.TempDir()
+	j, err := openJournal(dir, testBID)
+	tassert.CheckFatal(t, err)
+
+	for i := range 10 {
+		op := OpPut
+		if i%3 == 0 {
+			op = OpDel
+		}
+		tassert.CheckFatal(t, j.append(op, "obj-"+strconv.Itoa(i)))
+	}
+	cnt, size, first := j.backlog()
+	tassert.Fatalf(t, cnt == 10, "expected 10 records, got %d", cnt)
+	tassert.Fatalf(t, size > 0 && first > 0, "invalid backlog: size %d, first %d", size, first)
+
+	// partial read and commit
+	recs, end, err := j.read(4)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(recs) == 4, "expected 4 records, got %d", len(recs))
+	for i := range recs {
+		tassert.Errorf(t, recs[i].Name == "obj-"+strconv.Itoa(i), "record %d: unexpected name %q", i, recs[i].Name)
+	}
+	tassert.Errorf(t, recs[0].Op == OpDel && recs[1].Op == OpPut, "unexpected ops: %c, %c", recs[0].Op, recs[1].Op)
+	tassert.CheckFatal(t, j.commit(end, len(recs)))
+
+	cnt, _, _ = j.backlog()
+	tassert.Fatalf(t, cnt == 6, "expected 6 remaining records, got %d", cnt)
+
+	// reopen: offset must be persisted
+	tassert.CheckFatal(t, j.close())
+	j, err = openJournal(dir, testBID)
+	tassert.CheckFatal(t, err)
+	cnt, _, _ = j.backlog()
+	tassert.Fatalf(t, cnt == 6, "expected 6 records after reopen, got %d", cnt)
+
+	recs, end, err = j.read(batchSize)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(recs) == 6, "expected 6 records, got %d", len(recs))
+	tassert.Errorf(t, recs[0].Name == "obj-4", "unexpected first name %q", recs[0].Name)
+
+	// fully caught up => truncated
+	tassert.CheckFatal(t, j.commit(end, len(recs)))
+	cnt, size, _ = j.backlog()
+	tassert.Errorf(t, cnt == 0 && size == 0, "expected empty backlog, got (%d, %d)", cnt, size)
+	finfo, err := os.Stat(j.path)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, finfo.Size() == 0, "expected truncated journal, got size %d", finfo.Size())
+
+	j.destroy()
+	_, err = os.Stat(j.path)
+	tassert.Errorf(t, os.IsNotExist(err), "expected journal removed, got %v", err)
+}
+
+func TestJournalTornTail(t *testing.T) {
+	dir := t.TempDir()
+	j, err := openJournal(dir, testBID)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, j.append(OpPut, "a"))
+	tassert.CheckFatal(t, j.append(OpPut, "bb"))
+	tassert.CheckFatal(t, j.close())
+
+	// simulate crash in the middle of writing the last record
+	finfo, err := os.Stat(j.path)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, os.Truncate(j.path, finfo.Size()-1))
+
+	j, err = openJournal(dir, testBID)
+	tassert.CheckFatal(t, err)
+	cnt, _, _ := j.backlog()
+	tassert.Fatalf(t, cnt == 1, "expected 1 record after truncating torn tail, got %d", cnt)
+
+	recs, _, err := j.read(batchSize)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(recs) == 1 && recs[0].Name == "a", "unexpected records %+v", recs)
+
+	// appending after recovery
+	tassert.CheckFatal(t, j.append(OpDel, "c"))
+	recs, _, err = j.read(batchSize)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(recs) == 2 && recs[1].Name == "c" && recs[1].Op == OpDel, "unexpected records %+v", recs)
+	j.destroy()
+}
+
+func TestJournalDeadLetterDestroy(t *testing.T) {
+	dir := t.TempDir()
+	j, err := openJournal(dir, testBID)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, j.append(OpPut, "a"))
+	tassert.CheckFatal(t, j.append(OpPut, "b"))
+
+	recs, end, err := j.read(batchSize)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, j.deadLetter(&recs[1]))
+	tassert.CheckFatal(t, j.commit(end, len(recs)))
+
+	// dead letters share the journal's layout
+	b, err := os.ReadFile(j.deadPath())
+	tassert.CheckFatal(t, err)
+	expected, err := packRec(recs[1].Op, recs[1].Time, recs[1].Name)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(b, expected), "unexpected dead letters %q", b)
+
+	// destroyed stays destroyed: no appends, no commits, no files
+	j.destroy()
+	tassert.Errorf(t, j.append(OpPut, "c") != nil, "append to destroyed journal succeeded")
+	tassert.Errorf(t, j.deadLetter(&recs[0]) != nil, "dead letter to destroyed journal succeeded")
+	_, _, err = j.read(batchSize)
+	tassert.Errorf(t, err != nil, "read from destroyed journal succeeded")
+	for _, fpath := range []string{j.path, j.offPath(), j.deadPath()} {
+		_, err := os.Stat(fpath)
+		tassert.Errorf(t, os.IsNotExist(err), "expected %q removed, got %v", fpath, err)
+	}
+}
@@ -0,0 +1,179 @@
+// Package repl provides continuous (asynchronous) bucket replication to remote AIS clusters and clouds
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package repl
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// all open journals: bucket ID => mountpath => journal
+type jtab struct {
+	m  map[uint64]map[string]*journal
+	mu sync.Mutex
+}
+
+var jt jtab
+
+func Init() {
+	jt.m = make(map[uint64]map[string]*journal, 4)
+	xreg.RegBckXact(&factory{})
+}
+
+// Enqueue journals a change (PUT or DELETE) of the object and wakes up
+// the bucket's replicating xaction - a no-op when replication is disabled,
+// the object name doesn't match the configured prefix, or when it's a delete
+// and delete propagation is off.
+func Enqueue(lom *core.LOM, op byte) {
+	bck := lom.Bck()
+	conf := &bck.Props.Repl
+	if !conf.Enabled || !strings.HasPrefix(lom.ObjName, conf.Prefix) {
+		return
+	}
+	if op == OpDel && !conf.SyncDeletes {
+		return
+	}
+	j, err := jt.get(bck.Props.BID, lom.Mountpath())
+	if err == nil {
+		err = j.append(op, lom.ObjName)
+	}
+	if err != nil {
+		nlog.Errorln("failed to journal", string(op), lom.Cname(), "for replication:", err)
+		return
+	}
+	kick(bck)
+}
+
+// Resume shipping non-empty journals (e.g., upon restart); remove orphaned ones.
+func Resume(bowner meta.Bowner) {
+	var (
+		bmd   = bowner.Get()
+		bids  = make(map[uint64]*meta.Bck, 4)
+		avail = fs.GetAvail()
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if bck.Props.Repl.Enabled {
+			bids[bck.Props.BID] = bck
+		}
+		return false
+	})
+	for _, mi := range avail {
+		dir := filepath.Join(mi.Path, fname.ReplDir)
+		dentries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, dent := range dentries {
+			name := dent.Name()
+			if dent.IsDir() || !strings.HasSuffix(name, jrnlExt) {
+				continue
+			}
+			bid, err := strconv.ParseUint(strings.TrimSuffix(name, jrnlExt), 16, 64)
+			if err != nil {
+				continue
+			}
+			bck, ok := bids[bid]
+			if !ok {
+				// destroyed bucket or replication disabled
+				fqn := filepath.Join(dir, name)
+				os.Remove(fqn)
+				os.Remove(strings.TrimSuffix(fqn, jrnlExt) + offExt)
+				os.Remove(strings.TrimSuffix(fqn, jrnlExt) + deadExt)
+				continue
+			}
+			j, err := jt.get(bid, mi)
+			if err != nil {
+				nlog.Errorln(mi.String(), bck.Cname(""), err)
+				continue
+			}
+			if cnt, _, _ := j.backlog(); cnt > 0 {
+				nlog.Infoln("resuming replication:", bck.Cname(""), mi.String(), "backlog", cnt)
+				kick(bck)
+			}
+		}
+	}
+}
+
+func kick(bck *meta.Bck) {
+	rns := xreg.RenewBucketXact(apc.ActReplicate, bck, xreg.Args{})
+	if rns.Err != nil {
+		nlog.Errorln(bck.Cname(""), rns.Err)
+		return
+	}
+	r := rns.Entry.Get().(*Xact)
+	r.Kick()
+}
+
+//////////
+// jtab //
+//////////
+
+func (t *jtab) get(bid uint64, mi *fs.Mountpath) (j *journal, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	mpjs, ok := t.m[bid]
+	if !ok {
+		mpjs = make(map[string]*journal, 4)
+		t.m[bid] = mpjs
+	}
+	if j, ok = mpjs[mi.Path]; ok {
+		return j, nil
+	}
+	if j, err = openJournal(filepath.Join(mi.Path, fname.ReplDir), bid); err == nil {
+		mpjs[mi.Path] = j
+	}
+	return j, err
+}
+
+// open journals of a given bucket on currently available mountpaths;
+// never opens (and never creates) journals - see Enqueue and Resume
+func (t *jtab) all(bid uint64) (js []*journal) {
+	avail := fs.GetAvail()
+	t.mu.Lock()
+	mpjs := t.m[bid]
+	js = make([]*journal, 0, len(mpjs))
+	for mpath, j := range mpjs {
+		if _, ok := avail[mpath]; ok {
+			js = append(js, j)
+		}
+	}
+	t.mu.Unlock()
+	return js
+}
+
+// remove all journals of a given bucket (e.g., when replication gets disabled)
+func (t *jtab) destroy(bid uint64) {
+	t.mu.Lock()
+	for _, j := range t.m[bid] {
+		j.destroy()
+	}
+	delete(t.m, bid)
+	t.mu.Unlock()
+}
+
+func backlog(js []*journal) (cnt, size int64, lag int64) {
+	now := time.Now().UnixNano()
+	for _, j := range js {
+		c, s, first := j.backlog()
+		cnt += c
+		size += s
+		if c > 0 {
+			lag = max(lag, now-first)
+		}
+	}
+	return cnt, size, lag
+}
@@ -0,0 +1,364 @@
+// Package repl provides continuous (asynchronous) bucket replication to remote AIS clusters and clouds
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+const (
+	batchSize  = 256
+	numRetries = 3
+	retrySleep = time.Second      // initial; doubles with each retry
+	retryIval  = 30 * time.Second // when there's a backlog that couldn't be shipped
+)
+
+type (
+	factory struct {
+		xreg.RenewBase
+		xctn *Xact
+	}
+	Xact struct {
+		xact.DemandBase
+		kick  chan struct{}
+		vlabs map[string]string
+		st    struct {
+			put, del, retry, dead atomic.Int64
+		}
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*Xact)(nil)
+	_ xreg.Renewable = (*factory)(nil)
+)
+
+/////////////
+// factory //
+/////////////
+
+func (*factory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &factory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *factory) Start() error {
+	bck := p.Bck
+	if !bck.Props.Repl.Enabled {
+		return fmt.Errorf("%s: replication disabled, nothing to do", bck.String())
+	}
+	r := &Xact{
+		kick:  make(chan struct{}, 1),
+		vlabs: map[string]string{stats.VlabBucket: bck.Cname("")},
+	}
+
+	// target-local generation of a global UUID (compare w/ mirror)
+	var (
+		uname = bck.MakeUname("")
+		pack  = cos.NewPacker(nil, cos.PackedStrLen(p.Kind())+1+cos.PackedBytesLen(uname))
+	)
+	pack.WriteString(p.Kind())
+	pack.WriteUint8('|')
+	pack.WriteBytes(uname)
+	beid, _, _ := xreg.GenBEID(uint64(xact.IdleDefault), pack.Bytes())
+	if beid == "" {
+		beid = cos.GenUUID()
+	}
+	r.DemandBase.Init(beid, p.Kind(), bck, xact.IdleDefault)
+	p.xctn = r
+
+	go r.Run(nil)
+	return nil
+}
+
+func (*factory) Kind() string     { return apc.ActReplicate }
+func (p *factory) Get() core.Xact { return p.xctn }
+
+func (p *factory) WhenPrevIsRunning(xprev xreg.Renewable) (xreg.WPR, error) {
+	debug.Assertf(false, "%s vs %s", p.Str(p.Kind()), xprev) // xreg.usePrev() must've returned true
+	return xreg.WprUse, nil
+}
+
+//////////
+// Xact //
+//////////
+
+func (r *Xact) CtlMsg() string { return r.Bck().Props.Repl.String() }
+
+// non-blocking; also refreshes idle timer
+func (r *Xact) Kick() {
+	r.IncPending()
+	select {
+	case r.kick <- struct{}{}:
+	default:
+	}
+	r.DecPending()
+}
+
+func (r *Xact) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), r.CtlMsg())
+	ticker := time.NewTicker(retryIval)
+loop:
+	for {
+		select {
+		case <-r.kick:
+			r.ship()
+		case <-ticker.C:
+			if cnt, _, _ := backlog(jt.all(r.Bck().Props.BID)); cnt > 0 {
+				r.ship()
+			}
+		case <-r.IdleTimer():
+			break loop
+		case <-r.ChanAbort():
+			break loop
+		}
+	}
+	ticker.Stop()
+	r.DemandBase.Stop()
+	r.Finish()
+
+	// races with Enqueue => re-kick (a new xaction) if need be
+	if !r.IsAborted() {
+		if bck, ok := r.current(); ok && bck.Props.Repl.Enabled {
+			if cnt, _, _ := backlog(jt.all(bck.Props.BID)); cnt > 0 {
+				go kick(bck)
+			}
+		}
+	}
+}
+
+// current (BMD) version of the bucket, including its replication config
+func (r *Xact) current() (*meta.Bck, bool) {
+	bck := meta.CloneBck(r.Bck().Bucket())
+	props, present := core.T.Bowner().Get().Get(bck)
+	if !present || props.BID != r.Bck().Props.BID {
+		return nil, false
+	}
+	bck.Props = props
+	return bck, true
+}
+
+// drain all journals of the bucket: one goroutine per mountpath
+func (r *Xact) ship() {
+	bck, ok := r.current()
+	if !ok {
+		jt.destroy(r.Bck().Props.BID)
+		r.Abort(cmn.NewErrBckNotFound(r.Bck().Bucket()))
+		return
+	}
+	conf := &bck.Props.Repl
+	if !conf.Enabled {
+		nlog.Infoln(r.Name(), "replication disabled - discarding journals")
+		jt.destroy(bck.Props.BID)
+		return
+	}
+	cbck, err := conf.DstBck()
+	if err != nil {
+		r.AddErr(err)
+		return
+	}
+	dst := meta.CloneBck(&cbck)
+	if err := dst.Init(core.T.Bowner()); err != nil {
+		r.AddErr(err, 0)
+		return
+	}
+
+	r.IncPending()
+	var (
+		js = jt.all(bck.Props.BID)
+		wg = &sync.WaitGroup{}
+	)
+	for _, j := range js {
+		if cnt, _, _ := j.backlog(); cnt == 0 {
+			continue
+		}
+		wg.Add(1)
+		go r.drain(j, bck, dst, wg)
+	}
+	wg.Wait()
+	r.DecPending()
+}
+
+func (r *Xact) drain(j *journal, bck, dst *meta.Bck, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for !r.IsAborted() {
+		recs, end, err := j.read(batchSize)
+		if err != nil {
+			if errors.Is(err, errDestroyed) {
+				return
+			}
+			r.AddErr(err)
+			// (ship what's been read)
+		}
+		if len(recs) == 0 {
+			return
+		}
+		var n int
+		for i := range recs {
+			ecode, err := r.do(&recs[i], bck, dst)
+			if err != nil {
+				r.AddErr(err, 4, cos.ModXs)
+				if retriable(err, ecode) {
+					break
+				}
+				// permanent failure: set aside and move on
+				if err := j.deadLetter(&recs[i]); err != nil {
+					r.AddErr(err)
+					break
+				}
+				r.st.dead.Inc()
+			}
+			n++
+		}
+		if n == 0 {
+			return // keep the backlog and retry later
+		}
+		if n < len(recs) {
+			end = r.offsetOf(j, recs[:n])
+		}
+		if err := j.commit(end, n); err != nil {
+			if !errors.Is(err, errDestroyed) {
+				r.AddErr(err)
+			}
+			return
+		}
+		if n < len(recs) {
+			return
+		}
+	}
+}
+
+// offset past the given (shipped) prefix of the batch
+func (*Xact) offsetOf(j *journal, recs []Rec) int64 {
+	j.mu.Lock()
+	off := j.off
+	j.mu.Unlock()
+	for i := range recs {
+		off += szlen + hdrlen + int64(len(recs[i].Name))
+	}
+	return off
+}
+
+// ship a single record with retries
+func (r *Xact) do(rec *Rec, bck, dst *meta.Bck) (ecode int, err error) {
+	sleep := retrySleep
+	for i := range numRetries {
+		switch rec.Op {
+		case OpPut:
+			ecode, err = r.put(rec.Name, bck, dst)
+		case OpDel:
+			ecode, err = r.del(rec.Name, dst)
+		default:
+			debug.Assert(false, rec.Op)
+			return 0, nil
+		}
+		if err == nil || !retriable(err, ecode) || i == numRetries-1 {
+			break
+		}
+		r.st.retry.Inc()
+		time.Sleep(sleep)
+		sleep *= 2
+	}
+	if err != nil {
+		core.T.StatsUpdater().IncWith(stats.ErrReplCount, r.vlabs)
+		err = fmt.Errorf("%s: failed to replicate %s => %s: %w", r, bck.Cname(rec.Name), dst.Cname(rec.Name), err)
+	}
+	return ecode, err
+}
+
+func retriable(err error, ecode int) bool {
+	return ecode >= http.StatusInternalServerError || ecode == http.StatusTooManyRequests || cos.IsErrRetriableConn(err)
+}
+
+func (r *Xact) put(name string, bck, dst *meta.Bck) (int, error) {
+	lom := core.AllocLOM(name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return 0, err
+	}
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		if cos.IsNotExist(err) {
+			return 0, nil // deleted (or renamed) since - nothing to do
+		}
+		return 0, err
+	}
+	roc, err := lom.NewDeferROC(true) // unlocks on close
+	if err != nil {
+		return 0, err
+	}
+
+	dlom := core.AllocLOM(name)
+	defer core.FreeLOM(dlom)
+	if err := dlom.InitBck(dst); err != nil {
+		roc.Close()
+		return 0, err
+	}
+	dlom.CopyAttrs(lom.ObjAttrs(), false /*skip cksum*/)
+	size := lom.Lsize()
+
+	ecode, err := core.T.Backend(dst).PutObj(context.Background(), roc, dlom, nil) // closes roc
+	if err == nil {
+		r.st.put.Inc()
+		core.T.StatsUpdater().AddWith(
+			cos.NamedVal64{Name: stats.ReplPutCount, Value: 1, VarLabs: r.vlabs},
+			cos.NamedVal64{Name: stats.ReplPutSize, Value: size, VarLabs: r.vlabs},
+		)
+		r.ObjsAdd(1, size)
+		r.OutObjsAdd(1, size)
+	}
+	return ecode, err
+}
+
+func (r *Xact) del(name string, dst *meta.Bck) (int, error) {
+	dlom := core.AllocLOM(name)
+	defer core.FreeLOM(dlom)
+	if err := dlom.InitBck(dst); err != nil {
+		return 0, err
+	}
+	ecode, err := core.T.Backend(dst).DeleteObj(context.Background(), dlom)
+	if err != nil && !cos.IsNotExist(err, ecode) {
+		return ecode, err
+	}
+	r.st.del.Inc()
+	core.T.StatsUpdater().IncWith(stats.ReplDelCount, r.vlabs)
+	r.ObjsAdd(1, 0)
+	return 0, nil
+}
+
+func (r *Xact) Snap() (snap *core.Snap) {
+	snap = r.Base.NewSnap(r)
+	cnt, size, lag := backlog(jt.all(r.Bck().Props.BID))
+	snap.Ext = &apc.ReplStats{
+		Dst:         r.Bck().Props.Repl.Dst,
+		BacklogCnt:  cnt,
+		BacklogSize: size,
+		Lag:         cos.Duration(lag),
+		PutCount:    r.st.put.Load(),
+		DelCount:    r.st.del.Load(),
+		RetryCount:  r.st.retry.Load(),
+		DeadCount:   r.st.dead.Load(),
+	}
+	snap.IdleX = r.IsIdle()
+	return snap
+}
@@ -129,9 +129,9 @@ func (res *Res) Run(args *Args, tstats cos.StatsUpdater) {
 		j         = &jogger{p: res, xres: xres, avail: avail}
 		opts      = &mpather.JgroupOpts{
 			Parent:   xres,
-			CTs:      []string{fs.ObjCT, fs.ECSliceCT},
+			CTs:      append([]string{fs.ObjCT, fs.ECSliceCT}, core.AuxCTs...),
 			VisitObj: j.visitObj,
-			VisitCT:  j.visitECSlice,
+			VisitCT:  j.visitCT,
 			Slab:     slab,
 			RW:       true,
 		}
@@ -400,6 +400,9 @@ ret:
 func (*jogger) fixHrw(lom *core.LOM, mi *fs.Mountpath, buf []byte) (hlom *core.LOM, _ error) {
 	debug.Assertf(lom.IsLocked() == apc.LockWrite, "%s must be w-locked (have %d)", lom.Cname(), lom.IsLocked())
 
+	if err := lom.SnapRelocating(true /*local*/); err != nil {
+		return nil, err
+	}
 	if lom.IsChunked() {
 		u, err := core.NewUfest("", lom, true)
 		if err != nil {
@@ -494,6 +497,17 @@ func (j *jogger) fixCopies(hlom *core.LOM, buf []byte) (abortErr error) {
 	return nil
 }
 
+func (j *jogger) visitCT(ct *core.CT, buf []byte) error {
+	if ct.ContentType() == fs.ECSliceCT {
+		return j.visitECSlice(ct, buf)
+	}
+	if j.xres.IsAborted() {
+		return nil
+	}
+	j.visitAux(ct, buf)
+	return nil
+}
+
 func (j *jogger) visitECSlice(ct *core.CT, buf []byte) (err error) {
 	debug.Assert(ct.ContentType() == fs.ECSliceCT)
 	if !ct.Bck().Props.EC.Enabled {
@@ -540,3 +554,72 @@ func (j *jogger) _mvSlice(ct *core.CT, buf []byte) {
 		nlog.Warningln("failed to cleanup slice", ct.FQN(), "[", errSlice, "]")
 	}
 }
+
+// auxiliary per-object content (see core.AuxCTs):
+//   - main files (that carry the object's metadata) follow the object's HRW mountpath
+//   - manifests and chunk links of preserved chunked objects (see core/laux.go) are
+//     found by searching all mountpaths and move only when their own mountpath is going away
+func (j *jogger) visitAux(ct *core.CT, buf []byte) {
+	ci := fs.CSM.ParseUbase(ct.ObjectName(), ct.ContentType())
+	if !ci.Ok {
+		return
+	}
+	var (
+		extra = ci.Extras[0]
+		link  bool
+	)
+	if core.IsPsvCT(ct.ContentType()) {
+		_, sfx := core.ParsePsv(extra)
+		link = sfx != ""
+	}
+	if link && !ct.Mountpath().IsAnySet(fs.FlagWaitingDD) {
+		return
+	}
+
+	lom := core.AllocLOM(ci.Base)
+	defer func() { core.FreeLOM(lom) }()
+	if err := lom.InitBck(ct.Bck()); err != nil {
+		return
+	}
+	var md []byte
+	if !link {
+		md = core.AuxMeta(ct.FQN())
+		if len(md) > 0 {
+			// the owner - by the original name (cmn.OrigFntl), if any
+			plom := lom.CloneTo(ct.FQN())
+			if plom.LoadMetaFromFS() == nil && plom.IsFntl() {
+				if orig := plom.OrigFntl(); orig != nil {
+					olom := core.AllocLOM(orig[1])
+					if olom.InitBck(ct.Bck()) == nil {
+						lom, olom = olom, lom
+					}
+					core.FreeLOM(olom)
+				}
+			}
+			core.FreeLOM(plom)
+		}
+	}
+	mi := lom.Mountpath()
+	if mi.Path == ct.Mountpath().Path {
+		return
+	}
+
+	fh, err := os.Open(ct.FQN())
+	if err != nil {
+		return
+	}
+	if cmn.Rom.V(4, cos.ModReb) {
+		nlog.Infof("%s: moving %q -> %s", core.T, ct.FQN(), mi)
+	}
+	lom.Lock(true)
+	err = lom.WriteAux(mi, ct.ContentType(), extra, md, fh, buf)
+	lom.Unlock(true)
+	cos.Close(fh)
+	if err != nil {
+		j.xres.AddErr(fmt.Errorf("failed to move %q to %s: %v", ct.FQN(), mi, err), 0)
+		return
+	}
+	if err := cos.RemoveFile(ct.FQN()); err != nil {
+		nlog.Warningln("failed to cleanup", ct.FQN(), "[", err, "]")
+	}
+}
@@ -392,7 +392,7 @@ func (j *clnJ) _jogBck() {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkCT, fs.ObjCT, fs.ECSliceCT, fs.ECMetaCT, fs.ChunkCT, fs.ChunkMetaCT},
+		CTs:      []string{fs.WorkCT, fs.ObjCT, fs.ECSliceCT, fs.ECMetaCT, fs.ChunkCT, fs.ChunkMetaCT, fs.TrashCT},
 		Callback: j.visit,
 		Sorted:   false,
 	}
@@ -554,6 +554,24 @@ func (j *clnJ) visitCT(parsed *fs.ParsedFQN, fqn string) {
 			j.rmAnyBatch(flagRmOldWork)
 		}
 
+	// deleted objects (see core/ltrash.go): purge upon expiration
+	// (along with their manifests and chunk links that expire at the same time)
+	case fs.TrashCT:
+		contentInfo := fs.CSM.ParseUbase(parsed.ObjName, fs.TrashCT)
+		if !contentInfo.Ok {
+			j.rmInvalidFQN(fqn, "trash", nil)
+			return
+		}
+		deleted, _, err := core.ParseTrashed(contentInfo.Extras[0])
+		if err != nil {
+			j.rmInvalidFQN(fqn, "trash", err)
+			return
+		}
+		if time.Unix(0, deleted).Add(j.bck.Props.Trash.RetentionD()).Before(j.now) {
+			j.oldWork = append(j.oldWork, fqn)
+			j.rmAnyBatch(flagRmOldWork)
+		}
+
 	default:
 		debug.Assert(false, "Unsupported content type: ", parsed.ContentType)
 	}
@@ -763,6 +781,14 @@ func (j *clnJ) rmDeleted() {
 	if err != nil {
 		xcln.AddErr(err)
 	}
+	// destroyed buckets past their trash retention
+	n, err := j.mi.RemoveExpiredTrash(j.now)
+	if err != nil {
+		xcln.AddErr(err)
+	}
+	if n > 0 {
+		nlog.Infoln(j.String(), "purged", n, "expired trashed bucket"+cos.Plural(n))
+	}
 	if cnt := j.p.jcnt.Dec(); cnt > 0 {
 		return
 	}
@@ -902,6 +928,10 @@ func (j *clnJ) rmLeftovers(specifier int) {
 					err = os.Remove(fqn)
 					removed = err == nil
 				case lom.FromFS() != nil:
+					if lom.Bprops().Tier.Enabled {
+						// the only copy (e.g., placed as per previous storage classes) - leave it to resilver
+						break
+					}
 					err = os.Remove(fqn)
 					removed = err == nil
 				default:
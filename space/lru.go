@@ -58,6 +58,7 @@ type (
 		GetFSUsedPercentage func(path string) (usedPercentage int64, err error)
 		GetFSStats          func(path string) (blocks, bavail uint64, bsize int64, err error)
 		WG                  *sync.WaitGroup
+		DryRun              *apc.DryRunRes // when non-nil: select (and count) but don't evict (see DryRunLRU)
 		Buckets             []cmn.Bck
 		Force               bool
 	}
@@ -78,6 +79,7 @@ type (
 		joggers map[string]*lruJ
 		ini     IniLRU
 		wg      sync.WaitGroup
+		mu      sync.Mutex // (dry-run)
 	}
 
 	// lruJ represents a single LRU context and a single /jogger/
@@ -100,6 +102,7 @@ type (
 		newest      int64
 		now         int64
 		totalSize   int64 // difference between lowWM size and used size
+		dryEvicted  int64 // dry-run: total size that would be evicted (and is, therefore, excluded from used)
 		allowDelObj bool
 	}
 	lruFactory struct {
@@ -195,6 +198,23 @@ func RunLRU(ini *IniLRU) {
 	nlog.Infof("%s finished, %s", xlru, cs.String())
 }
 
+// run LRU selection logic on all mountpaths; return objects that would be evicted
+// (the xaction is created here and is never registered)
+func DryRunLRU(ini *IniLRU) *apc.DryRunRes {
+	var (
+		id = cos.GenUUID()
+		p  = &lruFactory{RenewBase: xreg.RenewBase{Args: xreg.Args{UUID: id, Custom: "dry-run"}}}
+	)
+	p.xctn = &XactLRU{p: p}
+	p.xctn.InitBase(id, apc.ActLRU, nil)
+	p.xctn.SetPrio(apc.PrioHigh) // (never throttled)
+
+	ini.Xaction = p.xctn
+	ini.DryRun = &apc.DryRunRes{}
+	RunLRU(ini)
+	return ini.DryRun
+}
+
 func (*XactLRU) Run(*sync.WaitGroup) { debug.Assert(false) } // via RunLRU
 
 func (r *XactLRU) CtlMsg() string {
@@ -387,6 +407,9 @@ func (j *lruJ) walk(fqn string, de fs.DirEntry) error {
 	if j.done() {
 		return nil
 	}
+	if j.ini.Xaction.WaitIfPaused() { // (priority-based admission and throttling)
+		return nil
+	}
 	j.nvisits++
 	if _, err := core.ResolveFQN(fqn, &parsed); err != nil {
 		xlru := j.ini.Xaction
@@ -423,8 +446,10 @@ func (j *lruJ) evict(batch int64) {
 		}
 	}
 	if fevicted > 0 {
-		j.ini.StatsT.Add(stats.LruEvictSize, bevicted)
-		j.ini.StatsT.Add(stats.LruEvictCount, fevicted)
+		if j.ini.DryRun == nil {
+			j.ini.StatsT.Add(stats.LruEvictSize, bevicted)
+			j.ini.StatsT.Add(stats.LruEvictCount, fevicted)
+		}
 		xlru.ObjsAdd(int(fevicted), bevicted)
 
 		// plus, once per batch
@@ -463,6 +488,14 @@ func (j *lruJ) capCheckAndThrottle(size int64) {
 
 // remove local copies that "belong" to different LRU joggers (space accounting may be temporarily not precise)
 func (j *lruJ) evictObj(lom *core.LOM) bool {
+	if j.ini.DryRun != nil {
+		size := lom.Lsize()
+		j.p.mu.Lock()
+		j.ini.DryRun.Add(lom.ObjName, size)
+		j.p.mu.Unlock()
+		j.dryEvicted += size
+		return true
+	}
 	lom.Lock(true)
 	err := lom.RemoveObj()
 	lom.Unlock(true)
@@ -493,7 +526,7 @@ func (j *lruJ) evictSize() error {
 		return nil
 	}
 	lwmBlocks := blocks * uint64(lwm) / 100
-	j.totalSize = int64(used-lwmBlocks) * bsize
+	j.totalSize = int64(used-lwmBlocks)*bsize - j.dryEvicted
 	return nil
 }
 
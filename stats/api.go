@@ -49,6 +49,7 @@ const (
 	VlabBucket    = "bucket"
 	VlabXkind     = "xkind"
 	VlabMountpath = "mountpath"
+	VlabTenant    = "tenant"
 )
 
 type (
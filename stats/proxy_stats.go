@@ -36,6 +36,12 @@ const (
 	AuthJWKSHist = "auth.jwks"
 )
 
+// proxy-side cache (see bucket property `proxy_cache`)
+const (
+	PxcHitCount  = "pxc.hit.n"
+	PxcMissCount = "pxc.miss.n"
+)
+
 type Prunner struct {
 	runner
 }
@@ -60,6 +66,7 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 
 	r.regCommon(p.Snode()) // common metrics
 	r.regAuth(p.Snode())
+	r.regPxc(p.Snode())
 
 	r.core.statsTime = cmn.GCO.Get().Periodic.StatsTime.D()
 	r.ctracker = make(copyTracker, numProxyStats)
@@ -73,6 +80,21 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 	return &r.runner.startedUp
 }
 
+func (r *Prunner) regPxc(snode *meta.Snode) {
+	r.reg(snode, PxcHitCount, KindCounter,
+		&Extra{
+			Help:    "number of GET, HEAD, and list-objects requests served from the proxy-side cache",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, PxcMissCount, KindCounter,
+		&Extra{
+			Help:    "number of GET, HEAD, and list-objects requests to proxy-cached buckets that missed the cache",
+			VarLabs: BckVlabs,
+		},
+	)
+}
+
 func (r *Prunner) regAuth(snode *meta.Snode) {
 	r.reg(snode, AuthTotalCount, KindCounter,
 		&Extra{
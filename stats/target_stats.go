@@ -103,6 +103,12 @@ const (
 	CleanupStoreCount = "cleanup.store.n"
 	CleanupStoreSize  = "cleanup.store.size"
 
+	// continuous bucket replication (repl)
+	ReplPutCount = "repl.put.n"
+	ReplPutSize  = "repl.put.size"
+	ReplDelCount = "repl.del.n"
+	ErrReplCount = errPrefix + "repl.n"
+
 	// ETL (ext/etl)
 	ETLInlineCount         = "etl.inline.n"
 	ETLInlineLatencyTotal  = "etl.inline.ns.total"
@@ -133,6 +139,9 @@ const (
 	ErrGetBatchCount     = errPrefix + "getbatch.n"
 	GetBatchSoftErrCount = errPrefix + "soft.getbatch.n"
 	GetBatchErrCountGFN  = errPrefix + "gfn.getbatch.n"
+
+	// tenants (KindGauge)
+	TenantUsed = "tenant.used"
 )
 
 // 4, streams (peer-to-peer long-lived connections)
@@ -320,6 +329,38 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 		},
 	)
 
+	// replication
+	r.reg(snode, TenantUsed, KindGauge,
+		&Extra{
+			Help:    "tenants: capacity (bytes) used by the tenant's buckets on this target (see config 'tenant')",
+			VarLabs: []string{VlabTenant},
+		},
+	)
+	r.reg(snode, ReplPutCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of objects shipped to the destination bucket",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ReplPutSize, KindSize,
+		&Extra{
+			Help:    "replication: total cumulative size (bytes) of objects shipped to the destination bucket",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ReplDelCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of deletes propagated to the destination bucket",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ErrReplCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of objects (or deletes) that failed to replicate after all retries",
+			VarLabs: BckVlabs,
+		},
+	)
+
 	// out-of-band (x 3)
 	r.reg(snode, VerChangeCount, KindCounter,
 		&Extra{
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"os"
 	"path"
@@ -81,6 +82,7 @@ type (
 		postCh   chan struct{} // to indicate that workCh has work
 		trname   string        // http endpoint: (trname, dstURL, dstID)
 		dstURL   string
+		ireq     core.IntraReq // to sign (see _do)
 		dstID    string
 		loghdr   string // log prefix
 		maxhdr   []byte // transport header buf must be large enough to accommodate max-size for this stream
@@ -122,6 +124,13 @@ func (s *Stream) initBase(client Client, dstURL, dstID string, extra *Extra) {
 	s.base.dstID = dstID
 
 	s.sessID = nextSessionID.Inc()
+	s.base.ireq = core.IntraReq{
+		Method:   http.MethodPut,
+		Path:     u.Path,
+		RawQuery: u.RawQuery,
+		SessID:   strconv.FormatInt(s.sessID, 10),
+		Streamed: true,
+	}
 	s.trname = path.Base(u.Path)
 
 	s.lastCh.Init()
@@ -10,7 +10,6 @@ import (
 	"io"
 	"net"
 	"net/http"
-	"strconv"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -88,8 +87,11 @@ func (s *base) _do(body io.Reader, req *fasthttp.Request, resp *fasthttp.Respons
 	req.Header.SetMethod(http.MethodPut)
 	req.SetRequestURI(s.dstURL)
 	req.SetBodyStream(body, -1)
-	req.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	req.Header.Set(apc.HdrSessID, s.ireq.SessID)
 	req.Header.Set(apc.HdrSenderID, core.T.SID())
+	if sig := core.T.IntraSig(&s.ireq); sig != "" {
+		req.Header.Set(apc.HdrSenderSig, sig)
+	}
 	req.Header.Set(cos.HdrUserAgent, ua)
 
 	// do
@@ -10,7 +10,6 @@ import (
 	"context"
 	"io"
 	"net/http"
-	"strconv"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -66,8 +65,11 @@ func (s *base) doCmpr(body io.Reader) error {
 }
 
 func (s *base) _do(req *http.Request) error {
-	req.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	req.Header.Set(apc.HdrSessID, s.ireq.SessID)
 	req.Header.Set(apc.HdrSenderID, core.T.SID())
+	if sig := core.T.IntraSig(&s.ireq); sig != "" {
+		req.Header.Set(apc.HdrSenderSig, sig)
+	}
 	req.Header.Set(cos.HdrUserAgent, ua)
 
 	resp, err := s.client.Do(req)
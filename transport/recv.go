@@ -65,7 +65,14 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 		lz4Reader *lz4.Reader
 		trname    = path.Base(r.URL.Path)
 		mm        = memsys.PageMM()
+		sid       = r.Header.Get(apc.HdrSenderID)
 	)
+	// handshake: streams are intra-cluster only (signature, if any, is validated
+	// at the server's entry - see apc.HdrSenderSig)
+	if sid == "" {
+		cmn.WriteErr(w, r, errors.New(trname+": missing sender ID"), http.StatusUnauthorized)
+		return
+	}
 	// Rx handler
 	h, err := oget(trname)
 	if err != nil {
@@ -98,7 +105,7 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 		it     = &iterator{
 			handler: h,
 			body:    reader,
-			sid:     r.Header.Get(apc.HdrSenderID),
+			sid:     sid,
 		}
 	)
 	debug.Assert(config.Transport.IdleTeardown > 0, "invalid config ", config.Transport)
@@ -0,0 +1,94 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/cron"
+)
+
+// scheduled (recurring) jobs (see ais/prxcron.go)
+
+// bucket-scoped actions that can be scheduled in addition to `apc.ActXactStart`
+var CronBckActions = []string{
+	apc.ActCopyBck,
+	apc.ActETLBck,
+	apc.ActPrefetchObjects,
+	apc.ActEvictObjects,
+	apc.ActDeleteObjects,
+	apc.ActMakeNCopies,
+	apc.ActECEncode,
+}
+
+type (
+	CronJob struct {
+		Added time.Time  `json:"added"`
+		Name  string     `json:"name"`
+		Cron  string     `json:"cron"` // e.g. "0 2 * * *" or "@daily" (see cmn/cron)
+		User  string     `json:"user,omitempty"`
+		Bck   cmn.Bck    `json:"bck"`              // required for bucket-scoped actions
+		BckTo cmn.Bck    `json:"bck-to,omitempty"` // destination bucket (copy-bucket and etl-bucket)
+		Msg   apc.ActMsg `json:"msg"`              // either apc.ActXactStart (with ArgsMsg value) or one of the CronBckActions
+	}
+
+	// as reported by the primary
+	CronJobStatus struct {
+		CronJob
+		NextRun time.Time `json:"next-run"`
+		LastRun time.Time `json:"last-run"`
+		LastID  string    `json:"last-id,omitempty"`  // xaction ID of the last run
+		LastErr string    `json:"last-err,omitempty"` // failed to start
+		Skipped int64     `json:"skipped,omitempty"`  // number of skipped (overlapping) runs
+	}
+)
+
+// validate and return the kind (and bucket) of the xaction to run
+func (j *CronJob) Validate() (kind string, bck cmn.Bck, err error) {
+	if err = cos.CheckAlphaPlus(j.Name, "job schedule name"); err != nil {
+		return
+	}
+	if _, err = cron.Parse(j.Cron); err != nil {
+		return
+	}
+	if j.Msg.Action == apc.ActXactStart {
+		var (
+			args ArgsMsg
+			dtor Descriptor
+		)
+		if j.Msg.Value == nil {
+			return "", bck, errors.New("missing xaction kind")
+		}
+		if err = cos.MorphMarshal(j.Msg.Value, &args); err != nil {
+			return
+		}
+		if kind, dtor, err = GetDescriptor(args.Kind); err != nil {
+			return
+		}
+		if !dtor.Startable || kind == apc.ActResilver || kind == apc.ActBlobDl {
+			return "", bck, fmt.Errorf("xaction %q cannot be scheduled", args.Kind)
+		}
+		return kind, args.Bck, nil
+	}
+	if !slices.Contains(CronBckActions, j.Msg.Action) {
+		return "", bck, fmt.Errorf("action %q cannot be scheduled (expecting %q or one of %v)",
+			j.Msg.Action, apc.ActXactStart, CronBckActions)
+	}
+	if err = j.Bck.Validate(); err != nil {
+		return
+	}
+	if j.Msg.Action == apc.ActCopyBck || j.Msg.Action == apc.ActETLBck {
+		if err = j.BckTo.Validate(); err != nil {
+			return "", bck, fmt.Errorf("%s: invalid destination bucket: %v", j.Msg.Action, err)
+		}
+	}
+	return j.Msg.Action, j.Bck, nil
+}
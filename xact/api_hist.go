@@ -0,0 +1,68 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// job history (see ais/prxhist.go)
+
+const DfltHistLimit = 100 // max number of returned history entries when not specified
+
+type (
+	// finished job as recorded by the IC proxies
+	HistEntry struct {
+		StartTime time.Time  `json:"start-time"`
+		EndTime   time.Time  `json:"end-time"`
+		ID        string     `json:"id"`
+		Kind      string     `json:"kind"`
+		CtlMsg    string     `json:"ctlmsg,omitempty"`
+		User      string     `json:"user,omitempty"` // initiating (authenticated) user, if any
+		Err       string     `json:"err,omitempty"`
+		Buckets   []cmn.Bck  `json:"buckets,omitempty"`
+		Stats     core.Stats `json:"stats"` // summed up across all targets
+		Nodes     int        `json:"nodes"` // number of (reporting) targets
+		Aborted   bool       `json:"aborted"`
+	}
+
+	// job history query; empty fields match all
+	HistQueryMsg struct {
+		Since   time.Time `json:"since"` // finished at or after
+		Bck     cmn.Bck   `json:"bck"`   // any of the job's buckets
+		ID      string    `json:"id,omitempty"`
+		Kind    string    `json:"kind,omitempty"`
+		User    string    `json:"user,omitempty"`
+		Limit   int       `json:"limit,omitempty"`    // max number of (most recent) entries; zero means DfltHistLimit
+		OnlyErr bool      `json:"only_err,omitempty"` // failed or aborted jobs only
+	}
+)
+
+func (e *HistEntry) Match(q *HistQueryMsg) bool {
+	switch {
+	case q.ID != "" && q.ID != e.ID:
+		return false
+	case q.Kind != "" && q.Kind != e.Kind:
+		return false
+	case q.User != "" && q.User != e.User:
+		return false
+	case !q.Since.IsZero() && e.EndTime.Before(q.Since):
+		return false
+	case q.OnlyErr && e.Err == "" && !e.Aborted:
+		return false
+	}
+	if q.Bck.IsEmpty() {
+		return true
+	}
+	for i := range e.Buckets {
+		if q.Bck.Equal(&e.Buckets[i]) {
+			return true
+		}
+	}
+	return false
+}
@@ -44,6 +44,15 @@ type (
 		// (see related: `Snap.Ext` in core/xaction.go)
 		ExtendedStats bool
 
+		// can be paused and resumed (api.PauseXaction, api.ResumeXaction);
+		// in-memory state (e.g., joggers' walk positions) is the checkpoint
+		// (see related: xact/pause.go)
+		Pausable bool
+
+		// batch job subject to target-side admission and throttling by priority class
+		// (see related: xact/sched.go)
+		Prio bool
+
 		// suppress verbose per-state log records and keep only hk.OldAgeXshort (1m)
 		// in registry history
 		QuietBrief bool
@@ -67,7 +76,7 @@ var Table = map[string]Descriptor{
 	apc.ActETLInline: {Scope: ScopeG, Startable: false, AbortRebRes: true},
 
 	// (one bucket) | (all buckets)
-	apc.ActLRU:          {DisplayName: "lru-eviction", Scope: ScopeGB, Startable: true},
+	apc.ActLRU:          {DisplayName: "lru-eviction", Scope: ScopeGB, Startable: true, Prio: true},
 	apc.ActStoreCleanup: {DisplayName: "cleanup", Scope: ScopeGB, Startable: true},
 	apc.ActSummaryBck: {
 		DisplayName: "summary",
@@ -78,8 +87,11 @@ var Table = map[string]Descriptor{
 	},
 
 	// single target (node)
-	apc.ActResilver: {Scope: ScopeT, Startable: true, Resilver: true},
-	apc.ActRechunk:  {Scope: ScopeB, Startable: true, RefreshCap: true, ConflictRebRes: true},
+	apc.ActResilver:  {Scope: ScopeT, Startable: true, Resilver: true},
+	apc.ActRechunk:   {Scope: ScopeB, Startable: true, RefreshCap: true, ConflictRebRes: true, Pausable: true, Prio: true},
+	apc.ActScrub:     {Scope: ScopeB, Startable: true, RefreshCap: true, ConflictRebRes: true, ExtendedStats: true, Pausable: true, Prio: true},
+	apc.ActInventory: {Scope: ScopeB, Access: apc.AceObjLIST, ConflictRebRes: true, AbortRebRes: true, Pausable: true, Prio: true},
+	apc.ActTier:      {Scope: ScopeB, Startable: true, RefreshCap: true, ConflictRebRes: true, ExtendedStats: true, Pausable: true, Prio: true},
 
 	// on-demand EC and n-way replication
 	// (non-startable, triggered by PUT => erasure-coded or mirrored bucket)
@@ -88,10 +100,22 @@ var Table = map[string]Descriptor{
 	apc.ActECRespond: {Scope: ScopeB, Startable: false, Idles: true},
 	apc.ActPutCopies: {Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true},
 
+	// continuous replication to remote AIS or cloud
+	// (non-startable, triggered by PUT, DELETE, and rename => bucket with "replication" enabled)
+	apc.ActReplicate: {Scope: ScopeB, Startable: false, Idles: true, ExtendedStats: true},
+	apc.ActCreateSnap: {
+		DisplayName:   "snapshot",
+		Scope:         ScopeB,
+		Access:        apc.AccessRW,
+		Startable:     false, // via api.CreateSnapshot
+		Metasync:      true,
+		ExtendedStats: true,
+	},
+
 	//
 	// on-demand multi-object (consider setting ConflictRebRes = true)
 	//
-	apc.ActArchive: {Scope: ScopeB, Access: apc.AccessRW, Startable: false, RefreshCap: true, Idles: true},
+	apc.ActArchive: {Scope: ScopeB, Access: apc.AccessRW, Startable: false, RefreshCap: true, Idles: true, Pausable: true, Prio: true},
 	apc.ActCopyObjects: {
 		DisplayName: "copy-objects",
 		Scope:       ScopeB,
@@ -99,6 +123,8 @@ var Table = map[string]Descriptor{
 		Startable:   false,
 		RefreshCap:  true,
 		Idles:       true,
+		Pausable:    true,
+		Prio:        true,
 	},
 	apc.ActETLObjects: {
 		DisplayName: "etl-objects",
@@ -108,6 +134,8 @@ var Table = map[string]Descriptor{
 		RefreshCap:  true,
 		Idles:       true,
 		AbortRebRes: true,
+		Pausable:    true,
+		Prio:        true,
 	},
 
 	apc.ActBlobDl: {Access: apc.AccessRW, Scope: ScopeB, Startable: true, AbortRebRes: true, RefreshCap: true},
@@ -124,6 +152,7 @@ var Table = map[string]Descriptor{
 		ConflictRebRes: true,
 		ExtendedStats:  true,
 		AbortRebRes:    true,
+		Prio:           true,
 	},
 
 	// multi-object
@@ -140,6 +169,8 @@ var Table = map[string]Descriptor{
 		Access:      apc.AceObjDELETE,
 		Startable:   false,
 		RefreshCap:  true,
+		Pausable:    true,
+		Prio:        true,
 	},
 	apc.ActEvictRemoteBck: {
 		DisplayName: "evict-remote-bucket",
@@ -154,6 +185,8 @@ var Table = map[string]Descriptor{
 		Access:      apc.AceObjDELETE,
 		Startable:   false,
 		RefreshCap:  true,
+		Pausable:    true,
+		Prio:        true,
 	},
 	apc.ActPrefetchObjects: {
 		DisplayName: "prefetch-objects",
@@ -161,6 +194,8 @@ var Table = map[string]Descriptor{
 		Access:      apc.AccessRW,
 		Startable:   true,
 		RefreshCap:  true,
+		Pausable:    true,
+		Prio:        true,
 	},
 
 	// entire bucket (storage svcs)
@@ -172,6 +207,8 @@ var Table = map[string]Descriptor{
 		Metasync:       true,
 		RefreshCap:     true,
 		ConflictRebRes: true,
+		Pausable:       true,
+		Prio:           true,
 	},
 	apc.ActMakeNCopies: {
 		DisplayName: "mirror",
@@ -180,6 +217,8 @@ var Table = map[string]Descriptor{
 		Startable:   true,
 		Metasync:    true,
 		RefreshCap:  true,
+		Pausable:    true,
+		Prio:        true,
 	},
 	apc.ActMoveBck: {
 		DisplayName:    "rename-bucket",
@@ -198,6 +237,8 @@ var Table = map[string]Descriptor{
 		Metasync:       true,
 		RefreshCap:     true,
 		ConflictRebRes: true,
+		Pausable:       true,
+		Prio:           true,
 	},
 	apc.ActETLBck: {
 		DisplayName: "etl-bucket",
@@ -207,6 +248,8 @@ var Table = map[string]Descriptor{
 		Metasync:    true,
 		RefreshCap:  true,
 		AbortRebRes: true,
+		Pausable:    true,
+		Prio:        true,
 	},
 
 	apc.ActList: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false, Metasync: false, Idles: true, QuietBrief: true},
@@ -214,7 +257,7 @@ var Table = map[string]Descriptor{
 	apc.ActGetBatch: {Scope: ScopeGB, Startable: false, Metasync: false, ConflictRebRes: true, Idles: true}, // apc.Moss
 
 	// cache management, internal usage
-	apc.ActLoadLomCache: {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true},
+	apc.ActLoadLomCache: {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true, Pausable: true, Prio: true},
 }
 
 func GetDescriptor(kindOrName string) (string, Descriptor, error) {
@@ -52,6 +52,7 @@ type (
 		Buckets     []cmn.Bck     // list of buckets (e.g., copy-bucket, lru-evict, etc.)
 		Timeout     time.Duration // max time to wait
 		Flags       uint32        `json:"flags,omitempty"` // enum (FlagZeroSize, ...) bitwise
+		Prio        string        `json:"prio,omitempty"`  // priority class of the job to start (apc.PrioLow, ...)
 		Force       bool          // force
 		OnlyRunning bool          // only for running xactions
 	}
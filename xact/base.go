@@ -39,6 +39,8 @@ type (
 			done   atomic.Bool
 			closed atomic.Bool
 		}
+		pz   pause
+		sch  sched
 		id   string
 		kind string
 		_nam string
@@ -175,6 +177,7 @@ func (xctn *Base) Abort(err error) bool {
 		xctn.abort.ch <- err
 		close(xctn.abort.ch)
 	}
+	xctn.pz.resume() // wake up paused workers (if any)
 
 	if !Table[xctn.kind].QuietBrief {
 		nlog.InfoDepth(1, xctn.Name(), err)
@@ -205,6 +208,8 @@ func (xctn *Base) Finish() {
 	if xctn.abort.closed.CAS(false, true) {
 		close(xctn.abort.ch)
 	}
+	xctn.pz.resume()
+	xctn.unadmit()
 
 	if err == nil {
 		debug.Assert(!aborted) // expecting xctn.abort.err
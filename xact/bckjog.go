@@ -6,23 +6,61 @@ package xact
 
 import (
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs/mpather"
 )
 
 type BckJog struct {
-	Config  *cmn.Config
-	joggers *mpather.Jgroup
+	Config   *cmn.Config
+	joggers  *mpather.Jgroup
+	CkptArgs any // (mpather.JgroupOpts.Ckpt) persisted with the checkpoint to restart this xaction
 	Base
+	ckpt bool
 }
 
 func (r *BckJog) Init(id, kind string, bck *meta.Bck, opts *mpather.JgroupOpts, config *cmn.Config) {
 	r.InitBase(id, kind, bck)
 	r.joggers = mpather.NewJgroup(opts, config, nil)
 	r.Config = config
+	r.ckpt = opts.Ckpt
 }
 
-func (r *BckJog) Run() { r.joggers.Run() }
+// when restarted from a pause checkpoint, stay paused (until resumed)
+func (r *BckJog) Run() {
+	if r.ckpt && r.joggers.LoadCkpt(r.ID()) {
+		nlog.Infoln(r.Name(), "restored from checkpoint")
+		if err := r.Pause(); err != nil {
+			nlog.Warningln(err)
+		}
+	}
+	r.joggers.Run()
+}
+
+// Pause and Resume override (and call) the respective Base methods to also
+// persist (and remove) the pause checkpoint - see fs/mpather/ckpt.go
+
+func (r *BckJog) Pause() error {
+	if err := r.Base.Pause(); err != nil || !r.ckpt {
+		return err
+	}
+	ckpt := &mpather.Ckpt{
+		Args:   r.CkptArgs,
+		ID:     r.ID(),
+		Kind:   r.Kind(),
+		Bck:    *r.Bck().Bucket(),
+		Paused: r.pz.at.Load(),
+	}
+	return r.joggers.SaveCkpt(ckpt)
+}
+
+func (r *BckJog) Resume() error {
+	if err := r.Base.Resume(); err != nil || !r.ckpt {
+		return err
+	}
+	r.joggers.RemoveCkpt(r.ID())
+	return nil
+}
 
 func (r *BckJog) NumJoggers() int {
 	if r.joggers == nil {
@@ -42,11 +80,18 @@ func (r *BckJog) Wait() error {
 	select {
 	case errCause := <-r.ChanAbort():
 		r.joggers.Stop()
+		// keep the checkpoint (if any) when shutting down
+		if r.ckpt && !nlog.Stopping() {
+			r.joggers.RemoveCkpt(r.ID())
+		}
 		if cmn.IsErrAborted(errCause) {
 			return errCause
 		}
 		return cmn.NewErrAborted(r.Name(), "x-bck-jog", errCause)
 	case <-r.joggers.ListenFinished():
+		if r.ckpt {
+			r.joggers.RemoveCkpt(r.ID())
+		}
 		return r.joggers.Stop()
 	}
 }
@@ -85,7 +85,7 @@ func (r *DemandBase) Reset(idleTime time.Duration) { r.idle.d.Store(int64(idleTi
 func (r *DemandBase) hkcb(now int64) time.Duration {
 	idle := r.idle.d.Load()
 
-	if r.pending.Load() > 0 {
+	if r.pending.Load() > 0 || r.IsPaused() {
 		return time.Duration(idle)
 	}
 
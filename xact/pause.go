@@ -0,0 +1,103 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Pause and resume
+//
+// Only xaction kinds marked `Pausable` in the xact.Table can be paused.
+// A paused xaction keeps running (vis-à-vis xreg) but does no work:
+// - bucket joggers (fs/mpather) block before visiting the next object;
+// - list-range iterators (xact/xs/lrit.go) block before the next list entry or page;
+// - demand xactions do not time out while paused (see xact/demand.go).
+//
+// Bucket-jogging xactions that can be restarted (scrub, rechunk) also persist
+// their walk positions upon pause - see xact/bckjog.go and fs/mpather/ckpt.go.
+// When the target restarts, it restarts those xactions from the checkpoint,
+// in the paused state. For all other kinds, the in-memory state (walk positions,
+// iterator's continuation token, stats) is the checkpoint that remains valid
+// for as long as the xaction is not aborted.
+//
+// Abort and Finish always resume, so that paused workers wake up and exit.
+
+type pause struct {
+	ch chan struct{} // closed upon resume
+	at atomic.Int64  // time paused (Unix nanoseconds); zero when not paused
+	mu sync.Mutex
+}
+
+func (pz *pause) resume() bool {
+	pz.mu.Lock()
+	if pz.at.Load() == 0 {
+		pz.mu.Unlock()
+		return false
+	}
+	close(pz.ch)
+	pz.ch = nil
+	pz.at.Store(0)
+	pz.mu.Unlock()
+	return true
+}
+
+func (xctn *Base) Pause() error {
+	if !Table[xctn.kind].Pausable {
+		return cmn.NewErrUnsupp("pause", xctn.String())
+	}
+	pz := &xctn.pz
+	pz.mu.Lock()
+	switch {
+	case pz.at.Load() != 0:
+		// already paused - nothing to do
+	case !xctn.IsRunning():
+		pz.mu.Unlock()
+		return fmt.Errorf("cannot pause %s: not running", xctn.String())
+	default:
+		pz.ch = make(chan struct{})
+		pz.at.Store(time.Now().UnixNano())
+		nlog.Infoln(xctn.Name(), "paused")
+	}
+	pz.mu.Unlock()
+	return nil
+}
+
+func (xctn *Base) Resume() error {
+	if !Table[xctn.kind].Pausable {
+		return cmn.NewErrUnsupp("resume", xctn.String())
+	}
+	if xctn.pz.resume() {
+		nlog.Infoln(xctn.Name(), "resumed")
+	}
+	return nil
+}
+
+func (xctn *Base) IsPaused() bool { return xctn.pz.at.Load() != 0 }
+
+// block while paused, queued, or throttled (see sched.go);
+// return true if aborted (in the meantime or prior)
+// (implements cos.Pauser)
+func (xctn *Base) WaitIfPaused() (aborted bool) {
+	pz := &xctn.pz
+	if pz.at.Load() != 0 {
+		pz.mu.Lock()
+		ch := pz.ch
+		pz.mu.Unlock()
+		if ch != nil {
+			<-ch
+		}
+	}
+	if xctn.IsAborted() {
+		return true
+	}
+	return xctn.schedule()
+}
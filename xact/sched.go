@@ -0,0 +1,246 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Priority classes and scheduling of batch jobs
+//
+// Batch job's priority class is specified at start time (apc.ActMsg.Prio or xact.ArgsMsg.Prio)
+// and defaults to apc.PrioNormal. When enabled (config "xact_sched"):
+// - the cluster runs at most `max_<class>` jobs of a given class at a time; the rest remain
+//   "queued" - running but waiting for admission before doing any work;
+// - admission is decided by the primary proxy (see Admit below and ais/prxadmit.go), so that
+//   all targets run (or queue) a given job together; when the primary cannot be reached
+//   the target falls back to admitting locally;
+// - each target throttles low-priority jobs when disk utilization exceeds disk.disk_util_low_wm, and
+//   normal-priority jobs when it exceeds disk.disk_util_high_wm, to yield to user GET/PUT
+//   traffic whose latency is dominated by disk utilization;
+// - never throttles high-priority jobs.
+//
+// Scheduling points are the same as pause/resume (see pause.go): bucket joggers and
+// list-range iterators call WaitIfPaused before visiting the next object.
+
+const (
+	prioNormal = iota
+	prioLow
+	prioHigh
+	numPrio
+)
+
+const (
+	admitPoll    = 500 * time.Millisecond // queued jobs: check admission at this interval
+	throttleIval = 100 * time.Millisecond // recompute throttling delay
+	throttleMax  = 100 * time.Millisecond // max delay per visited object
+)
+
+type (
+	sched struct {
+		next  atomic.Int64 // mono time to recompute throttling delay
+		delay atomic.Int64 // current delay (ns)
+		prio  atomic.Int32 // priority class (enum above)
+		slot  atomic.Int32 // admitted class + 1; zero when not admitted
+		mu    sync.Mutex   // admission
+		qd    atomic.Bool  // queued
+		lcl   atomic.Bool  // admitted locally (see admission below)
+	}
+	// target-local admission counters (fallback)
+	admission struct {
+		running [numPrio]int
+		mu      sync.Mutex
+	}
+)
+
+var adm admission
+
+// cluster-wide admission by the primary proxy (set by the target);
+// Admit returns false when the job must remain queued
+var (
+	Admit   func(xid, prio string) (bool, error)
+	Unadmit func(xid, prio string)
+)
+
+func prio2class(prio string) int32 {
+	switch prio {
+	case apc.PrioLow:
+		return prioLow
+	case apc.PrioHigh:
+		return prioHigh
+	default:
+		return prioNormal
+	}
+}
+
+func class2prio(class int32) string {
+	switch class {
+	case prioLow:
+		return apc.PrioLow
+	case prioHigh:
+		return apc.PrioHigh
+	default:
+		return apc.PrioNormal
+	}
+}
+
+func (a *admission) acquire(class int32, limit int) (ok bool) {
+	a.mu.Lock()
+	if limit == 0 || a.running[class] < limit {
+		a.running[class]++
+		ok = true
+	}
+	a.mu.Unlock()
+	return ok
+}
+
+func (a *admission) release(class int32) {
+	a.mu.Lock()
+	a.running[class]--
+	debug.Assert(a.running[class] >= 0)
+	a.mu.Unlock()
+}
+
+//
+// Base
+//
+
+// NOTE: takes effect upon admission; expected to be called prior to xctn.Run
+func (xctn *Base) SetPrio(prio string) { xctn.sch.prio.Store(prio2class(prio)) }
+
+// waiting for admission
+func (xctn *Base) IsQueued() bool { return xctn.sch.qd.Load() }
+
+// admit (once) and throttle
+func (xctn *Base) schedule() (aborted bool) {
+	config := cmn.GCO.Get()
+	if !config.XactSched.Enabled || !Table[xctn.kind].Prio {
+		return false
+	}
+	if xctn.sch.slot.Load() == 0 {
+		if aborted = xctn.admit(); aborted {
+			return true
+		}
+	}
+	xctn.throttle(config)
+	return false
+}
+
+// NOTE: the lock is held only for the duration of a single attempt (not while queued)
+func (xctn *Base) admit() (aborted bool) {
+	sch := &xctn.sch
+	for {
+		if sch.slot.Load() != 0 {
+			break // admitted (possibly, by another worker)
+		}
+		conf := &cmn.GCO.Get().XactSched
+		if !conf.Enabled {
+			break // (disabled in the meantime)
+		}
+		sch.mu.Lock()
+		ok := sch.slot.Load() != 0 || xctn._admit(conf)
+		sch.mu.Unlock()
+		if ok {
+			break
+		}
+		if !sch.qd.Swap(true) {
+			nlog.Infoln(xctn.Name(), "queued: max running", class2prio(sch.prio.Load()), "priority jobs")
+		}
+		time.Sleep(admitPoll)
+		if xctn.IsAborted() || xctn.IsDone() {
+			sch.qd.Store(false)
+			return true
+		}
+	}
+	if sch.qd.CAS(true, false) {
+		nlog.Infoln(xctn.Name(), "admitted")
+	}
+	if xctn.IsDone() {
+		xctn.unadmit() // (finished in the meantime)
+		return true
+	}
+	return false
+}
+
+// single attempt: cluster-wide or, failing that, target-local
+func (xctn *Base) _admit(conf *cmn.XactSchedConf) bool {
+	var (
+		sch   = &xctn.sch
+		class = sch.prio.Load()
+		prio  = class2prio(class)
+	)
+	if Admit != nil {
+		ok, err := Admit(xctn.ID(), prio)
+		if err == nil {
+			if ok {
+				sch.slot.Store(class + 1)
+			}
+			return ok
+		}
+		if !sch.qd.Load() {
+			nlog.Warningln(xctn.Name(), "failed to get cluster-wide admission, admitting locally:", err)
+		}
+	}
+	if !adm.acquire(class, conf.MaxRunning(prio)) {
+		return false
+	}
+	sch.lcl.Store(true)
+	sch.slot.Store(class + 1)
+	return true
+}
+
+// upon finishing
+func (xctn *Base) unadmit() {
+	sch := &xctn.sch
+	slot := sch.slot.Swap(0)
+	switch {
+	case slot == 0:
+	case sch.lcl.Swap(false):
+		adm.release(slot - 1)
+	case Unadmit != nil:
+		Unadmit(xctn.ID(), class2prio(slot-1))
+	}
+}
+
+func (xctn *Base) throttle(config *cmn.Config) {
+	var (
+		sch   = &xctn.sch
+		class = sch.prio.Load()
+		wm    int64
+	)
+	switch class {
+	case prioLow:
+		wm = config.Disk.DiskUtilLowWM
+	case prioNormal:
+		wm = config.Disk.DiskUtilHighWM
+	default:
+		return
+	}
+	if now := mono.NanoTime(); now > sch.next.Load() {
+		sch.next.Store(now + throttleIval.Nanoseconds())
+		var (
+			delay int64
+			util  = fs.GetMaxUtil()
+			maxwm = max(config.Disk.DiskUtilMaxWM, wm+1)
+		)
+		if util > wm {
+			// linear: from zero at the watermark to throttleMax at (and above) disk_util_max_wm
+			delay = throttleMax.Nanoseconds() * (min(util, maxwm) - wm) / (maxwm - wm)
+		}
+		sch.delay.Store(delay)
+	}
+	if delay := sch.delay.Load(); delay > 0 {
+		time.Sleep(time.Duration(delay))
+	}
+}
@@ -6,6 +6,7 @@ package xact
 
 import (
 	ratomic "sync/atomic"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -41,6 +42,14 @@ func (xctn *Base) NewSnap(self core.Xact) (snap *core.Snap) {
 	xctn.ToStats(&snap.Stats)
 
 	snap.IdleX = self.IsIdle()
+	if class := xctn.sch.prio.Load(); class != prioNormal {
+		snap.Prio = class2prio(class)
+	}
+	snap.QueuedX = xctn.sch.qd.Load()
+	if at := xctn.pz.at.Load(); at != 0 {
+		snap.PausedX = true
+		snap.PauseTime = time.Unix(0, at)
+	}
 
 	func() {
 		defer func() {
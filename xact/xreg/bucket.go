@@ -85,6 +85,18 @@ func RenewBckRechunks(bck *meta.Bck, uuid string, msg *apc.RechunkMsg) RenewRes
 	return RenewBucketXact(apc.ActRechunk, bck, Args{Custom: msg, UUID: uuid})
 }
 
+func RenewBckScrub(bck *meta.Bck, uuid string, msg *apc.ScrubMsg) RenewRes {
+	return RenewBucketXact(apc.ActScrub, bck, Args{Custom: msg, UUID: uuid})
+}
+
+func RenewBckTier(bck *meta.Bck, uuid string) RenewRes {
+	return RenewBucketXact(apc.ActTier, bck, Args{UUID: uuid})
+}
+
+func RenewBckInventory(bck *meta.Bck, uuid string, args *InvArgs) RenewRes {
+	return RenewBucketXact(apc.ActInventory, bck, Args{Custom: args, UUID: uuid})
+}
+
 func RenewPutMirror(lom *core.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{Custom: lom})
 }
@@ -26,6 +26,10 @@ type (
 		Msg       *apc.TCOMsg
 		DisableDM bool
 	}
+	InvArgs struct {
+		BckTo *meta.Bck
+		Msg   *apc.InventoryMsg
+	}
 	DsortArgs struct {
 		BckFrom *meta.Bck
 		BckTo   *meta.Bck
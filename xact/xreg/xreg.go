@@ -302,6 +302,52 @@ func DoAbort(flt *Flt, err error) {
 	}
 }
 
+// pause (or resume) running xaction(s) that match the filter;
+// by kind and/or bucket, non-pausable kinds are skipped
+func DoPause(flt *Flt, resume bool) (n int, err error) {
+	pause := func(xctn core.Xact) error {
+		if resume {
+			return xctn.Resume()
+		}
+		return xctn.Pause()
+	}
+	if flt.ID != "" {
+		xctn, errV := dreg.getXact(flt.ID)
+		if errV != nil {
+			return 0, errV
+		}
+		if xctn == nil || xctn.IsDone() {
+			return 0, cmn.NewErrXactNotFoundError("ID=" + flt.ID)
+		}
+		if flt.Kind != "" && xctn.Kind() != flt.Kind {
+			return 0, cmn.NewErrXactNotFoundError("[kind=" + flt.Kind + " vs " + xctn.String() + "]")
+		}
+		if err = pause(xctn); err == nil {
+			n = 1
+		}
+		return n, err
+	}
+	dreg.entries.forEach(func(entry Renewable) bool {
+		xctn := entry.Get()
+		if xctn.IsDone() || !xact.Table[xctn.Kind()].Pausable {
+			return true
+		}
+		if flt.Kind != "" && xctn.Kind() != flt.Kind {
+			return true
+		}
+		if flt.Bck != nil && (xctn.Bck() == nil || !flt.Bck.Equal(xctn.Bck(), true /*sameID*/, true /*same backend*/)) {
+			return true
+		}
+		if errV := pause(xctn); errV != nil {
+			err = errV
+			return false
+		}
+		n++
+		return true
+	})
+	return n, err
+}
+
 func GetSnap(flt *Flt) ([]*core.Snap, error) {
 	var onl bool
 	if flt.OnlyRunning != nil {
@@ -8,7 +8,6 @@ package xs
 import (
 	"archive/tar"
 	"context"
-	"encoding/binary"
 	"fmt"
 	"io"
 	"net/http"
@@ -460,9 +459,7 @@ func (r *XactArch) _recv(hdr *transport.ObjHdr, objReader io.Reader) error {
 			// progress request during quiesce - respond with current count
 			o := transport.AllocSend()
 			o.Hdr.Opcode = transport.OpcResponse
-			b := make([]byte, cos.SizeofI64)
-			binary.BigEndian.PutUint64(b, uint64(r.Objs()))
-			o.Hdr.Opaque = b
+			o.Hdr.Opaque = progressOpaque(r.Objs(), r.IsQueued())
 			r.p.dm.Bcast(o, nil)
 		case transport.OpcResponse:
 			r.sntl.rxProgress(hdr)
@@ -0,0 +1,81 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Dry-run of evict, delete, destroy (evict) bucket, and rename bucket:
+// utilize lr-iterator (single-threaded) to visit all selected objects
+// and count those that would be affected - without making any modifications.
+// Not an xaction (runs synchronously and is not registered).
+
+type dryRun struct {
+	res     apc.DryRunRes
+	abortCh chan error
+	name    string
+	lrit
+	aborted atomic.Bool
+	remote  bool // count listed remote objects that are not present in the cluster
+}
+
+// interface guard
+var _ lrwi = (*dryRun)(nil)
+
+func DryRun(action string, bck *meta.Bck, msg *apc.EvdMsg) (*apc.DryRunRes, error) {
+	var (
+		r       = &dryRun{name: "dry-run-" + action + "-" + bck.Cname(""), abortCh: make(chan error, 1)}
+		lsflags uint64
+	)
+	switch action {
+	case apc.ActDeleteObjects:
+		// (deleting remote objects is not limited to those present in the cluster)
+		r.remote = bck.IsRemote()
+	case apc.ActEvictObjects, apc.ActEvictRemoteBck, apc.ActDestroyBck, apc.ActMoveBck:
+		r.local = true
+	default:
+		debug.Assert(false, action)
+	}
+	if msg.NonRecurs {
+		lsflags = apc.LsNoRecursion
+	}
+	if err := r.lrit.init(r, &msg.ListRange, bck, lsflags, nwpNone, 0 /*burst*/); err != nil {
+		return nil, err
+	}
+	err := r.lrit.run(r, core.T.Sowner().Get(), false /*prealloc buf*/)
+	r.lrit.wait()
+	return &r.res, err
+}
+
+func (r *dryRun) do(lom *core.LOM, lrit *lrit, _ []byte) {
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err == nil {
+		r.res.Add(lom.ObjName, lom.Lsize())
+		return
+	}
+	if r.remote && lrit.lrp == lrpPrefix {
+		r.res.Add(lom.ObjName, 0) // listed remotely, not present in the cluster
+	}
+}
+
+// cos.Stopper (as the lr-iterator's parent)
+func (r *dryRun) Name() string { return r.name }
+func (*dryRun) IsDone() bool   { return false }
+
+func (r *dryRun) IsAborted() bool         { return r.aborted.Load() }
+func (r *dryRun) ChanAbort() <-chan error { return r.abortCh }
+
+func (r *dryRun) Abort(err error) bool {
+	if !r.aborted.CAS(false, true) {
+		return false
+	}
+	r.abortCh <- err
+	return true
+}
@@ -56,6 +56,9 @@ func Tinit(coi COI) {
 	xreg.RegBckXact(&blobFactory{})
 
 	xreg.RegBckXact(&rechunkFactory{kind: apc.ActRechunk})
+	xreg.RegBckXact(&scrubFactory{})
+	xreg.RegBckXact(&invFactory{})
+	xreg.RegBckXact(&tierFactory{})
 
 	// assign COI singleton
 	gcoi = coi
@@ -0,0 +1,293 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/bsnap"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Inventory writes this target's share of the bucket - as of a point in time -
+// as a single inventory object (CSV, JSONL, or Parquet) into the destination bucket,
+// one record per object: name, size, checksum, version, atime, and custom metadata.
+// Point-in-time: the job iterates a bucket snapshot (apc.InventoryMsg.Snap) - the one
+// named by the user or a temporary one that the proxy creates for the job (see bsnap.Range).
+// The inventory is first written into a local workfile and then PUT into the
+// destination bucket (via the regular copy path, which handles placement).
+// Parquet output requires 'parquet' build tag (see invparquet_on.go).
+// See also: apc.InventoryMsg
+
+const invBufSize = 64 * cos.KiB
+
+type (
+	invFactory struct {
+		xreg.RenewBase
+		xctn *XactInventory
+	}
+	XactInventory struct {
+		args    *xreg.InvArgs
+		fh      *os.File
+		bw      *bufio.Writer
+		cw      *csv.Writer
+		pw      invRows
+		err     error // first write error
+		objName string
+		wfqn    string // workfile
+		xact.Base
+		mu sync.Mutex
+	}
+	// Parquet rows
+	invRows interface {
+		Write(e *apc.InvEntry) error
+		Close() error
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactInventory)(nil)
+	_ xreg.Renewable = (*invFactory)(nil)
+)
+
+////////////////
+// invFactory //
+////////////////
+
+func (*invFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &invFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *invFactory) Start() (err error) {
+	p.xctn, err = newInventory(p)
+	return err
+}
+
+func (*invFactory) Kind() string     { return apc.ActInventory }
+func (p *invFactory) Get() core.Xact { return p.xctn }
+
+func (p *invFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	if p.UUID() == prevEntry.UUID() {
+		return xreg.WprUse, nil
+	}
+	return xreg.WprKeepAndStartNew, nil
+}
+
+///////////////////
+// XactInventory //
+///////////////////
+
+func newInventory(p *invFactory) (*XactInventory, error) {
+	var (
+		args = p.Args.Custom.(*xreg.InvArgs)
+		r    = &XactInventory{args: args}
+	)
+	r.objName = args.Msg.ObjName(p.Bck.Name, p.UUID(), core.T.SID())
+
+	// workfile
+	dst := core.AllocLOM(r.objName)
+	err := dst.InitBck(args.BckTo)
+	if err == nil {
+		r.wfqn = dst.GenFQN(fs.WorkCT, fs.WorkfileInventory)
+		r.fh, err = cos.CreateFile(r.wfqn)
+	}
+	core.FreeLOM(dst)
+	if err != nil {
+		return nil, err
+	}
+	r.bw = bufio.NewWriterSize(r.fh, invBufSize)
+	switch args.Msg.Format {
+	case apc.InvFormatCSV:
+		r.cw = csv.NewWriter(r.bw)
+		r.err = r.cw.Write(apc.InvColumns[:])
+	case apc.InvFormatParquet:
+		if r.pw, err = newInvParquet(r.bw); err != nil {
+			cos.Close(r.fh)
+			cos.RemoveFile(r.wfqn)
+			return nil, err
+		}
+	}
+	r.InitBase(p.UUID(), apc.ActInventory, p.Bck)
+	return r, nil
+}
+
+func (r *XactInventory) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	nlog.Infoln(r.Name(), r.CtlMsg())
+
+	err := bsnap.Range(r.Bck(), r.args.Msg.Snap, r.args.Msg.Prefix, r, r.visit)
+	if err == nil {
+		err = r.flush()
+	}
+	if err == nil && !r.IsAborted() {
+		err = r.upload()
+	}
+	if err != nil && !r.IsAborted() {
+		r.AddErr(err)
+	}
+	if r.fh != nil {
+		cos.Close(r.fh)
+	}
+	if errR := cos.RemoveFile(r.wfqn); errR != nil && !os.IsNotExist(errR) {
+		nlog.Warningln(r.Name(), "failed to remove workfile:", errR)
+	}
+	nlog.Infoln(r.Name(), "done:", r.CtlMsg())
+	r.Finish()
+}
+
+// (lom: snapshotted version)
+func (r *XactInventory) visit(lom *core.LOM) error {
+	e := apc.InvEntry{Name: lom.ObjName, Size: lom.Lsize(true), Version: lom.Version()}
+	if cksum := lom.Checksum(); cksum != nil && cksum.Ty() != cos.ChecksumNone {
+		e.CksumType, e.CksumVal = cksum.Ty(), cksum.Val()
+	}
+	if atime := lom.AtimeUnix(); atime > 0 {
+		e.Atime = time.Unix(0, atime).UTC().Format(time.RFC3339Nano)
+	}
+	if !r.args.Msg.NoCustom {
+		e.Custom = lom.GetCustomMD()
+	}
+
+	r.mu.Lock()
+	if r.err == nil {
+		r.err = r.write(&e)
+	}
+	err := r.err
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	r.ObjsAdd(1, e.Size)
+	return nil
+}
+
+// under lock
+func (r *XactInventory) write(e *apc.InvEntry) error {
+	if r.pw != nil {
+		return r.pw.Write(e)
+	}
+	if r.cw == nil {
+		b, err := jsoniter.Marshal(e)
+		if err != nil {
+			return err
+		}
+		r.bw.Write(b)
+		return r.bw.WriteByte('\n')
+	}
+	var custom string
+	if len(e.Custom) > 0 {
+		b, err := jsoniter.Marshal(e.Custom)
+		if err != nil {
+			return err
+		}
+		custom = cos.UnsafeS(b)
+	}
+	return r.cw.Write([]string{e.Name, strconv.FormatInt(e.Size, 10), e.CksumType, e.CksumVal, e.Version, e.Atime, custom})
+}
+
+func (r *XactInventory) flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	switch {
+	case r.cw != nil:
+		r.cw.Flush()
+		if err := r.cw.Error(); err != nil {
+			return err
+		}
+	case r.pw != nil:
+		if err := r.pw.Close(); err != nil {
+			return err
+		}
+	}
+	if err := r.bw.Flush(); err != nil {
+		return err
+	}
+	err := r.fh.Close()
+	r.fh = nil
+	return err
+}
+
+// PUT workfile => destination bucket
+func (r *XactInventory) upload() error {
+	fh, err := cos.NewFileHandle(r.wfqn)
+	if err != nil {
+		return err
+	}
+	finfo, err := fh.Stat()
+	if err != nil {
+		cos.Close(fh)
+		return err
+	}
+	var (
+		size = finfo.Size()
+		oah  = &cmn.ObjAttrs{Size: size, Atime: time.Now().UnixNano()}
+		lom  = core.AllocLOM(r.objName)
+	)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.args.BckTo); err != nil {
+		cos.Close(fh)
+		return err
+	}
+	a := AllocCOI()
+	{
+		a.GetROC = func(*core.LOM, bool, bool, *core.ETLArgs) core.ReadResp {
+			return core.ReadResp{R: fh, OAH: oah}
+		}
+		a.Xact = r
+		a.Config = cmn.GCO.Get()
+		a.BckTo = r.args.BckTo
+		a.ObjnameTo = r.objName
+		a.OWT = cmn.OwtTransform // (generated content)
+		a.Finalize = true
+	}
+	res := gcoi.CopyObject(lom, nil /*DM*/, a)
+	FreeCOI(a)
+	if res.Err != nil {
+		return fmt.Errorf("failed to PUT inventory %s: %w", r.args.BckTo.Cname(r.objName), res.Err)
+	}
+	r.OutObjsAdd(1, size)
+	return nil
+}
+
+func (r *XactInventory) Snap() *core.Snap {
+	return r.Base.NewSnap(r)
+}
+
+func (r *XactInventory) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(128)
+	sb.WriteString("format:")
+	sb.WriteString(r.args.Msg.Format)
+	sb.WriteString(", dst:")
+	sb.WriteString(r.args.BckTo.Cname(r.objName))
+	sb.WriteString(", snapshot:")
+	sb.WriteString(r.args.Msg.Snap)
+	if r.args.Msg.Prefix != "" {
+		sb.WriteString(", prefix:")
+		sb.WriteString(r.args.Msg.Prefix)
+	}
+	return sb.String()
+}
@@ -0,0 +1,18 @@
+//go:build !parquet
+
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+func newInvParquet(io.Writer) (invRows, error) {
+	return nil, cmn.NewErrUnsupp("write", "Parquet inventory (aisnode built without 'parquet' build tag)")
+}
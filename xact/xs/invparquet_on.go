@@ -0,0 +1,33 @@
+//go:build parquet
+
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"io"
+
+	"github.com/NVIDIA/aistore/api/apc"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+const invRowGroupCnt = 128 * 1024 // max rows per row group
+
+type invParquet struct {
+	pw *parquet.GenericWriter[apc.InvEntry]
+}
+
+func newInvParquet(w io.Writer) (invRows, error) {
+	return &invParquet{parquet.NewGenericWriter[apc.InvEntry](w, parquet.MaxRowsPerRowGroup(invRowGroupCnt))}, nil
+}
+
+func (p *invParquet) Write(e *apc.InvEntry) error {
+	_, err := p.pw.Write([]apc.InvEntry{*e})
+	return err
+}
+
+func (p *invParquet) Close() error { return p.pw.Close() } // (writes footer)
@@ -69,6 +69,7 @@ type (
 		}
 		lsflags uint64 // traverse: assorted lsmsg flags (`LsNoRecursion`)
 		lrp     int    // enum { lrpList, ... }
+		local   bool   // traverse: in-cluster objects only (even when the bucket is remote)
 	}
 )
 
@@ -221,7 +222,12 @@ func (r *lrit) wait() {
 	r.nwp.wg.Wait()
 }
 
-func (r *lrit) done() bool { return r.parent.IsAborted() || r.parent.IsDone() }
+func (r *lrit) done() bool {
+	if pauser, ok := r.parent.(cos.Pauser); ok && pauser.WaitIfPaused() {
+		return true
+	}
+	return r.parent.IsAborted() || r.parent.IsDone()
+}
 
 func (r *lrit) _list(wi lrwi, smap *meta.Smap) error {
 	r.lrp = lrpList
@@ -267,7 +273,7 @@ func (r *lrit) _prefix(wi lrwi, smap *meta.Smap) error {
 		lst     *cmn.LsoRes
 		lsmsg   = &apc.LsoMsg{Prefix: r.prefix, Props: apc.GetPropsStatus, Flags: r.lsflags | apc.LsNoDirs}
 		npg     = newNpgCtx(r.bck, lsmsg, noopCb, nil /*inventory*/, nil /*bp: see below*/)
-		bremote = r.bck.IsRemote()
+		bremote = r.bck.IsRemote() && !r.local
 	)
 	if err := r.bck.Init(core.T.Bowner()); err != nil {
 		return err
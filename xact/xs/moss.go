@@ -637,9 +637,21 @@ func (r *XactMoss) Send(req *apc.MossReq, smap *meta.Smap, dt *meta.Snode /*DT*/
 
 func (r *XactMoss) _sendreg(tsi *meta.Snode, lom *core.LOM, wid, nameInArch string, index int) error {
 	var (
-		oah      = lom.ObjAttrs()
-		roc, err = lom.NewDeferROC(false /*loaded*/)
+		oah = lom.ObjAttrs()
+		roc cos.ReadOpenCloser
+		err error
 	)
+	// end-to-end protection (compare w/ _e2e below)
+	if lom.ValidateEndToEnd() {
+		lom.Lock(false)
+		if err = lom.Load(false /*cache it*/, true /*locked*/); err == nil {
+			err = lom.ValidateContentChecksum(true /*locked*/)
+		}
+		lom.Unlock(false)
+	}
+	if err == nil {
+		roc, err = lom.NewDeferROC(false /*loaded*/)
+	}
 	mopaque := &mossOpaque{
 		wid:   wid,
 		oname: lom.ObjName,
@@ -1458,6 +1470,22 @@ func (wi *basewi) _write(lom *core.LOM, in *apc.MossIn, out *apc.MossOut, nameIn
 		return 0, err
 	}
 
+	var reader io.Reader = lmfh
+	if lom.ValidateEndToEnd() {
+		var v *core.UfestVerifier
+		if v, err = _e2e(lom, lmfh, in.ArchPath); err != nil {
+			cos.Close(lmfh)
+			if cos.IsErrBadCksum(err) && wi.req.ContinueOnErr {
+				err = wi.addMissing(err, nameInArch, out)
+			}
+			return 0, err
+		}
+		if v != nil {
+			defer v.Close()
+			reader = v
+		}
+	}
+
 	switch {
 	case in.ArchPath != "":
 		nameInArch = _withArchpath(nameInArch, in.ArchPath)
@@ -1474,12 +1502,31 @@ func (wi *basewi) _write(lom *core.LOM, in *apc.MossIn, out *apc.MossOut, nameIn
 		}
 	default:
 		size = lom.Lsize()
-		err = wi._txreg(lom, lmfh, out, nameInArch)
+		err = wi._txreg(lom, reader, out, nameInArch)
 	}
 	cos.Close(lmfh)
 	return size, err
 }
 
+// end-to-end protection ('checksum.end_to_end'; compare w/ ais/tgtobj.go):
+// - monolithic object: validate in its entirety prior to reading
+// - chunked: validate on the fly, chunk by chunk, via returned verifier
+// - chunked shard: validate all chunks prior to reading archived file
+func _e2e(lom *core.LOM, lmfh cos.LomReader, archpath string) (*core.UfestVerifier, error) {
+	ur, chunked := lmfh.(*core.UfestReader)
+	switch {
+	case !chunked:
+		return nil, lom.ValidateContentChecksum(true /*locked*/)
+	case archpath != "":
+		v := ur.Verifier(0, lom.Lsize())
+		_, err := io.Copy(io.Discard, v)
+		v.Close()
+		return nil, err
+	default:
+		return ur.Verifier(0, lom.Lsize()), nil
+	}
+}
+
 func _withArchpath(nameInArch, archpath string) string {
 	if archpath[0] == '/' {
 		return nameInArch + archpath
@@ -207,6 +207,7 @@ func (r *XactNsumm) Run(started *sync.WaitGroup) {
 		go func(wg cos.WG) {
 			res := &r.oneRes
 			res.TotalSize.OnDisk = fs.OnDiskSize(r.p.Bck.Bucket(), r.p.msg.Prefix)
+			res.TotalSize.Snapshots = snapsSize(r.p.Bck)
 			wg.Done()
 		}(lwg)
 	} else {
@@ -217,6 +218,7 @@ func (r *XactNsumm) Run(started *sync.WaitGroup) {
 			lwg.Add(1)
 			go func(bck *meta.Bck, res *cmn.BsummResult, wg cos.WG) {
 				res.TotalSize.OnDisk = fs.OnDiskSize(bck.Bucket(), r.p.msg.Prefix)
+				res.TotalSize.Snapshots = snapsSize(bck)
 				wg.Done()
 			}(bck, res, lwg)
 		}
@@ -301,9 +303,18 @@ func (r *XactNsumm) Result() (cmn.AllBsummResults, error) {
 	return all, r.Err()
 }
 
+// space taken by bucket snapshots (preserved objects and manifests)
+func snapsSize(bck *meta.Bck) uint64 {
+	if bck.Props == nil || len(bck.Props.Snaps) == 0 {
+		return 0
+	}
+	return fs.OnDiskSizeCT(bck.Bucket(), fs.SnapCT)
+}
+
 func (r *XactNsumm) cloneRes(dst, src *cmn.BsummResult) {
 	dst.Bck = src.Bck
 	dst.TotalSize.OnDisk = src.TotalSize.OnDisk
+	dst.TotalSize.Snapshots = src.TotalSize.Snapshots
 
 	dst.ObjCount.Present = ratomic.LoadUint64(&src.ObjCount.Present)
 	dst.TotalSize.PresentObjs = ratomic.LoadUint64(&src.TotalSize.PresentObjs)
@@ -113,6 +113,7 @@ func newxactRechunk(p *rechunkFactory) (*xactRechunk, error) {
 			Slab:     slab,
 			Prefix:   args.Prefix,
 			DoLoad:   mpather.Load,
+			Ckpt:     true, // can restart paused
 			RW:       true,
 		}
 	)
@@ -125,6 +126,7 @@ func newxactRechunk(p *rechunkFactory) (*xactRechunk, error) {
 	mpopts.Bck.Copy(p.Bck.Bucket())
 
 	r.BckJog.Init(p.UUID(), p.Kind(), p.Bck, mpopts, config)
+	r.CkptArgs = args
 
 	return r, nil
 }
@@ -0,0 +1,552 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Scrub walks local mountpaths (one jogger per mountpath) and, for a given bucket:
+// - (optionally) recomputes content checksums and compares them with object metadata;
+// - detects missing mirror copies (bucket's mirror.copies);
+// - detects EC gaps: missing or damaged metafiles, slices w/o metafile, and
+//   missing full replicas (this target being the "main" one);
+// - detects orphaned chunks (not referenced by completed or partial manifests);
+// - detects EC slices and replicas that do not span distinct failure domains
+//   (see meta.Smap.HrwTargetList).
+//
+// With `apc.ScrubMsg.Fix` scrub also repairs: restores corrupted objects from a good
+// local copy, via EC recovery, or by re-fetching from the remote backend; re-mirrors;
+// re-encodes (including EC objects that do not span distinct failure domains); and removes orphans.
+// Like other bucket joggers, scrub throttles itself (see mpather.JgroupOpts.RW and cmn/load).
+// Per-bucket summary: `apc.ScrubStats` in `core.Snap.Ext`.
+
+type (
+	scrubFactory struct {
+		xreg.RenewBase
+		xctn *XactScrub
+	}
+	XactScrub struct {
+		smap       *meta.Smap
+		args       *apc.ScrubMsg
+		numDomains int // (when targets are labeled)
+		xact.BckJog
+		stats struct {
+			visited       atomic.Int64
+			corrupted     atomic.Int64
+			missingCopies atomic.Int64
+			ecGaps        atomic.Int64
+			orphanChunks  atomic.Int64
+			domains       atomic.Int64
+			repaired      atomic.Int64
+			failed        atomic.Int64
+		}
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactScrub)(nil)
+	_ xreg.Renewable = (*scrubFactory)(nil)
+)
+
+//////////////////
+// scrubFactory //
+//////////////////
+
+func (*scrubFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &scrubFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *scrubFactory) Start() error {
+	slab, err := core.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	debug.AssertNoErr(err)
+	p.xctn = newScrub(p, slab)
+	return nil
+}
+
+func (*scrubFactory) Kind() string     { return apc.ActScrub }
+func (p *scrubFactory) Get() core.Xact { return p.xctn }
+
+func (p *scrubFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, _ error) {
+	prev := prevEntry.(*scrubFactory)
+	if p.UUID() == prev.UUID() {
+		return xreg.WprUse, nil
+	}
+	var (
+		prevArgs = prev.Args.Custom.(*apc.ScrubMsg)
+		currArgs = p.Args.Custom.(*apc.ScrubMsg)
+	)
+	if *prevArgs == *currArgs {
+		return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+	}
+	return wpr, fmt.Errorf("%s is currently running with different options, cannot start a new %q",
+		prevEntry.Get(), p.Str(p.Kind()))
+}
+
+///////////////
+// XactScrub //
+///////////////
+
+func newScrub(p *scrubFactory, slab *memsys.Slab) (r *XactScrub) {
+	r = &XactScrub{args: p.Args.Custom.(*apc.ScrubMsg), smap: core.T.Sowner().Get()}
+	if r.smap.HasDomains() {
+		r.numDomains = len(r.smap.Domains())
+	}
+	mpopts := &mpather.JgroupOpts{
+		Parent:   r,
+		CTs:      []string{fs.ObjCT},
+		VisitObj: r.visitObj,
+		VisitCT:  r.visitCT,
+		Slab:     slab,
+		Prefix:   r.args.Prefix,
+		Ckpt:     true, // can restart paused
+		RW:       true, // throttle
+		// NOTE: not loading - handling corrupted metadata (see visitObj)
+	}
+	if p.Bck.Props.EC.Enabled {
+		mpopts.CTs = append(mpopts.CTs, fs.ECMetaCT, fs.ECSliceCT)
+	}
+	if p.Bck.Props.Chunks.ChunkSize > 0 || p.Bck.Props.Chunks.ObjSizeLimit > 0 {
+		mpopts.CTs = append(mpopts.CTs, fs.ChunkCT)
+	}
+	mpopts.Bck.Copy(p.Bck.Bucket())
+	r.BckJog.Init(p.UUID(), apc.ActScrub, p.Bck, mpopts, cmn.GCO.Get())
+	r.CkptArgs = r.args
+	return r
+}
+
+func (r *XactScrub) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	r.BckJog.Run()
+	nlog.Infoln(r.Name(), r.CtlMsg())
+
+	err := r.BckJog.Wait()
+	if err != nil && !r.IsAborted() {
+		r.AddErr(err)
+	}
+	nlog.Infoln(r.Name(), "done:", r.CtlMsg())
+	r.Finish()
+}
+
+func (r *XactScrub) visitObj(lom *core.LOM, buf []byte) error {
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		switch {
+		case cos.IsNotExist(err):
+			// deleted or moved in the meantime
+		case cmn.IsErrLmetaCorrupted(err):
+			r.stats.corrupted.Inc()
+			nlog.Warningln(r.Name(), err)
+			if r.args.Fix {
+				r._fixed(r.refetch(lom))
+			}
+		default:
+			r.AddErr(err, 4, cos.ModXs)
+		}
+		return nil
+	}
+	if lom.IsCopy() {
+		return nil
+	}
+	r.stats.visited.Inc()
+	r.ObjsAdd(1, lom.Lsize())
+
+	// 1. checksum
+	if r.args.Checksum && !cos.NoneC(lom.Checksum()) {
+		lom.Lock(false)
+		err := lom.ValidateMetaChecksum()
+		if err == nil {
+			err = lom.ValidateContentChecksum(true /*locked*/)
+		}
+		lom.Unlock(false)
+		if err != nil {
+			if !cos.IsErrBadCksum(err) {
+				r.AddErr(err, 4, cos.ModXs)
+				return nil
+			}
+			r.stats.corrupted.Inc()
+			nlog.Warningln(r.Name(), err)
+			if r.args.Fix {
+				r._fixed(r.restore(lom, buf))
+			}
+			return nil
+		}
+	}
+
+	// 2. copies
+	if mirror := lom.MirrorConf(); mirror.Enabled && mirror.Copies > 1 {
+		if lom.NumCopies() < int(mirror.Copies) || r.lostCopies(lom) {
+			r.stats.missingCopies.Inc()
+			if r.args.Fix {
+				r._fixed(r.remirror(lom, int(mirror.Copies), buf))
+			}
+		}
+	}
+
+	// 3. EC: the main target must have metafile
+	if lom.ECEnabled() {
+		if _, local, err := lom.HrwTarget(r.smap); err == nil && local {
+			if err := cos.Stat(lom.GenFQN(fs.ECMetaCT)); cos.IsNotExist(err) {
+				r.stats.ecGaps.Inc()
+				if r.args.Fix {
+					r.reencode(lom)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *XactScrub) visitCT(ct *core.CT, _ []byte) error {
+	switch ct.ContentType() {
+	case fs.ECMetaCT:
+		md, err := ec.LoadMetadata(ct.FQN())
+		if err != nil {
+			if !cos.IsNotExist(err) {
+				r.stats.ecGaps.Inc()
+				nlog.Warningln(r.Name(), err)
+				if r.args.Fix {
+					r._fixed(r.recover(ct, true /*damaged*/))
+				}
+			}
+			return nil
+		}
+		// this target is supposed to store the full replica
+		if md.SliceID == 0 && md.FullReplica == core.T.SID() {
+			if err := cos.Stat(ct.GenFQN(fs.ObjCT)); cos.IsNotExist(err) {
+				r.stats.ecGaps.Inc()
+				if r.args.Fix {
+					r._fixed(r.recover(ct, false))
+				}
+			}
+			if r.numDomains > 0 && !r.smap.Spread(md.Daemons, r.numDomains) {
+				r.stats.domains.Inc()
+				if r.args.Fix {
+					r.respread(ct)
+				}
+			}
+		}
+	case fs.ECSliceCT:
+		if err := cos.Stat(ct.GenFQN(fs.ECMetaCT)); cos.IsNotExist(err) {
+			// unusable slice (the main target's re-encode will regenerate it)
+			r.stats.ecGaps.Inc()
+			if r.args.Fix {
+				r._fixed(cos.RemoveFile(ct.FQN()))
+			}
+		}
+	case fs.ChunkCT:
+		if r.orphanChunk(ct) {
+			r.stats.orphanChunks.Inc()
+			if r.args.Fix {
+				r._fixed(cos.RemoveFile(ct.FQN()))
+			}
+		}
+	default:
+		debug.Assert(false, ct.ContentType())
+	}
+	return nil
+}
+
+func (r *XactScrub) _fixed(err error) {
+	if err == nil {
+		r.stats.repaired.Inc()
+		return
+	}
+	r.stats.failed.Inc()
+	r.AddErr(err, 0)
+}
+
+// metadata says so but the replica's not there
+func (*XactScrub) lostCopies(lom *core.LOM) bool {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	for copyFQN := range lom.GetCopies() {
+		if err := cos.Stat(copyFQN); cos.IsNotExist(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// (compare with mirror/utils addCopies)
+func (*XactScrub) remirror(lom *core.LOM, copies int, buf []byte) error {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+
+	lom.UncacheUnless()
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	var lost []string
+	for copyFQN := range lom.GetCopies() {
+		if copyFQN != lom.FQN && cos.IsNotExist(cos.Stat(copyFQN)) {
+			lost = append(lost, copyFQN)
+		}
+	}
+	if len(lost) > 0 {
+		if err := lom.DelCopies(lost...); err != nil {
+			return err
+		}
+	}
+	for lom.NumCopies() < copies {
+		mi := lom.LeastUtilNoCopy()
+		if mi == nil {
+			return fmt.Errorf("%s (copies=%d): cannot find dst mountpath", lom, lom.NumCopies())
+		}
+		if err := lom.Copy(mi, buf); err != nil {
+			return err
+		}
+	}
+	return lom.Persist()
+}
+
+// restore corrupted object: from a good local copy, via EC, or from remote backend;
+// in the latter two cases, the corrupted object is first set aside and then
+// either removed (upon success) or put back (upon failure)
+func (r *XactScrub) restore(lom *core.LOM, buf []byte) error {
+	lom.Lock(true)
+	if lom.HasCopies() {
+		for copyFQN := range lom.GetCopies() {
+			if copyFQN == lom.FQN {
+				continue
+			}
+			if err := r._fromCopy(lom, copyFQN, buf); err == nil {
+				lom.Unlock(true)
+				return nil
+			}
+		}
+	}
+	if !lom.ECEnabled() && !lom.Bck().IsRemote() {
+		lom.Unlock(true)
+		return fmt.Errorf("%s: cannot restore corrupted %s (no good copies, no EC, no remote backend)", r.Name(), lom.Cname())
+	}
+	wfqn := lom.GenFQN(fs.WorkCT, fs.WorkfileScrub)
+	if err := lom.RenameMainTo(wfqn); err != nil {
+		lom.Unlock(true)
+		return err
+	}
+	lom.UncacheDel()
+	lom.Unlock(true)
+
+	var err error
+	if lom.ECEnabled() {
+		err = ec.ECM.Recover(lom)
+	}
+	if (err != nil || !lom.ECEnabled()) && lom.Bck().IsRemote() {
+		err = r.refetch(lom)
+	}
+	if err == nil {
+		if errV := cos.RemoveFile(wfqn); errV != nil {
+			nlog.Warningln(r.Name(), "failed to remove", wfqn, errV)
+		}
+		return nil
+	}
+	r._putBack(lom, wfqn)
+	return err
+}
+
+// restore failed: put the (corrupted) original back unless the object has been written in the meantime
+func (r *XactScrub) _putBack(lom *core.LOM, wfqn string) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if cos.IsNotExist(cos.Stat(lom.FQN)) {
+		if err := lom.RenameToMain(wfqn); err == nil {
+			lom.UncacheDel()
+			return
+		}
+	}
+	if err := cos.RemoveFile(wfqn); err != nil {
+		nlog.Warningln(r.Name(), "failed to remove", wfqn, err)
+	}
+}
+
+// under w-lock
+func (*XactScrub) _fromCopy(lom *core.LOM, copyFQN string, buf []byte) error {
+	cplom := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(cplom)
+	if err := cplom.InitFQN(copyFQN, lom.Bucket()); err != nil {
+		return err
+	}
+	if err := cplom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	if err := cplom.ValidateContentChecksum(true /*locked*/); err != nil {
+		return err
+	}
+	dst, err := cplom.Copy2FQN(lom.FQN, buf)
+	if err == nil {
+		core.FreeLOM(dst)
+	}
+	return err
+}
+
+func (r *XactScrub) refetch(lom *core.LOM) error {
+	if !lom.Bck().IsRemote() {
+		return fmt.Errorf("%s: cannot re-fetch %s (not a remote bucket)", r.Name(), lom.Cname())
+	}
+	_, err := core.T.GetCold(context.Background(), lom, r.Kind(), cmn.OwtGetLock)
+	return err
+}
+
+// - damaged metafile: remove it, and then re-encode or recover (main target only)
+// - missing full replica: recover
+func (r *XactScrub) recover(ct *core.CT, damaged bool) error {
+	lom := core.AllocLOM(ct.ObjectName())
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(ct.Bck()); err != nil {
+		return err
+	}
+	if damaged {
+		if err := cos.RemoveFile(ct.FQN()); err != nil {
+			return err
+		}
+	}
+	_, local, err := lom.HrwTarget(r.smap)
+	if err != nil {
+		return err
+	}
+	if cos.IsNotExist(cos.Stat(lom.FQN)) {
+		if local || !damaged {
+			return ec.ECM.Recover(lom)
+		}
+		return nil
+	}
+	if local && damaged {
+		if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+			return err
+		}
+		r.reencode(lom)
+	}
+	return nil
+}
+
+func (r *XactScrub) reencode(lom *core.LOM) {
+	err := ec.ECM.EncodeObject(lom, func(_ *core.LOM, err error) { r._fixed(err) })
+	if err != nil {
+		r._fixed(err)
+	}
+}
+
+// re-encode (main target only) to place slices and replicas across distinct failure domains;
+// slices left on the previous holders get removed (see ec putJogger.encode)
+// - not while rebalance or resilver is (or may still be) moving the object's slices
+func (r *XactScrub) respread(ct *core.CT) {
+	var (
+		g = xreg.GetRebMarked()
+		l = xreg.GetResilverMarked()
+	)
+	if g.Xact != nil || g.Interrupted || l.Xact != nil || l.Interrupted {
+		return
+	}
+	lom := core.AllocLOM(ct.ObjectName())
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(ct.Bck()); err != nil {
+		r._fixed(err)
+		return
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		r._fixed(err)
+		return
+	}
+	r.reencode(lom)
+}
+
+// (compare with space/cleanup visitChunk)
+func (r *XactScrub) orphanChunk(ct *core.CT) bool {
+	contentInfo := fs.CSM.ParseUbase(ct.ObjectName(), fs.ChunkCT)
+	if !contentInfo.Ok {
+		return false // (leaving it to space cleanup)
+	}
+	finfo, err := os.Lstat(ct.FQN())
+	if err != nil || time.Since(finfo.ModTime()) < r.Config.Space.DontCleanupTime.D() {
+		return false // in-progress upload
+	}
+	var (
+		uploadID = contentInfo.Extras[0]
+		lom      = core.AllocLOM(contentInfo.Base)
+	)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(ct.Bck()); err != nil {
+		return false
+	}
+	if err := cos.Stat(lom.GenFQN(fs.ChunkMetaCT, uploadID)); !cos.IsNotExist(err) {
+		return false // partial manifest exists (or cannot tell)
+	}
+
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return cos.IsNotExist(err) // only when the object is definitely gone
+	}
+	if !lom.IsChunked() {
+		return true
+	}
+	ufest, err := core.NewUfest("", lom, true /*must-exist*/)
+	if err != nil {
+		return false
+	}
+	if err := ufest.LoadCompleted(lom); err != nil {
+		return false
+	}
+	return ufest.ID() != uploadID
+}
+
+func (r *XactScrub) Snap() *core.Snap {
+	snap := r.Base.NewSnap(r)
+	snap.Ext = r.scrubStats()
+	return snap
+}
+
+func (r *XactScrub) scrubStats() *apc.ScrubStats {
+	return &apc.ScrubStats{
+		Visited:       r.stats.visited.Load(),
+		Corrupted:     r.stats.corrupted.Load(),
+		MissingCopies: r.stats.missingCopies.Load(),
+		ECGaps:        r.stats.ecGaps.Load(),
+		OrphanChunks:  r.stats.orphanChunks.Load(),
+		Domains:       r.stats.domains.Load(),
+		Repaired:      r.stats.repaired.Load(),
+		Failed:        r.stats.failed.Load(),
+	}
+}
+
+func (r *XactScrub) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(128)
+	sb.WriteString("checksum:")
+	sb.WriteString(strconv.FormatBool(r.args.Checksum))
+	sb.WriteString(", fix:")
+	sb.WriteString(strconv.FormatBool(r.args.Fix))
+	if r.args.Prefix != "" {
+		sb.WriteString(", prefix:")
+		sb.WriteString(r.args.Prefix)
+	}
+	if nv := r.stats.visited.Load(); nv > 0 {
+		sb.WriteString(", visited:")
+		sb.WriteString(strconv.FormatInt(nv, 10))
+	}
+	return sb.String()
+}
@@ -27,6 +27,7 @@ type (
 	apair struct {
 		last     atomic.Int64 // last progress update
 		progress atomic.Int64 // num visited objects
+		queued   atomic.Bool  // waiting for admission (see xact/sched.go)
 	}
 	sentinel struct {
 		r      core.Xact
@@ -101,6 +102,15 @@ func (s *sentinel) initLast(now int64) {
 	}
 }
 
+func (s *sentinel) bumpLast(now int64) {
+	for tid := range s.pend.m {
+		apair := s.pend.m[tid]
+		if last := apair.last.Load(); last != 0 && last != apairDeleted {
+			apair.last.CAS(last, now)
+		}
+	}
+}
+
 // Qival returns the quiesce check interval based on config
 func (s *sentinel) qival() time.Duration {
 	return cos.ClampDuration(s.config.Timeout.MaxHostBusy.D(), 10*time.Second, time.Minute)
@@ -111,6 +121,11 @@ func (s *sentinel) qcb(tot time.Duration) core.QuiRes {
 		return core.QuiDone
 	}
 	// have "pending" targets
+	if s.r.IsPaused() {
+		// paused cluster-wide: keep waiting, don't count towards progress timeout
+		s.bumpLast(mono.NanoTime())
+		return core.QuiActiveDontBump
+	}
 	progressTimeout := max(s.config.Timeout.SendFile.D(), time.Minute)
 	return s._qcb(tot, s.qival(), progressTimeout, s.r.ErrCnt())
 }
@@ -145,6 +160,11 @@ func (s *sentinel) _qcb(tot, ival, progressTimeout time.Duration, ecnt int) core
 		apair := s.pend.m[tid]
 		if last := apair.last.Load(); last != apairDeleted {
 			debug.Assert(last != 0)
+			if apair.queued.Load() {
+				// queued time doesn't count towards progress timeout
+				apair.last.CAS(last, now)
+				continue
+			}
 			if since := time.Duration(now - last); since > progressTimeout {
 				err := fmt.Errorf("%s: timed out waiting for %s [ %v, %v, %v ]", s.r.Name(), meta.Tname(tid), since, tot, s.pend.p)
 				return s._qabort(err)
@@ -224,6 +244,16 @@ func (s *sentinel) rxAbort(hdr *transport.ObjHdr) {
 	nlog.WarningDepth(1, err)
 }
 
+// progress response: [num visited objects (8 bytes) | queued (1 byte)]
+func progressOpaque(numvis int64, queued bool) []byte {
+	b := make([]byte, cos.SizeofI64+1)
+	binary.BigEndian.PutUint64(b, uint64(numvis))
+	if queued {
+		b[cos.SizeofI64] = 1
+	}
+	return b
+}
+
 func (s *sentinel) rxProgress(hdr *transport.ObjHdr) {
 	var (
 		numvis = int64(binary.BigEndian.Uint64(hdr.Opaque))
@@ -233,6 +263,7 @@ func (s *sentinel) rxProgress(hdr *transport.ObjHdr) {
 		debug.Assert(false, "missing apair ", hdr.SID)
 		return
 	}
+	apair.queued.Store(len(hdr.Opaque) > cos.SizeofI64 && hdr.Opaque[cos.SizeofI64] != 0)
 	prev := apair.progress.Swap(numvis)
 	debug.Assert(prev <= numvis, "progress regression: ", prev, " > ", numvis)
 	// always update last: receiving a response means the target is alive
@@ -6,7 +6,6 @@
 package xs
 
 import (
-	"encoding/binary"
 	"io"
 	"strconv"
 	"sync"
@@ -415,10 +414,9 @@ func (r *XactTCB) recv(hdr *transport.ObjHdr, objReader io.Reader, err error) er
 		case transport.OpcRequest:
 			o := transport.AllocSend()
 			o.Hdr.Opcode = transport.OpcResponse
-			b := make([]byte, cos.SizeofI64)
-			binary.BigEndian.PutUint64(b, uint64(r.BckJog.NumVisits())) // report progress
-			o.Hdr.Opaque = b
-			r.dm.Bcast(o, nil) // TODO: consider limiting this broadcast to only quiescing (waiting) targets
+			o.Hdr.Opaque = progressOpaque(r.BckJog.NumVisits(), r.IsQueued()) // report progress
+			// TODO: consider limiting this broadcast to only quiescing (waiting) targets
+			r.dm.Bcast(o, nil)
 		case transport.OpcResponse:
 			r.sntl.rxProgress(hdr) // handle response: progress by others
 		default:
@@ -6,7 +6,6 @@
 package xs
 
 import (
-	"encoding/binary"
 	"fmt"
 	"io"
 	"strconv"
@@ -410,9 +409,7 @@ func (r *XactTCO) _recv(hdr *transport.ObjHdr, objReader io.Reader) error {
 			// progress request during quiesce - respond with current count
 			o := transport.AllocSend()
 			o.Hdr.Opcode = transport.OpcResponse
-			b := make([]byte, cos.SizeofI64)
-			binary.BigEndian.PutUint64(b, uint64(r.Objs()))
-			o.Hdr.Opaque = b
+			o.Hdr.Opaque = progressOpaque(r.Objs(), r.IsQueued())
 			r.p.dm.Bcast(o, nil)
 		case transport.OpcResponse:
 			r.sntl.rxProgress(hdr)
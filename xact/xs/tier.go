@@ -0,0 +1,193 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Tiering walks local mountpaths and moves objects of a given bucket between its
+// storage classes (bucket property "tier" - see cmn.TierConf and core/ltier.go):
+// - demotes (hot => cold) objects that were not accessed for `tier.demote_after`;
+// - promotes (cold => hot) objects that were read at least `tier.promote_hits` times;
+// - pins (=> hot) objects that reside in neither class, e.g. the ones written prior
+//   to configuring the bucket.
+// Objects are moved within the target (to their HRW mountpath in the destination class)
+// under write lock; chunked objects move together with their manifests and chunks. To run periodically, schedule the xaction (see xact/api_cron.go).
+// Per-bucket summary: `apc.TierStats` in `core.Snap.Ext`.
+
+type (
+	tierFactory struct {
+		xreg.RenewBase
+		xctn *XactTier
+	}
+	XactTier struct {
+		conf  cmn.TierConf
+		avail fs.MPI
+		now   int64
+		xact.BckJog
+		stats struct {
+			visited  atomic.Int64
+			demoted  atomic.Int64
+			promoted atomic.Int64
+			pinned   atomic.Int64
+			skipped  atomic.Int64
+		}
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactTier)(nil)
+	_ xreg.Renewable = (*tierFactory)(nil)
+)
+
+/////////////////
+// tierFactory //
+/////////////////
+
+func (*tierFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &tierFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *tierFactory) Start() error {
+	slab, err := core.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	debug.AssertNoErr(err)
+	p.xctn = newTier(p, slab)
+	return nil
+}
+
+func (*tierFactory) Kind() string     { return apc.ActTier }
+func (p *tierFactory) Get() core.Xact { return p.xctn }
+
+func (*tierFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+//////////////
+// XactTier //
+//////////////
+
+func newTier(p *tierFactory, slab *memsys.Slab) (r *XactTier) {
+	r = &XactTier{conf: p.Bck.Props.Tier, avail: fs.GetAvail(), now: time.Now().UnixNano()}
+	mpopts := &mpather.JgroupOpts{
+		Parent:   r,
+		CTs:      []string{fs.ObjCT},
+		VisitObj: r.visitObj,
+		Slab:     slab,
+		Prefix:   r.conf.Prefix,
+		RW:       true, // throttle
+	}
+	mpopts.Bck.Copy(p.Bck.Bucket())
+	r.BckJog.Init(p.UUID(), apc.ActTier, p.Bck, mpopts, cmn.GCO.Get())
+	return r
+}
+
+func (r *XactTier) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	r.BckJog.Run()
+	nlog.Infoln(r.Name(), r.CtlMsg())
+
+	err := r.BckJog.Wait()
+	if err != nil && !r.IsAborted() {
+		r.AddErr(err)
+	}
+	nlog.Infoln(r.Name(), "done:", r.CtlMsg())
+	r.Finish()
+}
+
+func (r *XactTier) visitObj(lom *core.LOM, buf []byte) error {
+	conf := lom.TierConf()
+	if conf == nil {
+		return nil
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cos.IsNotExist(err) {
+			r.AddErr(err, 4, cos.ModXs)
+		}
+		return nil
+	}
+	r.stats.visited.Inc()
+	if lom.HasCopies() || fs.IsFntl(lom.ObjName) {
+		r.stats.skipped.Inc()
+		return nil
+	}
+
+	var (
+		dst *fs.Mountpath
+		cnt *atomic.Int64
+		mi  = lom.Mountpath()
+	)
+	switch {
+	case lom.IsCold(conf):
+		if lom.TierHits() >= conf.PromoteHitsN() {
+			dst, cnt = lom.TierMpath(r.avail, conf.Hot), &r.stats.promoted
+		}
+	case conf.Cold != "" && r.now-lom.AtimeUnix() > int64(conf.DemoteAfterD()):
+		dst, cnt = lom.TierMpath(r.avail, conf.Cold), &r.stats.demoted
+	case mi.Label != cos.MountpathLabel(conf.Hot):
+		dst, cnt = lom.TierMpath(r.avail, conf.Hot), &r.stats.pinned
+	}
+	if dst == nil || dst.Path == mi.Path {
+		return nil // (no mountpaths labeled with the destination class)
+	}
+
+	size := lom.Lsize()
+	moved, err := lom.MoveTier(dst, buf)
+	if err != nil {
+		r.AddErr(err, 4, cos.ModXs)
+		return nil
+	}
+	core.FreeLOM(moved)
+	cnt.Inc()
+	r.ObjsAdd(1, size)
+	return nil
+}
+
+func (r *XactTier) Snap() *core.Snap {
+	snap := r.Base.NewSnap(r)
+	snap.Ext = &apc.TierStats{
+		Visited:  r.stats.visited.Load(),
+		Demoted:  r.stats.demoted.Load(),
+		Promoted: r.stats.promoted.Load(),
+		Pinned:   r.stats.pinned.Load(),
+		Skipped:  r.stats.skipped.Load(),
+	}
+	return snap
+}
+
+func (r *XactTier) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(128)
+	sb.WriteString(r.conf.String())
+	if nv := r.stats.visited.Load(); nv > 0 {
+		sb.WriteString(", visited:")
+		sb.WriteString(strconv.FormatInt(nv, 10))
+		sb.WriteString(", demoted:")
+		sb.WriteString(strconv.FormatInt(r.stats.demoted.Load(), 10))
+		sb.WriteString(", promoted:")
+		sb.WriteString(strconv.FormatInt(r.stats.promoted.Load(), 10))
+	}
+	return sb.String()
+}